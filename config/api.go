@@ -15,6 +15,10 @@ type Api struct {
 
 	RequestLog bool `split_words:"true" default:"false"`
 	RunSwagger bool `split_words:"true" default:"true"`
+
+	// TrustedProxies lists the addresses and CIDR ranges of the reverse
+	// proxies whose X-Real-Ip and X-Forwarded-For headers are believed.
+	TrustedProxies []string `split_words:"true"`
 }
 
 func API() Api {
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+type Auth struct {
+	// RequireVerifiedEmail makes login refuse accounts whose email address
+	// has not been verified yet.
+	RequireVerifiedEmail bool `split_words:"true" default:"false"`
+
+	VerificationTTL            time.Duration `split_words:"true" default:"24h"`
+	VerificationResendInterval time.Duration `split_words:"true" default:"1m"`
+	// VerificationURL is the link mailed to users, with the token appended
+	// as the "token" query parameter.
+	VerificationURL string `split_words:"true" default:"http://localhost:3080/api/v1/verify-email"`
+
+	PasswordResetTTL            time.Duration `split_words:"true" default:"30m"`
+	PasswordResetResendInterval time.Duration `split_words:"true" default:"1m"`
+	// PasswordResetURL is the page where users choose a new password, with
+	// the token appended as the "token" query parameter.
+	PasswordResetURL string `split_words:"true" default:"http://localhost:3080/reset-password"`
+
+	// TOTPIssuer is the account label shown in authenticator apps.
+	TOTPIssuer string `envconfig:"TOTP_ISSUER" default:"go-goss"`
+	// TwoFactorRoles lists roles whose members must enable two-factor
+	// authentication before role checks let them through, e.g.
+	// "admin,super_admin". Empty disables enforcement.
+	TwoFactorRoles []string `split_words:"true"`
+
+	// Failed logins are counted per email and per IP within
+	// LoginAttemptWindow. Past the free attempts every further try has to
+	// wait twice as long as the previous one, from LoginBackoffBase up to
+	// LoginBackoffMax. LoginLockThreshold failures for one email lock the
+	// account until an admin unlocks it.
+	LoginAttemptWindow  time.Duration `split_words:"true" default:"1h"`
+	LoginFreeAttempts   int           `split_words:"true" default:"3"`
+	LoginIPFreeAttempts int           `envconfig:"LOGIN_IP_FREE_ATTEMPTS" default:"20"`
+	LoginBackoffBase    time.Duration `split_words:"true" default:"1s"`
+	LoginBackoffMax     time.Duration `split_words:"true" default:"15m"`
+	LoginLockThreshold  int           `split_words:"true" default:"10"`
+}
+
+func NewAuth() Auth {
+	var a Auth
+	envconfig.MustProcess("AUTH", &a)
+
+	return a
+}
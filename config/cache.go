@@ -16,6 +16,7 @@ type Cache struct {
 	User      string
 	Pass      string
 	CacheTime time.Duration `split_words:"true" default:"5s"`
+	LRUSize   int           `split_words:"true" default:"1000"`
 }
 
 func NewCache() Cache {
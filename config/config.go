@@ -16,6 +16,14 @@ type Config struct {
 
 	OpenTelemetry
 	Session
+	Auth
+	OIDC
+	RateLimit
+	Idempotency
+
+	Payment
+	Storage
+	Mail
 }
 
 func New() *Config {
@@ -31,6 +39,13 @@ func New() *Config {
 		Cache:         NewCache(),
 		Elasticsearch: ElasticSearch(),
 		Session:       NewSession(),
+		Auth:          NewAuth(),
+		OIDC:          NewOIDC(),
+		RateLimit:     NewRateLimit(),
+		Idempotency:   NewIdempotency(),
 		OpenTelemetry: NewOpenTelemetry(),
+		Payment:       NewPayment(),
+		Storage:       NewStorage(),
+		Mail:          NewMail(),
 	}
 }
@@ -16,6 +16,10 @@ type Session struct {
 	HttpOnly bool            `split_words:"true" default:"true"`
 	Secure   bool            `default:"true"`
 	SameSite SameSiteDecoder `split_words:"true" default:"lax"`
+
+	// Store selects where sessions are kept: "postgres" or "redis". Redis
+	// needs the cache to be enabled.
+	Store string `default:"postgres"`
 }
 
 func NewSession() Session {
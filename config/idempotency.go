@@ -0,0 +1,25 @@
+package config
+
+import (
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+// Idempotency controls how responses to requests sent with an
+// Idempotency-Key header are kept. They are kept in Redis when it is enabled.
+type Idempotency struct {
+	Enable bool `default:"true"`
+	// TTL is how long a response is replayed for retries.
+	TTL time.Duration `default:"24h"`
+	// LockTimeout is how long a key stays claimed by a request that has not
+	// completed, e.g. because its server stopped.
+	LockTimeout time.Duration `split_words:"true" default:"1m"`
+}
+
+func NewIdempotency() Idempotency {
+	var i Idempotency
+	envconfig.MustProcess("IDEMPOTENCY", &i)
+
+	return i
+}
@@ -0,0 +1,26 @@
+package config
+
+import (
+	"github.com/kelseyhightower/envconfig"
+)
+
+type Mail struct {
+	// Driver selects how emails are delivered: "log", "file" or "smtp".
+	Driver string `default:"log"`
+	From   string `default:"no-reply@localhost"`
+
+	// Dir is where the file driver writes one .eml file per message.
+	Dir string `default:"./mail"`
+
+	SMTPHost string `envconfig:"SMTP_HOST" default:"localhost"`
+	SMTPPort string `envconfig:"SMTP_PORT" default:"1025"`
+	SMTPUser string `envconfig:"SMTP_USER"`
+	SMTPPass string `envconfig:"SMTP_PASS"`
+}
+
+func NewMail() Mail {
+	var m Mail
+	envconfig.MustProcess("MAIL", &m)
+
+	return m
+}
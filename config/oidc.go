@@ -0,0 +1,29 @@
+package config
+
+import (
+	"github.com/kelseyhightower/envconfig"
+)
+
+// OIDC configures login through an external OpenID Connect provider. It is
+// disabled while Issuer is empty.
+type OIDC struct {
+	// Name identifies the provider in URLs and linked identities, e.g.
+	// /api/v1/oauth/google/login.
+	Name         string `default:"google"`
+	Issuer       string
+	ClientID     string   `split_words:"true"`
+	ClientSecret string   `split_words:"true"`
+	RedirectURL  string   `split_words:"true" default:"http://localhost:3080/api/v1/oauth/google/callback"`
+	Scopes       []string `default:"openid,email,profile"`
+
+	// LoginRedirectURL is where the browser is sent after a successful
+	// login. Empty responds with JSON instead.
+	LoginRedirectURL string `split_words:"true"`
+}
+
+func NewOIDC() OIDC {
+	var o OIDC
+	envconfig.MustProcess("OIDC", &o)
+
+	return o
+}
@@ -0,0 +1,22 @@
+package config
+
+import (
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+type Payment struct {
+	// WebhookSecret signs callbacks of the built-in fake provider.
+	WebhookSecret    string        `split_words:"true" default:"fake-webhook-secret"`
+	WebhookTolerance time.Duration `split_words:"true" default:"5m"`
+	// ProviderTimeout bounds each call to a payment provider.
+	ProviderTimeout time.Duration `split_words:"true" default:"15s"`
+}
+
+func NewPayment() Payment {
+	var p Payment
+	envconfig.MustProcess("PAYMENT", &p)
+
+	return p
+}
@@ -0,0 +1,32 @@
+package config
+
+import (
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+// RateLimit sets how many requests a client may make per period in each
+// route group. Limits are shared through Redis when it is enabled.
+type RateLimit struct {
+	Enable bool `default:"true"`
+
+	// Auth covers login, registration and password and verification emails,
+	// counted per IP address.
+	AuthLimit  int           `split_words:"true" default:"10"`
+	AuthPeriod time.Duration `split_words:"true" default:"1m"`
+	// Catalog covers reading products, categories and reviews.
+	CatalogLimit  int           `split_words:"true" default:"300"`
+	CatalogPeriod time.Duration `split_words:"true" default:"1m"`
+	// API covers every other endpoint. Catalog and API requests are counted
+	// per API key, per user when logged in, and per IP address otherwise.
+	APILimit  int           `envconfig:"API_LIMIT" default:"120"`
+	APIPeriod time.Duration `envconfig:"API_PERIOD" default:"1m"`
+}
+
+func NewRateLimit() RateLimit {
+	var r RateLimit
+	envconfig.MustProcess("RATE_LIMIT", &r)
+
+	return r
+}
@@ -0,0 +1,24 @@
+package config
+
+import (
+	"github.com/kelseyhightower/envconfig"
+)
+
+type Storage struct {
+	// Driver selects the BlobStore backend. Only "local" is available.
+	Driver string `default:"local"`
+	// LocalDir is where the local driver writes files, and BaseURL is the
+	// path they are served under.
+	LocalDir string `split_words:"true" default:"./storage"`
+	BaseURL  string `split_words:"true" default:"/media"`
+
+	MaxUploadSize  int64 `split_words:"true" default:"10485760"`
+	ThumbnailSizes []int `split_words:"true" default:"150,600"`
+}
+
+func NewStorage() Storage {
+	var s Storage
+	envconfig.MustProcess("STORAGE", &s)
+
+	return s
+}
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A user owns at most one cart. Anonymous carts have a NULL user_id and are
+-- tracked through the session instead.
+CREATE UNIQUE INDEX IF NOT EXISTS carts_user_id_key ON carts (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS carts_user_id_key;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "orders"
+    ADD COLUMN "discount_id" BIGINT REFERENCES "discounts" ("id"),
+    ADD COLUMN "discount_amount" NUMERIC(12,2) NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "orders"
+    DROP COLUMN "discount_amount",
+    DROP COLUMN "discount_id";
+-- +goose StatementEnd
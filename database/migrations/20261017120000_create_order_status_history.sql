@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+create table IF not exists  "order_status_history" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "order_id" BIGINT NOT NULL,
+    "from_status" VARCHAR(20),
+    "to_status" VARCHAR(20) NOT NULL,
+    "changed_by" BIGINT,
+    "note" TEXT,
+    "created_at" timestamp with time zone default current_timestamp,
+    FOREIGN KEY ("order_id") REFERENCES "orders" ("id") ON DELETE CASCADE,
+    FOREIGN KEY ("changed_by") REFERENCES "users" ("id") ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS orderstatushistory_order_id_created_at
+    ON "order_status_history" ("order_id", "created_at");
+
+-- Orders placed before history was kept start their timeline at creation.
+INSERT INTO "order_status_history" ("order_id", "to_status", "created_at")
+SELECT "id", COALESCE("status", 'pending'), "created_at" FROM "orders";
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "order_status_history";
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+create table IF not exists  "payment_webhook_events" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "provider" VARCHAR(50) NOT NULL,
+    "event_id" VARCHAR(100) NOT NULL,
+    "transaction_id" VARCHAR(100),
+    "payload" TEXT NOT NULL,
+    "attempts" INTEGER NOT NULL DEFAULT 0,
+    "last_error" TEXT,
+    "received_at" timestamp with time zone default current_timestamp,
+    "processed_at" timestamp with time zone,
+    CONSTRAINT "payment_webhook_events_provider_event_id_key" UNIQUE ("provider", "event_id")
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "payment_webhook_events";
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A user owns at most one wishlist.
+CREATE UNIQUE INDEX IF NOT EXISTS wishlists_user_id_key ON wishlists (user_id);
+
+ALTER TABLE "wishlist_items" ADD COLUMN "price_at_add" NUMERIC(12,2);
+
+-- Without history, the best guess for existing items is today's price.
+UPDATE "wishlist_items" wi
+SET "price_at_add" = p."price"
+FROM "products" p
+WHERE p."id" = wi."product_id";
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "wishlist_items" DROP COLUMN "price_at_add";
+DROP INDEX IF EXISTS wishlists_user_id_key;
+-- +goose StatementEnd
@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "product_images"
+    ADD COLUMN "position" INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN "storage_key" TEXT,
+    ADD COLUMN "content_type" VARCHAR(100),
+    ADD COLUMN "thumbnails" JSONB,
+    ADD COLUMN "created_at" timestamp with time zone default current_timestamp;
+
+-- Keep only the lowest id as primary where several images claim it.
+UPDATE "product_images" pi
+SET "is_primary" = false
+WHERE pi."is_primary"
+  AND EXISTS (SELECT 1
+              FROM "product_images" o
+              WHERE o."product_id" = pi."product_id"
+                AND o."is_primary"
+                AND o."id" < pi."id");
+
+-- Existing images keep their insertion order.
+UPDATE "product_images" pi
+SET "position" = o.rn - 1
+FROM (SELECT "id", row_number() OVER (PARTITION BY "product_id" ORDER BY "id") AS rn
+      FROM "product_images") o
+WHERE o."id" = pi."id";
+
+CREATE INDEX IF NOT EXISTS productimage_product_id_position ON product_images (product_id, position);
+CREATE UNIQUE INDEX IF NOT EXISTS product_images_primary_key ON product_images (product_id) WHERE is_primary;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS product_images_primary_key;
+DROP INDEX IF EXISTS productimage_product_id_position;
+ALTER TABLE "product_images"
+    DROP COLUMN "created_at",
+    DROP COLUMN "thumbnails",
+    DROP COLUMN "content_type",
+    DROP COLUMN "storage_key",
+    DROP COLUMN "position";
+-- +goose StatementEnd
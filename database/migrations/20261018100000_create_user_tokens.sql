@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+create table IF not exists  "user_tokens" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "user_id" BIGINT NOT NULL,
+    "purpose" VARCHAR(50) NOT NULL,
+    "token_hash" TEXT NOT NULL UNIQUE,
+    "expires_at" timestamp with time zone NOT NULL,
+    "used_at" timestamp with time zone,
+    "created_at" timestamp with time zone default current_timestamp,
+    FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS usertoken_user_id_purpose_created_at ON user_tokens (user_id, purpose, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "user_tokens";
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "users"
+    ADD COLUMN "totp_secret" VARCHAR(64),
+    ADD COLUMN "totp_enabled_at" timestamp with time zone,
+    ADD COLUMN "totp_last_step" BIGINT;
+
+create table IF not exists  "user_recovery_codes" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "user_id" BIGINT NOT NULL,
+    "code_hash" TEXT NOT NULL,
+    "used_at" timestamp with time zone,
+    "created_at" timestamp with time zone default current_timestamp,
+    FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS recoverycode_user_id_code_hash ON user_recovery_codes (user_id, code_hash);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "user_recovery_codes";
+ALTER TABLE "users"
+    DROP COLUMN "totp_last_step",
+    DROP COLUMN "totp_enabled_at",
+    DROP COLUMN "totp_secret";
+-- +goose StatementEnd
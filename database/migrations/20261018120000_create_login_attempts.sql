@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+create table IF not exists  "login_attempts" (
+    "key" TEXT PRIMARY KEY,
+    "failures" INTEGER NOT NULL,
+    "last_failed_at" timestamp with time zone NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "login_attempts";
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+create table IF not exists  "api_keys" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "user_id" BIGINT NOT NULL,
+    "name" VARCHAR(100) NOT NULL,
+    "prefix" VARCHAR(20) NOT NULL,
+    "key_hash" TEXT NOT NULL UNIQUE,
+    "scopes" JSONB NOT NULL,
+    "expires_at" timestamp with time zone,
+    "last_used_at" timestamp with time zone,
+    "revoked_at" timestamp with time zone,
+    "created_at" timestamp with time zone default current_timestamp,
+    FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "api_keys";
+-- +goose StatementEnd
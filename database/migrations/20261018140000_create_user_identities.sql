@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+create table IF not exists  "user_identities" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "user_id" BIGINT NOT NULL,
+    "provider" VARCHAR(50) NOT NULL,
+    "subject" VARCHAR(255) NOT NULL,
+    "email" VARCHAR(255),
+    "last_login_at" timestamp with time zone,
+    "created_at" timestamp with time zone default current_timestamp,
+    FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS useridentity_provider_subject ON user_identities (provider, subject);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "user_identities";
+-- +goose StatementEnd
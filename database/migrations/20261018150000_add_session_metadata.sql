@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "sessions"
+    ADD COLUMN "created_at" timestamp with time zone NOT NULL default current_timestamp,
+    ADD COLUMN "last_seen_at" timestamp with time zone NOT NULL default current_timestamp,
+    ADD COLUMN "ip_address" TEXT,
+    ADD COLUMN "user_agent" TEXT;
+
+CREATE INDEX IF NOT EXISTS session_user_id ON sessions (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS session_user_id;
+
+ALTER TABLE "sessions"
+    DROP COLUMN "created_at",
+    DROP COLUMN "last_seen_at",
+    DROP COLUMN "ip_address",
+    DROP COLUMN "user_agent";
+-- +goose StatementEnd
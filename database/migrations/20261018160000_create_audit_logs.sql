@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+create table IF not exists  "audit_logs" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "actor_id" BIGINT,
+    "table_name" VARCHAR(100) NOT NULL,
+    "table_row_id" BIGINT,
+    "action" VARCHAR(20) NOT NULL,
+    "old_values" jsonb,
+    "new_values" jsonb,
+    "diff" jsonb,
+    "http_method" VARCHAR(10) NOT NULL,
+    "url" TEXT NOT NULL,
+    "ip_address" VARCHAR(45) NOT NULL,
+    "user_agent" TEXT NOT NULL,
+    "created_at" timestamp with time zone default current_timestamp
+);
+CREATE INDEX IF NOT EXISTS auditlog_table_name_table_row_id ON audit_logs (table_name, table_row_id);
+CREATE INDEX IF NOT EXISTS auditlog_actor_id ON audit_logs (actor_id);
+CREATE INDEX IF NOT EXISTS auditlog_created_at ON audit_logs (created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "audit_logs";
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+create table IF not exists  "idempotency_keys" (
+    "key" TEXT PRIMARY KEY,
+    "fingerprint" VARCHAR(64) NOT NULL,
+    "status_code" INTEGER,
+    "header" jsonb,
+    "body" BYTEA,
+    "expires_at" timestamp with time zone NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idempotencykey_expires_at ON idempotency_keys (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "idempotency_keys";
+-- +goose StatementEnd
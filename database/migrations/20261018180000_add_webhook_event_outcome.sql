@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "payment_webhook_events"
+    ADD COLUMN "status" VARCHAR(20),
+    ADD COLUMN "amount" NUMERIC(12,2);
+
+-- Events stored so far all came from the fake provider, whose payload is
+-- already in the normalized form.
+UPDATE "payment_webhook_events"
+SET "status" = "payload"::json ->> 'status',
+    "amount" = ("payload"::json ->> 'amount')::NUMERIC
+WHERE "provider" = 'fake';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "payment_webhook_events"
+    DROP COLUMN "status",
+    DROP COLUMN "amount";
+-- +goose StatementEnd
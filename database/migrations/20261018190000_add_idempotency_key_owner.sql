@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "idempotency_keys" ADD COLUMN "owner" VARCHAR(32) NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "idempotency_keys" DROP COLUMN "owner";
+-- +goose StatementEnd
@@ -0,0 +1,402 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/thang1834/go-goss/ent/gen/auditlog"
+)
+
+// AuditLogCreate is the builder for creating a AuditLog entity.
+type AuditLogCreate struct {
+	config
+	mutation *AuditLogMutation
+	hooks    []Hook
+}
+
+// SetActorID sets the "actor_id" field.
+func (alc *AuditLogCreate) SetActorID(u uint64) *AuditLogCreate {
+	alc.mutation.SetActorID(u)
+	return alc
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableActorID(u *uint64) *AuditLogCreate {
+	if u != nil {
+		alc.SetActorID(*u)
+	}
+	return alc
+}
+
+// SetTableName sets the "table_name" field.
+func (alc *AuditLogCreate) SetTableName(s string) *AuditLogCreate {
+	alc.mutation.SetTableName(s)
+	return alc
+}
+
+// SetTableRowID sets the "table_row_id" field.
+func (alc *AuditLogCreate) SetTableRowID(u uint64) *AuditLogCreate {
+	alc.mutation.SetTableRowID(u)
+	return alc
+}
+
+// SetNillableTableRowID sets the "table_row_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableTableRowID(u *uint64) *AuditLogCreate {
+	if u != nil {
+		alc.SetTableRowID(*u)
+	}
+	return alc
+}
+
+// SetAction sets the "action" field.
+func (alc *AuditLogCreate) SetAction(s string) *AuditLogCreate {
+	alc.mutation.SetAction(s)
+	return alc
+}
+
+// SetOldValues sets the "old_values" field.
+func (alc *AuditLogCreate) SetOldValues(s string) *AuditLogCreate {
+	alc.mutation.SetOldValues(s)
+	return alc
+}
+
+// SetNillableOldValues sets the "old_values" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableOldValues(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetOldValues(*s)
+	}
+	return alc
+}
+
+// SetNewValues sets the "new_values" field.
+func (alc *AuditLogCreate) SetNewValues(s string) *AuditLogCreate {
+	alc.mutation.SetNewValues(s)
+	return alc
+}
+
+// SetNillableNewValues sets the "new_values" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableNewValues(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetNewValues(*s)
+	}
+	return alc
+}
+
+// SetDiff sets the "diff" field.
+func (alc *AuditLogCreate) SetDiff(s string) *AuditLogCreate {
+	alc.mutation.SetDiff(s)
+	return alc
+}
+
+// SetNillableDiff sets the "diff" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableDiff(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetDiff(*s)
+	}
+	return alc
+}
+
+// SetHTTPMethod sets the "http_method" field.
+func (alc *AuditLogCreate) SetHTTPMethod(s string) *AuditLogCreate {
+	alc.mutation.SetHTTPMethod(s)
+	return alc
+}
+
+// SetURL sets the "url" field.
+func (alc *AuditLogCreate) SetURL(s string) *AuditLogCreate {
+	alc.mutation.SetURL(s)
+	return alc
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (alc *AuditLogCreate) SetIPAddress(s string) *AuditLogCreate {
+	alc.mutation.SetIPAddress(s)
+	return alc
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (alc *AuditLogCreate) SetUserAgent(s string) *AuditLogCreate {
+	alc.mutation.SetUserAgent(s)
+	return alc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (alc *AuditLogCreate) SetCreatedAt(t time.Time) *AuditLogCreate {
+	alc.mutation.SetCreatedAt(t)
+	return alc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableCreatedAt(t *time.Time) *AuditLogCreate {
+	if t != nil {
+		alc.SetCreatedAt(*t)
+	}
+	return alc
+}
+
+// SetID sets the "id" field.
+func (alc *AuditLogCreate) SetID(u uint64) *AuditLogCreate {
+	alc.mutation.SetID(u)
+	return alc
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alc *AuditLogCreate) Mutation() *AuditLogMutation {
+	return alc.mutation
+}
+
+// Save creates the AuditLog in the database.
+func (alc *AuditLogCreate) Save(ctx context.Context) (*AuditLog, error) {
+	alc.defaults()
+	return withHooks(ctx, alc.sqlSave, alc.mutation, alc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (alc *AuditLogCreate) SaveX(ctx context.Context) *AuditLog {
+	v, err := alc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alc *AuditLogCreate) Exec(ctx context.Context) error {
+	_, err := alc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alc *AuditLogCreate) ExecX(ctx context.Context) {
+	if err := alc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (alc *AuditLogCreate) defaults() {
+	if _, ok := alc.mutation.CreatedAt(); !ok {
+		v := auditlog.DefaultCreatedAt()
+		alc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (alc *AuditLogCreate) check() error {
+	if _, ok := alc.mutation.TableName(); !ok {
+		return &ValidationError{Name: "table_name", err: errors.New(`gen: missing required field "AuditLog.table_name"`)}
+	}
+	if v, ok := alc.mutation.TableName(); ok {
+		if err := auditlog.TableNameValidator(v); err != nil {
+			return &ValidationError{Name: "table_name", err: fmt.Errorf(`gen: validator failed for field "AuditLog.table_name": %w`, err)}
+		}
+	}
+	if _, ok := alc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`gen: missing required field "AuditLog.action"`)}
+	}
+	if v, ok := alc.mutation.Action(); ok {
+		if err := auditlog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`gen: validator failed for field "AuditLog.action": %w`, err)}
+		}
+	}
+	if _, ok := alc.mutation.HTTPMethod(); !ok {
+		return &ValidationError{Name: "http_method", err: errors.New(`gen: missing required field "AuditLog.http_method"`)}
+	}
+	if v, ok := alc.mutation.HTTPMethod(); ok {
+		if err := auditlog.HTTPMethodValidator(v); err != nil {
+			return &ValidationError{Name: "http_method", err: fmt.Errorf(`gen: validator failed for field "AuditLog.http_method": %w`, err)}
+		}
+	}
+	if _, ok := alc.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`gen: missing required field "AuditLog.url"`)}
+	}
+	if _, ok := alc.mutation.IPAddress(); !ok {
+		return &ValidationError{Name: "ip_address", err: errors.New(`gen: missing required field "AuditLog.ip_address"`)}
+	}
+	if v, ok := alc.mutation.IPAddress(); ok {
+		if err := auditlog.IPAddressValidator(v); err != nil {
+			return &ValidationError{Name: "ip_address", err: fmt.Errorf(`gen: validator failed for field "AuditLog.ip_address": %w`, err)}
+		}
+	}
+	if _, ok := alc.mutation.UserAgent(); !ok {
+		return &ValidationError{Name: "user_agent", err: errors.New(`gen: missing required field "AuditLog.user_agent"`)}
+	}
+	if _, ok := alc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`gen: missing required field "AuditLog.created_at"`)}
+	}
+	return nil
+}
+
+func (alc *AuditLogCreate) sqlSave(ctx context.Context) (*AuditLog, error) {
+	if err := alc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := alc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, alc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	alc.mutation.id = &_node.ID
+	alc.mutation.done = true
+	return _node, nil
+}
+
+func (alc *AuditLogCreate) createSpec() (*AuditLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditLog{config: alc.config}
+		_spec = sqlgraph.NewCreateSpec(auditlog.Table, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUint64))
+	)
+	if id, ok := alc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := alc.mutation.ActorID(); ok {
+		_spec.SetField(auditlog.FieldActorID, field.TypeUint64, value)
+		_node.ActorID = &value
+	}
+	if value, ok := alc.mutation.TableName(); ok {
+		_spec.SetField(auditlog.FieldTableName, field.TypeString, value)
+		_node.TableName = value
+	}
+	if value, ok := alc.mutation.TableRowID(); ok {
+		_spec.SetField(auditlog.FieldTableRowID, field.TypeUint64, value)
+		_node.TableRowID = &value
+	}
+	if value, ok := alc.mutation.Action(); ok {
+		_spec.SetField(auditlog.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := alc.mutation.OldValues(); ok {
+		_spec.SetField(auditlog.FieldOldValues, field.TypeString, value)
+		_node.OldValues = &value
+	}
+	if value, ok := alc.mutation.NewValues(); ok {
+		_spec.SetField(auditlog.FieldNewValues, field.TypeString, value)
+		_node.NewValues = &value
+	}
+	if value, ok := alc.mutation.Diff(); ok {
+		_spec.SetField(auditlog.FieldDiff, field.TypeString, value)
+		_node.Diff = &value
+	}
+	if value, ok := alc.mutation.HTTPMethod(); ok {
+		_spec.SetField(auditlog.FieldHTTPMethod, field.TypeString, value)
+		_node.HTTPMethod = value
+	}
+	if value, ok := alc.mutation.URL(); ok {
+		_spec.SetField(auditlog.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := alc.mutation.IPAddress(); ok {
+		_spec.SetField(auditlog.FieldIPAddress, field.TypeString, value)
+		_node.IPAddress = value
+	}
+	if value, ok := alc.mutation.UserAgent(); ok {
+		_spec.SetField(auditlog.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := alc.mutation.CreatedAt(); ok {
+		_spec.SetField(auditlog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AuditLogCreateBulk is the builder for creating many AuditLog entities in bulk.
+type AuditLogCreateBulk struct {
+	config
+	err      error
+	builders []*AuditLogCreate
+}
+
+// Save creates the AuditLog entities in the database.
+func (alcb *AuditLogCreateBulk) Save(ctx context.Context) ([]*AuditLog, error) {
+	if alcb.err != nil {
+		return nil, alcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(alcb.builders))
+	nodes := make([]*AuditLog, len(alcb.builders))
+	mutators := make([]Mutator, len(alcb.builders))
+	for i := range alcb.builders {
+		func(i int, root context.Context) {
+			builder := alcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, alcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, alcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, alcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) SaveX(ctx context.Context) []*AuditLog {
+	v, err := alcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alcb *AuditLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := alcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) ExecX(ctx context.Context) {
+	if err := alcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,770 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/thang1834/go-goss/ent/gen/auditlog"
+	"github.com/thang1834/go-goss/ent/gen/predicate"
+)
+
+// AuditLogUpdate is the builder for updating AuditLog entities.
+type AuditLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (alu *AuditLogUpdate) Where(ps ...predicate.AuditLog) *AuditLogUpdate {
+	alu.mutation.Where(ps...)
+	return alu
+}
+
+// SetActorID sets the "actor_id" field.
+func (alu *AuditLogUpdate) SetActorID(u uint64) *AuditLogUpdate {
+	alu.mutation.ResetActorID()
+	alu.mutation.SetActorID(u)
+	return alu
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableActorID(u *uint64) *AuditLogUpdate {
+	if u != nil {
+		alu.SetActorID(*u)
+	}
+	return alu
+}
+
+// AddActorID adds u to the "actor_id" field.
+func (alu *AuditLogUpdate) AddActorID(u int64) *AuditLogUpdate {
+	alu.mutation.AddActorID(u)
+	return alu
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (alu *AuditLogUpdate) ClearActorID() *AuditLogUpdate {
+	alu.mutation.ClearActorID()
+	return alu
+}
+
+// SetTableName sets the "table_name" field.
+func (alu *AuditLogUpdate) SetTableName(s string) *AuditLogUpdate {
+	alu.mutation.SetTableName(s)
+	return alu
+}
+
+// SetNillableTableName sets the "table_name" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableTableName(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetTableName(*s)
+	}
+	return alu
+}
+
+// SetTableRowID sets the "table_row_id" field.
+func (alu *AuditLogUpdate) SetTableRowID(u uint64) *AuditLogUpdate {
+	alu.mutation.ResetTableRowID()
+	alu.mutation.SetTableRowID(u)
+	return alu
+}
+
+// SetNillableTableRowID sets the "table_row_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableTableRowID(u *uint64) *AuditLogUpdate {
+	if u != nil {
+		alu.SetTableRowID(*u)
+	}
+	return alu
+}
+
+// AddTableRowID adds u to the "table_row_id" field.
+func (alu *AuditLogUpdate) AddTableRowID(u int64) *AuditLogUpdate {
+	alu.mutation.AddTableRowID(u)
+	return alu
+}
+
+// ClearTableRowID clears the value of the "table_row_id" field.
+func (alu *AuditLogUpdate) ClearTableRowID() *AuditLogUpdate {
+	alu.mutation.ClearTableRowID()
+	return alu
+}
+
+// SetAction sets the "action" field.
+func (alu *AuditLogUpdate) SetAction(s string) *AuditLogUpdate {
+	alu.mutation.SetAction(s)
+	return alu
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableAction(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetAction(*s)
+	}
+	return alu
+}
+
+// SetOldValues sets the "old_values" field.
+func (alu *AuditLogUpdate) SetOldValues(s string) *AuditLogUpdate {
+	alu.mutation.SetOldValues(s)
+	return alu
+}
+
+// SetNillableOldValues sets the "old_values" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableOldValues(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetOldValues(*s)
+	}
+	return alu
+}
+
+// ClearOldValues clears the value of the "old_values" field.
+func (alu *AuditLogUpdate) ClearOldValues() *AuditLogUpdate {
+	alu.mutation.ClearOldValues()
+	return alu
+}
+
+// SetNewValues sets the "new_values" field.
+func (alu *AuditLogUpdate) SetNewValues(s string) *AuditLogUpdate {
+	alu.mutation.SetNewValues(s)
+	return alu
+}
+
+// SetNillableNewValues sets the "new_values" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableNewValues(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetNewValues(*s)
+	}
+	return alu
+}
+
+// ClearNewValues clears the value of the "new_values" field.
+func (alu *AuditLogUpdate) ClearNewValues() *AuditLogUpdate {
+	alu.mutation.ClearNewValues()
+	return alu
+}
+
+// SetDiff sets the "diff" field.
+func (alu *AuditLogUpdate) SetDiff(s string) *AuditLogUpdate {
+	alu.mutation.SetDiff(s)
+	return alu
+}
+
+// SetNillableDiff sets the "diff" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableDiff(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetDiff(*s)
+	}
+	return alu
+}
+
+// ClearDiff clears the value of the "diff" field.
+func (alu *AuditLogUpdate) ClearDiff() *AuditLogUpdate {
+	alu.mutation.ClearDiff()
+	return alu
+}
+
+// SetHTTPMethod sets the "http_method" field.
+func (alu *AuditLogUpdate) SetHTTPMethod(s string) *AuditLogUpdate {
+	alu.mutation.SetHTTPMethod(s)
+	return alu
+}
+
+// SetNillableHTTPMethod sets the "http_method" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableHTTPMethod(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetHTTPMethod(*s)
+	}
+	return alu
+}
+
+// SetURL sets the "url" field.
+func (alu *AuditLogUpdate) SetURL(s string) *AuditLogUpdate {
+	alu.mutation.SetURL(s)
+	return alu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableURL(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetURL(*s)
+	}
+	return alu
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (alu *AuditLogUpdate) SetIPAddress(s string) *AuditLogUpdate {
+	alu.mutation.SetIPAddress(s)
+	return alu
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableIPAddress(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetIPAddress(*s)
+	}
+	return alu
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (alu *AuditLogUpdate) SetUserAgent(s string) *AuditLogUpdate {
+	alu.mutation.SetUserAgent(s)
+	return alu
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableUserAgent(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetUserAgent(*s)
+	}
+	return alu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (alu *AuditLogUpdate) SetCreatedAt(t time.Time) *AuditLogUpdate {
+	alu.mutation.SetCreatedAt(t)
+	return alu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableCreatedAt(t *time.Time) *AuditLogUpdate {
+	if t != nil {
+		alu.SetCreatedAt(*t)
+	}
+	return alu
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alu *AuditLogUpdate) Mutation() *AuditLogMutation {
+	return alu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (alu *AuditLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, alu.sqlSave, alu.mutation, alu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alu *AuditLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := alu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (alu *AuditLogUpdate) Exec(ctx context.Context) error {
+	_, err := alu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alu *AuditLogUpdate) ExecX(ctx context.Context) {
+	if err := alu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (alu *AuditLogUpdate) check() error {
+	if v, ok := alu.mutation.TableName(); ok {
+		if err := auditlog.TableNameValidator(v); err != nil {
+			return &ValidationError{Name: "table_name", err: fmt.Errorf(`gen: validator failed for field "AuditLog.table_name": %w`, err)}
+		}
+	}
+	if v, ok := alu.mutation.Action(); ok {
+		if err := auditlog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`gen: validator failed for field "AuditLog.action": %w`, err)}
+		}
+	}
+	if v, ok := alu.mutation.HTTPMethod(); ok {
+		if err := auditlog.HTTPMethodValidator(v); err != nil {
+			return &ValidationError{Name: "http_method", err: fmt.Errorf(`gen: validator failed for field "AuditLog.http_method": %w`, err)}
+		}
+	}
+	if v, ok := alu.mutation.IPAddress(); ok {
+		if err := auditlog.IPAddressValidator(v); err != nil {
+			return &ValidationError{Name: "ip_address", err: fmt.Errorf(`gen: validator failed for field "AuditLog.ip_address": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (alu *AuditLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := alu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUint64))
+	if ps := alu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := alu.mutation.ActorID(); ok {
+		_spec.SetField(auditlog.FieldActorID, field.TypeUint64, value)
+	}
+	if value, ok := alu.mutation.AddedActorID(); ok {
+		_spec.AddField(auditlog.FieldActorID, field.TypeUint64, value)
+	}
+	if alu.mutation.ActorIDCleared() {
+		_spec.ClearField(auditlog.FieldActorID, field.TypeUint64)
+	}
+	if value, ok := alu.mutation.TableName(); ok {
+		_spec.SetField(auditlog.FieldTableName, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.TableRowID(); ok {
+		_spec.SetField(auditlog.FieldTableRowID, field.TypeUint64, value)
+	}
+	if value, ok := alu.mutation.AddedTableRowID(); ok {
+		_spec.AddField(auditlog.FieldTableRowID, field.TypeUint64, value)
+	}
+	if alu.mutation.TableRowIDCleared() {
+		_spec.ClearField(auditlog.FieldTableRowID, field.TypeUint64)
+	}
+	if value, ok := alu.mutation.Action(); ok {
+		_spec.SetField(auditlog.FieldAction, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.OldValues(); ok {
+		_spec.SetField(auditlog.FieldOldValues, field.TypeString, value)
+	}
+	if alu.mutation.OldValuesCleared() {
+		_spec.ClearField(auditlog.FieldOldValues, field.TypeString)
+	}
+	if value, ok := alu.mutation.NewValues(); ok {
+		_spec.SetField(auditlog.FieldNewValues, field.TypeString, value)
+	}
+	if alu.mutation.NewValuesCleared() {
+		_spec.ClearField(auditlog.FieldNewValues, field.TypeString)
+	}
+	if value, ok := alu.mutation.Diff(); ok {
+		_spec.SetField(auditlog.FieldDiff, field.TypeString, value)
+	}
+	if alu.mutation.DiffCleared() {
+		_spec.ClearField(auditlog.FieldDiff, field.TypeString)
+	}
+	if value, ok := alu.mutation.HTTPMethod(); ok {
+		_spec.SetField(auditlog.FieldHTTPMethod, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.URL(); ok {
+		_spec.SetField(auditlog.FieldURL, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.IPAddress(); ok {
+		_spec.SetField(auditlog.FieldIPAddress, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.UserAgent(); ok {
+		_spec.SetField(auditlog.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.CreatedAt(); ok {
+		_spec.SetField(auditlog.FieldCreatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, alu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	alu.mutation.done = true
+	return n, nil
+}
+
+// AuditLogUpdateOne is the builder for updating a single AuditLog entity.
+type AuditLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// SetActorID sets the "actor_id" field.
+func (aluo *AuditLogUpdateOne) SetActorID(u uint64) *AuditLogUpdateOne {
+	aluo.mutation.ResetActorID()
+	aluo.mutation.SetActorID(u)
+	return aluo
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableActorID(u *uint64) *AuditLogUpdateOne {
+	if u != nil {
+		aluo.SetActorID(*u)
+	}
+	return aluo
+}
+
+// AddActorID adds u to the "actor_id" field.
+func (aluo *AuditLogUpdateOne) AddActorID(u int64) *AuditLogUpdateOne {
+	aluo.mutation.AddActorID(u)
+	return aluo
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (aluo *AuditLogUpdateOne) ClearActorID() *AuditLogUpdateOne {
+	aluo.mutation.ClearActorID()
+	return aluo
+}
+
+// SetTableName sets the "table_name" field.
+func (aluo *AuditLogUpdateOne) SetTableName(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetTableName(s)
+	return aluo
+}
+
+// SetNillableTableName sets the "table_name" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableTableName(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetTableName(*s)
+	}
+	return aluo
+}
+
+// SetTableRowID sets the "table_row_id" field.
+func (aluo *AuditLogUpdateOne) SetTableRowID(u uint64) *AuditLogUpdateOne {
+	aluo.mutation.ResetTableRowID()
+	aluo.mutation.SetTableRowID(u)
+	return aluo
+}
+
+// SetNillableTableRowID sets the "table_row_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableTableRowID(u *uint64) *AuditLogUpdateOne {
+	if u != nil {
+		aluo.SetTableRowID(*u)
+	}
+	return aluo
+}
+
+// AddTableRowID adds u to the "table_row_id" field.
+func (aluo *AuditLogUpdateOne) AddTableRowID(u int64) *AuditLogUpdateOne {
+	aluo.mutation.AddTableRowID(u)
+	return aluo
+}
+
+// ClearTableRowID clears the value of the "table_row_id" field.
+func (aluo *AuditLogUpdateOne) ClearTableRowID() *AuditLogUpdateOne {
+	aluo.mutation.ClearTableRowID()
+	return aluo
+}
+
+// SetAction sets the "action" field.
+func (aluo *AuditLogUpdateOne) SetAction(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetAction(s)
+	return aluo
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableAction(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetAction(*s)
+	}
+	return aluo
+}
+
+// SetOldValues sets the "old_values" field.
+func (aluo *AuditLogUpdateOne) SetOldValues(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetOldValues(s)
+	return aluo
+}
+
+// SetNillableOldValues sets the "old_values" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableOldValues(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetOldValues(*s)
+	}
+	return aluo
+}
+
+// ClearOldValues clears the value of the "old_values" field.
+func (aluo *AuditLogUpdateOne) ClearOldValues() *AuditLogUpdateOne {
+	aluo.mutation.ClearOldValues()
+	return aluo
+}
+
+// SetNewValues sets the "new_values" field.
+func (aluo *AuditLogUpdateOne) SetNewValues(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetNewValues(s)
+	return aluo
+}
+
+// SetNillableNewValues sets the "new_values" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableNewValues(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetNewValues(*s)
+	}
+	return aluo
+}
+
+// ClearNewValues clears the value of the "new_values" field.
+func (aluo *AuditLogUpdateOne) ClearNewValues() *AuditLogUpdateOne {
+	aluo.mutation.ClearNewValues()
+	return aluo
+}
+
+// SetDiff sets the "diff" field.
+func (aluo *AuditLogUpdateOne) SetDiff(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetDiff(s)
+	return aluo
+}
+
+// SetNillableDiff sets the "diff" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableDiff(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetDiff(*s)
+	}
+	return aluo
+}
+
+// ClearDiff clears the value of the "diff" field.
+func (aluo *AuditLogUpdateOne) ClearDiff() *AuditLogUpdateOne {
+	aluo.mutation.ClearDiff()
+	return aluo
+}
+
+// SetHTTPMethod sets the "http_method" field.
+func (aluo *AuditLogUpdateOne) SetHTTPMethod(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetHTTPMethod(s)
+	return aluo
+}
+
+// SetNillableHTTPMethod sets the "http_method" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableHTTPMethod(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetHTTPMethod(*s)
+	}
+	return aluo
+}
+
+// SetURL sets the "url" field.
+func (aluo *AuditLogUpdateOne) SetURL(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetURL(s)
+	return aluo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableURL(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetURL(*s)
+	}
+	return aluo
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (aluo *AuditLogUpdateOne) SetIPAddress(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetIPAddress(s)
+	return aluo
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableIPAddress(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetIPAddress(*s)
+	}
+	return aluo
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (aluo *AuditLogUpdateOne) SetUserAgent(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetUserAgent(s)
+	return aluo
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableUserAgent(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetUserAgent(*s)
+	}
+	return aluo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aluo *AuditLogUpdateOne) SetCreatedAt(t time.Time) *AuditLogUpdateOne {
+	aluo.mutation.SetCreatedAt(t)
+	return aluo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableCreatedAt(t *time.Time) *AuditLogUpdateOne {
+	if t != nil {
+		aluo.SetCreatedAt(*t)
+	}
+	return aluo
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (aluo *AuditLogUpdateOne) Mutation() *AuditLogMutation {
+	return aluo.mutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (aluo *AuditLogUpdateOne) Where(ps ...predicate.AuditLog) *AuditLogUpdateOne {
+	aluo.mutation.Where(ps...)
+	return aluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aluo *AuditLogUpdateOne) Select(field string, fields ...string) *AuditLogUpdateOne {
+	aluo.fields = append([]string{field}, fields...)
+	return aluo
+}
+
+// Save executes the query and returns the updated AuditLog entity.
+func (aluo *AuditLogUpdateOne) Save(ctx context.Context) (*AuditLog, error) {
+	return withHooks(ctx, aluo.sqlSave, aluo.mutation, aluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) SaveX(ctx context.Context) *AuditLog {
+	node, err := aluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aluo *AuditLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := aluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) ExecX(ctx context.Context) {
+	if err := aluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aluo *AuditLogUpdateOne) check() error {
+	if v, ok := aluo.mutation.TableName(); ok {
+		if err := auditlog.TableNameValidator(v); err != nil {
+			return &ValidationError{Name: "table_name", err: fmt.Errorf(`gen: validator failed for field "AuditLog.table_name": %w`, err)}
+		}
+	}
+	if v, ok := aluo.mutation.Action(); ok {
+		if err := auditlog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`gen: validator failed for field "AuditLog.action": %w`, err)}
+		}
+	}
+	if v, ok := aluo.mutation.HTTPMethod(); ok {
+		if err := auditlog.HTTPMethodValidator(v); err != nil {
+			return &ValidationError{Name: "http_method", err: fmt.Errorf(`gen: validator failed for field "AuditLog.http_method": %w`, err)}
+		}
+	}
+	if v, ok := aluo.mutation.IPAddress(); ok {
+		if err := auditlog.IPAddressValidator(v); err != nil {
+			return &ValidationError{Name: "ip_address", err: fmt.Errorf(`gen: validator failed for field "AuditLog.ip_address": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aluo *AuditLogUpdateOne) sqlSave(ctx context.Context) (_node *AuditLog, err error) {
+	if err := aluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUint64))
+	id, ok := aluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`gen: missing "AuditLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditlog.FieldID)
+		for _, f := range fields {
+			if !auditlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("gen: invalid field %q for query", f)}
+			}
+			if f != auditlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aluo.mutation.ActorID(); ok {
+		_spec.SetField(auditlog.FieldActorID, field.TypeUint64, value)
+	}
+	if value, ok := aluo.mutation.AddedActorID(); ok {
+		_spec.AddField(auditlog.FieldActorID, field.TypeUint64, value)
+	}
+	if aluo.mutation.ActorIDCleared() {
+		_spec.ClearField(auditlog.FieldActorID, field.TypeUint64)
+	}
+	if value, ok := aluo.mutation.TableName(); ok {
+		_spec.SetField(auditlog.FieldTableName, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.TableRowID(); ok {
+		_spec.SetField(auditlog.FieldTableRowID, field.TypeUint64, value)
+	}
+	if value, ok := aluo.mutation.AddedTableRowID(); ok {
+		_spec.AddField(auditlog.FieldTableRowID, field.TypeUint64, value)
+	}
+	if aluo.mutation.TableRowIDCleared() {
+		_spec.ClearField(auditlog.FieldTableRowID, field.TypeUint64)
+	}
+	if value, ok := aluo.mutation.Action(); ok {
+		_spec.SetField(auditlog.FieldAction, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.OldValues(); ok {
+		_spec.SetField(auditlog.FieldOldValues, field.TypeString, value)
+	}
+	if aluo.mutation.OldValuesCleared() {
+		_spec.ClearField(auditlog.FieldOldValues, field.TypeString)
+	}
+	if value, ok := aluo.mutation.NewValues(); ok {
+		_spec.SetField(auditlog.FieldNewValues, field.TypeString, value)
+	}
+	if aluo.mutation.NewValuesCleared() {
+		_spec.ClearField(auditlog.FieldNewValues, field.TypeString)
+	}
+	if value, ok := aluo.mutation.Diff(); ok {
+		_spec.SetField(auditlog.FieldDiff, field.TypeString, value)
+	}
+	if aluo.mutation.DiffCleared() {
+		_spec.ClearField(auditlog.FieldDiff, field.TypeString)
+	}
+	if value, ok := aluo.mutation.HTTPMethod(); ok {
+		_spec.SetField(auditlog.FieldHTTPMethod, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.URL(); ok {
+		_spec.SetField(auditlog.FieldURL, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.IPAddress(); ok {
+		_spec.SetField(auditlog.FieldIPAddress, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.UserAgent(); ok {
+		_spec.SetField(auditlog.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.CreatedAt(); ok {
+		_spec.SetField(auditlog.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &AuditLog{config: aluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aluo.mutation.done = true
+	return _node, nil
+}
@@ -18,6 +18,8 @@ type Cart struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uint64 `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID *uint64 `json:"user_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -25,7 +27,6 @@ type Cart struct {
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CartQuery when eager-loading is set.
 	Edges        CartEdges `json:"edges"`
-	user_carts   *uint64
 	selectValues sql.SelectValues
 }
 
@@ -33,11 +34,13 @@ type Cart struct {
 type CartEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// Products holds the value of the products edge.
+	Products []*Product `json:"products,omitempty"`
 	// Items holds the value of the items edge.
 	Items []*CartItem `json:"items,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -51,10 +54,19 @@ func (e CartEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// ProductsOrErr returns the Products value or an error if the edge
+// was not loaded in eager-loading.
+func (e CartEdges) ProductsOrErr() ([]*Product, error) {
+	if e.loadedTypes[1] {
+		return e.Products, nil
+	}
+	return nil, &NotLoadedError{edge: "products"}
+}
+
 // ItemsOrErr returns the Items value or an error if the edge
 // was not loaded in eager-loading.
 func (e CartEdges) ItemsOrErr() ([]*CartItem, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.Items, nil
 	}
 	return nil, &NotLoadedError{edge: "items"}
@@ -65,12 +77,10 @@ func (*Cart) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case cart.FieldID:
+		case cart.FieldID, cart.FieldUserID:
 			values[i] = new(sql.NullInt64)
 		case cart.FieldCreatedAt, cart.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
-		case cart.ForeignKeys[0]: // user_carts
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -92,6 +102,13 @@ func (c *Cart) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			c.ID = uint64(value.Int64)
+		case cart.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				c.UserID = new(uint64)
+				*c.UserID = uint64(value.Int64)
+			}
 		case cart.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -104,13 +121,6 @@ func (c *Cart) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.UpdatedAt = value.Time
 			}
-		case cart.ForeignKeys[0]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_carts", value)
-			} else if value.Valid {
-				c.user_carts = new(uint64)
-				*c.user_carts = uint64(value.Int64)
-			}
 		default:
 			c.selectValues.Set(columns[i], values[i])
 		}
@@ -129,6 +139,11 @@ func (c *Cart) QueryUser() *UserQuery {
 	return NewCartClient(c.config).QueryUser(c)
 }
 
+// QueryProducts queries the "products" edge of the Cart entity.
+func (c *Cart) QueryProducts() *ProductQuery {
+	return NewCartClient(c.config).QueryProducts(c)
+}
+
 // QueryItems queries the "items" edge of the Cart entity.
 func (c *Cart) QueryItems() *CartItemQuery {
 	return NewCartClient(c.config).QueryItems(c)
@@ -157,6 +172,11 @@ func (c *Cart) String() string {
 	var builder strings.Builder
 	builder.WriteString("Cart(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	if v := c.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
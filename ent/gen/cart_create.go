@@ -11,7 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/thang1834/go-goss/ent/gen/cart"
-	"github.com/thang1834/go-goss/ent/gen/cartitem"
+	"github.com/thang1834/go-goss/ent/gen/product"
 	"github.com/thang1834/go-goss/ent/gen/user"
 )
 
@@ -22,6 +22,20 @@ type CartCreate struct {
 	hooks    []Hook
 }
 
+// SetUserID sets the "user_id" field.
+func (cc *CartCreate) SetUserID(u uint64) *CartCreate {
+	cc.mutation.SetUserID(u)
+	return cc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (cc *CartCreate) SetNillableUserID(u *uint64) *CartCreate {
+	if u != nil {
+		cc.SetUserID(*u)
+	}
+	return cc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (cc *CartCreate) SetCreatedAt(t time.Time) *CartCreate {
 	cc.mutation.SetCreatedAt(t)
@@ -56,38 +70,24 @@ func (cc *CartCreate) SetID(u uint64) *CartCreate {
 	return cc
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (cc *CartCreate) SetUserID(id uint64) *CartCreate {
-	cc.mutation.SetUserID(id)
-	return cc
-}
-
-// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
-func (cc *CartCreate) SetNillableUserID(id *uint64) *CartCreate {
-	if id != nil {
-		cc = cc.SetUserID(*id)
-	}
-	return cc
-}
-
 // SetUser sets the "user" edge to the User entity.
 func (cc *CartCreate) SetUser(u *User) *CartCreate {
 	return cc.SetUserID(u.ID)
 }
 
-// AddItemIDs adds the "items" edge to the CartItem entity by IDs.
-func (cc *CartCreate) AddItemIDs(ids ...int) *CartCreate {
-	cc.mutation.AddItemIDs(ids...)
+// AddProductIDs adds the "products" edge to the Product entity by IDs.
+func (cc *CartCreate) AddProductIDs(ids ...uint64) *CartCreate {
+	cc.mutation.AddProductIDs(ids...)
 	return cc
 }
 
-// AddItems adds the "items" edges to the CartItem entity.
-func (cc *CartCreate) AddItems(c ...*CartItem) *CartCreate {
-	ids := make([]int, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+// AddProducts adds the "products" edges to the Product entity.
+func (cc *CartCreate) AddProducts(p ...*Product) *CartCreate {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	return cc.AddItemIDs(ids...)
+	return cc.AddProductIDs(ids...)
 }
 
 // Mutation returns the CartMutation object of the builder.
@@ -197,18 +197,18 @@ func (cc *CartCreate) createSpec() (*Cart, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.user_carts = &nodes[0]
+		_node.UserID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := cc.mutation.ItemsIDs(); len(nodes) > 0 {
+	if nodes := cc.mutation.ProductsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   cart.ItemsTable,
-			Columns: []string{cart.ItemsColumn},
+			Table:   cart.ProductsTable,
+			Columns: cart.ProductsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
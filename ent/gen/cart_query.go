@@ -9,25 +9,28 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/thang1834/go-goss/ent/gen/cart"
 	"github.com/thang1834/go-goss/ent/gen/cartitem"
 	"github.com/thang1834/go-goss/ent/gen/predicate"
+	"github.com/thang1834/go-goss/ent/gen/product"
 	"github.com/thang1834/go-goss/ent/gen/user"
 )
 
 // CartQuery is the builder for querying Cart entities.
 type CartQuery struct {
 	config
-	ctx        *QueryContext
-	order      []cart.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Cart
-	withUser   *UserQuery
-	withItems  *CartItemQuery
-	withFKs    bool
+	ctx          *QueryContext
+	order        []cart.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.Cart
+	withUser     *UserQuery
+	withProducts *ProductQuery
+	withItems    *CartItemQuery
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -86,6 +89,28 @@ func (cq *CartQuery) QueryUser() *UserQuery {
 	return query
 }
 
+// QueryProducts chains the current query on the "products" edge.
+func (cq *CartQuery) QueryProducts() *ProductQuery {
+	query := (&ProductClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(cart.Table, cart.FieldID, selector),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, cart.ProductsTable, cart.ProductsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryItems chains the current query on the "items" edge.
 func (cq *CartQuery) QueryItems() *CartItemQuery {
 	query := (&CartItemClient{config: cq.config}).Query()
@@ -99,8 +124,8 @@ func (cq *CartQuery) QueryItems() *CartItemQuery {
 		}
 		step := sqlgraph.NewStep(
 			sqlgraph.From(cart.Table, cart.FieldID, selector),
-			sqlgraph.To(cartitem.Table, cartitem.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, cart.ItemsTable, cart.ItemsColumn),
+			sqlgraph.To(cartitem.Table, cartitem.CartColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, cart.ItemsTable, cart.ItemsColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
 		return fromU, nil
@@ -295,13 +320,14 @@ func (cq *CartQuery) Clone() *CartQuery {
 		return nil
 	}
 	return &CartQuery{
-		config:     cq.config,
-		ctx:        cq.ctx.Clone(),
-		order:      append([]cart.OrderOption{}, cq.order...),
-		inters:     append([]Interceptor{}, cq.inters...),
-		predicates: append([]predicate.Cart{}, cq.predicates...),
-		withUser:   cq.withUser.Clone(),
-		withItems:  cq.withItems.Clone(),
+		config:       cq.config,
+		ctx:          cq.ctx.Clone(),
+		order:        append([]cart.OrderOption{}, cq.order...),
+		inters:       append([]Interceptor{}, cq.inters...),
+		predicates:   append([]predicate.Cart{}, cq.predicates...),
+		withUser:     cq.withUser.Clone(),
+		withProducts: cq.withProducts.Clone(),
+		withItems:    cq.withItems.Clone(),
 		// clone intermediate query.
 		sql:  cq.sql.Clone(),
 		path: cq.path,
@@ -319,6 +345,17 @@ func (cq *CartQuery) WithUser(opts ...func(*UserQuery)) *CartQuery {
 	return cq
 }
 
+// WithProducts tells the query-builder to eager-load the nodes that are connected to
+// the "products" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CartQuery) WithProducts(opts ...func(*ProductQuery)) *CartQuery {
+	query := (&ProductClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withProducts = query
+	return cq
+}
+
 // WithItems tells the query-builder to eager-load the nodes that are connected to
 // the "items" edge. The optional arguments are used to configure the query builder of the edge.
 func (cq *CartQuery) WithItems(opts ...func(*CartItemQuery)) *CartQuery {
@@ -336,12 +373,12 @@ func (cq *CartQuery) WithItems(opts ...func(*CartItemQuery)) *CartQuery {
 // Example:
 //
 //	var v []struct {
-//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		UserID uint64 `json:"user_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Cart.Query().
-//		GroupBy(cart.FieldCreatedAt).
+//		GroupBy(cart.FieldUserID).
 //		Aggregate(gen.Count()).
 //		Scan(ctx, &v)
 func (cq *CartQuery) GroupBy(field string, fields ...string) *CartGroupBy {
@@ -359,11 +396,11 @@ func (cq *CartQuery) GroupBy(field string, fields ...string) *CartGroupBy {
 // Example:
 //
 //	var v []struct {
-//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		UserID uint64 `json:"user_id,omitempty"`
 //	}
 //
 //	client.Cart.Query().
-//		Select(cart.FieldCreatedAt).
+//		Select(cart.FieldUserID).
 //		Scan(ctx, &v)
 func (cq *CartQuery) Select(fields ...string) *CartSelect {
 	cq.ctx.Fields = append(cq.ctx.Fields, fields...)
@@ -407,19 +444,13 @@ func (cq *CartQuery) prepareQuery(ctx context.Context) error {
 func (cq *CartQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cart, error) {
 	var (
 		nodes       = []*Cart{}
-		withFKs     = cq.withFKs
 		_spec       = cq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			cq.withUser != nil,
+			cq.withProducts != nil,
 			cq.withItems != nil,
 		}
 	)
-	if cq.withUser != nil {
-		withFKs = true
-	}
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, cart.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Cart).scanValues(nil, columns)
 	}
@@ -429,6 +460,9 @@ func (cq *CartQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cart, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(cq.modifiers) > 0 {
+		_spec.Modifiers = cq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -444,6 +478,13 @@ func (cq *CartQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cart, e
 			return nil, err
 		}
 	}
+	if query := cq.withProducts; query != nil {
+		if err := cq.loadProducts(ctx, query, nodes,
+			func(n *Cart) { n.Edges.Products = []*Product{} },
+			func(n *Cart, e *Product) { n.Edges.Products = append(n.Edges.Products, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := cq.withItems; query != nil {
 		if err := cq.loadItems(ctx, query, nodes,
 			func(n *Cart) { n.Edges.Items = []*CartItem{} },
@@ -458,10 +499,10 @@ func (cq *CartQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*Ca
 	ids := make([]uint64, 0, len(nodes))
 	nodeids := make(map[uint64][]*Cart)
 	for i := range nodes {
-		if nodes[i].user_carts == nil {
+		if nodes[i].UserID == nil {
 			continue
 		}
-		fk := *nodes[i].user_carts
+		fk := *nodes[i].UserID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -478,7 +519,7 @@ func (cq *CartQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*Ca
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "user_carts" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -486,6 +527,67 @@ func (cq *CartQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*Ca
 	}
 	return nil
 }
+func (cq *CartQuery) loadProducts(ctx context.Context, query *ProductQuery, nodes []*Cart, init func(*Cart), assign func(*Cart, *Product)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uint64]*Cart)
+	nids := make(map[uint64]map[*Cart]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(cart.ProductsTable)
+		s.Join(joinT).On(s.C(product.FieldID), joinT.C(cart.ProductsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(cart.ProductsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(cart.ProductsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := uint64(values[0].(*sql.NullInt64).Int64)
+				inValue := uint64(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Cart]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Product](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "products" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 func (cq *CartQuery) loadItems(ctx context.Context, query *CartItemQuery, nodes []*Cart, init func(*Cart), assign func(*Cart, *CartItem)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uint64]*Cart)
@@ -496,7 +598,9 @@ func (cq *CartQuery) loadItems(ctx context.Context, query *CartItemQuery, nodes
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(cartitem.FieldCartID)
+	}
 	query.Where(predicate.CartItem(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(cart.ItemsColumn), fks...))
 	}))
@@ -505,13 +609,10 @@ func (cq *CartQuery) loadItems(ctx context.Context, query *CartItemQuery, nodes
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.cart_items
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "cart_items" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.CartID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "cart_items" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "cart_id" returned %v for node %v`, fk, n)
 		}
 		assign(node, n)
 	}
@@ -520,6 +621,9 @@ func (cq *CartQuery) loadItems(ctx context.Context, query *CartItemQuery, nodes
 
 func (cq *CartQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := cq.querySpec()
+	if len(cq.modifiers) > 0 {
+		_spec.Modifiers = cq.modifiers
+	}
 	_spec.Node.Columns = cq.ctx.Fields
 	if len(cq.ctx.Fields) > 0 {
 		_spec.Unique = cq.ctx.Unique != nil && *cq.ctx.Unique
@@ -543,6 +647,9 @@ func (cq *CartQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if cq.withUser != nil {
+			_spec.Node.AddColumnOnce(cart.FieldUserID)
+		}
 	}
 	if ps := cq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -582,6 +689,9 @@ func (cq *CartQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if cq.ctx.Unique != nil && *cq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range cq.modifiers {
+		m(selector)
+	}
 	for _, p := range cq.predicates {
 		p(selector)
 	}
@@ -599,6 +709,32 @@ func (cq *CartQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (cq *CartQuery) ForUpdate(opts ...sql.LockOption) *CartQuery {
+	if cq.driver.Dialect() == dialect.Postgres {
+		cq.Unique(false)
+	}
+	cq.modifiers = append(cq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return cq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (cq *CartQuery) ForShare(opts ...sql.LockOption) *CartQuery {
+	if cq.driver.Dialect() == dialect.Postgres {
+		cq.Unique(false)
+	}
+	cq.modifiers = append(cq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return cq
+}
+
 // CartGroupBy is the group-by builder for Cart entities.
 type CartGroupBy struct {
 	selector
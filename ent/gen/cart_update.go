@@ -12,8 +12,8 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/thang1834/go-goss/ent/gen/cart"
-	"github.com/thang1834/go-goss/ent/gen/cartitem"
 	"github.com/thang1834/go-goss/ent/gen/predicate"
+	"github.com/thang1834/go-goss/ent/gen/product"
 	"github.com/thang1834/go-goss/ent/gen/user"
 )
 
@@ -30,6 +30,26 @@ func (cu *CartUpdate) Where(ps ...predicate.Cart) *CartUpdate {
 	return cu
 }
 
+// SetUserID sets the "user_id" field.
+func (cu *CartUpdate) SetUserID(u uint64) *CartUpdate {
+	cu.mutation.SetUserID(u)
+	return cu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (cu *CartUpdate) SetNillableUserID(u *uint64) *CartUpdate {
+	if u != nil {
+		cu.SetUserID(*u)
+	}
+	return cu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (cu *CartUpdate) ClearUserID() *CartUpdate {
+	cu.mutation.ClearUserID()
+	return cu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (cu *CartUpdate) SetCreatedAt(t time.Time) *CartUpdate {
 	cu.mutation.SetCreatedAt(t)
@@ -50,38 +70,24 @@ func (cu *CartUpdate) SetUpdatedAt(t time.Time) *CartUpdate {
 	return cu
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (cu *CartUpdate) SetUserID(id uint64) *CartUpdate {
-	cu.mutation.SetUserID(id)
-	return cu
-}
-
-// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
-func (cu *CartUpdate) SetNillableUserID(id *uint64) *CartUpdate {
-	if id != nil {
-		cu = cu.SetUserID(*id)
-	}
-	return cu
-}
-
 // SetUser sets the "user" edge to the User entity.
 func (cu *CartUpdate) SetUser(u *User) *CartUpdate {
 	return cu.SetUserID(u.ID)
 }
 
-// AddItemIDs adds the "items" edge to the CartItem entity by IDs.
-func (cu *CartUpdate) AddItemIDs(ids ...int) *CartUpdate {
-	cu.mutation.AddItemIDs(ids...)
+// AddProductIDs adds the "products" edge to the Product entity by IDs.
+func (cu *CartUpdate) AddProductIDs(ids ...uint64) *CartUpdate {
+	cu.mutation.AddProductIDs(ids...)
 	return cu
 }
 
-// AddItems adds the "items" edges to the CartItem entity.
-func (cu *CartUpdate) AddItems(c ...*CartItem) *CartUpdate {
-	ids := make([]int, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+// AddProducts adds the "products" edges to the Product entity.
+func (cu *CartUpdate) AddProducts(p ...*Product) *CartUpdate {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	return cu.AddItemIDs(ids...)
+	return cu.AddProductIDs(ids...)
 }
 
 // Mutation returns the CartMutation object of the builder.
@@ -95,25 +101,25 @@ func (cu *CartUpdate) ClearUser() *CartUpdate {
 	return cu
 }
 
-// ClearItems clears all "items" edges to the CartItem entity.
-func (cu *CartUpdate) ClearItems() *CartUpdate {
-	cu.mutation.ClearItems()
+// ClearProducts clears all "products" edges to the Product entity.
+func (cu *CartUpdate) ClearProducts() *CartUpdate {
+	cu.mutation.ClearProducts()
 	return cu
 }
 
-// RemoveItemIDs removes the "items" edge to CartItem entities by IDs.
-func (cu *CartUpdate) RemoveItemIDs(ids ...int) *CartUpdate {
-	cu.mutation.RemoveItemIDs(ids...)
+// RemoveProductIDs removes the "products" edge to Product entities by IDs.
+func (cu *CartUpdate) RemoveProductIDs(ids ...uint64) *CartUpdate {
+	cu.mutation.RemoveProductIDs(ids...)
 	return cu
 }
 
-// RemoveItems removes "items" edges to CartItem entities.
-func (cu *CartUpdate) RemoveItems(c ...*CartItem) *CartUpdate {
-	ids := make([]int, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+// RemoveProducts removes "products" edges to Product entities.
+func (cu *CartUpdate) RemoveProducts(p ...*Product) *CartUpdate {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	return cu.RemoveItemIDs(ids...)
+	return cu.RemoveProductIDs(ids...)
 }
 
 // Save executes the query and returns the number of nodes affected by the update operation.
@@ -196,28 +202,28 @@ func (cu *CartUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if cu.mutation.ItemsCleared() {
+	if cu.mutation.ProductsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   cart.ItemsTable,
-			Columns: []string{cart.ItemsColumn},
+			Table:   cart.ProductsTable,
+			Columns: cart.ProductsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUint64),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := cu.mutation.RemovedItemsIDs(); len(nodes) > 0 && !cu.mutation.ItemsCleared() {
+	if nodes := cu.mutation.RemovedProductsIDs(); len(nodes) > 0 && !cu.mutation.ProductsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   cart.ItemsTable,
-			Columns: []string{cart.ItemsColumn},
+			Table:   cart.ProductsTable,
+			Columns: cart.ProductsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -225,15 +231,15 @@ func (cu *CartUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := cu.mutation.ItemsIDs(); len(nodes) > 0 {
+	if nodes := cu.mutation.ProductsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   cart.ItemsTable,
-			Columns: []string{cart.ItemsColumn},
+			Table:   cart.ProductsTable,
+			Columns: cart.ProductsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -261,6 +267,26 @@ type CartUpdateOne struct {
 	mutation *CartMutation
 }
 
+// SetUserID sets the "user_id" field.
+func (cuo *CartUpdateOne) SetUserID(u uint64) *CartUpdateOne {
+	cuo.mutation.SetUserID(u)
+	return cuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (cuo *CartUpdateOne) SetNillableUserID(u *uint64) *CartUpdateOne {
+	if u != nil {
+		cuo.SetUserID(*u)
+	}
+	return cuo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (cuo *CartUpdateOne) ClearUserID() *CartUpdateOne {
+	cuo.mutation.ClearUserID()
+	return cuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (cuo *CartUpdateOne) SetCreatedAt(t time.Time) *CartUpdateOne {
 	cuo.mutation.SetCreatedAt(t)
@@ -281,38 +307,24 @@ func (cuo *CartUpdateOne) SetUpdatedAt(t time.Time) *CartUpdateOne {
 	return cuo
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (cuo *CartUpdateOne) SetUserID(id uint64) *CartUpdateOne {
-	cuo.mutation.SetUserID(id)
-	return cuo
-}
-
-// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
-func (cuo *CartUpdateOne) SetNillableUserID(id *uint64) *CartUpdateOne {
-	if id != nil {
-		cuo = cuo.SetUserID(*id)
-	}
-	return cuo
-}
-
 // SetUser sets the "user" edge to the User entity.
 func (cuo *CartUpdateOne) SetUser(u *User) *CartUpdateOne {
 	return cuo.SetUserID(u.ID)
 }
 
-// AddItemIDs adds the "items" edge to the CartItem entity by IDs.
-func (cuo *CartUpdateOne) AddItemIDs(ids ...int) *CartUpdateOne {
-	cuo.mutation.AddItemIDs(ids...)
+// AddProductIDs adds the "products" edge to the Product entity by IDs.
+func (cuo *CartUpdateOne) AddProductIDs(ids ...uint64) *CartUpdateOne {
+	cuo.mutation.AddProductIDs(ids...)
 	return cuo
 }
 
-// AddItems adds the "items" edges to the CartItem entity.
-func (cuo *CartUpdateOne) AddItems(c ...*CartItem) *CartUpdateOne {
-	ids := make([]int, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+// AddProducts adds the "products" edges to the Product entity.
+func (cuo *CartUpdateOne) AddProducts(p ...*Product) *CartUpdateOne {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	return cuo.AddItemIDs(ids...)
+	return cuo.AddProductIDs(ids...)
 }
 
 // Mutation returns the CartMutation object of the builder.
@@ -326,25 +338,25 @@ func (cuo *CartUpdateOne) ClearUser() *CartUpdateOne {
 	return cuo
 }
 
-// ClearItems clears all "items" edges to the CartItem entity.
-func (cuo *CartUpdateOne) ClearItems() *CartUpdateOne {
-	cuo.mutation.ClearItems()
+// ClearProducts clears all "products" edges to the Product entity.
+func (cuo *CartUpdateOne) ClearProducts() *CartUpdateOne {
+	cuo.mutation.ClearProducts()
 	return cuo
 }
 
-// RemoveItemIDs removes the "items" edge to CartItem entities by IDs.
-func (cuo *CartUpdateOne) RemoveItemIDs(ids ...int) *CartUpdateOne {
-	cuo.mutation.RemoveItemIDs(ids...)
+// RemoveProductIDs removes the "products" edge to Product entities by IDs.
+func (cuo *CartUpdateOne) RemoveProductIDs(ids ...uint64) *CartUpdateOne {
+	cuo.mutation.RemoveProductIDs(ids...)
 	return cuo
 }
 
-// RemoveItems removes "items" edges to CartItem entities.
-func (cuo *CartUpdateOne) RemoveItems(c ...*CartItem) *CartUpdateOne {
-	ids := make([]int, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+// RemoveProducts removes "products" edges to Product entities.
+func (cuo *CartUpdateOne) RemoveProducts(p ...*Product) *CartUpdateOne {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	return cuo.RemoveItemIDs(ids...)
+	return cuo.RemoveProductIDs(ids...)
 }
 
 // Where appends a list predicates to the CartUpdate builder.
@@ -457,28 +469,28 @@ func (cuo *CartUpdateOne) sqlSave(ctx context.Context) (_node *Cart, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if cuo.mutation.ItemsCleared() {
+	if cuo.mutation.ProductsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   cart.ItemsTable,
-			Columns: []string{cart.ItemsColumn},
+			Table:   cart.ProductsTable,
+			Columns: cart.ProductsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUint64),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := cuo.mutation.RemovedItemsIDs(); len(nodes) > 0 && !cuo.mutation.ItemsCleared() {
+	if nodes := cuo.mutation.RemovedProductsIDs(); len(nodes) > 0 && !cuo.mutation.ProductsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   cart.ItemsTable,
-			Columns: []string{cart.ItemsColumn},
+			Table:   cart.ProductsTable,
+			Columns: cart.ProductsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -486,15 +498,15 @@ func (cuo *CartUpdateOne) sqlSave(ctx context.Context) (_node *Cart, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := cuo.mutation.ItemsIDs(); len(nodes) > 0 {
+	if nodes := cuo.mutation.ProductsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   cart.ItemsTable,
-			Columns: []string{cart.ItemsColumn},
+			Table:   cart.ProductsTable,
+			Columns: cart.ProductsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
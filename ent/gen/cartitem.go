@@ -16,16 +16,16 @@ import (
 // CartItem is the model entity for the CartItem schema.
 type CartItem struct {
 	config `json:"-"`
-	// ID of the ent.
-	ID int `json:"id,omitempty"`
+	// CartID holds the value of the "cart_id" field.
+	CartID uint64 `json:"cart_id,omitempty"`
+	// ProductID holds the value of the "product_id" field.
+	ProductID uint64 `json:"product_id,omitempty"`
 	// Quantity holds the value of the "quantity" field.
 	Quantity int `json:"quantity,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CartItemQuery when eager-loading is set.
-	Edges              CartItemEdges `json:"edges"`
-	cart_items         *uint64
-	product_cart_items *uint64
-	selectValues       sql.SelectValues
+	Edges        CartItemEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // CartItemEdges holds the relations/edges for other nodes in the graph.
@@ -66,11 +66,7 @@ func (*CartItem) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case cartitem.FieldID, cartitem.FieldQuantity:
-			values[i] = new(sql.NullInt64)
-		case cartitem.ForeignKeys[0]: // cart_items
-			values[i] = new(sql.NullInt64)
-		case cartitem.ForeignKeys[1]: // product_cart_items
+		case cartitem.FieldCartID, cartitem.FieldProductID, cartitem.FieldQuantity:
 			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -87,31 +83,23 @@ func (ci *CartItem) assignValues(columns []string, values []any) error {
 	}
 	for i := range columns {
 		switch columns[i] {
-		case cartitem.FieldID:
-			value, ok := values[i].(*sql.NullInt64)
-			if !ok {
-				return fmt.Errorf("unexpected type %T for field id", value)
-			}
-			ci.ID = int(value.Int64)
-		case cartitem.FieldQuantity:
+		case cartitem.FieldCartID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+				return fmt.Errorf("unexpected type %T for field cart_id", values[i])
 			} else if value.Valid {
-				ci.Quantity = int(value.Int64)
+				ci.CartID = uint64(value.Int64)
 			}
-		case cartitem.ForeignKeys[0]:
+		case cartitem.FieldProductID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field cart_items", value)
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
 			} else if value.Valid {
-				ci.cart_items = new(uint64)
-				*ci.cart_items = uint64(value.Int64)
+				ci.ProductID = uint64(value.Int64)
 			}
-		case cartitem.ForeignKeys[1]:
+		case cartitem.FieldQuantity:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field product_cart_items", value)
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
 			} else if value.Valid {
-				ci.product_cart_items = new(uint64)
-				*ci.product_cart_items = uint64(value.Int64)
+				ci.Quantity = int(value.Int64)
 			}
 		default:
 			ci.selectValues.Set(columns[i], values[i])
@@ -158,7 +146,12 @@ func (ci *CartItem) Unwrap() *CartItem {
 func (ci *CartItem) String() string {
 	var builder strings.Builder
 	builder.WriteString("CartItem(")
-	builder.WriteString(fmt.Sprintf("id=%v, ", ci.ID))
+	builder.WriteString("cart_id=")
+	builder.WriteString(fmt.Sprintf("%v", ci.CartID))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", ci.ProductID))
+	builder.WriteString(", ")
 	builder.WriteString("quantity=")
 	builder.WriteString(fmt.Sprintf("%v", ci.Quantity))
 	builder.WriteByte(')')
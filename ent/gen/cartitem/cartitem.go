@@ -10,14 +10,20 @@ import (
 const (
 	// Label holds the string label denoting the cartitem type in the database.
 	Label = "cart_item"
-	// FieldID holds the string denoting the id field in the database.
-	FieldID = "id"
+	// FieldCartID holds the string denoting the cart_id field in the database.
+	FieldCartID = "cart_id"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
 	// FieldQuantity holds the string denoting the quantity field in the database.
 	FieldQuantity = "quantity"
 	// EdgeCart holds the string denoting the cart edge name in mutations.
 	EdgeCart = "cart"
 	// EdgeProduct holds the string denoting the product edge name in mutations.
 	EdgeProduct = "product"
+	// CartFieldID holds the string denoting the ID field of the Cart.
+	CartFieldID = "id"
+	// ProductFieldID holds the string denoting the ID field of the Product.
+	ProductFieldID = "id"
 	// Table holds the table name of the cartitem in the database.
 	Table = "cart_items"
 	// CartTable is the table that holds the cart relation/edge.
@@ -26,29 +32,23 @@ const (
 	// It exists in this package in order to avoid circular dependency with the "cart" package.
 	CartInverseTable = "carts"
 	// CartColumn is the table column denoting the cart relation/edge.
-	CartColumn = "cart_items"
+	CartColumn = "cart_id"
 	// ProductTable is the table that holds the product relation/edge.
 	ProductTable = "cart_items"
 	// ProductInverseTable is the table name for the Product entity.
 	// It exists in this package in order to avoid circular dependency with the "product" package.
 	ProductInverseTable = "products"
 	// ProductColumn is the table column denoting the product relation/edge.
-	ProductColumn = "product_cart_items"
+	ProductColumn = "product_id"
 )
 
 // Columns holds all SQL columns for cartitem fields.
 var Columns = []string{
-	FieldID,
+	FieldCartID,
+	FieldProductID,
 	FieldQuantity,
 }
 
-// ForeignKeys holds the SQL foreign-keys that are owned by the "cart_items"
-// table and are not defined as standalone fields in the schema.
-var ForeignKeys = []string{
-	"cart_items",
-	"product_cart_items",
-}
-
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -56,20 +56,20 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
-	for i := range ForeignKeys {
-		if column == ForeignKeys[i] {
-			return true
-		}
-	}
 	return false
 }
 
 // OrderOption defines the ordering options for the CartItem queries.
 type OrderOption func(*sql.Selector)
 
-// ByID orders the results by the id field.
-func ByID(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldID, opts...).ToFunc()
+// ByCartID orders the results by the cart_id field.
+func ByCartID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCartID, opts...).ToFunc()
+}
+
+// ByProductID orders the results by the product_id field.
+func ByProductID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProductID, opts...).ToFunc()
 }
 
 // ByQuantity orders the results by the quantity field.
@@ -92,15 +92,15 @@ func ByProductField(field string, opts ...sql.OrderTermOption) OrderOption {
 }
 func newCartStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
-		sqlgraph.From(Table, FieldID),
-		sqlgraph.To(CartInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.M2O, true, CartTable, CartColumn),
+		sqlgraph.From(Table, CartColumn),
+		sqlgraph.To(CartInverseTable, CartFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, CartTable, CartColumn),
 	)
 }
 func newProductStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
-		sqlgraph.From(Table, FieldID),
-		sqlgraph.To(ProductInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.M2O, true, ProductTable, ProductColumn),
+		sqlgraph.From(Table, ProductColumn),
+		sqlgraph.To(ProductInverseTable, ProductFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ProductTable, ProductColumn),
 	)
 }
@@ -8,54 +8,59 @@ import (
 	"github.com/thang1834/go-goss/ent/gen/predicate"
 )
 
-// ID filters vertices based on their ID field.
-func ID(id int) predicate.CartItem {
-	return predicate.CartItem(sql.FieldEQ(FieldID, id))
+// CartID applies equality check predicate on the "cart_id" field. It's identical to CartIDEQ.
+func CartID(v uint64) predicate.CartItem {
+	return predicate.CartItem(sql.FieldEQ(FieldCartID, v))
 }
 
-// IDEQ applies the EQ predicate on the ID field.
-func IDEQ(id int) predicate.CartItem {
-	return predicate.CartItem(sql.FieldEQ(FieldID, id))
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v uint64) predicate.CartItem {
+	return predicate.CartItem(sql.FieldEQ(FieldProductID, v))
 }
 
-// IDNEQ applies the NEQ predicate on the ID field.
-func IDNEQ(id int) predicate.CartItem {
-	return predicate.CartItem(sql.FieldNEQ(FieldID, id))
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int) predicate.CartItem {
+	return predicate.CartItem(sql.FieldEQ(FieldQuantity, v))
 }
 
-// IDIn applies the In predicate on the ID field.
-func IDIn(ids ...int) predicate.CartItem {
-	return predicate.CartItem(sql.FieldIn(FieldID, ids...))
+// CartIDEQ applies the EQ predicate on the "cart_id" field.
+func CartIDEQ(v uint64) predicate.CartItem {
+	return predicate.CartItem(sql.FieldEQ(FieldCartID, v))
 }
 
-// IDNotIn applies the NotIn predicate on the ID field.
-func IDNotIn(ids ...int) predicate.CartItem {
-	return predicate.CartItem(sql.FieldNotIn(FieldID, ids...))
+// CartIDNEQ applies the NEQ predicate on the "cart_id" field.
+func CartIDNEQ(v uint64) predicate.CartItem {
+	return predicate.CartItem(sql.FieldNEQ(FieldCartID, v))
 }
 
-// IDGT applies the GT predicate on the ID field.
-func IDGT(id int) predicate.CartItem {
-	return predicate.CartItem(sql.FieldGT(FieldID, id))
+// CartIDIn applies the In predicate on the "cart_id" field.
+func CartIDIn(vs ...uint64) predicate.CartItem {
+	return predicate.CartItem(sql.FieldIn(FieldCartID, vs...))
 }
 
-// IDGTE applies the GTE predicate on the ID field.
-func IDGTE(id int) predicate.CartItem {
-	return predicate.CartItem(sql.FieldGTE(FieldID, id))
+// CartIDNotIn applies the NotIn predicate on the "cart_id" field.
+func CartIDNotIn(vs ...uint64) predicate.CartItem {
+	return predicate.CartItem(sql.FieldNotIn(FieldCartID, vs...))
 }
 
-// IDLT applies the LT predicate on the ID field.
-func IDLT(id int) predicate.CartItem {
-	return predicate.CartItem(sql.FieldLT(FieldID, id))
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v uint64) predicate.CartItem {
+	return predicate.CartItem(sql.FieldEQ(FieldProductID, v))
 }
 
-// IDLTE applies the LTE predicate on the ID field.
-func IDLTE(id int) predicate.CartItem {
-	return predicate.CartItem(sql.FieldLTE(FieldID, id))
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v uint64) predicate.CartItem {
+	return predicate.CartItem(sql.FieldNEQ(FieldProductID, v))
 }
 
-// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
-func Quantity(v int) predicate.CartItem {
-	return predicate.CartItem(sql.FieldEQ(FieldQuantity, v))
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...uint64) predicate.CartItem {
+	return predicate.CartItem(sql.FieldIn(FieldProductID, vs...))
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...uint64) predicate.CartItem {
+	return predicate.CartItem(sql.FieldNotIn(FieldProductID, vs...))
 }
 
 // QuantityEQ applies the EQ predicate on the "quantity" field.
@@ -102,8 +107,8 @@ func QuantityLTE(v int) predicate.CartItem {
 func HasCart() predicate.CartItem {
 	return predicate.CartItem(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, CartTable, CartColumn),
+			sqlgraph.From(Table, CartColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, CartTable, CartColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -125,8 +130,8 @@ func HasCartWith(preds ...predicate.Cart) predicate.CartItem {
 func HasProduct() predicate.CartItem {
 	return predicate.CartItem(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, ProductTable, ProductColumn),
+			sqlgraph.From(Table, ProductColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, ProductTable, ProductColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -21,23 +21,21 @@ type CartItemCreate struct {
 	hooks    []Hook
 }
 
-// SetQuantity sets the "quantity" field.
-func (cic *CartItemCreate) SetQuantity(i int) *CartItemCreate {
-	cic.mutation.SetQuantity(i)
+// SetCartID sets the "cart_id" field.
+func (cic *CartItemCreate) SetCartID(u uint64) *CartItemCreate {
+	cic.mutation.SetCartID(u)
 	return cic
 }
 
-// SetCartID sets the "cart" edge to the Cart entity by ID.
-func (cic *CartItemCreate) SetCartID(id uint64) *CartItemCreate {
-	cic.mutation.SetCartID(id)
+// SetProductID sets the "product_id" field.
+func (cic *CartItemCreate) SetProductID(u uint64) *CartItemCreate {
+	cic.mutation.SetProductID(u)
 	return cic
 }
 
-// SetNillableCartID sets the "cart" edge to the Cart entity by ID if the given value is not nil.
-func (cic *CartItemCreate) SetNillableCartID(id *uint64) *CartItemCreate {
-	if id != nil {
-		cic = cic.SetCartID(*id)
-	}
+// SetQuantity sets the "quantity" field.
+func (cic *CartItemCreate) SetQuantity(i int) *CartItemCreate {
+	cic.mutation.SetQuantity(i)
 	return cic
 }
 
@@ -46,20 +44,6 @@ func (cic *CartItemCreate) SetCart(c *Cart) *CartItemCreate {
 	return cic.SetCartID(c.ID)
 }
 
-// SetProductID sets the "product" edge to the Product entity by ID.
-func (cic *CartItemCreate) SetProductID(id uint64) *CartItemCreate {
-	cic.mutation.SetProductID(id)
-	return cic
-}
-
-// SetNillableProductID sets the "product" edge to the Product entity by ID if the given value is not nil.
-func (cic *CartItemCreate) SetNillableProductID(id *uint64) *CartItemCreate {
-	if id != nil {
-		cic = cic.SetProductID(*id)
-	}
-	return cic
-}
-
 // SetProduct sets the "product" edge to the Product entity.
 func (cic *CartItemCreate) SetProduct(p *Product) *CartItemCreate {
 	return cic.SetProductID(p.ID)
@@ -99,9 +83,21 @@ func (cic *CartItemCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (cic *CartItemCreate) check() error {
+	if _, ok := cic.mutation.CartID(); !ok {
+		return &ValidationError{Name: "cart_id", err: errors.New(`gen: missing required field "CartItem.cart_id"`)}
+	}
+	if _, ok := cic.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`gen: missing required field "CartItem.product_id"`)}
+	}
 	if _, ok := cic.mutation.Quantity(); !ok {
 		return &ValidationError{Name: "quantity", err: errors.New(`gen: missing required field "CartItem.quantity"`)}
 	}
+	if len(cic.mutation.CartIDs()) == 0 {
+		return &ValidationError{Name: "cart", err: errors.New(`gen: missing required edge "CartItem.cart"`)}
+	}
+	if len(cic.mutation.ProductIDs()) == 0 {
+		return &ValidationError{Name: "product", err: errors.New(`gen: missing required edge "CartItem.product"`)}
+	}
 	return nil
 }
 
@@ -116,17 +112,13 @@ func (cic *CartItemCreate) sqlSave(ctx context.Context) (*CartItem, error) {
 		}
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	_node.ID = int(id)
-	cic.mutation.id = &_node.ID
-	cic.mutation.done = true
 	return _node, nil
 }
 
 func (cic *CartItemCreate) createSpec() (*CartItem, *sqlgraph.CreateSpec) {
 	var (
 		_node = &CartItem{config: cic.config}
-		_spec = sqlgraph.NewCreateSpec(cartitem.Table, sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeInt))
+		_spec = sqlgraph.NewCreateSpec(cartitem.Table, nil)
 	)
 	if value, ok := cic.mutation.Quantity(); ok {
 		_spec.SetField(cartitem.FieldQuantity, field.TypeInt, value)
@@ -135,7 +127,7 @@ func (cic *CartItemCreate) createSpec() (*CartItem, *sqlgraph.CreateSpec) {
 	if nodes := cic.mutation.CartIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   cartitem.CartTable,
 			Columns: []string{cartitem.CartColumn},
 			Bidi:    false,
@@ -146,13 +138,13 @@ func (cic *CartItemCreate) createSpec() (*CartItem, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.cart_items = &nodes[0]
+		_node.CartID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := cic.mutation.ProductIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   cartitem.ProductTable,
 			Columns: []string{cartitem.ProductColumn},
 			Bidi:    false,
@@ -163,7 +155,7 @@ func (cic *CartItemCreate) createSpec() (*CartItem, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.product_cart_items = &nodes[0]
+		_node.ProductID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
@@ -212,11 +204,6 @@ func (cicb *CartItemCreateBulk) Save(ctx context.Context) ([]*CartItem, error) {
 				if err != nil {
 					return nil, err
 				}
-				mutation.id = &nodes[i].ID
-				if specs[i].ID.Value != nil {
-					id := specs[i].ID.Value.(int64)
-					nodes[i].ID = int(id)
-				}
 				mutation.done = true
 				return nodes[i], nil
 			})
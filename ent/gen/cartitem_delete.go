@@ -7,7 +7,6 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
-	"entgo.io/ent/schema/field"
 	"github.com/thang1834/go-goss/ent/gen/cartitem"
 	"github.com/thang1834/go-goss/ent/gen/predicate"
 )
@@ -40,7 +39,7 @@ func (cid *CartItemDelete) ExecX(ctx context.Context) int {
 }
 
 func (cid *CartItemDelete) sqlExec(ctx context.Context) (int, error) {
-	_spec := sqlgraph.NewDeleteSpec(cartitem.Table, sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeInt))
+	_spec := sqlgraph.NewDeleteSpec(cartitem.Table, nil)
 	if ps := cid.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -8,9 +8,9 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
-	"entgo.io/ent/schema/field"
 	"github.com/thang1834/go-goss/ent/gen/cart"
 	"github.com/thang1834/go-goss/ent/gen/cartitem"
 	"github.com/thang1834/go-goss/ent/gen/predicate"
@@ -26,7 +26,7 @@ type CartItemQuery struct {
 	predicates  []predicate.CartItem
 	withCart    *CartQuery
 	withProduct *ProductQuery
-	withFKs     bool
+	modifiers   []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -75,9 +75,9 @@ func (ciq *CartItemQuery) QueryCart() *CartQuery {
 			return nil, err
 		}
 		step := sqlgraph.NewStep(
-			sqlgraph.From(cartitem.Table, cartitem.FieldID, selector),
+			sqlgraph.From(cartitem.Table, cartitem.CartColumn, selector),
 			sqlgraph.To(cart.Table, cart.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, cartitem.CartTable, cartitem.CartColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, cartitem.CartTable, cartitem.CartColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(ciq.driver.Dialect(), step)
 		return fromU, nil
@@ -97,9 +97,9 @@ func (ciq *CartItemQuery) QueryProduct() *ProductQuery {
 			return nil, err
 		}
 		step := sqlgraph.NewStep(
-			sqlgraph.From(cartitem.Table, cartitem.FieldID, selector),
+			sqlgraph.From(cartitem.Table, cartitem.ProductColumn, selector),
 			sqlgraph.To(product.Table, product.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, cartitem.ProductTable, cartitem.ProductColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, cartitem.ProductTable, cartitem.ProductColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(ciq.driver.Dialect(), step)
 		return fromU, nil
@@ -129,29 +129,6 @@ func (ciq *CartItemQuery) FirstX(ctx context.Context) *CartItem {
 	return node
 }
 
-// FirstID returns the first CartItem ID from the query.
-// Returns a *NotFoundError when no CartItem ID was found.
-func (ciq *CartItemQuery) FirstID(ctx context.Context) (id int, err error) {
-	var ids []int
-	if ids, err = ciq.Limit(1).IDs(setContextOp(ctx, ciq.ctx, ent.OpQueryFirstID)); err != nil {
-		return
-	}
-	if len(ids) == 0 {
-		err = &NotFoundError{cartitem.Label}
-		return
-	}
-	return ids[0], nil
-}
-
-// FirstIDX is like FirstID, but panics if an error occurs.
-func (ciq *CartItemQuery) FirstIDX(ctx context.Context) int {
-	id, err := ciq.FirstID(ctx)
-	if err != nil && !IsNotFound(err) {
-		panic(err)
-	}
-	return id
-}
-
 // Only returns a single CartItem entity found by the query, ensuring it only returns one.
 // Returns a *NotSingularError when more than one CartItem entity is found.
 // Returns a *NotFoundError when no CartItem entities are found.
@@ -179,34 +156,6 @@ func (ciq *CartItemQuery) OnlyX(ctx context.Context) *CartItem {
 	return node
 }
 
-// OnlyID is like Only, but returns the only CartItem ID in the query.
-// Returns a *NotSingularError when more than one CartItem ID is found.
-// Returns a *NotFoundError when no entities are found.
-func (ciq *CartItemQuery) OnlyID(ctx context.Context) (id int, err error) {
-	var ids []int
-	if ids, err = ciq.Limit(2).IDs(setContextOp(ctx, ciq.ctx, ent.OpQueryOnlyID)); err != nil {
-		return
-	}
-	switch len(ids) {
-	case 1:
-		id = ids[0]
-	case 0:
-		err = &NotFoundError{cartitem.Label}
-	default:
-		err = &NotSingularError{cartitem.Label}
-	}
-	return
-}
-
-// OnlyIDX is like OnlyID, but panics if an error occurs.
-func (ciq *CartItemQuery) OnlyIDX(ctx context.Context) int {
-	id, err := ciq.OnlyID(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return id
-}
-
 // All executes the query and returns a list of CartItems.
 func (ciq *CartItemQuery) All(ctx context.Context) ([]*CartItem, error) {
 	ctx = setContextOp(ctx, ciq.ctx, ent.OpQueryAll)
@@ -226,27 +175,6 @@ func (ciq *CartItemQuery) AllX(ctx context.Context) []*CartItem {
 	return nodes
 }
 
-// IDs executes the query and returns a list of CartItem IDs.
-func (ciq *CartItemQuery) IDs(ctx context.Context) (ids []int, err error) {
-	if ciq.ctx.Unique == nil && ciq.path != nil {
-		ciq.Unique(true)
-	}
-	ctx = setContextOp(ctx, ciq.ctx, ent.OpQueryIDs)
-	if err = ciq.Select(cartitem.FieldID).Scan(ctx, &ids); err != nil {
-		return nil, err
-	}
-	return ids, nil
-}
-
-// IDsX is like IDs, but panics if an error occurs.
-func (ciq *CartItemQuery) IDsX(ctx context.Context) []int {
-	ids, err := ciq.IDs(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return ids
-}
-
 // Count returns the count of the given query.
 func (ciq *CartItemQuery) Count(ctx context.Context) (int, error) {
 	ctx = setContextOp(ctx, ciq.ctx, ent.OpQueryCount)
@@ -268,7 +196,7 @@ func (ciq *CartItemQuery) CountX(ctx context.Context) int {
 // Exist returns true if the query has elements in the graph.
 func (ciq *CartItemQuery) Exist(ctx context.Context) (bool, error) {
 	ctx = setContextOp(ctx, ciq.ctx, ent.OpQueryExist)
-	switch _, err := ciq.FirstID(ctx); {
+	switch _, err := ciq.First(ctx); {
 	case IsNotFound(err):
 		return false, nil
 	case err != nil:
@@ -335,12 +263,12 @@ func (ciq *CartItemQuery) WithProduct(opts ...func(*ProductQuery)) *CartItemQuer
 // Example:
 //
 //	var v []struct {
-//		Quantity int `json:"quantity,omitempty"`
+//		CartID uint64 `json:"cart_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.CartItem.Query().
-//		GroupBy(cartitem.FieldQuantity).
+//		GroupBy(cartitem.FieldCartID).
 //		Aggregate(gen.Count()).
 //		Scan(ctx, &v)
 func (ciq *CartItemQuery) GroupBy(field string, fields ...string) *CartItemGroupBy {
@@ -358,11 +286,11 @@ func (ciq *CartItemQuery) GroupBy(field string, fields ...string) *CartItemGroup
 // Example:
 //
 //	var v []struct {
-//		Quantity int `json:"quantity,omitempty"`
+//		CartID uint64 `json:"cart_id,omitempty"`
 //	}
 //
 //	client.CartItem.Query().
-//		Select(cartitem.FieldQuantity).
+//		Select(cartitem.FieldCartID).
 //		Scan(ctx, &v)
 func (ciq *CartItemQuery) Select(fields ...string) *CartItemSelect {
 	ciq.ctx.Fields = append(ciq.ctx.Fields, fields...)
@@ -406,19 +334,12 @@ func (ciq *CartItemQuery) prepareQuery(ctx context.Context) error {
 func (ciq *CartItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CartItem, error) {
 	var (
 		nodes       = []*CartItem{}
-		withFKs     = ciq.withFKs
 		_spec       = ciq.querySpec()
 		loadedTypes = [2]bool{
 			ciq.withCart != nil,
 			ciq.withProduct != nil,
 		}
 	)
-	if ciq.withCart != nil || ciq.withProduct != nil {
-		withFKs = true
-	}
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, cartitem.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*CartItem).scanValues(nil, columns)
 	}
@@ -428,6 +349,9 @@ func (ciq *CartItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ca
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(ciq.modifiers) > 0 {
+		_spec.Modifiers = ciq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -456,10 +380,7 @@ func (ciq *CartItemQuery) loadCart(ctx context.Context, query *CartQuery, nodes
 	ids := make([]uint64, 0, len(nodes))
 	nodeids := make(map[uint64][]*CartItem)
 	for i := range nodes {
-		if nodes[i].cart_items == nil {
-			continue
-		}
-		fk := *nodes[i].cart_items
+		fk := nodes[i].CartID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -476,7 +397,7 @@ func (ciq *CartItemQuery) loadCart(ctx context.Context, query *CartQuery, nodes
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "cart_items" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "cart_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -488,10 +409,7 @@ func (ciq *CartItemQuery) loadProduct(ctx context.Context, query *ProductQuery,
 	ids := make([]uint64, 0, len(nodes))
 	nodeids := make(map[uint64][]*CartItem)
 	for i := range nodes {
-		if nodes[i].product_cart_items == nil {
-			continue
-		}
-		fk := *nodes[i].product_cart_items
+		fk := nodes[i].ProductID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -508,7 +426,7 @@ func (ciq *CartItemQuery) loadProduct(ctx context.Context, query *ProductQuery,
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "product_cart_items" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "product_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -519,15 +437,16 @@ func (ciq *CartItemQuery) loadProduct(ctx context.Context, query *ProductQuery,
 
 func (ciq *CartItemQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ciq.querySpec()
-	_spec.Node.Columns = ciq.ctx.Fields
-	if len(ciq.ctx.Fields) > 0 {
-		_spec.Unique = ciq.ctx.Unique != nil && *ciq.ctx.Unique
+	if len(ciq.modifiers) > 0 {
+		_spec.Modifiers = ciq.modifiers
 	}
+	_spec.Unique = false
+	_spec.Node.Columns = nil
 	return sqlgraph.CountNodes(ctx, ciq.driver, _spec)
 }
 
 func (ciq *CartItemQuery) querySpec() *sqlgraph.QuerySpec {
-	_spec := sqlgraph.NewQuerySpec(cartitem.Table, cartitem.Columns, sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeInt))
+	_spec := sqlgraph.NewQuerySpec(cartitem.Table, cartitem.Columns, nil)
 	_spec.From = ciq.sql
 	if unique := ciq.ctx.Unique; unique != nil {
 		_spec.Unique = *unique
@@ -536,11 +455,14 @@ func (ciq *CartItemQuery) querySpec() *sqlgraph.QuerySpec {
 	}
 	if fields := ciq.ctx.Fields; len(fields) > 0 {
 		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, cartitem.FieldID)
 		for i := range fields {
-			if fields[i] != cartitem.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
-			}
+			_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+		}
+		if ciq.withCart != nil {
+			_spec.Node.AddColumnOnce(cartitem.FieldCartID)
+		}
+		if ciq.withProduct != nil {
+			_spec.Node.AddColumnOnce(cartitem.FieldProductID)
 		}
 	}
 	if ps := ciq.predicates; len(ps) > 0 {
@@ -581,6 +503,9 @@ func (ciq *CartItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if ciq.ctx.Unique != nil && *ciq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range ciq.modifiers {
+		m(selector)
+	}
 	for _, p := range ciq.predicates {
 		p(selector)
 	}
@@ -598,6 +523,32 @@ func (ciq *CartItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ciq *CartItemQuery) ForUpdate(opts ...sql.LockOption) *CartItemQuery {
+	if ciq.driver.Dialect() == dialect.Postgres {
+		ciq.Unique(false)
+	}
+	ciq.modifiers = append(ciq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ciq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ciq *CartItemQuery) ForShare(opts ...sql.LockOption) *CartItemQuery {
+	if ciq.driver.Dialect() == dialect.Postgres {
+		ciq.Unique(false)
+	}
+	ciq.modifiers = append(ciq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ciq
+}
+
 // CartItemGroupBy is the group-by builder for CartItem entities.
 type CartItemGroupBy struct {
 	selector
@@ -29,6 +29,34 @@ func (ciu *CartItemUpdate) Where(ps ...predicate.CartItem) *CartItemUpdate {
 	return ciu
 }
 
+// SetCartID sets the "cart_id" field.
+func (ciu *CartItemUpdate) SetCartID(u uint64) *CartItemUpdate {
+	ciu.mutation.SetCartID(u)
+	return ciu
+}
+
+// SetNillableCartID sets the "cart_id" field if the given value is not nil.
+func (ciu *CartItemUpdate) SetNillableCartID(u *uint64) *CartItemUpdate {
+	if u != nil {
+		ciu.SetCartID(*u)
+	}
+	return ciu
+}
+
+// SetProductID sets the "product_id" field.
+func (ciu *CartItemUpdate) SetProductID(u uint64) *CartItemUpdate {
+	ciu.mutation.SetProductID(u)
+	return ciu
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (ciu *CartItemUpdate) SetNillableProductID(u *uint64) *CartItemUpdate {
+	if u != nil {
+		ciu.SetProductID(*u)
+	}
+	return ciu
+}
+
 // SetQuantity sets the "quantity" field.
 func (ciu *CartItemUpdate) SetQuantity(i int) *CartItemUpdate {
 	ciu.mutation.ResetQuantity()
@@ -50,39 +78,11 @@ func (ciu *CartItemUpdate) AddQuantity(i int) *CartItemUpdate {
 	return ciu
 }
 
-// SetCartID sets the "cart" edge to the Cart entity by ID.
-func (ciu *CartItemUpdate) SetCartID(id uint64) *CartItemUpdate {
-	ciu.mutation.SetCartID(id)
-	return ciu
-}
-
-// SetNillableCartID sets the "cart" edge to the Cart entity by ID if the given value is not nil.
-func (ciu *CartItemUpdate) SetNillableCartID(id *uint64) *CartItemUpdate {
-	if id != nil {
-		ciu = ciu.SetCartID(*id)
-	}
-	return ciu
-}
-
 // SetCart sets the "cart" edge to the Cart entity.
 func (ciu *CartItemUpdate) SetCart(c *Cart) *CartItemUpdate {
 	return ciu.SetCartID(c.ID)
 }
 
-// SetProductID sets the "product" edge to the Product entity by ID.
-func (ciu *CartItemUpdate) SetProductID(id uint64) *CartItemUpdate {
-	ciu.mutation.SetProductID(id)
-	return ciu
-}
-
-// SetNillableProductID sets the "product" edge to the Product entity by ID if the given value is not nil.
-func (ciu *CartItemUpdate) SetNillableProductID(id *uint64) *CartItemUpdate {
-	if id != nil {
-		ciu = ciu.SetProductID(*id)
-	}
-	return ciu
-}
-
 // SetProduct sets the "product" edge to the Product entity.
 func (ciu *CartItemUpdate) SetProduct(p *Product) *CartItemUpdate {
 	return ciu.SetProductID(p.ID)
@@ -132,8 +132,22 @@ func (ciu *CartItemUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (ciu *CartItemUpdate) check() error {
+	if ciu.mutation.CartCleared() && len(ciu.mutation.CartIDs()) > 0 {
+		return errors.New(`gen: clearing a required unique edge "CartItem.cart"`)
+	}
+	if ciu.mutation.ProductCleared() && len(ciu.mutation.ProductIDs()) > 0 {
+		return errors.New(`gen: clearing a required unique edge "CartItem.product"`)
+	}
+	return nil
+}
+
 func (ciu *CartItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	_spec := sqlgraph.NewUpdateSpec(cartitem.Table, cartitem.Columns, sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeInt))
+	if err := ciu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cartitem.Table, cartitem.Columns, sqlgraph.NewFieldSpec(cartitem.FieldCartID, field.TypeUint64), sqlgraph.NewFieldSpec(cartitem.FieldProductID, field.TypeUint64))
 	if ps := ciu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -150,7 +164,7 @@ func (ciu *CartItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ciu.mutation.CartCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   cartitem.CartTable,
 			Columns: []string{cartitem.CartColumn},
 			Bidi:    false,
@@ -163,7 +177,7 @@ func (ciu *CartItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if nodes := ciu.mutation.CartIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   cartitem.CartTable,
 			Columns: []string{cartitem.CartColumn},
 			Bidi:    false,
@@ -179,7 +193,7 @@ func (ciu *CartItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ciu.mutation.ProductCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   cartitem.ProductTable,
 			Columns: []string{cartitem.ProductColumn},
 			Bidi:    false,
@@ -192,7 +206,7 @@ func (ciu *CartItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if nodes := ciu.mutation.ProductIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   cartitem.ProductTable,
 			Columns: []string{cartitem.ProductColumn},
 			Bidi:    false,
@@ -225,6 +239,34 @@ type CartItemUpdateOne struct {
 	mutation *CartItemMutation
 }
 
+// SetCartID sets the "cart_id" field.
+func (ciuo *CartItemUpdateOne) SetCartID(u uint64) *CartItemUpdateOne {
+	ciuo.mutation.SetCartID(u)
+	return ciuo
+}
+
+// SetNillableCartID sets the "cart_id" field if the given value is not nil.
+func (ciuo *CartItemUpdateOne) SetNillableCartID(u *uint64) *CartItemUpdateOne {
+	if u != nil {
+		ciuo.SetCartID(*u)
+	}
+	return ciuo
+}
+
+// SetProductID sets the "product_id" field.
+func (ciuo *CartItemUpdateOne) SetProductID(u uint64) *CartItemUpdateOne {
+	ciuo.mutation.SetProductID(u)
+	return ciuo
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (ciuo *CartItemUpdateOne) SetNillableProductID(u *uint64) *CartItemUpdateOne {
+	if u != nil {
+		ciuo.SetProductID(*u)
+	}
+	return ciuo
+}
+
 // SetQuantity sets the "quantity" field.
 func (ciuo *CartItemUpdateOne) SetQuantity(i int) *CartItemUpdateOne {
 	ciuo.mutation.ResetQuantity()
@@ -246,39 +288,11 @@ func (ciuo *CartItemUpdateOne) AddQuantity(i int) *CartItemUpdateOne {
 	return ciuo
 }
 
-// SetCartID sets the "cart" edge to the Cart entity by ID.
-func (ciuo *CartItemUpdateOne) SetCartID(id uint64) *CartItemUpdateOne {
-	ciuo.mutation.SetCartID(id)
-	return ciuo
-}
-
-// SetNillableCartID sets the "cart" edge to the Cart entity by ID if the given value is not nil.
-func (ciuo *CartItemUpdateOne) SetNillableCartID(id *uint64) *CartItemUpdateOne {
-	if id != nil {
-		ciuo = ciuo.SetCartID(*id)
-	}
-	return ciuo
-}
-
 // SetCart sets the "cart" edge to the Cart entity.
 func (ciuo *CartItemUpdateOne) SetCart(c *Cart) *CartItemUpdateOne {
 	return ciuo.SetCartID(c.ID)
 }
 
-// SetProductID sets the "product" edge to the Product entity by ID.
-func (ciuo *CartItemUpdateOne) SetProductID(id uint64) *CartItemUpdateOne {
-	ciuo.mutation.SetProductID(id)
-	return ciuo
-}
-
-// SetNillableProductID sets the "product" edge to the Product entity by ID if the given value is not nil.
-func (ciuo *CartItemUpdateOne) SetNillableProductID(id *uint64) *CartItemUpdateOne {
-	if id != nil {
-		ciuo = ciuo.SetProductID(*id)
-	}
-	return ciuo
-}
-
 // SetProduct sets the "product" edge to the Product entity.
 func (ciuo *CartItemUpdateOne) SetProduct(p *Product) *CartItemUpdateOne {
 	return ciuo.SetProductID(p.ID)
@@ -341,23 +355,39 @@ func (ciuo *CartItemUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (ciuo *CartItemUpdateOne) check() error {
+	if ciuo.mutation.CartCleared() && len(ciuo.mutation.CartIDs()) > 0 {
+		return errors.New(`gen: clearing a required unique edge "CartItem.cart"`)
+	}
+	if ciuo.mutation.ProductCleared() && len(ciuo.mutation.ProductIDs()) > 0 {
+		return errors.New(`gen: clearing a required unique edge "CartItem.product"`)
+	}
+	return nil
+}
+
 func (ciuo *CartItemUpdateOne) sqlSave(ctx context.Context) (_node *CartItem, err error) {
-	_spec := sqlgraph.NewUpdateSpec(cartitem.Table, cartitem.Columns, sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeInt))
-	id, ok := ciuo.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`gen: missing "CartItem.id" for update`)}
+	if err := ciuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cartitem.Table, cartitem.Columns, sqlgraph.NewFieldSpec(cartitem.FieldCartID, field.TypeUint64), sqlgraph.NewFieldSpec(cartitem.FieldProductID, field.TypeUint64))
+	if id, ok := ciuo.mutation.CartID(); !ok {
+		return nil, &ValidationError{Name: "cart_id", err: errors.New(`gen: missing "CartItem.cart_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[0].Value = id
+	}
+	if id, ok := ciuo.mutation.ProductID(); !ok {
+		return nil, &ValidationError{Name: "product_id", err: errors.New(`gen: missing "CartItem.product_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[1].Value = id
 	}
-	_spec.Node.ID.Value = id
 	if fields := ciuo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, cartitem.FieldID)
-		for _, f := range fields {
+		_spec.Node.Columns = make([]string, len(fields))
+		for i, f := range fields {
 			if !cartitem.ValidColumn(f) {
 				return nil, &ValidationError{Name: f, err: fmt.Errorf("gen: invalid field %q for query", f)}
 			}
-			if f != cartitem.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+			_spec.Node.Columns[i] = f
 		}
 	}
 	if ps := ciuo.mutation.predicates; len(ps) > 0 {
@@ -376,7 +406,7 @@ func (ciuo *CartItemUpdateOne) sqlSave(ctx context.Context) (_node *CartItem, er
 	if ciuo.mutation.CartCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   cartitem.CartTable,
 			Columns: []string{cartitem.CartColumn},
 			Bidi:    false,
@@ -389,7 +419,7 @@ func (ciuo *CartItemUpdateOne) sqlSave(ctx context.Context) (_node *CartItem, er
 	if nodes := ciuo.mutation.CartIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   cartitem.CartTable,
 			Columns: []string{cartitem.CartColumn},
 			Bidi:    false,
@@ -405,7 +435,7 @@ func (ciuo *CartItemUpdateOne) sqlSave(ctx context.Context) (_node *CartItem, er
 	if ciuo.mutation.ProductCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   cartitem.ProductTable,
 			Columns: []string{cartitem.ProductColumn},
 			Bidi:    false,
@@ -418,7 +448,7 @@ func (ciuo *CartItemUpdateOne) sqlSave(ctx context.Context) (_node *CartItem, er
 	if nodes := ciuo.mutation.ProductIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   cartitem.ProductTable,
 			Columns: []string{cartitem.ProductColumn},
 			Bidi:    false,
@@ -22,7 +22,7 @@ type Category struct {
 	// Slug holds the value of the "slug" field.
 	Slug string `json:"slug,omitempty"`
 	// ParentID holds the value of the "parent_id" field.
-	ParentID int `json:"parent_id,omitempty"`
+	ParentID uint64 `json:"parent_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -35,19 +35,45 @@ type Category struct {
 
 // CategoryEdges holds the relations/edges for other nodes in the graph.
 type CategoryEdges struct {
+	// Parent holds the value of the parent edge.
+	Parent *Category `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Category `json:"children,omitempty"`
 	// Products holds the value of the products edge.
 	Products []*Product `json:"products,omitempty"`
 	// Discounts holds the value of the discounts edge.
-	Discounts []*DiscountCategory `json:"discounts,omitempty"`
+	Discounts []*Discount `json:"discounts,omitempty"`
+	// DiscountCategories holds the value of the discount_categories edge.
+	DiscountCategories []*DiscountCategory `json:"discount_categories,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [5]bool
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CategoryEdges) ParentOrErr() (*Category, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: category.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e CategoryEdges) ChildrenOrErr() ([]*Category, error) {
+	if e.loadedTypes[1] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
 }
 
 // ProductsOrErr returns the Products value or an error if the edge
 // was not loaded in eager-loading.
 func (e CategoryEdges) ProductsOrErr() ([]*Product, error) {
-	if e.loadedTypes[0] {
+	if e.loadedTypes[2] {
 		return e.Products, nil
 	}
 	return nil, &NotLoadedError{edge: "products"}
@@ -55,13 +81,22 @@ func (e CategoryEdges) ProductsOrErr() ([]*Product, error) {
 
 // DiscountsOrErr returns the Discounts value or an error if the edge
 // was not loaded in eager-loading.
-func (e CategoryEdges) DiscountsOrErr() ([]*DiscountCategory, error) {
-	if e.loadedTypes[1] {
+func (e CategoryEdges) DiscountsOrErr() ([]*Discount, error) {
+	if e.loadedTypes[3] {
 		return e.Discounts, nil
 	}
 	return nil, &NotLoadedError{edge: "discounts"}
 }
 
+// DiscountCategoriesOrErr returns the DiscountCategories value or an error if the edge
+// was not loaded in eager-loading.
+func (e CategoryEdges) DiscountCategoriesOrErr() ([]*DiscountCategory, error) {
+	if e.loadedTypes[4] {
+		return e.DiscountCategories, nil
+	}
+	return nil, &NotLoadedError{edge: "discount_categories"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Category) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -110,7 +145,7 @@ func (c *Category) assignValues(columns []string, values []any) error {
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
 			} else if value.Valid {
-				c.ParentID = int(value.Int64)
+				c.ParentID = uint64(value.Int64)
 			}
 		case category.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -137,16 +172,31 @@ func (c *Category) Value(name string) (ent.Value, error) {
 	return c.selectValues.Get(name)
 }
 
+// QueryParent queries the "parent" edge of the Category entity.
+func (c *Category) QueryParent() *CategoryQuery {
+	return NewCategoryClient(c.config).QueryParent(c)
+}
+
+// QueryChildren queries the "children" edge of the Category entity.
+func (c *Category) QueryChildren() *CategoryQuery {
+	return NewCategoryClient(c.config).QueryChildren(c)
+}
+
 // QueryProducts queries the "products" edge of the Category entity.
 func (c *Category) QueryProducts() *ProductQuery {
 	return NewCategoryClient(c.config).QueryProducts(c)
 }
 
 // QueryDiscounts queries the "discounts" edge of the Category entity.
-func (c *Category) QueryDiscounts() *DiscountCategoryQuery {
+func (c *Category) QueryDiscounts() *DiscountQuery {
 	return NewCategoryClient(c.config).QueryDiscounts(c)
 }
 
+// QueryDiscountCategories queries the "discount_categories" edge of the Category entity.
+func (c *Category) QueryDiscountCategories() *DiscountCategoryQuery {
+	return NewCategoryClient(c.config).QueryDiscountCategories(c)
+}
+
 // Update returns a builder for updating this Category.
 // Note that you need to call Category.Unwrap() before calling this method if this Category
 // was returned from a transaction, and the transaction was committed or rolled back.
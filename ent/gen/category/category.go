@@ -24,26 +24,45 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// EdgeParent holds the string denoting the parent edge name in mutations.
+	EdgeParent = "parent"
+	// EdgeChildren holds the string denoting the children edge name in mutations.
+	EdgeChildren = "children"
 	// EdgeProducts holds the string denoting the products edge name in mutations.
 	EdgeProducts = "products"
 	// EdgeDiscounts holds the string denoting the discounts edge name in mutations.
 	EdgeDiscounts = "discounts"
+	// EdgeDiscountCategories holds the string denoting the discount_categories edge name in mutations.
+	EdgeDiscountCategories = "discount_categories"
 	// Table holds the table name of the category in the database.
 	Table = "categories"
+	// ParentTable is the table that holds the parent relation/edge.
+	ParentTable = "categories"
+	// ParentColumn is the table column denoting the parent relation/edge.
+	ParentColumn = "parent_id"
+	// ChildrenTable is the table that holds the children relation/edge.
+	ChildrenTable = "categories"
+	// ChildrenColumn is the table column denoting the children relation/edge.
+	ChildrenColumn = "parent_id"
 	// ProductsTable is the table that holds the products relation/edge.
 	ProductsTable = "products"
 	// ProductsInverseTable is the table name for the Product entity.
 	// It exists in this package in order to avoid circular dependency with the "product" package.
 	ProductsInverseTable = "products"
 	// ProductsColumn is the table column denoting the products relation/edge.
-	ProductsColumn = "category_products"
-	// DiscountsTable is the table that holds the discounts relation/edge.
+	ProductsColumn = "category_id"
+	// DiscountsTable is the table that holds the discounts relation/edge. The primary key declared below.
 	DiscountsTable = "discount_categories"
-	// DiscountsInverseTable is the table name for the DiscountCategory entity.
+	// DiscountsInverseTable is the table name for the Discount entity.
+	// It exists in this package in order to avoid circular dependency with the "discount" package.
+	DiscountsInverseTable = "discounts"
+	// DiscountCategoriesTable is the table that holds the discount_categories relation/edge.
+	DiscountCategoriesTable = "discount_categories"
+	// DiscountCategoriesInverseTable is the table name for the DiscountCategory entity.
 	// It exists in this package in order to avoid circular dependency with the "discountcategory" package.
-	DiscountsInverseTable = "discount_categories"
-	// DiscountsColumn is the table column denoting the discounts relation/edge.
-	DiscountsColumn = "category_discounts"
+	DiscountCategoriesInverseTable = "discount_categories"
+	// DiscountCategoriesColumn is the table column denoting the discount_categories relation/edge.
+	DiscountCategoriesColumn = "category_id"
 )
 
 // Columns holds all SQL columns for category fields.
@@ -56,6 +75,12 @@ var Columns = []string{
 	FieldUpdatedAt,
 }
 
+var (
+	// DiscountsPrimaryKey and DiscountsColumn2 are the table columns denoting the
+	// primary key for the discounts relation (M2M).
+	DiscountsPrimaryKey = []string{"discount_id", "category_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -112,6 +137,27 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByParentField orders the results by parent field.
+func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newParentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByChildrenCount orders the results by children count.
+func ByChildrenCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChildrenStep(), opts...)
+	}
+}
+
+// ByChildren orders the results by children terms.
+func ByChildren(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChildrenStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByProductsCount orders the results by products count.
 func ByProductsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -139,6 +185,34 @@ func ByDiscounts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newDiscountsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByDiscountCategoriesCount orders the results by discount_categories count.
+func ByDiscountCategoriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDiscountCategoriesStep(), opts...)
+	}
+}
+
+// ByDiscountCategories orders the results by discount_categories terms.
+func ByDiscountCategories(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDiscountCategoriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newParentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+	)
+}
+func newChildrenStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+	)
+}
 func newProductsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -150,6 +224,13 @@ func newDiscountsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(DiscountsInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, DiscountsTable, DiscountsColumn),
+		sqlgraph.Edge(sqlgraph.M2M, true, DiscountsTable, DiscountsPrimaryKey...),
+	)
+}
+func newDiscountCategoriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DiscountCategoriesInverseTable, DiscountCategoriesColumn),
+		sqlgraph.Edge(sqlgraph.O2M, true, DiscountCategoriesTable, DiscountCategoriesColumn),
 	)
 }
@@ -11,7 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/thang1834/go-goss/ent/gen/category"
-	"github.com/thang1834/go-goss/ent/gen/discountcategory"
+	"github.com/thang1834/go-goss/ent/gen/discount"
 	"github.com/thang1834/go-goss/ent/gen/product"
 )
 
@@ -35,15 +35,15 @@ func (cc *CategoryCreate) SetSlug(s string) *CategoryCreate {
 }
 
 // SetParentID sets the "parent_id" field.
-func (cc *CategoryCreate) SetParentID(i int) *CategoryCreate {
-	cc.mutation.SetParentID(i)
+func (cc *CategoryCreate) SetParentID(u uint64) *CategoryCreate {
+	cc.mutation.SetParentID(u)
 	return cc
 }
 
 // SetNillableParentID sets the "parent_id" field if the given value is not nil.
-func (cc *CategoryCreate) SetNillableParentID(i *int) *CategoryCreate {
-	if i != nil {
-		cc.SetParentID(*i)
+func (cc *CategoryCreate) SetNillableParentID(u *uint64) *CategoryCreate {
+	if u != nil {
+		cc.SetParentID(*u)
 	}
 	return cc
 }
@@ -82,6 +82,26 @@ func (cc *CategoryCreate) SetID(u uint64) *CategoryCreate {
 	return cc
 }
 
+// SetParent sets the "parent" edge to the Category entity.
+func (cc *CategoryCreate) SetParent(c *Category) *CategoryCreate {
+	return cc.SetParentID(c.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Category entity by IDs.
+func (cc *CategoryCreate) AddChildIDs(ids ...uint64) *CategoryCreate {
+	cc.mutation.AddChildIDs(ids...)
+	return cc
+}
+
+// AddChildren adds the "children" edges to the Category entity.
+func (cc *CategoryCreate) AddChildren(c ...*Category) *CategoryCreate {
+	ids := make([]uint64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cc.AddChildIDs(ids...)
+}
+
 // AddProductIDs adds the "products" edge to the Product entity by IDs.
 func (cc *CategoryCreate) AddProductIDs(ids ...uint64) *CategoryCreate {
 	cc.mutation.AddProductIDs(ids...)
@@ -97,15 +117,15 @@ func (cc *CategoryCreate) AddProducts(p ...*Product) *CategoryCreate {
 	return cc.AddProductIDs(ids...)
 }
 
-// AddDiscountIDs adds the "discounts" edge to the DiscountCategory entity by IDs.
-func (cc *CategoryCreate) AddDiscountIDs(ids ...int) *CategoryCreate {
+// AddDiscountIDs adds the "discounts" edge to the Discount entity by IDs.
+func (cc *CategoryCreate) AddDiscountIDs(ids ...uint64) *CategoryCreate {
 	cc.mutation.AddDiscountIDs(ids...)
 	return cc
 }
 
-// AddDiscounts adds the "discounts" edges to the DiscountCategory entity.
-func (cc *CategoryCreate) AddDiscounts(d ...*DiscountCategory) *CategoryCreate {
-	ids := make([]int, len(d))
+// AddDiscounts adds the "discounts" edges to the Discount entity.
+func (cc *CategoryCreate) AddDiscounts(d ...*Discount) *CategoryCreate {
+	ids := make([]uint64, len(d))
 	for i := range d {
 		ids[i] = d[i].ID
 	}
@@ -221,10 +241,6 @@ func (cc *CategoryCreate) createSpec() (*Category, *sqlgraph.CreateSpec) {
 		_spec.SetField(category.FieldSlug, field.TypeString, value)
 		_node.Slug = value
 	}
-	if value, ok := cc.mutation.ParentID(); ok {
-		_spec.SetField(category.FieldParentID, field.TypeInt, value)
-		_node.ParentID = value
-	}
 	if value, ok := cc.mutation.CreatedAt(); ok {
 		_spec.SetField(category.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -233,6 +249,39 @@ func (cc *CategoryCreate) createSpec() (*Category, *sqlgraph.CreateSpec) {
 		_spec.SetField(category.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if nodes := cc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   category.ParentTable,
+			Columns: []string{category.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.ChildrenTable,
+			Columns: []string{category.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := cc.mutation.ProductsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -251,13 +300,13 @@ func (cc *CategoryCreate) createSpec() (*Category, *sqlgraph.CreateSpec) {
 	}
 	if nodes := cc.mutation.DiscountsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
 			Table:   category.DiscountsTable,
-			Columns: []string{category.DiscountsColumn},
+			Columns: category.DiscountsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountcategory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
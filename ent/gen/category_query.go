@@ -9,10 +9,12 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/thang1834/go-goss/ent/gen/category"
+	"github.com/thang1834/go-goss/ent/gen/discount"
 	"github.com/thang1834/go-goss/ent/gen/discountcategory"
 	"github.com/thang1834/go-goss/ent/gen/predicate"
 	"github.com/thang1834/go-goss/ent/gen/product"
@@ -21,12 +23,16 @@ import (
 // CategoryQuery is the builder for querying Category entities.
 type CategoryQuery struct {
 	config
-	ctx           *QueryContext
-	order         []category.OrderOption
-	inters        []Interceptor
-	predicates    []predicate.Category
-	withProducts  *ProductQuery
-	withDiscounts *DiscountCategoryQuery
+	ctx                    *QueryContext
+	order                  []category.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.Category
+	withParent             *CategoryQuery
+	withChildren           *CategoryQuery
+	withProducts           *ProductQuery
+	withDiscounts          *DiscountQuery
+	withDiscountCategories *DiscountCategoryQuery
+	modifiers              []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -63,6 +69,50 @@ func (cq *CategoryQuery) Order(o ...category.OrderOption) *CategoryQuery {
 	return cq
 }
 
+// QueryParent chains the current query on the "parent" edge.
+func (cq *CategoryQuery) QueryParent() *CategoryQuery {
+	query := (&CategoryClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(category.Table, category.FieldID, selector),
+			sqlgraph.To(category.Table, category.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, category.ParentTable, category.ParentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChildren chains the current query on the "children" edge.
+func (cq *CategoryQuery) QueryChildren() *CategoryQuery {
+	query := (&CategoryClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(category.Table, category.FieldID, selector),
+			sqlgraph.To(category.Table, category.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, category.ChildrenTable, category.ChildrenColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryProducts chains the current query on the "products" edge.
 func (cq *CategoryQuery) QueryProducts() *ProductQuery {
 	query := (&ProductClient{config: cq.config}).Query()
@@ -86,7 +136,29 @@ func (cq *CategoryQuery) QueryProducts() *ProductQuery {
 }
 
 // QueryDiscounts chains the current query on the "discounts" edge.
-func (cq *CategoryQuery) QueryDiscounts() *DiscountCategoryQuery {
+func (cq *CategoryQuery) QueryDiscounts() *DiscountQuery {
+	query := (&DiscountClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(category.Table, category.FieldID, selector),
+			sqlgraph.To(discount.Table, discount.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, category.DiscountsTable, category.DiscountsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDiscountCategories chains the current query on the "discount_categories" edge.
+func (cq *CategoryQuery) QueryDiscountCategories() *DiscountCategoryQuery {
 	query := (&DiscountCategoryClient{config: cq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := cq.prepareQuery(ctx); err != nil {
@@ -98,8 +170,8 @@ func (cq *CategoryQuery) QueryDiscounts() *DiscountCategoryQuery {
 		}
 		step := sqlgraph.NewStep(
 			sqlgraph.From(category.Table, category.FieldID, selector),
-			sqlgraph.To(discountcategory.Table, discountcategory.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, category.DiscountsTable, category.DiscountsColumn),
+			sqlgraph.To(discountcategory.Table, discountcategory.CategoryColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, category.DiscountCategoriesTable, category.DiscountCategoriesColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
 		return fromU, nil
@@ -294,19 +366,44 @@ func (cq *CategoryQuery) Clone() *CategoryQuery {
 		return nil
 	}
 	return &CategoryQuery{
-		config:        cq.config,
-		ctx:           cq.ctx.Clone(),
-		order:         append([]category.OrderOption{}, cq.order...),
-		inters:        append([]Interceptor{}, cq.inters...),
-		predicates:    append([]predicate.Category{}, cq.predicates...),
-		withProducts:  cq.withProducts.Clone(),
-		withDiscounts: cq.withDiscounts.Clone(),
+		config:                 cq.config,
+		ctx:                    cq.ctx.Clone(),
+		order:                  append([]category.OrderOption{}, cq.order...),
+		inters:                 append([]Interceptor{}, cq.inters...),
+		predicates:             append([]predicate.Category{}, cq.predicates...),
+		withParent:             cq.withParent.Clone(),
+		withChildren:           cq.withChildren.Clone(),
+		withProducts:           cq.withProducts.Clone(),
+		withDiscounts:          cq.withDiscounts.Clone(),
+		withDiscountCategories: cq.withDiscountCategories.Clone(),
 		// clone intermediate query.
 		sql:  cq.sql.Clone(),
 		path: cq.path,
 	}
 }
 
+// WithParent tells the query-builder to eager-load the nodes that are connected to
+// the "parent" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CategoryQuery) WithParent(opts ...func(*CategoryQuery)) *CategoryQuery {
+	query := (&CategoryClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withParent = query
+	return cq
+}
+
+// WithChildren tells the query-builder to eager-load the nodes that are connected to
+// the "children" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CategoryQuery) WithChildren(opts ...func(*CategoryQuery)) *CategoryQuery {
+	query := (&CategoryClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withChildren = query
+	return cq
+}
+
 // WithProducts tells the query-builder to eager-load the nodes that are connected to
 // the "products" edge. The optional arguments are used to configure the query builder of the edge.
 func (cq *CategoryQuery) WithProducts(opts ...func(*ProductQuery)) *CategoryQuery {
@@ -320,8 +417,8 @@ func (cq *CategoryQuery) WithProducts(opts ...func(*ProductQuery)) *CategoryQuer
 
 // WithDiscounts tells the query-builder to eager-load the nodes that are connected to
 // the "discounts" edge. The optional arguments are used to configure the query builder of the edge.
-func (cq *CategoryQuery) WithDiscounts(opts ...func(*DiscountCategoryQuery)) *CategoryQuery {
-	query := (&DiscountCategoryClient{config: cq.config}).Query()
+func (cq *CategoryQuery) WithDiscounts(opts ...func(*DiscountQuery)) *CategoryQuery {
+	query := (&DiscountClient{config: cq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -329,6 +426,17 @@ func (cq *CategoryQuery) WithDiscounts(opts ...func(*DiscountCategoryQuery)) *Ca
 	return cq
 }
 
+// WithDiscountCategories tells the query-builder to eager-load the nodes that are connected to
+// the "discount_categories" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CategoryQuery) WithDiscountCategories(opts ...func(*DiscountCategoryQuery)) *CategoryQuery {
+	query := (&DiscountCategoryClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withDiscountCategories = query
+	return cq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +515,12 @@ func (cq *CategoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cat
 	var (
 		nodes       = []*Category{}
 		_spec       = cq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [5]bool{
+			cq.withParent != nil,
+			cq.withChildren != nil,
 			cq.withProducts != nil,
 			cq.withDiscounts != nil,
+			cq.withDiscountCategories != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -421,6 +532,9 @@ func (cq *CategoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cat
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(cq.modifiers) > 0 {
+		_spec.Modifiers = cq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -430,6 +544,19 @@ func (cq *CategoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cat
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := cq.withParent; query != nil {
+		if err := cq.loadParent(ctx, query, nodes, nil,
+			func(n *Category, e *Category) { n.Edges.Parent = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cq.withChildren; query != nil {
+		if err := cq.loadChildren(ctx, query, nodes,
+			func(n *Category) { n.Edges.Children = []*Category{} },
+			func(n *Category, e *Category) { n.Edges.Children = append(n.Edges.Children, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := cq.withProducts; query != nil {
 		if err := cq.loadProducts(ctx, query, nodes,
 			func(n *Category) { n.Edges.Products = []*Product{} },
@@ -439,14 +566,82 @@ func (cq *CategoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Cat
 	}
 	if query := cq.withDiscounts; query != nil {
 		if err := cq.loadDiscounts(ctx, query, nodes,
-			func(n *Category) { n.Edges.Discounts = []*DiscountCategory{} },
-			func(n *Category, e *DiscountCategory) { n.Edges.Discounts = append(n.Edges.Discounts, e) }); err != nil {
+			func(n *Category) { n.Edges.Discounts = []*Discount{} },
+			func(n *Category, e *Discount) { n.Edges.Discounts = append(n.Edges.Discounts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cq.withDiscountCategories; query != nil {
+		if err := cq.loadDiscountCategories(ctx, query, nodes,
+			func(n *Category) { n.Edges.DiscountCategories = []*DiscountCategory{} },
+			func(n *Category, e *DiscountCategory) {
+				n.Edges.DiscountCategories = append(n.Edges.DiscountCategories, e)
+			}); err != nil {
 			return nil, err
 		}
 	}
 	return nodes, nil
 }
 
+func (cq *CategoryQuery) loadParent(ctx context.Context, query *CategoryQuery, nodes []*Category, init func(*Category), assign func(*Category, *Category)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*Category)
+	for i := range nodes {
+		fk := nodes[i].ParentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(category.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "parent_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (cq *CategoryQuery) loadChildren(ctx context.Context, query *CategoryQuery, nodes []*Category, init func(*Category), assign func(*Category, *Category)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*Category)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(category.FieldParentID)
+	}
+	query.Where(predicate.Category(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(category.ChildrenColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ParentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "parent_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (cq *CategoryQuery) loadProducts(ctx context.Context, query *ProductQuery, nodes []*Category, init func(*Category), assign func(*Category, *Product)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uint64]*Category)
@@ -457,7 +652,9 @@ func (cq *CategoryQuery) loadProducts(ctx context.Context, query *ProductQuery,
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(product.FieldCategoryID)
+	}
 	query.Where(predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(category.ProductsColumn), fks...))
 	}))
@@ -466,19 +663,77 @@ func (cq *CategoryQuery) loadProducts(ctx context.Context, query *ProductQuery,
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.category_products
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "category_products" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.CategoryID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "category_products" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "category_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (cq *CategoryQuery) loadDiscounts(ctx context.Context, query *DiscountCategoryQuery, nodes []*Category, init func(*Category), assign func(*Category, *DiscountCategory)) error {
+func (cq *CategoryQuery) loadDiscounts(ctx context.Context, query *DiscountQuery, nodes []*Category, init func(*Category), assign func(*Category, *Discount)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uint64]*Category)
+	nids := make(map[uint64]map[*Category]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(category.DiscountsTable)
+		s.Join(joinT).On(s.C(discount.FieldID), joinT.C(category.DiscountsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(category.DiscountsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(category.DiscountsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := uint64(values[0].(*sql.NullInt64).Int64)
+				inValue := uint64(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Category]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Discount](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "discounts" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (cq *CategoryQuery) loadDiscountCategories(ctx context.Context, query *DiscountCategoryQuery, nodes []*Category, init func(*Category), assign func(*Category, *DiscountCategory)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uint64]*Category)
 	for i := range nodes {
@@ -488,22 +743,21 @@ func (cq *CategoryQuery) loadDiscounts(ctx context.Context, query *DiscountCateg
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(discountcategory.FieldCategoryID)
+	}
 	query.Where(predicate.DiscountCategory(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(category.DiscountsColumn), fks...))
+		s.Where(sql.InValues(s.C(category.DiscountCategoriesColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.category_discounts
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "category_discounts" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.CategoryID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "category_discounts" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "category_id" returned %v for node %v`, fk, n)
 		}
 		assign(node, n)
 	}
@@ -512,6 +766,9 @@ func (cq *CategoryQuery) loadDiscounts(ctx context.Context, query *DiscountCateg
 
 func (cq *CategoryQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := cq.querySpec()
+	if len(cq.modifiers) > 0 {
+		_spec.Modifiers = cq.modifiers
+	}
 	_spec.Node.Columns = cq.ctx.Fields
 	if len(cq.ctx.Fields) > 0 {
 		_spec.Unique = cq.ctx.Unique != nil && *cq.ctx.Unique
@@ -535,6 +792,9 @@ func (cq *CategoryQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if cq.withParent != nil {
+			_spec.Node.AddColumnOnce(category.FieldParentID)
+		}
 	}
 	if ps := cq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -574,6 +834,9 @@ func (cq *CategoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if cq.ctx.Unique != nil && *cq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range cq.modifiers {
+		m(selector)
+	}
 	for _, p := range cq.predicates {
 		p(selector)
 	}
@@ -591,6 +854,32 @@ func (cq *CategoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (cq *CategoryQuery) ForUpdate(opts ...sql.LockOption) *CategoryQuery {
+	if cq.driver.Dialect() == dialect.Postgres {
+		cq.Unique(false)
+	}
+	cq.modifiers = append(cq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return cq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (cq *CategoryQuery) ForShare(opts ...sql.LockOption) *CategoryQuery {
+	if cq.driver.Dialect() == dialect.Postgres {
+		cq.Unique(false)
+	}
+	cq.modifiers = append(cq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return cq
+}
+
 // CategoryGroupBy is the group-by builder for Category entities.
 type CategoryGroupBy struct {
 	selector
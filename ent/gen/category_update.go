@@ -12,7 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/thang1834/go-goss/ent/gen/category"
-	"github.com/thang1834/go-goss/ent/gen/discountcategory"
+	"github.com/thang1834/go-goss/ent/gen/discount"
 	"github.com/thang1834/go-goss/ent/gen/predicate"
 	"github.com/thang1834/go-goss/ent/gen/product"
 )
@@ -59,26 +59,19 @@ func (cu *CategoryUpdate) SetNillableSlug(s *string) *CategoryUpdate {
 }
 
 // SetParentID sets the "parent_id" field.
-func (cu *CategoryUpdate) SetParentID(i int) *CategoryUpdate {
-	cu.mutation.ResetParentID()
-	cu.mutation.SetParentID(i)
+func (cu *CategoryUpdate) SetParentID(u uint64) *CategoryUpdate {
+	cu.mutation.SetParentID(u)
 	return cu
 }
 
 // SetNillableParentID sets the "parent_id" field if the given value is not nil.
-func (cu *CategoryUpdate) SetNillableParentID(i *int) *CategoryUpdate {
-	if i != nil {
-		cu.SetParentID(*i)
+func (cu *CategoryUpdate) SetNillableParentID(u *uint64) *CategoryUpdate {
+	if u != nil {
+		cu.SetParentID(*u)
 	}
 	return cu
 }
 
-// AddParentID adds i to the "parent_id" field.
-func (cu *CategoryUpdate) AddParentID(i int) *CategoryUpdate {
-	cu.mutation.AddParentID(i)
-	return cu
-}
-
 // ClearParentID clears the value of the "parent_id" field.
 func (cu *CategoryUpdate) ClearParentID() *CategoryUpdate {
 	cu.mutation.ClearParentID()
@@ -105,6 +98,26 @@ func (cu *CategoryUpdate) SetUpdatedAt(t time.Time) *CategoryUpdate {
 	return cu
 }
 
+// SetParent sets the "parent" edge to the Category entity.
+func (cu *CategoryUpdate) SetParent(c *Category) *CategoryUpdate {
+	return cu.SetParentID(c.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Category entity by IDs.
+func (cu *CategoryUpdate) AddChildIDs(ids ...uint64) *CategoryUpdate {
+	cu.mutation.AddChildIDs(ids...)
+	return cu
+}
+
+// AddChildren adds the "children" edges to the Category entity.
+func (cu *CategoryUpdate) AddChildren(c ...*Category) *CategoryUpdate {
+	ids := make([]uint64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.AddChildIDs(ids...)
+}
+
 // AddProductIDs adds the "products" edge to the Product entity by IDs.
 func (cu *CategoryUpdate) AddProductIDs(ids ...uint64) *CategoryUpdate {
 	cu.mutation.AddProductIDs(ids...)
@@ -120,15 +133,15 @@ func (cu *CategoryUpdate) AddProducts(p ...*Product) *CategoryUpdate {
 	return cu.AddProductIDs(ids...)
 }
 
-// AddDiscountIDs adds the "discounts" edge to the DiscountCategory entity by IDs.
-func (cu *CategoryUpdate) AddDiscountIDs(ids ...int) *CategoryUpdate {
+// AddDiscountIDs adds the "discounts" edge to the Discount entity by IDs.
+func (cu *CategoryUpdate) AddDiscountIDs(ids ...uint64) *CategoryUpdate {
 	cu.mutation.AddDiscountIDs(ids...)
 	return cu
 }
 
-// AddDiscounts adds the "discounts" edges to the DiscountCategory entity.
-func (cu *CategoryUpdate) AddDiscounts(d ...*DiscountCategory) *CategoryUpdate {
-	ids := make([]int, len(d))
+// AddDiscounts adds the "discounts" edges to the Discount entity.
+func (cu *CategoryUpdate) AddDiscounts(d ...*Discount) *CategoryUpdate {
+	ids := make([]uint64, len(d))
 	for i := range d {
 		ids[i] = d[i].ID
 	}
@@ -140,6 +153,33 @@ func (cu *CategoryUpdate) Mutation() *CategoryMutation {
 	return cu.mutation
 }
 
+// ClearParent clears the "parent" edge to the Category entity.
+func (cu *CategoryUpdate) ClearParent() *CategoryUpdate {
+	cu.mutation.ClearParent()
+	return cu
+}
+
+// ClearChildren clears all "children" edges to the Category entity.
+func (cu *CategoryUpdate) ClearChildren() *CategoryUpdate {
+	cu.mutation.ClearChildren()
+	return cu
+}
+
+// RemoveChildIDs removes the "children" edge to Category entities by IDs.
+func (cu *CategoryUpdate) RemoveChildIDs(ids ...uint64) *CategoryUpdate {
+	cu.mutation.RemoveChildIDs(ids...)
+	return cu
+}
+
+// RemoveChildren removes "children" edges to Category entities.
+func (cu *CategoryUpdate) RemoveChildren(c ...*Category) *CategoryUpdate {
+	ids := make([]uint64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.RemoveChildIDs(ids...)
+}
+
 // ClearProducts clears all "products" edges to the Product entity.
 func (cu *CategoryUpdate) ClearProducts() *CategoryUpdate {
 	cu.mutation.ClearProducts()
@@ -161,21 +201,21 @@ func (cu *CategoryUpdate) RemoveProducts(p ...*Product) *CategoryUpdate {
 	return cu.RemoveProductIDs(ids...)
 }
 
-// ClearDiscounts clears all "discounts" edges to the DiscountCategory entity.
+// ClearDiscounts clears all "discounts" edges to the Discount entity.
 func (cu *CategoryUpdate) ClearDiscounts() *CategoryUpdate {
 	cu.mutation.ClearDiscounts()
 	return cu
 }
 
-// RemoveDiscountIDs removes the "discounts" edge to DiscountCategory entities by IDs.
-func (cu *CategoryUpdate) RemoveDiscountIDs(ids ...int) *CategoryUpdate {
+// RemoveDiscountIDs removes the "discounts" edge to Discount entities by IDs.
+func (cu *CategoryUpdate) RemoveDiscountIDs(ids ...uint64) *CategoryUpdate {
 	cu.mutation.RemoveDiscountIDs(ids...)
 	return cu
 }
 
-// RemoveDiscounts removes "discounts" edges to DiscountCategory entities.
-func (cu *CategoryUpdate) RemoveDiscounts(d ...*DiscountCategory) *CategoryUpdate {
-	ids := make([]int, len(d))
+// RemoveDiscounts removes "discounts" edges to Discount entities.
+func (cu *CategoryUpdate) RemoveDiscounts(d ...*Discount) *CategoryUpdate {
+	ids := make([]uint64, len(d))
 	for i := range d {
 		ids[i] = d[i].ID
 	}
@@ -251,21 +291,86 @@ func (cu *CategoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := cu.mutation.Slug(); ok {
 		_spec.SetField(category.FieldSlug, field.TypeString, value)
 	}
-	if value, ok := cu.mutation.ParentID(); ok {
-		_spec.SetField(category.FieldParentID, field.TypeInt, value)
-	}
-	if value, ok := cu.mutation.AddedParentID(); ok {
-		_spec.AddField(category.FieldParentID, field.TypeInt, value)
-	}
-	if cu.mutation.ParentIDCleared() {
-		_spec.ClearField(category.FieldParentID, field.TypeInt)
-	}
 	if value, ok := cu.mutation.CreatedAt(); ok {
 		_spec.SetField(category.FieldCreatedAt, field.TypeTime, value)
 	}
 	if value, ok := cu.mutation.UpdatedAt(); ok {
 		_spec.SetField(category.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if cu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   category.ParentTable,
+			Columns: []string{category.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   category.ParentTable,
+			Columns: []string{category.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.ChildrenTable,
+			Columns: []string{category.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !cu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.ChildrenTable,
+			Columns: []string{category.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.ChildrenTable,
+			Columns: []string{category.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if cu.mutation.ProductsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -313,26 +418,26 @@ func (cu *CategoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if cu.mutation.DiscountsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
 			Table:   category.DiscountsTable,
-			Columns: []string{category.DiscountsColumn},
+			Columns: category.DiscountsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountcategory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeUint64),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := cu.mutation.RemovedDiscountsIDs(); len(nodes) > 0 && !cu.mutation.DiscountsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
 			Table:   category.DiscountsTable,
-			Columns: []string{category.DiscountsColumn},
+			Columns: category.DiscountsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountcategory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -342,13 +447,13 @@ func (cu *CategoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if nodes := cu.mutation.DiscountsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
 			Table:   category.DiscountsTable,
-			Columns: []string{category.DiscountsColumn},
+			Columns: category.DiscountsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountcategory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -405,26 +510,19 @@ func (cuo *CategoryUpdateOne) SetNillableSlug(s *string) *CategoryUpdateOne {
 }
 
 // SetParentID sets the "parent_id" field.
-func (cuo *CategoryUpdateOne) SetParentID(i int) *CategoryUpdateOne {
-	cuo.mutation.ResetParentID()
-	cuo.mutation.SetParentID(i)
+func (cuo *CategoryUpdateOne) SetParentID(u uint64) *CategoryUpdateOne {
+	cuo.mutation.SetParentID(u)
 	return cuo
 }
 
 // SetNillableParentID sets the "parent_id" field if the given value is not nil.
-func (cuo *CategoryUpdateOne) SetNillableParentID(i *int) *CategoryUpdateOne {
-	if i != nil {
-		cuo.SetParentID(*i)
+func (cuo *CategoryUpdateOne) SetNillableParentID(u *uint64) *CategoryUpdateOne {
+	if u != nil {
+		cuo.SetParentID(*u)
 	}
 	return cuo
 }
 
-// AddParentID adds i to the "parent_id" field.
-func (cuo *CategoryUpdateOne) AddParentID(i int) *CategoryUpdateOne {
-	cuo.mutation.AddParentID(i)
-	return cuo
-}
-
 // ClearParentID clears the value of the "parent_id" field.
 func (cuo *CategoryUpdateOne) ClearParentID() *CategoryUpdateOne {
 	cuo.mutation.ClearParentID()
@@ -451,6 +549,26 @@ func (cuo *CategoryUpdateOne) SetUpdatedAt(t time.Time) *CategoryUpdateOne {
 	return cuo
 }
 
+// SetParent sets the "parent" edge to the Category entity.
+func (cuo *CategoryUpdateOne) SetParent(c *Category) *CategoryUpdateOne {
+	return cuo.SetParentID(c.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Category entity by IDs.
+func (cuo *CategoryUpdateOne) AddChildIDs(ids ...uint64) *CategoryUpdateOne {
+	cuo.mutation.AddChildIDs(ids...)
+	return cuo
+}
+
+// AddChildren adds the "children" edges to the Category entity.
+func (cuo *CategoryUpdateOne) AddChildren(c ...*Category) *CategoryUpdateOne {
+	ids := make([]uint64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.AddChildIDs(ids...)
+}
+
 // AddProductIDs adds the "products" edge to the Product entity by IDs.
 func (cuo *CategoryUpdateOne) AddProductIDs(ids ...uint64) *CategoryUpdateOne {
 	cuo.mutation.AddProductIDs(ids...)
@@ -466,15 +584,15 @@ func (cuo *CategoryUpdateOne) AddProducts(p ...*Product) *CategoryUpdateOne {
 	return cuo.AddProductIDs(ids...)
 }
 
-// AddDiscountIDs adds the "discounts" edge to the DiscountCategory entity by IDs.
-func (cuo *CategoryUpdateOne) AddDiscountIDs(ids ...int) *CategoryUpdateOne {
+// AddDiscountIDs adds the "discounts" edge to the Discount entity by IDs.
+func (cuo *CategoryUpdateOne) AddDiscountIDs(ids ...uint64) *CategoryUpdateOne {
 	cuo.mutation.AddDiscountIDs(ids...)
 	return cuo
 }
 
-// AddDiscounts adds the "discounts" edges to the DiscountCategory entity.
-func (cuo *CategoryUpdateOne) AddDiscounts(d ...*DiscountCategory) *CategoryUpdateOne {
-	ids := make([]int, len(d))
+// AddDiscounts adds the "discounts" edges to the Discount entity.
+func (cuo *CategoryUpdateOne) AddDiscounts(d ...*Discount) *CategoryUpdateOne {
+	ids := make([]uint64, len(d))
 	for i := range d {
 		ids[i] = d[i].ID
 	}
@@ -486,6 +604,33 @@ func (cuo *CategoryUpdateOne) Mutation() *CategoryMutation {
 	return cuo.mutation
 }
 
+// ClearParent clears the "parent" edge to the Category entity.
+func (cuo *CategoryUpdateOne) ClearParent() *CategoryUpdateOne {
+	cuo.mutation.ClearParent()
+	return cuo
+}
+
+// ClearChildren clears all "children" edges to the Category entity.
+func (cuo *CategoryUpdateOne) ClearChildren() *CategoryUpdateOne {
+	cuo.mutation.ClearChildren()
+	return cuo
+}
+
+// RemoveChildIDs removes the "children" edge to Category entities by IDs.
+func (cuo *CategoryUpdateOne) RemoveChildIDs(ids ...uint64) *CategoryUpdateOne {
+	cuo.mutation.RemoveChildIDs(ids...)
+	return cuo
+}
+
+// RemoveChildren removes "children" edges to Category entities.
+func (cuo *CategoryUpdateOne) RemoveChildren(c ...*Category) *CategoryUpdateOne {
+	ids := make([]uint64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.RemoveChildIDs(ids...)
+}
+
 // ClearProducts clears all "products" edges to the Product entity.
 func (cuo *CategoryUpdateOne) ClearProducts() *CategoryUpdateOne {
 	cuo.mutation.ClearProducts()
@@ -507,21 +652,21 @@ func (cuo *CategoryUpdateOne) RemoveProducts(p ...*Product) *CategoryUpdateOne {
 	return cuo.RemoveProductIDs(ids...)
 }
 
-// ClearDiscounts clears all "discounts" edges to the DiscountCategory entity.
+// ClearDiscounts clears all "discounts" edges to the Discount entity.
 func (cuo *CategoryUpdateOne) ClearDiscounts() *CategoryUpdateOne {
 	cuo.mutation.ClearDiscounts()
 	return cuo
 }
 
-// RemoveDiscountIDs removes the "discounts" edge to DiscountCategory entities by IDs.
-func (cuo *CategoryUpdateOne) RemoveDiscountIDs(ids ...int) *CategoryUpdateOne {
+// RemoveDiscountIDs removes the "discounts" edge to Discount entities by IDs.
+func (cuo *CategoryUpdateOne) RemoveDiscountIDs(ids ...uint64) *CategoryUpdateOne {
 	cuo.mutation.RemoveDiscountIDs(ids...)
 	return cuo
 }
 
-// RemoveDiscounts removes "discounts" edges to DiscountCategory entities.
-func (cuo *CategoryUpdateOne) RemoveDiscounts(d ...*DiscountCategory) *CategoryUpdateOne {
-	ids := make([]int, len(d))
+// RemoveDiscounts removes "discounts" edges to Discount entities.
+func (cuo *CategoryUpdateOne) RemoveDiscounts(d ...*Discount) *CategoryUpdateOne {
+	ids := make([]uint64, len(d))
 	for i := range d {
 		ids[i] = d[i].ID
 	}
@@ -627,21 +772,86 @@ func (cuo *CategoryUpdateOne) sqlSave(ctx context.Context) (_node *Category, err
 	if value, ok := cuo.mutation.Slug(); ok {
 		_spec.SetField(category.FieldSlug, field.TypeString, value)
 	}
-	if value, ok := cuo.mutation.ParentID(); ok {
-		_spec.SetField(category.FieldParentID, field.TypeInt, value)
-	}
-	if value, ok := cuo.mutation.AddedParentID(); ok {
-		_spec.AddField(category.FieldParentID, field.TypeInt, value)
-	}
-	if cuo.mutation.ParentIDCleared() {
-		_spec.ClearField(category.FieldParentID, field.TypeInt)
-	}
 	if value, ok := cuo.mutation.CreatedAt(); ok {
 		_spec.SetField(category.FieldCreatedAt, field.TypeTime, value)
 	}
 	if value, ok := cuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(category.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if cuo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   category.ParentTable,
+			Columns: []string{category.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   category.ParentTable,
+			Columns: []string{category.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.ChildrenTable,
+			Columns: []string{category.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !cuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.ChildrenTable,
+			Columns: []string{category.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.ChildrenTable,
+			Columns: []string{category.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if cuo.mutation.ProductsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -689,26 +899,26 @@ func (cuo *CategoryUpdateOne) sqlSave(ctx context.Context) (_node *Category, err
 	}
 	if cuo.mutation.DiscountsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
 			Table:   category.DiscountsTable,
-			Columns: []string{category.DiscountsColumn},
+			Columns: category.DiscountsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountcategory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeUint64),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := cuo.mutation.RemovedDiscountsIDs(); len(nodes) > 0 && !cuo.mutation.DiscountsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
 			Table:   category.DiscountsTable,
-			Columns: []string{category.DiscountsColumn},
+			Columns: category.DiscountsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountcategory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -718,13 +928,13 @@ func (cuo *CategoryUpdateOne) sqlSave(ctx context.Context) (_node *Category, err
 	}
 	if nodes := cuo.mutation.DiscountsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
 			Table:   category.DiscountsTable,
-			Columns: []string{category.DiscountsColumn},
+			Columns: category.DiscountsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountcategory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -15,28 +15,39 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/thang1834/go-goss/ent/gen/apikey"
+	"github.com/thang1834/go-goss/ent/gen/auditlog"
 	"github.com/thang1834/go-goss/ent/gen/cart"
 	"github.com/thang1834/go-goss/ent/gen/cartitem"
 	"github.com/thang1834/go-goss/ent/gen/category"
 	"github.com/thang1834/go-goss/ent/gen/discount"
 	"github.com/thang1834/go-goss/ent/gen/discountcategory"
 	"github.com/thang1834/go-goss/ent/gen/discountproduct"
+	"github.com/thang1834/go-goss/ent/gen/idempotencykey"
+	"github.com/thang1834/go-goss/ent/gen/loginattempt"
 	"github.com/thang1834/go-goss/ent/gen/order"
 	"github.com/thang1834/go-goss/ent/gen/orderitem"
+	"github.com/thang1834/go-goss/ent/gen/orderstatushistory"
 	"github.com/thang1834/go-goss/ent/gen/payment"
 	"github.com/thang1834/go-goss/ent/gen/permission"
 	"github.com/thang1834/go-goss/ent/gen/product"
 	"github.com/thang1834/go-goss/ent/gen/productimage"
+	"github.com/thang1834/go-goss/ent/gen/recoverycode"
 	"github.com/thang1834/go-goss/ent/gen/review"
 	"github.com/thang1834/go-goss/ent/gen/role"
 	"github.com/thang1834/go-goss/ent/gen/rolepermission"
 	"github.com/thang1834/go-goss/ent/gen/session"
 	"github.com/thang1834/go-goss/ent/gen/user"
+	"github.com/thang1834/go-goss/ent/gen/useridentity"
 	"github.com/thang1834/go-goss/ent/gen/userpermission"
 	"github.com/thang1834/go-goss/ent/gen/userrole"
+	"github.com/thang1834/go-goss/ent/gen/usertoken"
 	"github.com/thang1834/go-goss/ent/gen/uservoucher"
+	"github.com/thang1834/go-goss/ent/gen/webhookevent"
 	"github.com/thang1834/go-goss/ent/gen/wishlist"
 	"github.com/thang1834/go-goss/ent/gen/wishlistitem"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -44,6 +55,10 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
 	// Cart is the client for interacting with the Cart builders.
 	Cart *CartClient
 	// CartItem is the client for interacting with the CartItem builders.
@@ -56,10 +71,16 @@ type Client struct {
 	DiscountCategory *DiscountCategoryClient
 	// DiscountProduct is the client for interacting with the DiscountProduct builders.
 	DiscountProduct *DiscountProductClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
+	// LoginAttempt is the client for interacting with the LoginAttempt builders.
+	LoginAttempt *LoginAttemptClient
 	// Order is the client for interacting with the Order builders.
 	Order *OrderClient
 	// OrderItem is the client for interacting with the OrderItem builders.
 	OrderItem *OrderItemClient
+	// OrderStatusHistory is the client for interacting with the OrderStatusHistory builders.
+	OrderStatusHistory *OrderStatusHistoryClient
 	// Payment is the client for interacting with the Payment builders.
 	Payment *PaymentClient
 	// Permission is the client for interacting with the Permission builders.
@@ -68,6 +89,8 @@ type Client struct {
 	Product *ProductClient
 	// ProductImage is the client for interacting with the ProductImage builders.
 	ProductImage *ProductImageClient
+	// RecoveryCode is the client for interacting with the RecoveryCode builders.
+	RecoveryCode *RecoveryCodeClient
 	// Review is the client for interacting with the Review builders.
 	Review *ReviewClient
 	// Role is the client for interacting with the Role builders.
@@ -78,12 +101,18 @@ type Client struct {
 	Session *SessionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserIdentity is the client for interacting with the UserIdentity builders.
+	UserIdentity *UserIdentityClient
 	// UserPermission is the client for interacting with the UserPermission builders.
 	UserPermission *UserPermissionClient
 	// UserRole is the client for interacting with the UserRole builders.
 	UserRole *UserRoleClient
+	// UserToken is the client for interacting with the UserToken builders.
+	UserToken *UserTokenClient
 	// UserVoucher is the client for interacting with the UserVoucher builders.
 	UserVoucher *UserVoucherClient
+	// WebhookEvent is the client for interacting with the WebhookEvent builders.
+	WebhookEvent *WebhookEventClient
 	// Wishlist is the client for interacting with the Wishlist builders.
 	Wishlist *WishlistClient
 	// WishlistItem is the client for interacting with the WishlistItem builders.
@@ -99,26 +128,35 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.APIKey = NewAPIKeyClient(c.config)
+	c.AuditLog = NewAuditLogClient(c.config)
 	c.Cart = NewCartClient(c.config)
 	c.CartItem = NewCartItemClient(c.config)
 	c.Category = NewCategoryClient(c.config)
 	c.Discount = NewDiscountClient(c.config)
 	c.DiscountCategory = NewDiscountCategoryClient(c.config)
 	c.DiscountProduct = NewDiscountProductClient(c.config)
+	c.IdempotencyKey = NewIdempotencyKeyClient(c.config)
+	c.LoginAttempt = NewLoginAttemptClient(c.config)
 	c.Order = NewOrderClient(c.config)
 	c.OrderItem = NewOrderItemClient(c.config)
+	c.OrderStatusHistory = NewOrderStatusHistoryClient(c.config)
 	c.Payment = NewPaymentClient(c.config)
 	c.Permission = NewPermissionClient(c.config)
 	c.Product = NewProductClient(c.config)
 	c.ProductImage = NewProductImageClient(c.config)
+	c.RecoveryCode = NewRecoveryCodeClient(c.config)
 	c.Review = NewReviewClient(c.config)
 	c.Role = NewRoleClient(c.config)
 	c.RolePermission = NewRolePermissionClient(c.config)
 	c.Session = NewSessionClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.UserIdentity = NewUserIdentityClient(c.config)
 	c.UserPermission = NewUserPermissionClient(c.config)
 	c.UserRole = NewUserRoleClient(c.config)
+	c.UserToken = NewUserTokenClient(c.config)
 	c.UserVoucher = NewUserVoucherClient(c.config)
+	c.WebhookEvent = NewWebhookEventClient(c.config)
 	c.Wishlist = NewWishlistClient(c.config)
 	c.WishlistItem = NewWishlistItemClient(c.config)
 }
@@ -211,30 +249,39 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:              ctx,
-		config:           cfg,
-		Cart:             NewCartClient(cfg),
-		CartItem:         NewCartItemClient(cfg),
-		Category:         NewCategoryClient(cfg),
-		Discount:         NewDiscountClient(cfg),
-		DiscountCategory: NewDiscountCategoryClient(cfg),
-		DiscountProduct:  NewDiscountProductClient(cfg),
-		Order:            NewOrderClient(cfg),
-		OrderItem:        NewOrderItemClient(cfg),
-		Payment:          NewPaymentClient(cfg),
-		Permission:       NewPermissionClient(cfg),
-		Product:          NewProductClient(cfg),
-		ProductImage:     NewProductImageClient(cfg),
-		Review:           NewReviewClient(cfg),
-		Role:             NewRoleClient(cfg),
-		RolePermission:   NewRolePermissionClient(cfg),
-		Session:          NewSessionClient(cfg),
-		User:             NewUserClient(cfg),
-		UserPermission:   NewUserPermissionClient(cfg),
-		UserRole:         NewUserRoleClient(cfg),
-		UserVoucher:      NewUserVoucherClient(cfg),
-		Wishlist:         NewWishlistClient(cfg),
-		WishlistItem:     NewWishlistItemClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		APIKey:             NewAPIKeyClient(cfg),
+		AuditLog:           NewAuditLogClient(cfg),
+		Cart:               NewCartClient(cfg),
+		CartItem:           NewCartItemClient(cfg),
+		Category:           NewCategoryClient(cfg),
+		Discount:           NewDiscountClient(cfg),
+		DiscountCategory:   NewDiscountCategoryClient(cfg),
+		DiscountProduct:    NewDiscountProductClient(cfg),
+		IdempotencyKey:     NewIdempotencyKeyClient(cfg),
+		LoginAttempt:       NewLoginAttemptClient(cfg),
+		Order:              NewOrderClient(cfg),
+		OrderItem:          NewOrderItemClient(cfg),
+		OrderStatusHistory: NewOrderStatusHistoryClient(cfg),
+		Payment:            NewPaymentClient(cfg),
+		Permission:         NewPermissionClient(cfg),
+		Product:            NewProductClient(cfg),
+		ProductImage:       NewProductImageClient(cfg),
+		RecoveryCode:       NewRecoveryCodeClient(cfg),
+		Review:             NewReviewClient(cfg),
+		Role:               NewRoleClient(cfg),
+		RolePermission:     NewRolePermissionClient(cfg),
+		Session:            NewSessionClient(cfg),
+		User:               NewUserClient(cfg),
+		UserIdentity:       NewUserIdentityClient(cfg),
+		UserPermission:     NewUserPermissionClient(cfg),
+		UserRole:           NewUserRoleClient(cfg),
+		UserToken:          NewUserTokenClient(cfg),
+		UserVoucher:        NewUserVoucherClient(cfg),
+		WebhookEvent:       NewWebhookEventClient(cfg),
+		Wishlist:           NewWishlistClient(cfg),
+		WishlistItem:       NewWishlistItemClient(cfg),
 	}, nil
 }
 
@@ -252,37 +299,46 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:              ctx,
-		config:           cfg,
-		Cart:             NewCartClient(cfg),
-		CartItem:         NewCartItemClient(cfg),
-		Category:         NewCategoryClient(cfg),
-		Discount:         NewDiscountClient(cfg),
-		DiscountCategory: NewDiscountCategoryClient(cfg),
-		DiscountProduct:  NewDiscountProductClient(cfg),
-		Order:            NewOrderClient(cfg),
-		OrderItem:        NewOrderItemClient(cfg),
-		Payment:          NewPaymentClient(cfg),
-		Permission:       NewPermissionClient(cfg),
-		Product:          NewProductClient(cfg),
-		ProductImage:     NewProductImageClient(cfg),
-		Review:           NewReviewClient(cfg),
-		Role:             NewRoleClient(cfg),
-		RolePermission:   NewRolePermissionClient(cfg),
-		Session:          NewSessionClient(cfg),
-		User:             NewUserClient(cfg),
-		UserPermission:   NewUserPermissionClient(cfg),
-		UserRole:         NewUserRoleClient(cfg),
-		UserVoucher:      NewUserVoucherClient(cfg),
-		Wishlist:         NewWishlistClient(cfg),
-		WishlistItem:     NewWishlistItemClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		APIKey:             NewAPIKeyClient(cfg),
+		AuditLog:           NewAuditLogClient(cfg),
+		Cart:               NewCartClient(cfg),
+		CartItem:           NewCartItemClient(cfg),
+		Category:           NewCategoryClient(cfg),
+		Discount:           NewDiscountClient(cfg),
+		DiscountCategory:   NewDiscountCategoryClient(cfg),
+		DiscountProduct:    NewDiscountProductClient(cfg),
+		IdempotencyKey:     NewIdempotencyKeyClient(cfg),
+		LoginAttempt:       NewLoginAttemptClient(cfg),
+		Order:              NewOrderClient(cfg),
+		OrderItem:          NewOrderItemClient(cfg),
+		OrderStatusHistory: NewOrderStatusHistoryClient(cfg),
+		Payment:            NewPaymentClient(cfg),
+		Permission:         NewPermissionClient(cfg),
+		Product:            NewProductClient(cfg),
+		ProductImage:       NewProductImageClient(cfg),
+		RecoveryCode:       NewRecoveryCodeClient(cfg),
+		Review:             NewReviewClient(cfg),
+		Role:               NewRoleClient(cfg),
+		RolePermission:     NewRolePermissionClient(cfg),
+		Session:            NewSessionClient(cfg),
+		User:               NewUserClient(cfg),
+		UserIdentity:       NewUserIdentityClient(cfg),
+		UserPermission:     NewUserPermissionClient(cfg),
+		UserRole:           NewUserRoleClient(cfg),
+		UserToken:          NewUserTokenClient(cfg),
+		UserVoucher:        NewUserVoucherClient(cfg),
+		WebhookEvent:       NewWebhookEventClient(cfg),
+		Wishlist:           NewWishlistClient(cfg),
+		WishlistItem:       NewWishlistItemClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Cart.
+//		APIKey.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -305,10 +361,12 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Cart, c.CartItem, c.Category, c.Discount, c.DiscountCategory,
-		c.DiscountProduct, c.Order, c.OrderItem, c.Payment, c.Permission, c.Product,
-		c.ProductImage, c.Review, c.Role, c.RolePermission, c.Session, c.User,
-		c.UserPermission, c.UserRole, c.UserVoucher, c.Wishlist, c.WishlistItem,
+		c.APIKey, c.AuditLog, c.Cart, c.CartItem, c.Category, c.Discount,
+		c.DiscountCategory, c.DiscountProduct, c.IdempotencyKey, c.LoginAttempt,
+		c.Order, c.OrderItem, c.OrderStatusHistory, c.Payment, c.Permission, c.Product,
+		c.ProductImage, c.RecoveryCode, c.Review, c.Role, c.RolePermission, c.Session,
+		c.User, c.UserIdentity, c.UserPermission, c.UserRole, c.UserToken,
+		c.UserVoucher, c.WebhookEvent, c.Wishlist, c.WishlistItem,
 	} {
 		n.Use(hooks...)
 	}
@@ -318,10 +376,12 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Cart, c.CartItem, c.Category, c.Discount, c.DiscountCategory,
-		c.DiscountProduct, c.Order, c.OrderItem, c.Payment, c.Permission, c.Product,
-		c.ProductImage, c.Review, c.Role, c.RolePermission, c.Session, c.User,
-		c.UserPermission, c.UserRole, c.UserVoucher, c.Wishlist, c.WishlistItem,
+		c.APIKey, c.AuditLog, c.Cart, c.CartItem, c.Category, c.Discount,
+		c.DiscountCategory, c.DiscountProduct, c.IdempotencyKey, c.LoginAttempt,
+		c.Order, c.OrderItem, c.OrderStatusHistory, c.Payment, c.Permission, c.Product,
+		c.ProductImage, c.RecoveryCode, c.Review, c.Role, c.RolePermission, c.Session,
+		c.User, c.UserIdentity, c.UserPermission, c.UserRole, c.UserToken,
+		c.UserVoucher, c.WebhookEvent, c.Wishlist, c.WishlistItem,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -330,6 +390,10 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *APIKeyMutation:
+		return c.APIKey.mutate(ctx, m)
+	case *AuditLogMutation:
+		return c.AuditLog.mutate(ctx, m)
 	case *CartMutation:
 		return c.Cart.mutate(ctx, m)
 	case *CartItemMutation:
@@ -342,10 +406,16 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.DiscountCategory.mutate(ctx, m)
 	case *DiscountProductMutation:
 		return c.DiscountProduct.mutate(ctx, m)
+	case *IdempotencyKeyMutation:
+		return c.IdempotencyKey.mutate(ctx, m)
+	case *LoginAttemptMutation:
+		return c.LoginAttempt.mutate(ctx, m)
 	case *OrderMutation:
 		return c.Order.mutate(ctx, m)
 	case *OrderItemMutation:
 		return c.OrderItem.mutate(ctx, m)
+	case *OrderStatusHistoryMutation:
+		return c.OrderStatusHistory.mutate(ctx, m)
 	case *PaymentMutation:
 		return c.Payment.mutate(ctx, m)
 	case *PermissionMutation:
@@ -354,6 +424,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Product.mutate(ctx, m)
 	case *ProductImageMutation:
 		return c.ProductImage.mutate(ctx, m)
+	case *RecoveryCodeMutation:
+		return c.RecoveryCode.mutate(ctx, m)
 	case *ReviewMutation:
 		return c.Review.mutate(ctx, m)
 	case *RoleMutation:
@@ -364,12 +436,18 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Session.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *UserIdentityMutation:
+		return c.UserIdentity.mutate(ctx, m)
 	case *UserPermissionMutation:
 		return c.UserPermission.mutate(ctx, m)
 	case *UserRoleMutation:
 		return c.UserRole.mutate(ctx, m)
+	case *UserTokenMutation:
+		return c.UserToken.mutate(ctx, m)
 	case *UserVoucherMutation:
 		return c.UserVoucher.mutate(ctx, m)
+	case *WebhookEventMutation:
+		return c.WebhookEvent.mutate(ctx, m)
 	case *WishlistMutation:
 		return c.Wishlist.mutate(ctx, m)
 	case *WishlistItemMutation:
@@ -379,6 +457,288 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// APIKeyClient is a client for the APIKey schema.
+type APIKeyClient struct {
+	config
+}
+
+// NewAPIKeyClient returns a client for the APIKey from the given config.
+func NewAPIKeyClient(c config) *APIKeyClient {
+	return &APIKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apikey.Hooks(f(g(h())))`.
+func (c *APIKeyClient) Use(hooks ...Hook) {
+	c.hooks.APIKey = append(c.hooks.APIKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `apikey.Intercept(f(g(h())))`.
+func (c *APIKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.APIKey = append(c.inters.APIKey, interceptors...)
+}
+
+// Create returns a builder for creating a APIKey entity.
+func (c *APIKeyClient) Create() *APIKeyCreate {
+	mutation := newAPIKeyMutation(c.config, OpCreate)
+	return &APIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of APIKey entities.
+func (c *APIKeyClient) CreateBulk(builders ...*APIKeyCreate) *APIKeyCreateBulk {
+	return &APIKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *APIKeyClient) MapCreateBulk(slice any, setFunc func(*APIKeyCreate, int)) *APIKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &APIKeyCreateBulk{err: fmt.Errorf("calling to APIKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*APIKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &APIKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for APIKey.
+func (c *APIKeyClient) Update() *APIKeyUpdate {
+	mutation := newAPIKeyMutation(c.config, OpUpdate)
+	return &APIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *APIKeyClient) UpdateOne(ak *APIKey) *APIKeyUpdateOne {
+	mutation := newAPIKeyMutation(c.config, OpUpdateOne, withAPIKey(ak))
+	return &APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *APIKeyClient) UpdateOneID(id uint64) *APIKeyUpdateOne {
+	mutation := newAPIKeyMutation(c.config, OpUpdateOne, withAPIKeyID(id))
+	return &APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for APIKey.
+func (c *APIKeyClient) Delete() *APIKeyDelete {
+	mutation := newAPIKeyMutation(c.config, OpDelete)
+	return &APIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *APIKeyClient) DeleteOne(ak *APIKey) *APIKeyDeleteOne {
+	return c.DeleteOneID(ak.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *APIKeyClient) DeleteOneID(id uint64) *APIKeyDeleteOne {
+	builder := c.Delete().Where(apikey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &APIKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for APIKey.
+func (c *APIKeyClient) Query() *APIKeyQuery {
+	return &APIKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAPIKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a APIKey entity by its id.
+func (c *APIKeyClient) Get(ctx context.Context, id uint64) (*APIKey, error) {
+	return c.Query().Where(apikey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *APIKeyClient) GetX(ctx context.Context, id uint64) *APIKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a APIKey.
+func (c *APIKeyClient) QueryUser(ak *APIKey) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ak.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apikey.Table, apikey.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, apikey.UserTable, apikey.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(ak.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *APIKeyClient) Hooks() []Hook {
+	return c.hooks.APIKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *APIKeyClient) Interceptors() []Interceptor {
+	return c.inters.APIKey
+}
+
+func (c *APIKeyClient) mutate(ctx context.Context, m *APIKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&APIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&APIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&APIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("gen: unknown APIKey mutation op: %q", m.Op())
+	}
+}
+
+// AuditLogClient is a client for the AuditLog schema.
+type AuditLogClient struct {
+	config
+}
+
+// NewAuditLogClient returns a client for the AuditLog from the given config.
+func NewAuditLogClient(c config) *AuditLogClient {
+	return &AuditLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditlog.Hooks(f(g(h())))`.
+func (c *AuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AuditLog = append(c.hooks.AuditLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditlog.Intercept(f(g(h())))`.
+func (c *AuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLog = append(c.inters.AuditLog, interceptors...)
+}
+
+// Create returns a builder for creating a AuditLog entity.
+func (c *AuditLogClient) Create() *AuditLogCreate {
+	mutation := newAuditLogMutation(c.config, OpCreate)
+	return &AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditLog entities.
+func (c *AuditLogClient) CreateBulk(builders ...*AuditLogCreate) *AuditLogCreateBulk {
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditLogClient) MapCreateBulk(slice any, setFunc func(*AuditLogCreate, int)) *AuditLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditLogCreateBulk{err: fmt.Errorf("calling to AuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditLog.
+func (c *AuditLogClient) Update() *AuditLogUpdate {
+	mutation := newAuditLogMutation(c.config, OpUpdate)
+	return &AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditLogClient) UpdateOne(al *AuditLog) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLog(al))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditLogClient) UpdateOneID(id uint64) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLogID(id))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditLog.
+func (c *AuditLogClient) Delete() *AuditLogDelete {
+	mutation := newAuditLogMutation(c.config, OpDelete)
+	return &AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditLogClient) DeleteOne(al *AuditLog) *AuditLogDeleteOne {
+	return c.DeleteOneID(al.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditLogClient) DeleteOneID(id uint64) *AuditLogDeleteOne {
+	builder := c.Delete().Where(auditlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditLogDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditLog.
+func (c *AuditLogClient) Query() *AuditLogQuery {
+	return &AuditLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditLog entity by its id.
+func (c *AuditLogClient) Get(ctx context.Context, id uint64) (*AuditLog, error) {
+	return c.Query().Where(auditlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditLogClient) GetX(ctx context.Context, id uint64) *AuditLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditLogClient) Hooks() []Hook {
+	return c.hooks.AuditLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditLogClient) Interceptors() []Interceptor {
+	return c.inters.AuditLog
+}
+
+func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("gen: unknown AuditLog mutation op: %q", m.Op())
+	}
+}
+
 // CartClient is a client for the Cart schema.
 type CartClient struct {
 	config
@@ -503,6 +863,22 @@ func (c *CartClient) QueryUser(ca *Cart) *UserQuery {
 	return query
 }
 
+// QueryProducts queries the products edge of a Cart.
+func (c *CartClient) QueryProducts(ca *Cart) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(cart.Table, cart.FieldID, id),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, cart.ProductsTable, cart.ProductsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryItems queries the items edge of a Cart.
 func (c *CartClient) QueryItems(ca *Cart) *CartItemQuery {
 	query := (&CartItemClient{config: c.config}).Query()
@@ -510,8 +886,8 @@ func (c *CartClient) QueryItems(ca *Cart) *CartItemQuery {
 		id := ca.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(cart.Table, cart.FieldID, id),
-			sqlgraph.To(cartitem.Table, cartitem.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, cart.ItemsTable, cart.ItemsColumn),
+			sqlgraph.To(cartitem.Table, cartitem.CartColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, cart.ItemsTable, cart.ItemsColumn),
 		)
 		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
 		return fromV, nil
@@ -600,13 +976,9 @@ func (c *CartItemClient) Update() *CartItemUpdate {
 
 // UpdateOne returns an update builder for the given entity.
 func (c *CartItemClient) UpdateOne(ci *CartItem) *CartItemUpdateOne {
-	mutation := newCartItemMutation(c.config, OpUpdateOne, withCartItem(ci))
-	return &CartItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// UpdateOneID returns an update builder for the given id.
-func (c *CartItemClient) UpdateOneID(id int) *CartItemUpdateOne {
-	mutation := newCartItemMutation(c.config, OpUpdateOne, withCartItemID(id))
+	mutation := newCartItemMutation(c.config, OpUpdateOne)
+	mutation.cart = &ci.CartID
+	mutation.product = &ci.ProductID
 	return &CartItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
@@ -616,19 +988,6 @@ func (c *CartItemClient) Delete() *CartItemDelete {
 	return &CartItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// DeleteOne returns a builder for deleting the given entity.
-func (c *CartItemClient) DeleteOne(ci *CartItem) *CartItemDeleteOne {
-	return c.DeleteOneID(ci.ID)
-}
-
-// DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CartItemClient) DeleteOneID(id int) *CartItemDeleteOne {
-	builder := c.Delete().Where(cartitem.ID(id))
-	builder.mutation.id = &id
-	builder.mutation.op = OpDeleteOne
-	return &CartItemDeleteOne{builder}
-}
-
 // Query returns a query builder for CartItem.
 func (c *CartItemClient) Query() *CartItemQuery {
 	return &CartItemQuery{
@@ -638,50 +997,18 @@ func (c *CartItemClient) Query() *CartItemQuery {
 	}
 }
 
-// Get returns a CartItem entity by its id.
-func (c *CartItemClient) Get(ctx context.Context, id int) (*CartItem, error) {
-	return c.Query().Where(cartitem.ID(id)).Only(ctx)
-}
-
-// GetX is like Get, but panics if an error occurs.
-func (c *CartItemClient) GetX(ctx context.Context, id int) *CartItem {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
-	}
-	return obj
-}
-
 // QueryCart queries the cart edge of a CartItem.
 func (c *CartItemClient) QueryCart(ci *CartItem) *CartQuery {
-	query := (&CartClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ci.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(cartitem.Table, cartitem.FieldID, id),
-			sqlgraph.To(cart.Table, cart.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, cartitem.CartTable, cartitem.CartColumn),
-		)
-		fromV = sqlgraph.Neighbors(ci.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+	return c.Query().
+		Where(cartitem.CartID(ci.CartID), cartitem.ProductID(ci.ProductID)).
+		QueryCart()
 }
 
 // QueryProduct queries the product edge of a CartItem.
 func (c *CartItemClient) QueryProduct(ci *CartItem) *ProductQuery {
-	query := (&ProductClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ci.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(cartitem.Table, cartitem.FieldID, id),
-			sqlgraph.To(product.Table, product.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, cartitem.ProductTable, cartitem.ProductColumn),
-		)
-		fromV = sqlgraph.Neighbors(ci.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+	return c.Query().
+		Where(cartitem.CartID(ci.CartID), cartitem.ProductID(ci.ProductID)).
+		QueryProduct()
 }
 
 // Hooks returns the client hooks.
@@ -817,6 +1144,38 @@ func (c *CategoryClient) GetX(ctx context.Context, id uint64) *Category {
 	return obj
 }
 
+// QueryParent queries the parent edge of a Category.
+func (c *CategoryClient) QueryParent(ca *Category) *CategoryQuery {
+	query := (&CategoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(category.Table, category.FieldID, id),
+			sqlgraph.To(category.Table, category.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, category.ParentTable, category.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Category.
+func (c *CategoryClient) QueryChildren(ca *Category) *CategoryQuery {
+	query := (&CategoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(category.Table, category.FieldID, id),
+			sqlgraph.To(category.Table, category.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, category.ChildrenTable, category.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryProducts queries the products edge of a Category.
 func (c *CategoryClient) QueryProducts(ca *Category) *ProductQuery {
 	query := (&ProductClient{config: c.config}).Query()
@@ -834,14 +1193,30 @@ func (c *CategoryClient) QueryProducts(ca *Category) *ProductQuery {
 }
 
 // QueryDiscounts queries the discounts edge of a Category.
-func (c *CategoryClient) QueryDiscounts(ca *Category) *DiscountCategoryQuery {
+func (c *CategoryClient) QueryDiscounts(ca *Category) *DiscountQuery {
+	query := (&DiscountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(category.Table, category.FieldID, id),
+			sqlgraph.To(discount.Table, discount.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, category.DiscountsTable, category.DiscountsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDiscountCategories queries the discount_categories edge of a Category.
+func (c *CategoryClient) QueryDiscountCategories(ca *Category) *DiscountCategoryQuery {
 	query := (&DiscountCategoryClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := ca.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(category.Table, category.FieldID, id),
-			sqlgraph.To(discountcategory.Table, discountcategory.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, category.DiscountsTable, category.DiscountsColumn),
+			sqlgraph.To(discountcategory.Table, discountcategory.CategoryColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, category.DiscountCategoriesTable, category.DiscountCategoriesColumn),
 		)
 		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
 		return fromV, nil
@@ -983,14 +1358,14 @@ func (c *DiscountClient) GetX(ctx context.Context, id uint64) *Discount {
 }
 
 // QueryProducts queries the products edge of a Discount.
-func (c *DiscountClient) QueryProducts(d *Discount) *DiscountProductQuery {
-	query := (&DiscountProductClient{config: c.config}).Query()
+func (c *DiscountClient) QueryProducts(d *Discount) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := d.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(discount.Table, discount.FieldID, id),
-			sqlgraph.To(discountproduct.Table, discountproduct.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, discount.ProductsTable, discount.ProductsColumn),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, discount.ProductsTable, discount.ProductsPrimaryKey...),
 		)
 		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
 		return fromV, nil
@@ -999,14 +1374,14 @@ func (c *DiscountClient) QueryProducts(d *Discount) *DiscountProductQuery {
 }
 
 // QueryCategories queries the categories edge of a Discount.
-func (c *DiscountClient) QueryCategories(d *Discount) *DiscountCategoryQuery {
-	query := (&DiscountCategoryClient{config: c.config}).Query()
+func (c *DiscountClient) QueryCategories(d *Discount) *CategoryQuery {
+	query := (&CategoryClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := d.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(discount.Table, discount.FieldID, id),
-			sqlgraph.To(discountcategory.Table, discountcategory.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, discount.CategoriesTable, discount.CategoriesColumn),
+			sqlgraph.To(category.Table, category.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, discount.CategoriesTable, discount.CategoriesPrimaryKey...),
 		)
 		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
 		return fromV, nil
@@ -1030,7 +1405,55 @@ func (c *DiscountClient) QueryUserVouchers(d *Discount) *UserVoucherQuery {
 	return query
 }
 
-// Hooks returns the client hooks.
+// QueryOrders queries the orders edge of a Discount.
+func (c *DiscountClient) QueryOrders(d *Discount) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discount.Table, discount.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, discount.OrdersTable, discount.OrdersColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDiscountProducts queries the discount_products edge of a Discount.
+func (c *DiscountClient) QueryDiscountProducts(d *Discount) *DiscountProductQuery {
+	query := (&DiscountProductClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discount.Table, discount.FieldID, id),
+			sqlgraph.To(discountproduct.Table, discountproduct.DiscountColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, discount.DiscountProductsTable, discount.DiscountProductsColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDiscountCategories queries the discount_categories edge of a Discount.
+func (c *DiscountClient) QueryDiscountCategories(d *Discount) *DiscountCategoryQuery {
+	query := (&DiscountCategoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discount.Table, discount.FieldID, id),
+			sqlgraph.To(discountcategory.Table, discountcategory.DiscountColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, discount.DiscountCategoriesTable, discount.DiscountCategoriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
 func (c *DiscountClient) Hooks() []Hook {
 	return c.hooks.Discount
 }
@@ -1111,13 +1534,9 @@ func (c *DiscountCategoryClient) Update() *DiscountCategoryUpdate {
 
 // UpdateOne returns an update builder for the given entity.
 func (c *DiscountCategoryClient) UpdateOne(dc *DiscountCategory) *DiscountCategoryUpdateOne {
-	mutation := newDiscountCategoryMutation(c.config, OpUpdateOne, withDiscountCategory(dc))
-	return &DiscountCategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// UpdateOneID returns an update builder for the given id.
-func (c *DiscountCategoryClient) UpdateOneID(id int) *DiscountCategoryUpdateOne {
-	mutation := newDiscountCategoryMutation(c.config, OpUpdateOne, withDiscountCategoryID(id))
+	mutation := newDiscountCategoryMutation(c.config, OpUpdateOne)
+	mutation.discount = &dc.DiscountID
+	mutation.category = &dc.CategoryID
 	return &DiscountCategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
@@ -1127,19 +1546,6 @@ func (c *DiscountCategoryClient) Delete() *DiscountCategoryDelete {
 	return &DiscountCategoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// DeleteOne returns a builder for deleting the given entity.
-func (c *DiscountCategoryClient) DeleteOne(dc *DiscountCategory) *DiscountCategoryDeleteOne {
-	return c.DeleteOneID(dc.ID)
-}
-
-// DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DiscountCategoryClient) DeleteOneID(id int) *DiscountCategoryDeleteOne {
-	builder := c.Delete().Where(discountcategory.ID(id))
-	builder.mutation.id = &id
-	builder.mutation.op = OpDeleteOne
-	return &DiscountCategoryDeleteOne{builder}
-}
-
 // Query returns a query builder for DiscountCategory.
 func (c *DiscountCategoryClient) Query() *DiscountCategoryQuery {
 	return &DiscountCategoryQuery{
@@ -1149,50 +1555,18 @@ func (c *DiscountCategoryClient) Query() *DiscountCategoryQuery {
 	}
 }
 
-// Get returns a DiscountCategory entity by its id.
-func (c *DiscountCategoryClient) Get(ctx context.Context, id int) (*DiscountCategory, error) {
-	return c.Query().Where(discountcategory.ID(id)).Only(ctx)
-}
-
-// GetX is like Get, but panics if an error occurs.
-func (c *DiscountCategoryClient) GetX(ctx context.Context, id int) *DiscountCategory {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
-	}
-	return obj
-}
-
 // QueryDiscount queries the discount edge of a DiscountCategory.
 func (c *DiscountCategoryClient) QueryDiscount(dc *DiscountCategory) *DiscountQuery {
-	query := (&DiscountClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := dc.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(discountcategory.Table, discountcategory.FieldID, id),
-			sqlgraph.To(discount.Table, discount.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, discountcategory.DiscountTable, discountcategory.DiscountColumn),
-		)
-		fromV = sqlgraph.Neighbors(dc.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+	return c.Query().
+		Where(discountcategory.DiscountID(dc.DiscountID), discountcategory.CategoryID(dc.CategoryID)).
+		QueryDiscount()
 }
 
 // QueryCategory queries the category edge of a DiscountCategory.
 func (c *DiscountCategoryClient) QueryCategory(dc *DiscountCategory) *CategoryQuery {
-	query := (&CategoryClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := dc.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(discountcategory.Table, discountcategory.FieldID, id),
-			sqlgraph.To(category.Table, category.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, discountcategory.CategoryTable, discountcategory.CategoryColumn),
-		)
-		fromV = sqlgraph.Neighbors(dc.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+	return c.Query().
+		Where(discountcategory.DiscountID(dc.DiscountID), discountcategory.CategoryID(dc.CategoryID)).
+		QueryCategory()
 }
 
 // Hooks returns the client hooks.
@@ -1276,13 +1650,9 @@ func (c *DiscountProductClient) Update() *DiscountProductUpdate {
 
 // UpdateOne returns an update builder for the given entity.
 func (c *DiscountProductClient) UpdateOne(dp *DiscountProduct) *DiscountProductUpdateOne {
-	mutation := newDiscountProductMutation(c.config, OpUpdateOne, withDiscountProduct(dp))
-	return &DiscountProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// UpdateOneID returns an update builder for the given id.
-func (c *DiscountProductClient) UpdateOneID(id int) *DiscountProductUpdateOne {
-	mutation := newDiscountProductMutation(c.config, OpUpdateOne, withDiscountProductID(id))
+	mutation := newDiscountProductMutation(c.config, OpUpdateOne)
+	mutation.discount = &dp.DiscountID
+	mutation.product = &dp.ProductID
 	return &DiscountProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
@@ -1292,35 +1662,155 @@ func (c *DiscountProductClient) Delete() *DiscountProductDelete {
 	return &DiscountProductDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// Query returns a query builder for DiscountProduct.
+func (c *DiscountProductClient) Query() *DiscountProductQuery {
+	return &DiscountProductQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDiscountProduct},
+		inters: c.Interceptors(),
+	}
+}
+
+// QueryDiscount queries the discount edge of a DiscountProduct.
+func (c *DiscountProductClient) QueryDiscount(dp *DiscountProduct) *DiscountQuery {
+	return c.Query().
+		Where(discountproduct.DiscountID(dp.DiscountID), discountproduct.ProductID(dp.ProductID)).
+		QueryDiscount()
+}
+
+// QueryProduct queries the product edge of a DiscountProduct.
+func (c *DiscountProductClient) QueryProduct(dp *DiscountProduct) *ProductQuery {
+	return c.Query().
+		Where(discountproduct.DiscountID(dp.DiscountID), discountproduct.ProductID(dp.ProductID)).
+		QueryProduct()
+}
+
+// Hooks returns the client hooks.
+func (c *DiscountProductClient) Hooks() []Hook {
+	return c.hooks.DiscountProduct
+}
+
+// Interceptors returns the client interceptors.
+func (c *DiscountProductClient) Interceptors() []Interceptor {
+	return c.inters.DiscountProduct
+}
+
+func (c *DiscountProductClient) mutate(ctx context.Context, m *DiscountProductMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DiscountProductCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DiscountProductUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DiscountProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DiscountProductDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("gen: unknown DiscountProduct mutation op: %q", m.Op())
+	}
+}
+
+// IdempotencyKeyClient is a client for the IdempotencyKey schema.
+type IdempotencyKeyClient struct {
+	config
+}
+
+// NewIdempotencyKeyClient returns a client for the IdempotencyKey from the given config.
+func NewIdempotencyKeyClient(c config) *IdempotencyKeyClient {
+	return &IdempotencyKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `idempotencykey.Hooks(f(g(h())))`.
+func (c *IdempotencyKeyClient) Use(hooks ...Hook) {
+	c.hooks.IdempotencyKey = append(c.hooks.IdempotencyKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `idempotencykey.Intercept(f(g(h())))`.
+func (c *IdempotencyKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IdempotencyKey = append(c.inters.IdempotencyKey, interceptors...)
+}
+
+// Create returns a builder for creating a IdempotencyKey entity.
+func (c *IdempotencyKeyClient) Create() *IdempotencyKeyCreate {
+	mutation := newIdempotencyKeyMutation(c.config, OpCreate)
+	return &IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IdempotencyKey entities.
+func (c *IdempotencyKeyClient) CreateBulk(builders ...*IdempotencyKeyCreate) *IdempotencyKeyCreateBulk {
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IdempotencyKeyClient) MapCreateBulk(slice any, setFunc func(*IdempotencyKeyCreate, int)) *IdempotencyKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IdempotencyKeyCreateBulk{err: fmt.Errorf("calling to IdempotencyKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IdempotencyKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Update() *IdempotencyKeyUpdate {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdate)
+	return &IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IdempotencyKeyClient) UpdateOne(ik *IdempotencyKey) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKey(ik))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IdempotencyKeyClient) UpdateOneID(id string) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKeyID(id))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Delete() *IdempotencyKeyDelete {
+	mutation := newIdempotencyKeyMutation(c.config, OpDelete)
+	return &IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DiscountProductClient) DeleteOne(dp *DiscountProduct) *DiscountProductDeleteOne {
-	return c.DeleteOneID(dp.ID)
+func (c *IdempotencyKeyClient) DeleteOne(ik *IdempotencyKey) *IdempotencyKeyDeleteOne {
+	return c.DeleteOneID(ik.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DiscountProductClient) DeleteOneID(id int) *DiscountProductDeleteOne {
-	builder := c.Delete().Where(discountproduct.ID(id))
+func (c *IdempotencyKeyClient) DeleteOneID(id string) *IdempotencyKeyDeleteOne {
+	builder := c.Delete().Where(idempotencykey.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DiscountProductDeleteOne{builder}
+	return &IdempotencyKeyDeleteOne{builder}
 }
 
-// Query returns a query builder for DiscountProduct.
-func (c *DiscountProductClient) Query() *DiscountProductQuery {
-	return &DiscountProductQuery{
+// Query returns a query builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Query() *IdempotencyKeyQuery {
+	return &IdempotencyKeyQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDiscountProduct},
+		ctx:    &QueryContext{Type: TypeIdempotencyKey},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DiscountProduct entity by its id.
-func (c *DiscountProductClient) Get(ctx context.Context, id int) (*DiscountProduct, error) {
-	return c.Query().Where(discountproduct.ID(id)).Only(ctx)
+// Get returns a IdempotencyKey entity by its id.
+func (c *IdempotencyKeyClient) Get(ctx context.Context, id string) (*IdempotencyKey, error) {
+	return c.Query().Where(idempotencykey.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DiscountProductClient) GetX(ctx context.Context, id int) *DiscountProduct {
+func (c *IdempotencyKeyClient) GetX(ctx context.Context, id string) *IdempotencyKey {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1328,60 +1818,161 @@ func (c *DiscountProductClient) GetX(ctx context.Context, id int) *DiscountProdu
 	return obj
 }
 
-// QueryDiscount queries the discount edge of a DiscountProduct.
-func (c *DiscountProductClient) QueryDiscount(dp *DiscountProduct) *DiscountQuery {
-	query := (&DiscountClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := dp.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(discountproduct.Table, discountproduct.FieldID, id),
-			sqlgraph.To(discount.Table, discount.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, discountproduct.DiscountTable, discountproduct.DiscountColumn),
-		)
-		fromV = sqlgraph.Neighbors(dp.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *IdempotencyKeyClient) Hooks() []Hook {
+	return c.hooks.IdempotencyKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *IdempotencyKeyClient) Interceptors() []Interceptor {
+	return c.inters.IdempotencyKey
+}
+
+func (c *IdempotencyKeyClient) mutate(ctx context.Context, m *IdempotencyKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("gen: unknown IdempotencyKey mutation op: %q", m.Op())
 	}
-	return query
 }
 
-// QueryProduct queries the product edge of a DiscountProduct.
-func (c *DiscountProductClient) QueryProduct(dp *DiscountProduct) *ProductQuery {
-	query := (&ProductClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := dp.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(discountproduct.Table, discountproduct.FieldID, id),
-			sqlgraph.To(product.Table, product.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, discountproduct.ProductTable, discountproduct.ProductColumn),
-		)
-		fromV = sqlgraph.Neighbors(dp.driver.Dialect(), step)
-		return fromV, nil
+// LoginAttemptClient is a client for the LoginAttempt schema.
+type LoginAttemptClient struct {
+	config
+}
+
+// NewLoginAttemptClient returns a client for the LoginAttempt from the given config.
+func NewLoginAttemptClient(c config) *LoginAttemptClient {
+	return &LoginAttemptClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `loginattempt.Hooks(f(g(h())))`.
+func (c *LoginAttemptClient) Use(hooks ...Hook) {
+	c.hooks.LoginAttempt = append(c.hooks.LoginAttempt, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `loginattempt.Intercept(f(g(h())))`.
+func (c *LoginAttemptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LoginAttempt = append(c.inters.LoginAttempt, interceptors...)
+}
+
+// Create returns a builder for creating a LoginAttempt entity.
+func (c *LoginAttemptClient) Create() *LoginAttemptCreate {
+	mutation := newLoginAttemptMutation(c.config, OpCreate)
+	return &LoginAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LoginAttempt entities.
+func (c *LoginAttemptClient) CreateBulk(builders ...*LoginAttemptCreate) *LoginAttemptCreateBulk {
+	return &LoginAttemptCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LoginAttemptClient) MapCreateBulk(slice any, setFunc func(*LoginAttemptCreate, int)) *LoginAttemptCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LoginAttemptCreateBulk{err: fmt.Errorf("calling to LoginAttemptClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	return query
+	builders := make([]*LoginAttemptCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LoginAttemptCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LoginAttempt.
+func (c *LoginAttemptClient) Update() *LoginAttemptUpdate {
+	mutation := newLoginAttemptMutation(c.config, OpUpdate)
+	return &LoginAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LoginAttemptClient) UpdateOne(la *LoginAttempt) *LoginAttemptUpdateOne {
+	mutation := newLoginAttemptMutation(c.config, OpUpdateOne, withLoginAttempt(la))
+	return &LoginAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LoginAttemptClient) UpdateOneID(id string) *LoginAttemptUpdateOne {
+	mutation := newLoginAttemptMutation(c.config, OpUpdateOne, withLoginAttemptID(id))
+	return &LoginAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LoginAttempt.
+func (c *LoginAttemptClient) Delete() *LoginAttemptDelete {
+	mutation := newLoginAttemptMutation(c.config, OpDelete)
+	return &LoginAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LoginAttemptClient) DeleteOne(la *LoginAttempt) *LoginAttemptDeleteOne {
+	return c.DeleteOneID(la.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LoginAttemptClient) DeleteOneID(id string) *LoginAttemptDeleteOne {
+	builder := c.Delete().Where(loginattempt.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LoginAttemptDeleteOne{builder}
+}
+
+// Query returns a query builder for LoginAttempt.
+func (c *LoginAttemptClient) Query() *LoginAttemptQuery {
+	return &LoginAttemptQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLoginAttempt},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LoginAttempt entity by its id.
+func (c *LoginAttemptClient) Get(ctx context.Context, id string) (*LoginAttempt, error) {
+	return c.Query().Where(loginattempt.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LoginAttemptClient) GetX(ctx context.Context, id string) *LoginAttempt {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *DiscountProductClient) Hooks() []Hook {
-	return c.hooks.DiscountProduct
+func (c *LoginAttemptClient) Hooks() []Hook {
+	return c.hooks.LoginAttempt
 }
 
 // Interceptors returns the client interceptors.
-func (c *DiscountProductClient) Interceptors() []Interceptor {
-	return c.inters.DiscountProduct
+func (c *LoginAttemptClient) Interceptors() []Interceptor {
+	return c.inters.LoginAttempt
 }
 
-func (c *DiscountProductClient) mutate(ctx context.Context, m *DiscountProductMutation) (Value, error) {
+func (c *LoginAttemptClient) mutate(ctx context.Context, m *LoginAttemptMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DiscountProductCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LoginAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DiscountProductUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LoginAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DiscountProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LoginAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DiscountProductDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&LoginAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("gen: unknown DiscountProduct mutation op: %q", m.Op())
+		return nil, fmt.Errorf("gen: unknown LoginAttempt mutation op: %q", m.Op())
 	}
 }
 
@@ -1509,6 +2100,22 @@ func (c *OrderClient) QueryUser(o *Order) *UserQuery {
 	return query
 }
 
+// QueryDiscount queries the discount edge of a Order.
+func (c *OrderClient) QueryDiscount(o *Order) *DiscountQuery {
+	query := (&DiscountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(discount.Table, discount.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, order.DiscountTable, order.DiscountColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryItems queries the items edge of a Order.
 func (c *OrderClient) QueryItems(o *Order) *OrderItemQuery {
 	query := (&OrderItemClient{config: c.config}).Query()
@@ -1541,6 +2148,22 @@ func (c *OrderClient) QueryPayments(o *Order) *PaymentQuery {
 	return query
 }
 
+// QueryStatusHistory queries the status_history edge of a Order.
+func (c *OrderClient) QueryStatusHistory(o *Order) *OrderStatusHistoryQuery {
+	query := (&OrderStatusHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(orderstatushistory.Table, orderstatushistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, order.StatusHistoryTable, order.StatusHistoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *OrderClient) Hooks() []Hook {
 	return c.hooks.Order
@@ -1731,6 +2354,171 @@ func (c *OrderItemClient) mutate(ctx context.Context, m *OrderItemMutation) (Val
 	}
 }
 
+// OrderStatusHistoryClient is a client for the OrderStatusHistory schema.
+type OrderStatusHistoryClient struct {
+	config
+}
+
+// NewOrderStatusHistoryClient returns a client for the OrderStatusHistory from the given config.
+func NewOrderStatusHistoryClient(c config) *OrderStatusHistoryClient {
+	return &OrderStatusHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `orderstatushistory.Hooks(f(g(h())))`.
+func (c *OrderStatusHistoryClient) Use(hooks ...Hook) {
+	c.hooks.OrderStatusHistory = append(c.hooks.OrderStatusHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `orderstatushistory.Intercept(f(g(h())))`.
+func (c *OrderStatusHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OrderStatusHistory = append(c.inters.OrderStatusHistory, interceptors...)
+}
+
+// Create returns a builder for creating a OrderStatusHistory entity.
+func (c *OrderStatusHistoryClient) Create() *OrderStatusHistoryCreate {
+	mutation := newOrderStatusHistoryMutation(c.config, OpCreate)
+	return &OrderStatusHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OrderStatusHistory entities.
+func (c *OrderStatusHistoryClient) CreateBulk(builders ...*OrderStatusHistoryCreate) *OrderStatusHistoryCreateBulk {
+	return &OrderStatusHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrderStatusHistoryClient) MapCreateBulk(slice any, setFunc func(*OrderStatusHistoryCreate, int)) *OrderStatusHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrderStatusHistoryCreateBulk{err: fmt.Errorf("calling to OrderStatusHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrderStatusHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrderStatusHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OrderStatusHistory.
+func (c *OrderStatusHistoryClient) Update() *OrderStatusHistoryUpdate {
+	mutation := newOrderStatusHistoryMutation(c.config, OpUpdate)
+	return &OrderStatusHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrderStatusHistoryClient) UpdateOne(osh *OrderStatusHistory) *OrderStatusHistoryUpdateOne {
+	mutation := newOrderStatusHistoryMutation(c.config, OpUpdateOne, withOrderStatusHistory(osh))
+	return &OrderStatusHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrderStatusHistoryClient) UpdateOneID(id uint64) *OrderStatusHistoryUpdateOne {
+	mutation := newOrderStatusHistoryMutation(c.config, OpUpdateOne, withOrderStatusHistoryID(id))
+	return &OrderStatusHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OrderStatusHistory.
+func (c *OrderStatusHistoryClient) Delete() *OrderStatusHistoryDelete {
+	mutation := newOrderStatusHistoryMutation(c.config, OpDelete)
+	return &OrderStatusHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrderStatusHistoryClient) DeleteOne(osh *OrderStatusHistory) *OrderStatusHistoryDeleteOne {
+	return c.DeleteOneID(osh.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrderStatusHistoryClient) DeleteOneID(id uint64) *OrderStatusHistoryDeleteOne {
+	builder := c.Delete().Where(orderstatushistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrderStatusHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for OrderStatusHistory.
+func (c *OrderStatusHistoryClient) Query() *OrderStatusHistoryQuery {
+	return &OrderStatusHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrderStatusHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OrderStatusHistory entity by its id.
+func (c *OrderStatusHistoryClient) Get(ctx context.Context, id uint64) (*OrderStatusHistory, error) {
+	return c.Query().Where(orderstatushistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrderStatusHistoryClient) GetX(ctx context.Context, id uint64) *OrderStatusHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrder queries the order edge of a OrderStatusHistory.
+func (c *OrderStatusHistoryClient) QueryOrder(osh *OrderStatusHistory) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := osh.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderstatushistory.Table, orderstatushistory.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderstatushistory.OrderTable, orderstatushistory.OrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(osh.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a OrderStatusHistory.
+func (c *OrderStatusHistoryClient) QueryUser(osh *OrderStatusHistory) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := osh.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderstatushistory.Table, orderstatushistory.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderstatushistory.UserTable, orderstatushistory.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(osh.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrderStatusHistoryClient) Hooks() []Hook {
+	return c.hooks.OrderStatusHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrderStatusHistoryClient) Interceptors() []Interceptor {
+	return c.inters.OrderStatusHistory
+}
+
+func (c *OrderStatusHistoryClient) mutate(ctx context.Context, m *OrderStatusHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrderStatusHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrderStatusHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrderStatusHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrderStatusHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("gen: unknown OrderStatusHistory mutation op: %q", m.Op())
+	}
+}
+
 // PaymentClient is a client for the Payment schema.
 type PaymentClient struct {
 	config
@@ -2201,15 +2989,63 @@ func (c *ProductClient) QueryReviews(pr *Product) *ReviewQuery {
 	return query
 }
 
-// QueryCartItems queries the cart_items edge of a Product.
-func (c *ProductClient) QueryCartItems(pr *Product) *CartItemQuery {
-	query := (&CartItemClient{config: c.config}).Query()
+// QueryCarts queries the carts edge of a Product.
+func (c *ProductClient) QueryCarts(pr *Product) *CartQuery {
+	query := (&CartClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(cart.Table, cart.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, product.CartsTable, product.CartsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrderItems queries the order_items edge of a Product.
+func (c *ProductClient) QueryOrderItems(pr *Product) *OrderItemQuery {
+	query := (&OrderItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(orderitem.Table, orderitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.OrderItemsTable, product.OrderItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDiscounts queries the discounts edge of a Product.
+func (c *ProductClient) QueryDiscounts(pr *Product) *DiscountQuery {
+	query := (&DiscountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(discount.Table, discount.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, product.DiscountsTable, product.DiscountsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWishlists queries the wishlists edge of a Product.
+func (c *ProductClient) QueryWishlists(pr *Product) *WishlistQuery {
+	query := (&WishlistClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := pr.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(product.Table, product.FieldID, id),
-			sqlgraph.To(cartitem.Table, cartitem.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, product.CartItemsTable, product.CartItemsColumn),
+			sqlgraph.To(wishlist.Table, wishlist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, product.WishlistsTable, product.WishlistsPrimaryKey...),
 		)
 		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
 		return fromV, nil
@@ -2217,15 +3053,15 @@ func (c *ProductClient) QueryCartItems(pr *Product) *CartItemQuery {
 	return query
 }
 
-// QueryOrderItems queries the order_items edge of a Product.
-func (c *ProductClient) QueryOrderItems(pr *Product) *OrderItemQuery {
-	query := (&OrderItemClient{config: c.config}).Query()
+// QueryCartItems queries the cart_items edge of a Product.
+func (c *ProductClient) QueryCartItems(pr *Product) *CartItemQuery {
+	query := (&CartItemClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := pr.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(product.Table, product.FieldID, id),
-			sqlgraph.To(orderitem.Table, orderitem.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, product.OrderItemsTable, product.OrderItemsColumn),
+			sqlgraph.To(cartitem.Table, cartitem.ProductColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, product.CartItemsTable, product.CartItemsColumn),
 		)
 		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
 		return fromV, nil
@@ -2233,15 +3069,15 @@ func (c *ProductClient) QueryOrderItems(pr *Product) *OrderItemQuery {
 	return query
 }
 
-// QueryDiscounts queries the discounts edge of a Product.
-func (c *ProductClient) QueryDiscounts(pr *Product) *DiscountProductQuery {
+// QueryDiscountProducts queries the discount_products edge of a Product.
+func (c *ProductClient) QueryDiscountProducts(pr *Product) *DiscountProductQuery {
 	query := (&DiscountProductClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := pr.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(product.Table, product.FieldID, id),
-			sqlgraph.To(discountproduct.Table, discountproduct.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, product.DiscountsTable, product.DiscountsColumn),
+			sqlgraph.To(discountproduct.Table, discountproduct.ProductColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, product.DiscountProductsTable, product.DiscountProductsColumn),
 		)
 		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
 		return fromV, nil
@@ -2256,8 +3092,8 @@ func (c *ProductClient) QueryWishlistItems(pr *Product) *WishlistItemQuery {
 		id := pr.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(product.Table, product.FieldID, id),
-			sqlgraph.To(wishlistitem.Table, wishlistitem.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, product.WishlistItemsTable, product.WishlistItemsColumn),
+			sqlgraph.To(wishlistitem.Table, wishlistitem.ProductColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, product.WishlistItemsTable, product.WishlistItemsColumn),
 		)
 		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
 		return fromV, nil
@@ -2439,6 +3275,155 @@ func (c *ProductImageClient) mutate(ctx context.Context, m *ProductImageMutation
 	}
 }
 
+// RecoveryCodeClient is a client for the RecoveryCode schema.
+type RecoveryCodeClient struct {
+	config
+}
+
+// NewRecoveryCodeClient returns a client for the RecoveryCode from the given config.
+func NewRecoveryCodeClient(c config) *RecoveryCodeClient {
+	return &RecoveryCodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `recoverycode.Hooks(f(g(h())))`.
+func (c *RecoveryCodeClient) Use(hooks ...Hook) {
+	c.hooks.RecoveryCode = append(c.hooks.RecoveryCode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `recoverycode.Intercept(f(g(h())))`.
+func (c *RecoveryCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RecoveryCode = append(c.inters.RecoveryCode, interceptors...)
+}
+
+// Create returns a builder for creating a RecoveryCode entity.
+func (c *RecoveryCodeClient) Create() *RecoveryCodeCreate {
+	mutation := newRecoveryCodeMutation(c.config, OpCreate)
+	return &RecoveryCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RecoveryCode entities.
+func (c *RecoveryCodeClient) CreateBulk(builders ...*RecoveryCodeCreate) *RecoveryCodeCreateBulk {
+	return &RecoveryCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RecoveryCodeClient) MapCreateBulk(slice any, setFunc func(*RecoveryCodeCreate, int)) *RecoveryCodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RecoveryCodeCreateBulk{err: fmt.Errorf("calling to RecoveryCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RecoveryCodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RecoveryCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RecoveryCode.
+func (c *RecoveryCodeClient) Update() *RecoveryCodeUpdate {
+	mutation := newRecoveryCodeMutation(c.config, OpUpdate)
+	return &RecoveryCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RecoveryCodeClient) UpdateOne(rc *RecoveryCode) *RecoveryCodeUpdateOne {
+	mutation := newRecoveryCodeMutation(c.config, OpUpdateOne, withRecoveryCode(rc))
+	return &RecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RecoveryCodeClient) UpdateOneID(id uint64) *RecoveryCodeUpdateOne {
+	mutation := newRecoveryCodeMutation(c.config, OpUpdateOne, withRecoveryCodeID(id))
+	return &RecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RecoveryCode.
+func (c *RecoveryCodeClient) Delete() *RecoveryCodeDelete {
+	mutation := newRecoveryCodeMutation(c.config, OpDelete)
+	return &RecoveryCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RecoveryCodeClient) DeleteOne(rc *RecoveryCode) *RecoveryCodeDeleteOne {
+	return c.DeleteOneID(rc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RecoveryCodeClient) DeleteOneID(id uint64) *RecoveryCodeDeleteOne {
+	builder := c.Delete().Where(recoverycode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RecoveryCodeDeleteOne{builder}
+}
+
+// Query returns a query builder for RecoveryCode.
+func (c *RecoveryCodeClient) Query() *RecoveryCodeQuery {
+	return &RecoveryCodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRecoveryCode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RecoveryCode entity by its id.
+func (c *RecoveryCodeClient) Get(ctx context.Context, id uint64) (*RecoveryCode, error) {
+	return c.Query().Where(recoverycode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RecoveryCodeClient) GetX(ctx context.Context, id uint64) *RecoveryCode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a RecoveryCode.
+func (c *RecoveryCodeClient) QueryUser(rc *RecoveryCode) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recoverycode.Table, recoverycode.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, recoverycode.UserTable, recoverycode.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RecoveryCodeClient) Hooks() []Hook {
+	return c.hooks.RecoveryCode
+}
+
+// Interceptors returns the client interceptors.
+func (c *RecoveryCodeClient) Interceptors() []Interceptor {
+	return c.inters.RecoveryCode
+}
+
+func (c *RecoveryCodeClient) mutate(ctx context.Context, m *RecoveryCodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RecoveryCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RecoveryCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RecoveryCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("gen: unknown RecoveryCode mutation op: %q", m.Op())
+	}
+}
+
 // ReviewClient is a client for the Review schema.
 type ReviewClient struct {
 	config
@@ -2728,15 +3713,15 @@ func (c *RoleClient) QueryUserRoles(r *Role) *UserRoleQuery {
 	return query
 }
 
-// QueryPermissions queries the permissions edge of a Role.
-func (c *RoleClient) QueryPermissions(r *Role) *RolePermissionQuery {
+// QueryRolePermissions queries the role_permissions edge of a Role.
+func (c *RoleClient) QueryRolePermissions(r *Role) *RolePermissionQuery {
 	query := (&RolePermissionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := r.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(role.Table, role.FieldID, id),
 			sqlgraph.To(rolepermission.Table, rolepermission.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, role.PermissionsTable, role.PermissionsColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, role.RolePermissionsTable, role.RolePermissionsColumn),
 		)
 		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
 		return fromV, nil
@@ -3175,15 +4160,15 @@ func (c *UserClient) GetX(ctx context.Context, id uint64) *User {
 	return obj
 }
 
-// QueryRoles queries the roles edge of a User.
-func (c *UserClient) QueryRoles(u *User) *UserRoleQuery {
+// QueryUserRoles queries the user_roles edge of a User.
+func (c *UserClient) QueryUserRoles(u *User) *UserRoleQuery {
 	query := (&UserRoleClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
 			sqlgraph.To(userrole.Table, userrole.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.RolesTable, user.RolesColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UserRolesTable, user.UserRolesColumn),
 		)
 		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
 		return fromV, nil
@@ -3191,15 +4176,15 @@ func (c *UserClient) QueryRoles(u *User) *UserRoleQuery {
 	return query
 }
 
-// QueryPermissions queries the permissions edge of a User.
-func (c *UserClient) QueryPermissions(u *User) *UserPermissionQuery {
+// QueryUserPermissions queries the user_permissions edge of a User.
+func (c *UserClient) QueryUserPermissions(u *User) *UserPermissionQuery {
 	query := (&UserPermissionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := u.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, id),
 			sqlgraph.To(userpermission.Table, userpermission.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.PermissionsTable, user.PermissionsColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UserPermissionsTable, user.UserPermissionsColumn),
 		)
 		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
 		return fromV, nil
@@ -3252,63 +4237,292 @@ func (c *UserClient) QueryWishlists(u *User) *WishlistQuery {
 		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
 		return fromV, nil
 	}
-	return query
+	return query
+}
+
+// QueryReviews queries the reviews edge of a User.
+func (c *UserClient) QueryReviews(u *User) *ReviewQuery {
+	query := (&ReviewClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(review.Table, review.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ReviewsTable, user.ReviewsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVouchers queries the vouchers edge of a User.
+func (c *UserClient) QueryVouchers(u *User) *UserVoucherQuery {
+	query := (&UserVoucherClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(uservoucher.Table, uservoucher.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.VouchersTable, user.VouchersColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrderStatusChanges queries the order_status_changes edge of a User.
+func (c *UserClient) QueryOrderStatusChanges(u *User) *OrderStatusHistoryQuery {
+	query := (&OrderStatusHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(orderstatushistory.Table, orderstatushistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.OrderStatusChangesTable, user.OrderStatusChangesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTokens queries the tokens edge of a User.
+func (c *UserClient) QueryTokens(u *User) *UserTokenQuery {
+	query := (&UserTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(usertoken.Table, usertoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TokensTable, user.TokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRecoveryCodes queries the recovery_codes edge of a User.
+func (c *UserClient) QueryRecoveryCodes(u *User) *RecoveryCodeQuery {
+	query := (&RecoveryCodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(recoverycode.Table, recoverycode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RecoveryCodesTable, user.RecoveryCodesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAPIKeys queries the api_keys edge of a User.
+func (c *UserClient) QueryAPIKeys(u *User) *APIKeyQuery {
+	query := (&APIKeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(apikey.Table, apikey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.APIKeysTable, user.APIKeysColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIdentities queries the identities edge of a User.
+func (c *UserClient) QueryIdentities(u *User) *UserIdentityQuery {
+	query := (&UserIdentityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(useridentity.Table, useridentity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.IdentitiesTable, user.IdentitiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserClient) Hooks() []Hook {
+	return c.hooks.User
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
+
+func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("gen: unknown User mutation op: %q", m.Op())
+	}
+}
+
+// UserIdentityClient is a client for the UserIdentity schema.
+type UserIdentityClient struct {
+	config
+}
+
+// NewUserIdentityClient returns a client for the UserIdentity from the given config.
+func NewUserIdentityClient(c config) *UserIdentityClient {
+	return &UserIdentityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `useridentity.Hooks(f(g(h())))`.
+func (c *UserIdentityClient) Use(hooks ...Hook) {
+	c.hooks.UserIdentity = append(c.hooks.UserIdentity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `useridentity.Intercept(f(g(h())))`.
+func (c *UserIdentityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserIdentity = append(c.inters.UserIdentity, interceptors...)
+}
+
+// Create returns a builder for creating a UserIdentity entity.
+func (c *UserIdentityClient) Create() *UserIdentityCreate {
+	mutation := newUserIdentityMutation(c.config, OpCreate)
+	return &UserIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserIdentity entities.
+func (c *UserIdentityClient) CreateBulk(builders ...*UserIdentityCreate) *UserIdentityCreateBulk {
+	return &UserIdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserIdentityClient) MapCreateBulk(slice any, setFunc func(*UserIdentityCreate, int)) *UserIdentityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserIdentityCreateBulk{err: fmt.Errorf("calling to UserIdentityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserIdentityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserIdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserIdentity.
+func (c *UserIdentityClient) Update() *UserIdentityUpdate {
+	mutation := newUserIdentityMutation(c.config, OpUpdate)
+	return &UserIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserIdentityClient) UpdateOne(ui *UserIdentity) *UserIdentityUpdateOne {
+	mutation := newUserIdentityMutation(c.config, OpUpdateOne, withUserIdentity(ui))
+	return &UserIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserIdentityClient) UpdateOneID(id uint64) *UserIdentityUpdateOne {
+	mutation := newUserIdentityMutation(c.config, OpUpdateOne, withUserIdentityID(id))
+	return &UserIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserIdentity.
+func (c *UserIdentityClient) Delete() *UserIdentityDelete {
+	mutation := newUserIdentityMutation(c.config, OpDelete)
+	return &UserIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserIdentityClient) DeleteOne(ui *UserIdentity) *UserIdentityDeleteOne {
+	return c.DeleteOneID(ui.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserIdentityClient) DeleteOneID(id uint64) *UserIdentityDeleteOne {
+	builder := c.Delete().Where(useridentity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserIdentityDeleteOne{builder}
+}
+
+// Query returns a query builder for UserIdentity.
+func (c *UserIdentityClient) Query() *UserIdentityQuery {
+	return &UserIdentityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserIdentity},
+		inters: c.Interceptors(),
+	}
 }
 
-// QueryReviews queries the reviews edge of a User.
-func (c *UserClient) QueryReviews(u *User) *ReviewQuery {
-	query := (&ReviewClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := u.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(review.Table, review.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.ReviewsTable, user.ReviewsColumn),
-		)
-		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a UserIdentity entity by its id.
+func (c *UserIdentityClient) Get(ctx context.Context, id uint64) (*UserIdentity, error) {
+	return c.Query().Where(useridentity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserIdentityClient) GetX(ctx context.Context, id uint64) *UserIdentity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
-// QueryVouchers queries the vouchers edge of a User.
-func (c *UserClient) QueryVouchers(u *User) *UserVoucherQuery {
-	query := (&UserVoucherClient{config: c.config}).Query()
+// QueryUser queries the user edge of a UserIdentity.
+func (c *UserIdentityClient) QueryUser(ui *UserIdentity) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := u.ID
+		id := ui.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(uservoucher.Table, uservoucher.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.VouchersTable, user.VouchersColumn),
+			sqlgraph.From(useridentity.Table, useridentity.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, useridentity.UserTable, useridentity.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ui.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *UserClient) Hooks() []Hook {
-	return c.hooks.User
+func (c *UserIdentityClient) Hooks() []Hook {
+	return c.hooks.UserIdentity
 }
 
 // Interceptors returns the client interceptors.
-func (c *UserClient) Interceptors() []Interceptor {
-	return c.inters.User
+func (c *UserIdentityClient) Interceptors() []Interceptor {
+	return c.inters.UserIdentity
 }
 
-func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+func (c *UserIdentityClient) mutate(ctx context.Context, m *UserIdentityMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UserIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("gen: unknown User mutation op: %q", m.Op())
+		return nil, fmt.Errorf("gen: unknown UserIdentity mutation op: %q", m.Op())
 	}
 }
 
@@ -3642,6 +4856,155 @@ func (c *UserRoleClient) mutate(ctx context.Context, m *UserRoleMutation) (Value
 	}
 }
 
+// UserTokenClient is a client for the UserToken schema.
+type UserTokenClient struct {
+	config
+}
+
+// NewUserTokenClient returns a client for the UserToken from the given config.
+func NewUserTokenClient(c config) *UserTokenClient {
+	return &UserTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usertoken.Hooks(f(g(h())))`.
+func (c *UserTokenClient) Use(hooks ...Hook) {
+	c.hooks.UserToken = append(c.hooks.UserToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usertoken.Intercept(f(g(h())))`.
+func (c *UserTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserToken = append(c.inters.UserToken, interceptors...)
+}
+
+// Create returns a builder for creating a UserToken entity.
+func (c *UserTokenClient) Create() *UserTokenCreate {
+	mutation := newUserTokenMutation(c.config, OpCreate)
+	return &UserTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserToken entities.
+func (c *UserTokenClient) CreateBulk(builders ...*UserTokenCreate) *UserTokenCreateBulk {
+	return &UserTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserTokenClient) MapCreateBulk(slice any, setFunc func(*UserTokenCreate, int)) *UserTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserTokenCreateBulk{err: fmt.Errorf("calling to UserTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserToken.
+func (c *UserTokenClient) Update() *UserTokenUpdate {
+	mutation := newUserTokenMutation(c.config, OpUpdate)
+	return &UserTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserTokenClient) UpdateOne(ut *UserToken) *UserTokenUpdateOne {
+	mutation := newUserTokenMutation(c.config, OpUpdateOne, withUserToken(ut))
+	return &UserTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserTokenClient) UpdateOneID(id uint64) *UserTokenUpdateOne {
+	mutation := newUserTokenMutation(c.config, OpUpdateOne, withUserTokenID(id))
+	return &UserTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserToken.
+func (c *UserTokenClient) Delete() *UserTokenDelete {
+	mutation := newUserTokenMutation(c.config, OpDelete)
+	return &UserTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserTokenClient) DeleteOne(ut *UserToken) *UserTokenDeleteOne {
+	return c.DeleteOneID(ut.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserTokenClient) DeleteOneID(id uint64) *UserTokenDeleteOne {
+	builder := c.Delete().Where(usertoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for UserToken.
+func (c *UserTokenClient) Query() *UserTokenQuery {
+	return &UserTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserToken entity by its id.
+func (c *UserTokenClient) Get(ctx context.Context, id uint64) (*UserToken, error) {
+	return c.Query().Where(usertoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserTokenClient) GetX(ctx context.Context, id uint64) *UserToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a UserToken.
+func (c *UserTokenClient) QueryUser(ut *UserToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ut.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(usertoken.Table, usertoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, usertoken.UserTable, usertoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(ut.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserTokenClient) Hooks() []Hook {
+	return c.hooks.UserToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserTokenClient) Interceptors() []Interceptor {
+	return c.inters.UserToken
+}
+
+func (c *UserTokenClient) mutate(ctx context.Context, m *UserTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("gen: unknown UserToken mutation op: %q", m.Op())
+	}
+}
+
 // UserVoucherClient is a client for the UserVoucher schema.
 type UserVoucherClient struct {
 	config
@@ -3807,6 +5170,139 @@ func (c *UserVoucherClient) mutate(ctx context.Context, m *UserVoucherMutation)
 	}
 }
 
+// WebhookEventClient is a client for the WebhookEvent schema.
+type WebhookEventClient struct {
+	config
+}
+
+// NewWebhookEventClient returns a client for the WebhookEvent from the given config.
+func NewWebhookEventClient(c config) *WebhookEventClient {
+	return &WebhookEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookevent.Hooks(f(g(h())))`.
+func (c *WebhookEventClient) Use(hooks ...Hook) {
+	c.hooks.WebhookEvent = append(c.hooks.WebhookEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookevent.Intercept(f(g(h())))`.
+func (c *WebhookEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookEvent = append(c.inters.WebhookEvent, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookEvent entity.
+func (c *WebhookEventClient) Create() *WebhookEventCreate {
+	mutation := newWebhookEventMutation(c.config, OpCreate)
+	return &WebhookEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookEvent entities.
+func (c *WebhookEventClient) CreateBulk(builders ...*WebhookEventCreate) *WebhookEventCreateBulk {
+	return &WebhookEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookEventClient) MapCreateBulk(slice any, setFunc func(*WebhookEventCreate, int)) *WebhookEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookEventCreateBulk{err: fmt.Errorf("calling to WebhookEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookEvent.
+func (c *WebhookEventClient) Update() *WebhookEventUpdate {
+	mutation := newWebhookEventMutation(c.config, OpUpdate)
+	return &WebhookEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookEventClient) UpdateOne(we *WebhookEvent) *WebhookEventUpdateOne {
+	mutation := newWebhookEventMutation(c.config, OpUpdateOne, withWebhookEvent(we))
+	return &WebhookEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookEventClient) UpdateOneID(id uint64) *WebhookEventUpdateOne {
+	mutation := newWebhookEventMutation(c.config, OpUpdateOne, withWebhookEventID(id))
+	return &WebhookEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookEvent.
+func (c *WebhookEventClient) Delete() *WebhookEventDelete {
+	mutation := newWebhookEventMutation(c.config, OpDelete)
+	return &WebhookEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookEventClient) DeleteOne(we *WebhookEvent) *WebhookEventDeleteOne {
+	return c.DeleteOneID(we.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookEventClient) DeleteOneID(id uint64) *WebhookEventDeleteOne {
+	builder := c.Delete().Where(webhookevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookEventDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookEvent.
+func (c *WebhookEventClient) Query() *WebhookEventQuery {
+	return &WebhookEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookEvent entity by its id.
+func (c *WebhookEventClient) Get(ctx context.Context, id uint64) (*WebhookEvent, error) {
+	return c.Query().Where(webhookevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookEventClient) GetX(ctx context.Context, id uint64) *WebhookEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookEventClient) Hooks() []Hook {
+	return c.hooks.WebhookEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookEventClient) Interceptors() []Interceptor {
+	return c.inters.WebhookEvent
+}
+
+func (c *WebhookEventClient) mutate(ctx context.Context, m *WebhookEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("gen: unknown WebhookEvent mutation op: %q", m.Op())
+	}
+}
+
 // WishlistClient is a client for the Wishlist schema.
 type WishlistClient struct {
 	config
@@ -3931,6 +5427,22 @@ func (c *WishlistClient) QueryUser(w *Wishlist) *UserQuery {
 	return query
 }
 
+// QueryProducts queries the products edge of a Wishlist.
+func (c *WishlistClient) QueryProducts(w *Wishlist) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wishlist.Table, wishlist.FieldID, id),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, wishlist.ProductsTable, wishlist.ProductsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryItems queries the items edge of a Wishlist.
 func (c *WishlistClient) QueryItems(w *Wishlist) *WishlistItemQuery {
 	query := (&WishlistItemClient{config: c.config}).Query()
@@ -3938,8 +5450,8 @@ func (c *WishlistClient) QueryItems(w *Wishlist) *WishlistItemQuery {
 		id := w.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(wishlist.Table, wishlist.FieldID, id),
-			sqlgraph.To(wishlistitem.Table, wishlistitem.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, wishlist.ItemsTable, wishlist.ItemsColumn),
+			sqlgraph.To(wishlistitem.Table, wishlistitem.WishlistColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, wishlist.ItemsTable, wishlist.ItemsColumn),
 		)
 		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
 		return fromV, nil
@@ -4028,13 +5540,9 @@ func (c *WishlistItemClient) Update() *WishlistItemUpdate {
 
 // UpdateOne returns an update builder for the given entity.
 func (c *WishlistItemClient) UpdateOne(wi *WishlistItem) *WishlistItemUpdateOne {
-	mutation := newWishlistItemMutation(c.config, OpUpdateOne, withWishlistItem(wi))
-	return &WishlistItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// UpdateOneID returns an update builder for the given id.
-func (c *WishlistItemClient) UpdateOneID(id int) *WishlistItemUpdateOne {
-	mutation := newWishlistItemMutation(c.config, OpUpdateOne, withWishlistItemID(id))
+	mutation := newWishlistItemMutation(c.config, OpUpdateOne)
+	mutation.wishlist = &wi.WishlistID
+	mutation.product = &wi.ProductID
 	return &WishlistItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
@@ -4044,19 +5552,6 @@ func (c *WishlistItemClient) Delete() *WishlistItemDelete {
 	return &WishlistItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// DeleteOne returns a builder for deleting the given entity.
-func (c *WishlistItemClient) DeleteOne(wi *WishlistItem) *WishlistItemDeleteOne {
-	return c.DeleteOneID(wi.ID)
-}
-
-// DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *WishlistItemClient) DeleteOneID(id int) *WishlistItemDeleteOne {
-	builder := c.Delete().Where(wishlistitem.ID(id))
-	builder.mutation.id = &id
-	builder.mutation.op = OpDeleteOne
-	return &WishlistItemDeleteOne{builder}
-}
-
 // Query returns a query builder for WishlistItem.
 func (c *WishlistItemClient) Query() *WishlistItemQuery {
 	return &WishlistItemQuery{
@@ -4066,50 +5561,18 @@ func (c *WishlistItemClient) Query() *WishlistItemQuery {
 	}
 }
 
-// Get returns a WishlistItem entity by its id.
-func (c *WishlistItemClient) Get(ctx context.Context, id int) (*WishlistItem, error) {
-	return c.Query().Where(wishlistitem.ID(id)).Only(ctx)
-}
-
-// GetX is like Get, but panics if an error occurs.
-func (c *WishlistItemClient) GetX(ctx context.Context, id int) *WishlistItem {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
-	}
-	return obj
-}
-
 // QueryWishlist queries the wishlist edge of a WishlistItem.
 func (c *WishlistItemClient) QueryWishlist(wi *WishlistItem) *WishlistQuery {
-	query := (&WishlistClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := wi.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(wishlistitem.Table, wishlistitem.FieldID, id),
-			sqlgraph.To(wishlist.Table, wishlist.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, wishlistitem.WishlistTable, wishlistitem.WishlistColumn),
-		)
-		fromV = sqlgraph.Neighbors(wi.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+	return c.Query().
+		Where(wishlistitem.WishlistID(wi.WishlistID), wishlistitem.ProductID(wi.ProductID)).
+		QueryWishlist()
 }
 
 // QueryProduct queries the product edge of a WishlistItem.
 func (c *WishlistItemClient) QueryProduct(wi *WishlistItem) *ProductQuery {
-	query := (&ProductClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := wi.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(wishlistitem.Table, wishlistitem.FieldID, id),
-			sqlgraph.To(product.Table, product.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, wishlistitem.ProductTable, wishlistitem.ProductColumn),
-		)
-		fromV = sqlgraph.Neighbors(wi.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+	return c.Query().
+		Where(wishlistitem.WishlistID(wi.WishlistID), wishlistitem.ProductID(wi.ProductID)).
+		QueryProduct()
 }
 
 // Hooks returns the client hooks.
@@ -4140,15 +5603,43 @@ func (c *WishlistItemClient) mutate(ctx context.Context, m *WishlistItemMutation
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Cart, CartItem, Category, Discount, DiscountCategory, DiscountProduct, Order,
-		OrderItem, Payment, Permission, Product, ProductImage, Review, Role,
-		RolePermission, Session, User, UserPermission, UserRole, UserVoucher, Wishlist,
+		APIKey, AuditLog, Cart, CartItem, Category, Discount, DiscountCategory,
+		DiscountProduct, IdempotencyKey, LoginAttempt, Order, OrderItem,
+		OrderStatusHistory, Payment, Permission, Product, ProductImage, RecoveryCode,
+		Review, Role, RolePermission, Session, User, UserIdentity, UserPermission,
+		UserRole, UserToken, UserVoucher, WebhookEvent, Wishlist,
 		WishlistItem []ent.Hook
 	}
 	inters struct {
-		Cart, CartItem, Category, Discount, DiscountCategory, DiscountProduct, Order,
-		OrderItem, Payment, Permission, Product, ProductImage, Review, Role,
-		RolePermission, Session, User, UserPermission, UserRole, UserVoucher, Wishlist,
+		APIKey, AuditLog, Cart, CartItem, Category, Discount, DiscountCategory,
+		DiscountProduct, IdempotencyKey, LoginAttempt, Order, OrderItem,
+		OrderStatusHistory, Payment, Permission, Product, ProductImage, RecoveryCode,
+		Review, Role, RolePermission, Session, User, UserIdentity, UserPermission,
+		UserRole, UserToken, UserVoucher, WebhookEvent, Wishlist,
 		WishlistItem []ent.Interceptor
 	}
 )
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
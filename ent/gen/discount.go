@@ -34,7 +34,7 @@ type Discount struct {
 	// UsageCount holds the value of the "usage_count" field.
 	UsageCount int `json:"usage_count,omitempty"`
 	// MinOrderValue holds the value of the "min_order_value" field.
-	MinOrderValue int `json:"min_order_value,omitempty"`
+	MinOrderValue float64 `json:"min_order_value,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -48,19 +48,25 @@ type Discount struct {
 // DiscountEdges holds the relations/edges for other nodes in the graph.
 type DiscountEdges struct {
 	// Products holds the value of the products edge.
-	Products []*DiscountProduct `json:"products,omitempty"`
+	Products []*Product `json:"products,omitempty"`
 	// Categories holds the value of the categories edge.
-	Categories []*DiscountCategory `json:"categories,omitempty"`
+	Categories []*Category `json:"categories,omitempty"`
 	// UserVouchers holds the value of the user_vouchers edge.
 	UserVouchers []*UserVoucher `json:"user_vouchers,omitempty"`
+	// Orders holds the value of the orders edge.
+	Orders []*Order `json:"orders,omitempty"`
+	// DiscountProducts holds the value of the discount_products edge.
+	DiscountProducts []*DiscountProduct `json:"discount_products,omitempty"`
+	// DiscountCategories holds the value of the discount_categories edge.
+	DiscountCategories []*DiscountCategory `json:"discount_categories,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [6]bool
 }
 
 // ProductsOrErr returns the Products value or an error if the edge
 // was not loaded in eager-loading.
-func (e DiscountEdges) ProductsOrErr() ([]*DiscountProduct, error) {
+func (e DiscountEdges) ProductsOrErr() ([]*Product, error) {
 	if e.loadedTypes[0] {
 		return e.Products, nil
 	}
@@ -69,7 +75,7 @@ func (e DiscountEdges) ProductsOrErr() ([]*DiscountProduct, error) {
 
 // CategoriesOrErr returns the Categories value or an error if the edge
 // was not loaded in eager-loading.
-func (e DiscountEdges) CategoriesOrErr() ([]*DiscountCategory, error) {
+func (e DiscountEdges) CategoriesOrErr() ([]*Category, error) {
 	if e.loadedTypes[1] {
 		return e.Categories, nil
 	}
@@ -85,14 +91,41 @@ func (e DiscountEdges) UserVouchersOrErr() ([]*UserVoucher, error) {
 	return nil, &NotLoadedError{edge: "user_vouchers"}
 }
 
+// OrdersOrErr returns the Orders value or an error if the edge
+// was not loaded in eager-loading.
+func (e DiscountEdges) OrdersOrErr() ([]*Order, error) {
+	if e.loadedTypes[3] {
+		return e.Orders, nil
+	}
+	return nil, &NotLoadedError{edge: "orders"}
+}
+
+// DiscountProductsOrErr returns the DiscountProducts value or an error if the edge
+// was not loaded in eager-loading.
+func (e DiscountEdges) DiscountProductsOrErr() ([]*DiscountProduct, error) {
+	if e.loadedTypes[4] {
+		return e.DiscountProducts, nil
+	}
+	return nil, &NotLoadedError{edge: "discount_products"}
+}
+
+// DiscountCategoriesOrErr returns the DiscountCategories value or an error if the edge
+// was not loaded in eager-loading.
+func (e DiscountEdges) DiscountCategoriesOrErr() ([]*DiscountCategory, error) {
+	if e.loadedTypes[5] {
+		return e.DiscountCategories, nil
+	}
+	return nil, &NotLoadedError{edge: "discount_categories"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Discount) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case discount.FieldDiscountValue:
+		case discount.FieldDiscountValue, discount.FieldMinOrderValue:
 			values[i] = new(sql.NullFloat64)
-		case discount.FieldID, discount.FieldUsageLimit, discount.FieldUsageCount, discount.FieldMinOrderValue:
+		case discount.FieldID, discount.FieldUsageLimit, discount.FieldUsageCount:
 			values[i] = new(sql.NullInt64)
 		case discount.FieldCode, discount.FieldDescription, discount.FieldDiscountType:
 			values[i] = new(sql.NullString)
@@ -168,10 +201,10 @@ func (d *Discount) assignValues(columns []string, values []any) error {
 				d.UsageCount = int(value.Int64)
 			}
 		case discount.FieldMinOrderValue:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field min_order_value", values[i])
 			} else if value.Valid {
-				d.MinOrderValue = int(value.Int64)
+				d.MinOrderValue = value.Float64
 			}
 		case discount.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -199,12 +232,12 @@ func (d *Discount) Value(name string) (ent.Value, error) {
 }
 
 // QueryProducts queries the "products" edge of the Discount entity.
-func (d *Discount) QueryProducts() *DiscountProductQuery {
+func (d *Discount) QueryProducts() *ProductQuery {
 	return NewDiscountClient(d.config).QueryProducts(d)
 }
 
 // QueryCategories queries the "categories" edge of the Discount entity.
-func (d *Discount) QueryCategories() *DiscountCategoryQuery {
+func (d *Discount) QueryCategories() *CategoryQuery {
 	return NewDiscountClient(d.config).QueryCategories(d)
 }
 
@@ -213,6 +246,21 @@ func (d *Discount) QueryUserVouchers() *UserVoucherQuery {
 	return NewDiscountClient(d.config).QueryUserVouchers(d)
 }
 
+// QueryOrders queries the "orders" edge of the Discount entity.
+func (d *Discount) QueryOrders() *OrderQuery {
+	return NewDiscountClient(d.config).QueryOrders(d)
+}
+
+// QueryDiscountProducts queries the "discount_products" edge of the Discount entity.
+func (d *Discount) QueryDiscountProducts() *DiscountProductQuery {
+	return NewDiscountClient(d.config).QueryDiscountProducts(d)
+}
+
+// QueryDiscountCategories queries the "discount_categories" edge of the Discount entity.
+func (d *Discount) QueryDiscountCategories() *DiscountCategoryQuery {
+	return NewDiscountClient(d.config).QueryDiscountCategories(d)
+}
+
 // Update returns a builder for updating this Discount.
 // Note that you need to call Discount.Unwrap() before calling this method if this Discount
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -42,29 +42,52 @@ const (
 	EdgeCategories = "categories"
 	// EdgeUserVouchers holds the string denoting the user_vouchers edge name in mutations.
 	EdgeUserVouchers = "user_vouchers"
+	// EdgeOrders holds the string denoting the orders edge name in mutations.
+	EdgeOrders = "orders"
+	// EdgeDiscountProducts holds the string denoting the discount_products edge name in mutations.
+	EdgeDiscountProducts = "discount_products"
+	// EdgeDiscountCategories holds the string denoting the discount_categories edge name in mutations.
+	EdgeDiscountCategories = "discount_categories"
 	// Table holds the table name of the discount in the database.
 	Table = "discounts"
-	// ProductsTable is the table that holds the products relation/edge.
+	// ProductsTable is the table that holds the products relation/edge. The primary key declared below.
 	ProductsTable = "discount_products"
-	// ProductsInverseTable is the table name for the DiscountProduct entity.
-	// It exists in this package in order to avoid circular dependency with the "discountproduct" package.
-	ProductsInverseTable = "discount_products"
-	// ProductsColumn is the table column denoting the products relation/edge.
-	ProductsColumn = "discount_products"
-	// CategoriesTable is the table that holds the categories relation/edge.
+	// ProductsInverseTable is the table name for the Product entity.
+	// It exists in this package in order to avoid circular dependency with the "product" package.
+	ProductsInverseTable = "products"
+	// CategoriesTable is the table that holds the categories relation/edge. The primary key declared below.
 	CategoriesTable = "discount_categories"
-	// CategoriesInverseTable is the table name for the DiscountCategory entity.
-	// It exists in this package in order to avoid circular dependency with the "discountcategory" package.
-	CategoriesInverseTable = "discount_categories"
-	// CategoriesColumn is the table column denoting the categories relation/edge.
-	CategoriesColumn = "discount_categories"
+	// CategoriesInverseTable is the table name for the Category entity.
+	// It exists in this package in order to avoid circular dependency with the "category" package.
+	CategoriesInverseTable = "categories"
 	// UserVouchersTable is the table that holds the user_vouchers relation/edge.
 	UserVouchersTable = "user_vouchers"
 	// UserVouchersInverseTable is the table name for the UserVoucher entity.
 	// It exists in this package in order to avoid circular dependency with the "uservoucher" package.
 	UserVouchersInverseTable = "user_vouchers"
 	// UserVouchersColumn is the table column denoting the user_vouchers relation/edge.
-	UserVouchersColumn = "discount_user_vouchers"
+	UserVouchersColumn = "discount_id"
+	// OrdersTable is the table that holds the orders relation/edge.
+	OrdersTable = "orders"
+	// OrdersInverseTable is the table name for the Order entity.
+	// It exists in this package in order to avoid circular dependency with the "order" package.
+	OrdersInverseTable = "orders"
+	// OrdersColumn is the table column denoting the orders relation/edge.
+	OrdersColumn = "discount_id"
+	// DiscountProductsTable is the table that holds the discount_products relation/edge.
+	DiscountProductsTable = "discount_products"
+	// DiscountProductsInverseTable is the table name for the DiscountProduct entity.
+	// It exists in this package in order to avoid circular dependency with the "discountproduct" package.
+	DiscountProductsInverseTable = "discount_products"
+	// DiscountProductsColumn is the table column denoting the discount_products relation/edge.
+	DiscountProductsColumn = "discount_id"
+	// DiscountCategoriesTable is the table that holds the discount_categories relation/edge.
+	DiscountCategoriesTable = "discount_categories"
+	// DiscountCategoriesInverseTable is the table name for the DiscountCategory entity.
+	// It exists in this package in order to avoid circular dependency with the "discountcategory" package.
+	DiscountCategoriesInverseTable = "discount_categories"
+	// DiscountCategoriesColumn is the table column denoting the discount_categories relation/edge.
+	DiscountCategoriesColumn = "discount_id"
 )
 
 // Columns holds all SQL columns for discount fields.
@@ -83,6 +106,15 @@ var Columns = []string{
 	FieldUpdatedAt,
 }
 
+var (
+	// ProductsPrimaryKey and ProductsColumn2 are the table columns denoting the
+	// primary key for the products relation (M2M).
+	ProductsPrimaryKey = []string{"discount_id", "product_id"}
+	// CategoriesPrimaryKey and CategoriesColumn2 are the table columns denoting the
+	// primary key for the categories relation (M2M).
+	CategoriesPrimaryKey = []string{"discount_id", "category_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -208,18 +240,60 @@ func ByUserVouchers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUserVouchersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByOrdersCount orders the results by orders count.
+func ByOrdersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOrdersStep(), opts...)
+	}
+}
+
+// ByOrders orders the results by orders terms.
+func ByOrders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrdersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDiscountProductsCount orders the results by discount_products count.
+func ByDiscountProductsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDiscountProductsStep(), opts...)
+	}
+}
+
+// ByDiscountProducts orders the results by discount_products terms.
+func ByDiscountProducts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDiscountProductsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDiscountCategoriesCount orders the results by discount_categories count.
+func ByDiscountCategoriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDiscountCategoriesStep(), opts...)
+	}
+}
+
+// ByDiscountCategories orders the results by discount_categories terms.
+func ByDiscountCategories(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDiscountCategoriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newProductsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(ProductsInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, ProductsTable, ProductsColumn),
+		sqlgraph.Edge(sqlgraph.M2M, false, ProductsTable, ProductsPrimaryKey...),
 	)
 }
 func newCategoriesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(CategoriesInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, CategoriesTable, CategoriesColumn),
+		sqlgraph.Edge(sqlgraph.M2M, false, CategoriesTable, CategoriesPrimaryKey...),
 	)
 }
 func newUserVouchersStep() *sqlgraph.Step {
@@ -229,3 +303,24 @@ func newUserVouchersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, UserVouchersTable, UserVouchersColumn),
 	)
 }
+func newOrdersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrdersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OrdersTable, OrdersColumn),
+	)
+}
+func newDiscountProductsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DiscountProductsInverseTable, DiscountProductsColumn),
+		sqlgraph.Edge(sqlgraph.O2M, true, DiscountProductsTable, DiscountProductsColumn),
+	)
+}
+func newDiscountCategoriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DiscountCategoriesInverseTable, DiscountCategoriesColumn),
+		sqlgraph.Edge(sqlgraph.O2M, true, DiscountCategoriesTable, DiscountCategoriesColumn),
+	)
+}
@@ -96,7 +96,7 @@ func UsageCount(v int) predicate.Discount {
 }
 
 // MinOrderValue applies equality check predicate on the "min_order_value" field. It's identical to MinOrderValueEQ.
-func MinOrderValue(v int) predicate.Discount {
+func MinOrderValue(v float64) predicate.Discount {
 	return predicate.Discount(sql.FieldEQ(FieldMinOrderValue, v))
 }
 
@@ -526,42 +526,42 @@ func UsageCountLTE(v int) predicate.Discount {
 }
 
 // MinOrderValueEQ applies the EQ predicate on the "min_order_value" field.
-func MinOrderValueEQ(v int) predicate.Discount {
+func MinOrderValueEQ(v float64) predicate.Discount {
 	return predicate.Discount(sql.FieldEQ(FieldMinOrderValue, v))
 }
 
 // MinOrderValueNEQ applies the NEQ predicate on the "min_order_value" field.
-func MinOrderValueNEQ(v int) predicate.Discount {
+func MinOrderValueNEQ(v float64) predicate.Discount {
 	return predicate.Discount(sql.FieldNEQ(FieldMinOrderValue, v))
 }
 
 // MinOrderValueIn applies the In predicate on the "min_order_value" field.
-func MinOrderValueIn(vs ...int) predicate.Discount {
+func MinOrderValueIn(vs ...float64) predicate.Discount {
 	return predicate.Discount(sql.FieldIn(FieldMinOrderValue, vs...))
 }
 
 // MinOrderValueNotIn applies the NotIn predicate on the "min_order_value" field.
-func MinOrderValueNotIn(vs ...int) predicate.Discount {
+func MinOrderValueNotIn(vs ...float64) predicate.Discount {
 	return predicate.Discount(sql.FieldNotIn(FieldMinOrderValue, vs...))
 }
 
 // MinOrderValueGT applies the GT predicate on the "min_order_value" field.
-func MinOrderValueGT(v int) predicate.Discount {
+func MinOrderValueGT(v float64) predicate.Discount {
 	return predicate.Discount(sql.FieldGT(FieldMinOrderValue, v))
 }
 
 // MinOrderValueGTE applies the GTE predicate on the "min_order_value" field.
-func MinOrderValueGTE(v int) predicate.Discount {
+func MinOrderValueGTE(v float64) predicate.Discount {
 	return predicate.Discount(sql.FieldGTE(FieldMinOrderValue, v))
 }
 
 // MinOrderValueLT applies the LT predicate on the "min_order_value" field.
-func MinOrderValueLT(v int) predicate.Discount {
+func MinOrderValueLT(v float64) predicate.Discount {
 	return predicate.Discount(sql.FieldLT(FieldMinOrderValue, v))
 }
 
 // MinOrderValueLTE applies the LTE predicate on the "min_order_value" field.
-func MinOrderValueLTE(v int) predicate.Discount {
+func MinOrderValueLTE(v float64) predicate.Discount {
 	return predicate.Discount(sql.FieldLTE(FieldMinOrderValue, v))
 }
 
@@ -660,14 +660,14 @@ func HasProducts() predicate.Discount {
 	return predicate.Discount(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, ProductsTable, ProductsColumn),
+			sqlgraph.Edge(sqlgraph.M2M, false, ProductsTable, ProductsPrimaryKey...),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
 // HasProductsWith applies the HasEdge predicate on the "products" edge with a given conditions (other predicates).
-func HasProductsWith(preds ...predicate.DiscountProduct) predicate.Discount {
+func HasProductsWith(preds ...predicate.Product) predicate.Discount {
 	return predicate.Discount(func(s *sql.Selector) {
 		step := newProductsStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
@@ -683,14 +683,14 @@ func HasCategories() predicate.Discount {
 	return predicate.Discount(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, CategoriesTable, CategoriesColumn),
+			sqlgraph.Edge(sqlgraph.M2M, false, CategoriesTable, CategoriesPrimaryKey...),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
 // HasCategoriesWith applies the HasEdge predicate on the "categories" edge with a given conditions (other predicates).
-func HasCategoriesWith(preds ...predicate.DiscountCategory) predicate.Discount {
+func HasCategoriesWith(preds ...predicate.Category) predicate.Discount {
 	return predicate.Discount(func(s *sql.Selector) {
 		step := newCategoriesStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
@@ -724,6 +724,75 @@ func HasUserVouchersWith(preds ...predicate.UserVoucher) predicate.Discount {
 	})
 }
 
+// HasOrders applies the HasEdge predicate on the "orders" edge.
+func HasOrders() predicate.Discount {
+	return predicate.Discount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OrdersTable, OrdersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrdersWith applies the HasEdge predicate on the "orders" edge with a given conditions (other predicates).
+func HasOrdersWith(preds ...predicate.Order) predicate.Discount {
+	return predicate.Discount(func(s *sql.Selector) {
+		step := newOrdersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDiscountProducts applies the HasEdge predicate on the "discount_products" edge.
+func HasDiscountProducts() predicate.Discount {
+	return predicate.Discount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, DiscountProductsTable, DiscountProductsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDiscountProductsWith applies the HasEdge predicate on the "discount_products" edge with a given conditions (other predicates).
+func HasDiscountProductsWith(preds ...predicate.DiscountProduct) predicate.Discount {
+	return predicate.Discount(func(s *sql.Selector) {
+		step := newDiscountProductsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDiscountCategories applies the HasEdge predicate on the "discount_categories" edge.
+func HasDiscountCategories() predicate.Discount {
+	return predicate.Discount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, DiscountCategoriesTable, DiscountCategoriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDiscountCategoriesWith applies the HasEdge predicate on the "discount_categories" edge with a given conditions (other predicates).
+func HasDiscountCategoriesWith(preds ...predicate.DiscountCategory) predicate.Discount {
+	return predicate.Discount(func(s *sql.Selector) {
+		step := newDiscountCategoriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Discount) predicate.Discount {
 	return predicate.Discount(sql.AndPredicates(predicates...))
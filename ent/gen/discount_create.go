@@ -10,9 +10,10 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/thang1834/go-goss/ent/gen/category"
 	"github.com/thang1834/go-goss/ent/gen/discount"
-	"github.com/thang1834/go-goss/ent/gen/discountcategory"
-	"github.com/thang1834/go-goss/ent/gen/discountproduct"
+	"github.com/thang1834/go-goss/ent/gen/order"
+	"github.com/thang1834/go-goss/ent/gen/product"
 	"github.com/thang1834/go-goss/ent/gen/uservoucher"
 )
 
@@ -96,15 +97,15 @@ func (dc *DiscountCreate) SetNillableUsageCount(i *int) *DiscountCreate {
 }
 
 // SetMinOrderValue sets the "min_order_value" field.
-func (dc *DiscountCreate) SetMinOrderValue(i int) *DiscountCreate {
-	dc.mutation.SetMinOrderValue(i)
+func (dc *DiscountCreate) SetMinOrderValue(f float64) *DiscountCreate {
+	dc.mutation.SetMinOrderValue(f)
 	return dc
 }
 
 // SetNillableMinOrderValue sets the "min_order_value" field if the given value is not nil.
-func (dc *DiscountCreate) SetNillableMinOrderValue(i *int) *DiscountCreate {
-	if i != nil {
-		dc.SetMinOrderValue(*i)
+func (dc *DiscountCreate) SetNillableMinOrderValue(f *float64) *DiscountCreate {
+	if f != nil {
+		dc.SetMinOrderValue(*f)
 	}
 	return dc
 }
@@ -143,32 +144,32 @@ func (dc *DiscountCreate) SetID(u uint64) *DiscountCreate {
 	return dc
 }
 
-// AddProductIDs adds the "products" edge to the DiscountProduct entity by IDs.
-func (dc *DiscountCreate) AddProductIDs(ids ...int) *DiscountCreate {
+// AddProductIDs adds the "products" edge to the Product entity by IDs.
+func (dc *DiscountCreate) AddProductIDs(ids ...uint64) *DiscountCreate {
 	dc.mutation.AddProductIDs(ids...)
 	return dc
 }
 
-// AddProducts adds the "products" edges to the DiscountProduct entity.
-func (dc *DiscountCreate) AddProducts(d ...*DiscountProduct) *DiscountCreate {
-	ids := make([]int, len(d))
-	for i := range d {
-		ids[i] = d[i].ID
+// AddProducts adds the "products" edges to the Product entity.
+func (dc *DiscountCreate) AddProducts(p ...*Product) *DiscountCreate {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
 	return dc.AddProductIDs(ids...)
 }
 
-// AddCategoryIDs adds the "categories" edge to the DiscountCategory entity by IDs.
-func (dc *DiscountCreate) AddCategoryIDs(ids ...int) *DiscountCreate {
+// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
+func (dc *DiscountCreate) AddCategoryIDs(ids ...uint64) *DiscountCreate {
 	dc.mutation.AddCategoryIDs(ids...)
 	return dc
 }
 
-// AddCategories adds the "categories" edges to the DiscountCategory entity.
-func (dc *DiscountCreate) AddCategories(d ...*DiscountCategory) *DiscountCreate {
-	ids := make([]int, len(d))
-	for i := range d {
-		ids[i] = d[i].ID
+// AddCategories adds the "categories" edges to the Category entity.
+func (dc *DiscountCreate) AddCategories(c ...*Category) *DiscountCreate {
+	ids := make([]uint64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
 	}
 	return dc.AddCategoryIDs(ids...)
 }
@@ -188,6 +189,21 @@ func (dc *DiscountCreate) AddUserVouchers(u ...*UserVoucher) *DiscountCreate {
 	return dc.AddUserVoucherIDs(ids...)
 }
 
+// AddOrderIDs adds the "orders" edge to the Order entity by IDs.
+func (dc *DiscountCreate) AddOrderIDs(ids ...uint64) *DiscountCreate {
+	dc.mutation.AddOrderIDs(ids...)
+	return dc
+}
+
+// AddOrders adds the "orders" edges to the Order entity.
+func (dc *DiscountCreate) AddOrders(o ...*Order) *DiscountCreate {
+	ids := make([]uint64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return dc.AddOrderIDs(ids...)
+}
+
 // Mutation returns the DiscountMutation object of the builder.
 func (dc *DiscountCreate) Mutation() *DiscountMutation {
 	return dc.mutation
@@ -328,7 +344,7 @@ func (dc *DiscountCreate) createSpec() (*Discount, *sqlgraph.CreateSpec) {
 		_node.UsageCount = value
 	}
 	if value, ok := dc.mutation.MinOrderValue(); ok {
-		_spec.SetField(discount.FieldMinOrderValue, field.TypeInt, value)
+		_spec.SetField(discount.FieldMinOrderValue, field.TypeFloat64, value)
 		_node.MinOrderValue = value
 	}
 	if value, ok := dc.mutation.CreatedAt(); ok {
@@ -341,13 +357,13 @@ func (dc *DiscountCreate) createSpec() (*Discount, *sqlgraph.CreateSpec) {
 	}
 	if nodes := dc.mutation.ProductsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   discount.ProductsTable,
-			Columns: []string{discount.ProductsColumn},
+			Columns: discount.ProductsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountproduct.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -357,13 +373,13 @@ func (dc *DiscountCreate) createSpec() (*Discount, *sqlgraph.CreateSpec) {
 	}
 	if nodes := dc.mutation.CategoriesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   discount.CategoriesTable,
-			Columns: []string{discount.CategoriesColumn},
+			Columns: discount.CategoriesPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountcategory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -387,6 +403,22 @@ func (dc *DiscountCreate) createSpec() (*Discount, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := dc.mutation.OrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discount.OrdersTable,
+			Columns: []string{discount.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
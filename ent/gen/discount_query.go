@@ -9,26 +9,34 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/thang1834/go-goss/ent/gen/category"
 	"github.com/thang1834/go-goss/ent/gen/discount"
 	"github.com/thang1834/go-goss/ent/gen/discountcategory"
 	"github.com/thang1834/go-goss/ent/gen/discountproduct"
+	"github.com/thang1834/go-goss/ent/gen/order"
 	"github.com/thang1834/go-goss/ent/gen/predicate"
+	"github.com/thang1834/go-goss/ent/gen/product"
 	"github.com/thang1834/go-goss/ent/gen/uservoucher"
 )
 
 // DiscountQuery is the builder for querying Discount entities.
 type DiscountQuery struct {
 	config
-	ctx              *QueryContext
-	order            []discount.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.Discount
-	withProducts     *DiscountProductQuery
-	withCategories   *DiscountCategoryQuery
-	withUserVouchers *UserVoucherQuery
+	ctx                    *QueryContext
+	order                  []discount.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.Discount
+	withProducts           *ProductQuery
+	withCategories         *CategoryQuery
+	withUserVouchers       *UserVoucherQuery
+	withOrders             *OrderQuery
+	withDiscountProducts   *DiscountProductQuery
+	withDiscountCategories *DiscountCategoryQuery
+	modifiers              []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -66,8 +74,8 @@ func (dq *DiscountQuery) Order(o ...discount.OrderOption) *DiscountQuery {
 }
 
 // QueryProducts chains the current query on the "products" edge.
-func (dq *DiscountQuery) QueryProducts() *DiscountProductQuery {
-	query := (&DiscountProductClient{config: dq.config}).Query()
+func (dq *DiscountQuery) QueryProducts() *ProductQuery {
+	query := (&ProductClient{config: dq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := dq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -78,8 +86,8 @@ func (dq *DiscountQuery) QueryProducts() *DiscountProductQuery {
 		}
 		step := sqlgraph.NewStep(
 			sqlgraph.From(discount.Table, discount.FieldID, selector),
-			sqlgraph.To(discountproduct.Table, discountproduct.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, discount.ProductsTable, discount.ProductsColumn),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, discount.ProductsTable, discount.ProductsPrimaryKey...),
 		)
 		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
 		return fromU, nil
@@ -88,8 +96,8 @@ func (dq *DiscountQuery) QueryProducts() *DiscountProductQuery {
 }
 
 // QueryCategories chains the current query on the "categories" edge.
-func (dq *DiscountQuery) QueryCategories() *DiscountCategoryQuery {
-	query := (&DiscountCategoryClient{config: dq.config}).Query()
+func (dq *DiscountQuery) QueryCategories() *CategoryQuery {
+	query := (&CategoryClient{config: dq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := dq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -100,8 +108,8 @@ func (dq *DiscountQuery) QueryCategories() *DiscountCategoryQuery {
 		}
 		step := sqlgraph.NewStep(
 			sqlgraph.From(discount.Table, discount.FieldID, selector),
-			sqlgraph.To(discountcategory.Table, discountcategory.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, discount.CategoriesTable, discount.CategoriesColumn),
+			sqlgraph.To(category.Table, category.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, discount.CategoriesTable, discount.CategoriesPrimaryKey...),
 		)
 		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
 		return fromU, nil
@@ -131,6 +139,72 @@ func (dq *DiscountQuery) QueryUserVouchers() *UserVoucherQuery {
 	return query
 }
 
+// QueryOrders chains the current query on the "orders" edge.
+func (dq *DiscountQuery) QueryOrders() *OrderQuery {
+	query := (&OrderClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discount.Table, discount.FieldID, selector),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, discount.OrdersTable, discount.OrdersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDiscountProducts chains the current query on the "discount_products" edge.
+func (dq *DiscountQuery) QueryDiscountProducts() *DiscountProductQuery {
+	query := (&DiscountProductClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discount.Table, discount.FieldID, selector),
+			sqlgraph.To(discountproduct.Table, discountproduct.DiscountColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, discount.DiscountProductsTable, discount.DiscountProductsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDiscountCategories chains the current query on the "discount_categories" edge.
+func (dq *DiscountQuery) QueryDiscountCategories() *DiscountCategoryQuery {
+	query := (&DiscountCategoryClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discount.Table, discount.FieldID, selector),
+			sqlgraph.To(discountcategory.Table, discountcategory.DiscountColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, discount.DiscountCategoriesTable, discount.DiscountCategoriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Discount entity from the query.
 // Returns a *NotFoundError when no Discount was found.
 func (dq *DiscountQuery) First(ctx context.Context) (*Discount, error) {
@@ -318,14 +392,17 @@ func (dq *DiscountQuery) Clone() *DiscountQuery {
 		return nil
 	}
 	return &DiscountQuery{
-		config:           dq.config,
-		ctx:              dq.ctx.Clone(),
-		order:            append([]discount.OrderOption{}, dq.order...),
-		inters:           append([]Interceptor{}, dq.inters...),
-		predicates:       append([]predicate.Discount{}, dq.predicates...),
-		withProducts:     dq.withProducts.Clone(),
-		withCategories:   dq.withCategories.Clone(),
-		withUserVouchers: dq.withUserVouchers.Clone(),
+		config:                 dq.config,
+		ctx:                    dq.ctx.Clone(),
+		order:                  append([]discount.OrderOption{}, dq.order...),
+		inters:                 append([]Interceptor{}, dq.inters...),
+		predicates:             append([]predicate.Discount{}, dq.predicates...),
+		withProducts:           dq.withProducts.Clone(),
+		withCategories:         dq.withCategories.Clone(),
+		withUserVouchers:       dq.withUserVouchers.Clone(),
+		withOrders:             dq.withOrders.Clone(),
+		withDiscountProducts:   dq.withDiscountProducts.Clone(),
+		withDiscountCategories: dq.withDiscountCategories.Clone(),
 		// clone intermediate query.
 		sql:  dq.sql.Clone(),
 		path: dq.path,
@@ -334,8 +411,8 @@ func (dq *DiscountQuery) Clone() *DiscountQuery {
 
 // WithProducts tells the query-builder to eager-load the nodes that are connected to
 // the "products" edge. The optional arguments are used to configure the query builder of the edge.
-func (dq *DiscountQuery) WithProducts(opts ...func(*DiscountProductQuery)) *DiscountQuery {
-	query := (&DiscountProductClient{config: dq.config}).Query()
+func (dq *DiscountQuery) WithProducts(opts ...func(*ProductQuery)) *DiscountQuery {
+	query := (&ProductClient{config: dq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -345,8 +422,8 @@ func (dq *DiscountQuery) WithProducts(opts ...func(*DiscountProductQuery)) *Disc
 
 // WithCategories tells the query-builder to eager-load the nodes that are connected to
 // the "categories" edge. The optional arguments are used to configure the query builder of the edge.
-func (dq *DiscountQuery) WithCategories(opts ...func(*DiscountCategoryQuery)) *DiscountQuery {
-	query := (&DiscountCategoryClient{config: dq.config}).Query()
+func (dq *DiscountQuery) WithCategories(opts ...func(*CategoryQuery)) *DiscountQuery {
+	query := (&CategoryClient{config: dq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -365,6 +442,39 @@ func (dq *DiscountQuery) WithUserVouchers(opts ...func(*UserVoucherQuery)) *Disc
 	return dq
 }
 
+// WithOrders tells the query-builder to eager-load the nodes that are connected to
+// the "orders" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DiscountQuery) WithOrders(opts ...func(*OrderQuery)) *DiscountQuery {
+	query := (&OrderClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withOrders = query
+	return dq
+}
+
+// WithDiscountProducts tells the query-builder to eager-load the nodes that are connected to
+// the "discount_products" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DiscountQuery) WithDiscountProducts(opts ...func(*DiscountProductQuery)) *DiscountQuery {
+	query := (&DiscountProductClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withDiscountProducts = query
+	return dq
+}
+
+// WithDiscountCategories tells the query-builder to eager-load the nodes that are connected to
+// the "discount_categories" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DiscountQuery) WithDiscountCategories(opts ...func(*DiscountCategoryQuery)) *DiscountQuery {
+	query := (&DiscountCategoryClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withDiscountCategories = query
+	return dq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -443,10 +553,13 @@ func (dq *DiscountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Dis
 	var (
 		nodes       = []*Discount{}
 		_spec       = dq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [6]bool{
 			dq.withProducts != nil,
 			dq.withCategories != nil,
 			dq.withUserVouchers != nil,
+			dq.withOrders != nil,
+			dq.withDiscountProducts != nil,
+			dq.withDiscountCategories != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -458,6 +571,9 @@ func (dq *DiscountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Dis
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(dq.modifiers) > 0 {
+		_spec.Modifiers = dq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -469,15 +585,15 @@ func (dq *DiscountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Dis
 	}
 	if query := dq.withProducts; query != nil {
 		if err := dq.loadProducts(ctx, query, nodes,
-			func(n *Discount) { n.Edges.Products = []*DiscountProduct{} },
-			func(n *Discount, e *DiscountProduct) { n.Edges.Products = append(n.Edges.Products, e) }); err != nil {
+			func(n *Discount) { n.Edges.Products = []*Product{} },
+			func(n *Discount, e *Product) { n.Edges.Products = append(n.Edges.Products, e) }); err != nil {
 			return nil, err
 		}
 	}
 	if query := dq.withCategories; query != nil {
 		if err := dq.loadCategories(ctx, query, nodes,
-			func(n *Discount) { n.Edges.Categories = []*DiscountCategory{} },
-			func(n *Discount, e *DiscountCategory) { n.Edges.Categories = append(n.Edges.Categories, e) }); err != nil {
+			func(n *Discount) { n.Edges.Categories = []*Category{} },
+			func(n *Discount, e *Category) { n.Edges.Categories = append(n.Edges.Categories, e) }); err != nil {
 			return nil, err
 		}
 	}
@@ -488,10 +604,155 @@ func (dq *DiscountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Dis
 			return nil, err
 		}
 	}
+	if query := dq.withOrders; query != nil {
+		if err := dq.loadOrders(ctx, query, nodes,
+			func(n *Discount) { n.Edges.Orders = []*Order{} },
+			func(n *Discount, e *Order) { n.Edges.Orders = append(n.Edges.Orders, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := dq.withDiscountProducts; query != nil {
+		if err := dq.loadDiscountProducts(ctx, query, nodes,
+			func(n *Discount) { n.Edges.DiscountProducts = []*DiscountProduct{} },
+			func(n *Discount, e *DiscountProduct) { n.Edges.DiscountProducts = append(n.Edges.DiscountProducts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := dq.withDiscountCategories; query != nil {
+		if err := dq.loadDiscountCategories(ctx, query, nodes,
+			func(n *Discount) { n.Edges.DiscountCategories = []*DiscountCategory{} },
+			func(n *Discount, e *DiscountCategory) {
+				n.Edges.DiscountCategories = append(n.Edges.DiscountCategories, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
-func (dq *DiscountQuery) loadProducts(ctx context.Context, query *DiscountProductQuery, nodes []*Discount, init func(*Discount), assign func(*Discount, *DiscountProduct)) error {
+func (dq *DiscountQuery) loadProducts(ctx context.Context, query *ProductQuery, nodes []*Discount, init func(*Discount), assign func(*Discount, *Product)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uint64]*Discount)
+	nids := make(map[uint64]map[*Discount]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(discount.ProductsTable)
+		s.Join(joinT).On(s.C(product.FieldID), joinT.C(discount.ProductsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(discount.ProductsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(discount.ProductsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := uint64(values[0].(*sql.NullInt64).Int64)
+				inValue := uint64(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Discount]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Product](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "products" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (dq *DiscountQuery) loadCategories(ctx context.Context, query *CategoryQuery, nodes []*Discount, init func(*Discount), assign func(*Discount, *Category)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uint64]*Discount)
+	nids := make(map[uint64]map[*Discount]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(discount.CategoriesTable)
+		s.Join(joinT).On(s.C(category.FieldID), joinT.C(discount.CategoriesPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(discount.CategoriesPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(discount.CategoriesPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := uint64(values[0].(*sql.NullInt64).Int64)
+				inValue := uint64(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Discount]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Category](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "categories" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (dq *DiscountQuery) loadUserVouchers(ctx context.Context, query *UserVoucherQuery, nodes []*Discount, init func(*Discount), assign func(*Discount, *UserVoucher)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uint64]*Discount)
 	for i := range nodes {
@@ -501,28 +762,27 @@ func (dq *DiscountQuery) loadProducts(ctx context.Context, query *DiscountProduc
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
-	query.Where(predicate.DiscountProduct(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(discount.ProductsColumn), fks...))
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(uservoucher.FieldDiscountID)
+	}
+	query.Where(predicate.UserVoucher(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(discount.UserVouchersColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.discount_products
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "discount_products" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.DiscountID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "discount_products" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "discount_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (dq *DiscountQuery) loadCategories(ctx context.Context, query *DiscountCategoryQuery, nodes []*Discount, init func(*Discount), assign func(*Discount, *DiscountCategory)) error {
+func (dq *DiscountQuery) loadOrders(ctx context.Context, query *OrderQuery, nodes []*Discount, init func(*Discount), assign func(*Discount, *Order)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uint64]*Discount)
 	for i := range nodes {
@@ -532,28 +792,30 @@ func (dq *DiscountQuery) loadCategories(ctx context.Context, query *DiscountCate
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
-	query.Where(predicate.DiscountCategory(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(discount.CategoriesColumn), fks...))
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(order.FieldDiscountID)
+	}
+	query.Where(predicate.Order(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(discount.OrdersColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.discount_categories
+		fk := n.DiscountID
 		if fk == nil {
-			return fmt.Errorf(`foreign-key "discount_categories" is nil for node %v`, n.ID)
+			return fmt.Errorf(`foreign-key "discount_id" is nil for node %v`, n.ID)
 		}
 		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "discount_categories" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "discount_id" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (dq *DiscountQuery) loadUserVouchers(ctx context.Context, query *UserVoucherQuery, nodes []*Discount, init func(*Discount), assign func(*Discount, *UserVoucher)) error {
+func (dq *DiscountQuery) loadDiscountProducts(ctx context.Context, query *DiscountProductQuery, nodes []*Discount, init func(*Discount), assign func(*Discount, *DiscountProduct)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uint64]*Discount)
 	for i := range nodes {
@@ -563,22 +825,51 @@ func (dq *DiscountQuery) loadUserVouchers(ctx context.Context, query *UserVouche
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
-	query.Where(predicate.UserVoucher(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(discount.UserVouchersColumn), fks...))
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(discountproduct.FieldDiscountID)
+	}
+	query.Where(predicate.DiscountProduct(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(discount.DiscountProductsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.discount_user_vouchers
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "discount_user_vouchers" is nil for node %v`, n.ID)
+		fk := n.DiscountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "discount_id" returned %v for node %v`, fk, n)
 		}
-		node, ok := nodeids[*fk]
+		assign(node, n)
+	}
+	return nil
+}
+func (dq *DiscountQuery) loadDiscountCategories(ctx context.Context, query *DiscountCategoryQuery, nodes []*Discount, init func(*Discount), assign func(*Discount, *DiscountCategory)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*Discount)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(discountcategory.FieldDiscountID)
+	}
+	query.Where(predicate.DiscountCategory(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(discount.DiscountCategoriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DiscountID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "discount_user_vouchers" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "discount_id" returned %v for node %v`, fk, n)
 		}
 		assign(node, n)
 	}
@@ -587,6 +878,9 @@ func (dq *DiscountQuery) loadUserVouchers(ctx context.Context, query *UserVouche
 
 func (dq *DiscountQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := dq.querySpec()
+	if len(dq.modifiers) > 0 {
+		_spec.Modifiers = dq.modifiers
+	}
 	_spec.Node.Columns = dq.ctx.Fields
 	if len(dq.ctx.Fields) > 0 {
 		_spec.Unique = dq.ctx.Unique != nil && *dq.ctx.Unique
@@ -649,6 +943,9 @@ func (dq *DiscountQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if dq.ctx.Unique != nil && *dq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range dq.modifiers {
+		m(selector)
+	}
 	for _, p := range dq.predicates {
 		p(selector)
 	}
@@ -666,6 +963,32 @@ func (dq *DiscountQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (dq *DiscountQuery) ForUpdate(opts ...sql.LockOption) *DiscountQuery {
+	if dq.driver.Dialect() == dialect.Postgres {
+		dq.Unique(false)
+	}
+	dq.modifiers = append(dq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return dq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (dq *DiscountQuery) ForShare(opts ...sql.LockOption) *DiscountQuery {
+	if dq.driver.Dialect() == dialect.Postgres {
+		dq.Unique(false)
+	}
+	dq.modifiers = append(dq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return dq
+}
+
 // DiscountGroupBy is the group-by builder for Discount entities.
 type DiscountGroupBy struct {
 	selector
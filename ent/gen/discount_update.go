@@ -11,10 +11,11 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/thang1834/go-goss/ent/gen/category"
 	"github.com/thang1834/go-goss/ent/gen/discount"
-	"github.com/thang1834/go-goss/ent/gen/discountcategory"
-	"github.com/thang1834/go-goss/ent/gen/discountproduct"
+	"github.com/thang1834/go-goss/ent/gen/order"
 	"github.com/thang1834/go-goss/ent/gen/predicate"
+	"github.com/thang1834/go-goss/ent/gen/product"
 	"github.com/thang1834/go-goss/ent/gen/uservoucher"
 )
 
@@ -177,23 +178,23 @@ func (du *DiscountUpdate) AddUsageCount(i int) *DiscountUpdate {
 }
 
 // SetMinOrderValue sets the "min_order_value" field.
-func (du *DiscountUpdate) SetMinOrderValue(i int) *DiscountUpdate {
+func (du *DiscountUpdate) SetMinOrderValue(f float64) *DiscountUpdate {
 	du.mutation.ResetMinOrderValue()
-	du.mutation.SetMinOrderValue(i)
+	du.mutation.SetMinOrderValue(f)
 	return du
 }
 
 // SetNillableMinOrderValue sets the "min_order_value" field if the given value is not nil.
-func (du *DiscountUpdate) SetNillableMinOrderValue(i *int) *DiscountUpdate {
-	if i != nil {
-		du.SetMinOrderValue(*i)
+func (du *DiscountUpdate) SetNillableMinOrderValue(f *float64) *DiscountUpdate {
+	if f != nil {
+		du.SetMinOrderValue(*f)
 	}
 	return du
 }
 
-// AddMinOrderValue adds i to the "min_order_value" field.
-func (du *DiscountUpdate) AddMinOrderValue(i int) *DiscountUpdate {
-	du.mutation.AddMinOrderValue(i)
+// AddMinOrderValue adds f to the "min_order_value" field.
+func (du *DiscountUpdate) AddMinOrderValue(f float64) *DiscountUpdate {
+	du.mutation.AddMinOrderValue(f)
 	return du
 }
 
@@ -223,32 +224,32 @@ func (du *DiscountUpdate) SetUpdatedAt(t time.Time) *DiscountUpdate {
 	return du
 }
 
-// AddProductIDs adds the "products" edge to the DiscountProduct entity by IDs.
-func (du *DiscountUpdate) AddProductIDs(ids ...int) *DiscountUpdate {
+// AddProductIDs adds the "products" edge to the Product entity by IDs.
+func (du *DiscountUpdate) AddProductIDs(ids ...uint64) *DiscountUpdate {
 	du.mutation.AddProductIDs(ids...)
 	return du
 }
 
-// AddProducts adds the "products" edges to the DiscountProduct entity.
-func (du *DiscountUpdate) AddProducts(d ...*DiscountProduct) *DiscountUpdate {
-	ids := make([]int, len(d))
-	for i := range d {
-		ids[i] = d[i].ID
+// AddProducts adds the "products" edges to the Product entity.
+func (du *DiscountUpdate) AddProducts(p ...*Product) *DiscountUpdate {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
 	return du.AddProductIDs(ids...)
 }
 
-// AddCategoryIDs adds the "categories" edge to the DiscountCategory entity by IDs.
-func (du *DiscountUpdate) AddCategoryIDs(ids ...int) *DiscountUpdate {
+// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
+func (du *DiscountUpdate) AddCategoryIDs(ids ...uint64) *DiscountUpdate {
 	du.mutation.AddCategoryIDs(ids...)
 	return du
 }
 
-// AddCategories adds the "categories" edges to the DiscountCategory entity.
-func (du *DiscountUpdate) AddCategories(d ...*DiscountCategory) *DiscountUpdate {
-	ids := make([]int, len(d))
-	for i := range d {
-		ids[i] = d[i].ID
+// AddCategories adds the "categories" edges to the Category entity.
+func (du *DiscountUpdate) AddCategories(c ...*Category) *DiscountUpdate {
+	ids := make([]uint64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
 	}
 	return du.AddCategoryIDs(ids...)
 }
@@ -268,49 +269,64 @@ func (du *DiscountUpdate) AddUserVouchers(u ...*UserVoucher) *DiscountUpdate {
 	return du.AddUserVoucherIDs(ids...)
 }
 
+// AddOrderIDs adds the "orders" edge to the Order entity by IDs.
+func (du *DiscountUpdate) AddOrderIDs(ids ...uint64) *DiscountUpdate {
+	du.mutation.AddOrderIDs(ids...)
+	return du
+}
+
+// AddOrders adds the "orders" edges to the Order entity.
+func (du *DiscountUpdate) AddOrders(o ...*Order) *DiscountUpdate {
+	ids := make([]uint64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return du.AddOrderIDs(ids...)
+}
+
 // Mutation returns the DiscountMutation object of the builder.
 func (du *DiscountUpdate) Mutation() *DiscountMutation {
 	return du.mutation
 }
 
-// ClearProducts clears all "products" edges to the DiscountProduct entity.
+// ClearProducts clears all "products" edges to the Product entity.
 func (du *DiscountUpdate) ClearProducts() *DiscountUpdate {
 	du.mutation.ClearProducts()
 	return du
 }
 
-// RemoveProductIDs removes the "products" edge to DiscountProduct entities by IDs.
-func (du *DiscountUpdate) RemoveProductIDs(ids ...int) *DiscountUpdate {
+// RemoveProductIDs removes the "products" edge to Product entities by IDs.
+func (du *DiscountUpdate) RemoveProductIDs(ids ...uint64) *DiscountUpdate {
 	du.mutation.RemoveProductIDs(ids...)
 	return du
 }
 
-// RemoveProducts removes "products" edges to DiscountProduct entities.
-func (du *DiscountUpdate) RemoveProducts(d ...*DiscountProduct) *DiscountUpdate {
-	ids := make([]int, len(d))
-	for i := range d {
-		ids[i] = d[i].ID
+// RemoveProducts removes "products" edges to Product entities.
+func (du *DiscountUpdate) RemoveProducts(p ...*Product) *DiscountUpdate {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
 	return du.RemoveProductIDs(ids...)
 }
 
-// ClearCategories clears all "categories" edges to the DiscountCategory entity.
+// ClearCategories clears all "categories" edges to the Category entity.
 func (du *DiscountUpdate) ClearCategories() *DiscountUpdate {
 	du.mutation.ClearCategories()
 	return du
 }
 
-// RemoveCategoryIDs removes the "categories" edge to DiscountCategory entities by IDs.
-func (du *DiscountUpdate) RemoveCategoryIDs(ids ...int) *DiscountUpdate {
+// RemoveCategoryIDs removes the "categories" edge to Category entities by IDs.
+func (du *DiscountUpdate) RemoveCategoryIDs(ids ...uint64) *DiscountUpdate {
 	du.mutation.RemoveCategoryIDs(ids...)
 	return du
 }
 
-// RemoveCategories removes "categories" edges to DiscountCategory entities.
-func (du *DiscountUpdate) RemoveCategories(d ...*DiscountCategory) *DiscountUpdate {
-	ids := make([]int, len(d))
-	for i := range d {
-		ids[i] = d[i].ID
+// RemoveCategories removes "categories" edges to Category entities.
+func (du *DiscountUpdate) RemoveCategories(c ...*Category) *DiscountUpdate {
+	ids := make([]uint64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
 	}
 	return du.RemoveCategoryIDs(ids...)
 }
@@ -336,6 +352,27 @@ func (du *DiscountUpdate) RemoveUserVouchers(u ...*UserVoucher) *DiscountUpdate
 	return du.RemoveUserVoucherIDs(ids...)
 }
 
+// ClearOrders clears all "orders" edges to the Order entity.
+func (du *DiscountUpdate) ClearOrders() *DiscountUpdate {
+	du.mutation.ClearOrders()
+	return du
+}
+
+// RemoveOrderIDs removes the "orders" edge to Order entities by IDs.
+func (du *DiscountUpdate) RemoveOrderIDs(ids ...uint64) *DiscountUpdate {
+	du.mutation.RemoveOrderIDs(ids...)
+	return du
+}
+
+// RemoveOrders removes "orders" edges to Order entities.
+func (du *DiscountUpdate) RemoveOrders(o ...*Order) *DiscountUpdate {
+	ids := make([]uint64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return du.RemoveOrderIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (du *DiscountUpdate) Save(ctx context.Context) (int, error) {
 	du.defaults()
@@ -421,13 +458,13 @@ func (du *DiscountUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		_spec.AddField(discount.FieldUsageCount, field.TypeInt, value)
 	}
 	if value, ok := du.mutation.MinOrderValue(); ok {
-		_spec.SetField(discount.FieldMinOrderValue, field.TypeInt, value)
+		_spec.SetField(discount.FieldMinOrderValue, field.TypeFloat64, value)
 	}
 	if value, ok := du.mutation.AddedMinOrderValue(); ok {
-		_spec.AddField(discount.FieldMinOrderValue, field.TypeInt, value)
+		_spec.AddField(discount.FieldMinOrderValue, field.TypeFloat64, value)
 	}
 	if du.mutation.MinOrderValueCleared() {
-		_spec.ClearField(discount.FieldMinOrderValue, field.TypeInt)
+		_spec.ClearField(discount.FieldMinOrderValue, field.TypeFloat64)
 	}
 	if value, ok := du.mutation.CreatedAt(); ok {
 		_spec.SetField(discount.FieldCreatedAt, field.TypeTime, value)
@@ -437,26 +474,26 @@ func (du *DiscountUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if du.mutation.ProductsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   discount.ProductsTable,
-			Columns: []string{discount.ProductsColumn},
+			Columns: discount.ProductsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountproduct.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUint64),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := du.mutation.RemovedProductsIDs(); len(nodes) > 0 && !du.mutation.ProductsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   discount.ProductsTable,
-			Columns: []string{discount.ProductsColumn},
+			Columns: discount.ProductsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountproduct.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -466,13 +503,13 @@ func (du *DiscountUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if nodes := du.mutation.ProductsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   discount.ProductsTable,
-			Columns: []string{discount.ProductsColumn},
+			Columns: discount.ProductsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountproduct.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -482,26 +519,26 @@ func (du *DiscountUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if du.mutation.CategoriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   discount.CategoriesTable,
-			Columns: []string{discount.CategoriesColumn},
+			Columns: discount.CategoriesPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountcategory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUint64),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := du.mutation.RemovedCategoriesIDs(); len(nodes) > 0 && !du.mutation.CategoriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   discount.CategoriesTable,
-			Columns: []string{discount.CategoriesColumn},
+			Columns: discount.CategoriesPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountcategory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -511,13 +548,13 @@ func (du *DiscountUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if nodes := du.mutation.CategoriesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   discount.CategoriesTable,
-			Columns: []string{discount.CategoriesColumn},
+			Columns: discount.CategoriesPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountcategory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -570,6 +607,51 @@ func (du *DiscountUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if du.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discount.OrdersTable,
+			Columns: []string{discount.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedOrdersIDs(); len(nodes) > 0 && !du.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discount.OrdersTable,
+			Columns: []string{discount.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.OrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discount.OrdersTable,
+			Columns: []string{discount.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{discount.Label}
@@ -736,23 +818,23 @@ func (duo *DiscountUpdateOne) AddUsageCount(i int) *DiscountUpdateOne {
 }
 
 // SetMinOrderValue sets the "min_order_value" field.
-func (duo *DiscountUpdateOne) SetMinOrderValue(i int) *DiscountUpdateOne {
+func (duo *DiscountUpdateOne) SetMinOrderValue(f float64) *DiscountUpdateOne {
 	duo.mutation.ResetMinOrderValue()
-	duo.mutation.SetMinOrderValue(i)
+	duo.mutation.SetMinOrderValue(f)
 	return duo
 }
 
 // SetNillableMinOrderValue sets the "min_order_value" field if the given value is not nil.
-func (duo *DiscountUpdateOne) SetNillableMinOrderValue(i *int) *DiscountUpdateOne {
-	if i != nil {
-		duo.SetMinOrderValue(*i)
+func (duo *DiscountUpdateOne) SetNillableMinOrderValue(f *float64) *DiscountUpdateOne {
+	if f != nil {
+		duo.SetMinOrderValue(*f)
 	}
 	return duo
 }
 
-// AddMinOrderValue adds i to the "min_order_value" field.
-func (duo *DiscountUpdateOne) AddMinOrderValue(i int) *DiscountUpdateOne {
-	duo.mutation.AddMinOrderValue(i)
+// AddMinOrderValue adds f to the "min_order_value" field.
+func (duo *DiscountUpdateOne) AddMinOrderValue(f float64) *DiscountUpdateOne {
+	duo.mutation.AddMinOrderValue(f)
 	return duo
 }
 
@@ -782,32 +864,32 @@ func (duo *DiscountUpdateOne) SetUpdatedAt(t time.Time) *DiscountUpdateOne {
 	return duo
 }
 
-// AddProductIDs adds the "products" edge to the DiscountProduct entity by IDs.
-func (duo *DiscountUpdateOne) AddProductIDs(ids ...int) *DiscountUpdateOne {
+// AddProductIDs adds the "products" edge to the Product entity by IDs.
+func (duo *DiscountUpdateOne) AddProductIDs(ids ...uint64) *DiscountUpdateOne {
 	duo.mutation.AddProductIDs(ids...)
 	return duo
 }
 
-// AddProducts adds the "products" edges to the DiscountProduct entity.
-func (duo *DiscountUpdateOne) AddProducts(d ...*DiscountProduct) *DiscountUpdateOne {
-	ids := make([]int, len(d))
-	for i := range d {
-		ids[i] = d[i].ID
+// AddProducts adds the "products" edges to the Product entity.
+func (duo *DiscountUpdateOne) AddProducts(p ...*Product) *DiscountUpdateOne {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
 	return duo.AddProductIDs(ids...)
 }
 
-// AddCategoryIDs adds the "categories" edge to the DiscountCategory entity by IDs.
-func (duo *DiscountUpdateOne) AddCategoryIDs(ids ...int) *DiscountUpdateOne {
+// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
+func (duo *DiscountUpdateOne) AddCategoryIDs(ids ...uint64) *DiscountUpdateOne {
 	duo.mutation.AddCategoryIDs(ids...)
 	return duo
 }
 
-// AddCategories adds the "categories" edges to the DiscountCategory entity.
-func (duo *DiscountUpdateOne) AddCategories(d ...*DiscountCategory) *DiscountUpdateOne {
-	ids := make([]int, len(d))
-	for i := range d {
-		ids[i] = d[i].ID
+// AddCategories adds the "categories" edges to the Category entity.
+func (duo *DiscountUpdateOne) AddCategories(c ...*Category) *DiscountUpdateOne {
+	ids := make([]uint64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
 	}
 	return duo.AddCategoryIDs(ids...)
 }
@@ -827,49 +909,64 @@ func (duo *DiscountUpdateOne) AddUserVouchers(u ...*UserVoucher) *DiscountUpdate
 	return duo.AddUserVoucherIDs(ids...)
 }
 
+// AddOrderIDs adds the "orders" edge to the Order entity by IDs.
+func (duo *DiscountUpdateOne) AddOrderIDs(ids ...uint64) *DiscountUpdateOne {
+	duo.mutation.AddOrderIDs(ids...)
+	return duo
+}
+
+// AddOrders adds the "orders" edges to the Order entity.
+func (duo *DiscountUpdateOne) AddOrders(o ...*Order) *DiscountUpdateOne {
+	ids := make([]uint64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return duo.AddOrderIDs(ids...)
+}
+
 // Mutation returns the DiscountMutation object of the builder.
 func (duo *DiscountUpdateOne) Mutation() *DiscountMutation {
 	return duo.mutation
 }
 
-// ClearProducts clears all "products" edges to the DiscountProduct entity.
+// ClearProducts clears all "products" edges to the Product entity.
 func (duo *DiscountUpdateOne) ClearProducts() *DiscountUpdateOne {
 	duo.mutation.ClearProducts()
 	return duo
 }
 
-// RemoveProductIDs removes the "products" edge to DiscountProduct entities by IDs.
-func (duo *DiscountUpdateOne) RemoveProductIDs(ids ...int) *DiscountUpdateOne {
+// RemoveProductIDs removes the "products" edge to Product entities by IDs.
+func (duo *DiscountUpdateOne) RemoveProductIDs(ids ...uint64) *DiscountUpdateOne {
 	duo.mutation.RemoveProductIDs(ids...)
 	return duo
 }
 
-// RemoveProducts removes "products" edges to DiscountProduct entities.
-func (duo *DiscountUpdateOne) RemoveProducts(d ...*DiscountProduct) *DiscountUpdateOne {
-	ids := make([]int, len(d))
-	for i := range d {
-		ids[i] = d[i].ID
+// RemoveProducts removes "products" edges to Product entities.
+func (duo *DiscountUpdateOne) RemoveProducts(p ...*Product) *DiscountUpdateOne {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
 	return duo.RemoveProductIDs(ids...)
 }
 
-// ClearCategories clears all "categories" edges to the DiscountCategory entity.
+// ClearCategories clears all "categories" edges to the Category entity.
 func (duo *DiscountUpdateOne) ClearCategories() *DiscountUpdateOne {
 	duo.mutation.ClearCategories()
 	return duo
 }
 
-// RemoveCategoryIDs removes the "categories" edge to DiscountCategory entities by IDs.
-func (duo *DiscountUpdateOne) RemoveCategoryIDs(ids ...int) *DiscountUpdateOne {
+// RemoveCategoryIDs removes the "categories" edge to Category entities by IDs.
+func (duo *DiscountUpdateOne) RemoveCategoryIDs(ids ...uint64) *DiscountUpdateOne {
 	duo.mutation.RemoveCategoryIDs(ids...)
 	return duo
 }
 
-// RemoveCategories removes "categories" edges to DiscountCategory entities.
-func (duo *DiscountUpdateOne) RemoveCategories(d ...*DiscountCategory) *DiscountUpdateOne {
-	ids := make([]int, len(d))
-	for i := range d {
-		ids[i] = d[i].ID
+// RemoveCategories removes "categories" edges to Category entities.
+func (duo *DiscountUpdateOne) RemoveCategories(c ...*Category) *DiscountUpdateOne {
+	ids := make([]uint64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
 	}
 	return duo.RemoveCategoryIDs(ids...)
 }
@@ -895,6 +992,27 @@ func (duo *DiscountUpdateOne) RemoveUserVouchers(u ...*UserVoucher) *DiscountUpd
 	return duo.RemoveUserVoucherIDs(ids...)
 }
 
+// ClearOrders clears all "orders" edges to the Order entity.
+func (duo *DiscountUpdateOne) ClearOrders() *DiscountUpdateOne {
+	duo.mutation.ClearOrders()
+	return duo
+}
+
+// RemoveOrderIDs removes the "orders" edge to Order entities by IDs.
+func (duo *DiscountUpdateOne) RemoveOrderIDs(ids ...uint64) *DiscountUpdateOne {
+	duo.mutation.RemoveOrderIDs(ids...)
+	return duo
+}
+
+// RemoveOrders removes "orders" edges to Order entities.
+func (duo *DiscountUpdateOne) RemoveOrders(o ...*Order) *DiscountUpdateOne {
+	ids := make([]uint64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return duo.RemoveOrderIDs(ids...)
+}
+
 // Where appends a list predicates to the DiscountUpdate builder.
 func (duo *DiscountUpdateOne) Where(ps ...predicate.Discount) *DiscountUpdateOne {
 	duo.mutation.Where(ps...)
@@ -1010,13 +1128,13 @@ func (duo *DiscountUpdateOne) sqlSave(ctx context.Context) (_node *Discount, err
 		_spec.AddField(discount.FieldUsageCount, field.TypeInt, value)
 	}
 	if value, ok := duo.mutation.MinOrderValue(); ok {
-		_spec.SetField(discount.FieldMinOrderValue, field.TypeInt, value)
+		_spec.SetField(discount.FieldMinOrderValue, field.TypeFloat64, value)
 	}
 	if value, ok := duo.mutation.AddedMinOrderValue(); ok {
-		_spec.AddField(discount.FieldMinOrderValue, field.TypeInt, value)
+		_spec.AddField(discount.FieldMinOrderValue, field.TypeFloat64, value)
 	}
 	if duo.mutation.MinOrderValueCleared() {
-		_spec.ClearField(discount.FieldMinOrderValue, field.TypeInt)
+		_spec.ClearField(discount.FieldMinOrderValue, field.TypeFloat64)
 	}
 	if value, ok := duo.mutation.CreatedAt(); ok {
 		_spec.SetField(discount.FieldCreatedAt, field.TypeTime, value)
@@ -1026,26 +1144,26 @@ func (duo *DiscountUpdateOne) sqlSave(ctx context.Context) (_node *Discount, err
 	}
 	if duo.mutation.ProductsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   discount.ProductsTable,
-			Columns: []string{discount.ProductsColumn},
+			Columns: discount.ProductsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountproduct.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUint64),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := duo.mutation.RemovedProductsIDs(); len(nodes) > 0 && !duo.mutation.ProductsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   discount.ProductsTable,
-			Columns: []string{discount.ProductsColumn},
+			Columns: discount.ProductsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountproduct.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -1055,13 +1173,13 @@ func (duo *DiscountUpdateOne) sqlSave(ctx context.Context) (_node *Discount, err
 	}
 	if nodes := duo.mutation.ProductsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   discount.ProductsTable,
-			Columns: []string{discount.ProductsColumn},
+			Columns: discount.ProductsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountproduct.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -1071,26 +1189,26 @@ func (duo *DiscountUpdateOne) sqlSave(ctx context.Context) (_node *Discount, err
 	}
 	if duo.mutation.CategoriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   discount.CategoriesTable,
-			Columns: []string{discount.CategoriesColumn},
+			Columns: discount.CategoriesPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountcategory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUint64),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := duo.mutation.RemovedCategoriesIDs(); len(nodes) > 0 && !duo.mutation.CategoriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   discount.CategoriesTable,
-			Columns: []string{discount.CategoriesColumn},
+			Columns: discount.CategoriesPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountcategory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -1100,13 +1218,13 @@ func (duo *DiscountUpdateOne) sqlSave(ctx context.Context) (_node *Discount, err
 	}
 	if nodes := duo.mutation.CategoriesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   discount.CategoriesTable,
-			Columns: []string{discount.CategoriesColumn},
+			Columns: discount.CategoriesPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountcategory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -1159,6 +1277,51 @@ func (duo *DiscountUpdateOne) sqlSave(ctx context.Context) (_node *Discount, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if duo.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discount.OrdersTable,
+			Columns: []string{discount.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedOrdersIDs(); len(nodes) > 0 && !duo.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discount.OrdersTable,
+			Columns: []string{discount.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.OrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discount.OrdersTable,
+			Columns: []string{discount.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Discount{config: duo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
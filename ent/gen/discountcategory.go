@@ -15,15 +15,15 @@ import (
 
 // DiscountCategory is the model entity for the DiscountCategory schema.
 type DiscountCategory struct {
-	config
-	// ID of the ent.
-	ID int `json:"id,omitempty"`
+	config `json:"-"`
+	// DiscountID holds the value of the "discount_id" field.
+	DiscountID uint64 `json:"discount_id,omitempty"`
+	// CategoryID holds the value of the "category_id" field.
+	CategoryID uint64 `json:"category_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DiscountCategoryQuery when eager-loading is set.
-	Edges               DiscountCategoryEdges `json:"edges"`
-	category_discounts  *uint64
-	discount_categories *uint64
-	selectValues        sql.SelectValues
+	Edges        DiscountCategoryEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // DiscountCategoryEdges holds the relations/edges for other nodes in the graph.
@@ -64,11 +64,7 @@ func (*DiscountCategory) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case discountcategory.FieldID:
-			values[i] = new(sql.NullInt64)
-		case discountcategory.ForeignKeys[0]: // category_discounts
-			values[i] = new(sql.NullInt64)
-		case discountcategory.ForeignKeys[1]: // discount_categories
+		case discountcategory.FieldDiscountID, discountcategory.FieldCategoryID:
 			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -85,25 +81,17 @@ func (dc *DiscountCategory) assignValues(columns []string, values []any) error {
 	}
 	for i := range columns {
 		switch columns[i] {
-		case discountcategory.FieldID:
-			value, ok := values[i].(*sql.NullInt64)
-			if !ok {
-				return fmt.Errorf("unexpected type %T for field id", value)
-			}
-			dc.ID = int(value.Int64)
-		case discountcategory.ForeignKeys[0]:
+		case discountcategory.FieldDiscountID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field category_discounts", value)
+				return fmt.Errorf("unexpected type %T for field discount_id", values[i])
 			} else if value.Valid {
-				dc.category_discounts = new(uint64)
-				*dc.category_discounts = uint64(value.Int64)
+				dc.DiscountID = uint64(value.Int64)
 			}
-		case discountcategory.ForeignKeys[1]:
+		case discountcategory.FieldCategoryID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field discount_categories", value)
+				return fmt.Errorf("unexpected type %T for field category_id", values[i])
 			} else if value.Valid {
-				dc.discount_categories = new(uint64)
-				*dc.discount_categories = uint64(value.Int64)
+				dc.CategoryID = uint64(value.Int64)
 			}
 		default:
 			dc.selectValues.Set(columns[i], values[i])
@@ -150,7 +138,11 @@ func (dc *DiscountCategory) Unwrap() *DiscountCategory {
 func (dc *DiscountCategory) String() string {
 	var builder strings.Builder
 	builder.WriteString("DiscountCategory(")
-	builder.WriteString(fmt.Sprintf("id=%v", dc.ID))
+	builder.WriteString("discount_id=")
+	builder.WriteString(fmt.Sprintf("%v", dc.DiscountID))
+	builder.WriteString(", ")
+	builder.WriteString("category_id=")
+	builder.WriteString(fmt.Sprintf("%v", dc.CategoryID))
 	builder.WriteByte(')')
 	return builder.String()
 }
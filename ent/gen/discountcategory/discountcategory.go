@@ -10,12 +10,18 @@ import (
 const (
 	// Label holds the string label denoting the discountcategory type in the database.
 	Label = "discount_category"
-	// FieldID holds the string denoting the id field in the database.
-	FieldID = "id"
+	// FieldDiscountID holds the string denoting the discount_id field in the database.
+	FieldDiscountID = "discount_id"
+	// FieldCategoryID holds the string denoting the category_id field in the database.
+	FieldCategoryID = "category_id"
 	// EdgeDiscount holds the string denoting the discount edge name in mutations.
 	EdgeDiscount = "discount"
 	// EdgeCategory holds the string denoting the category edge name in mutations.
 	EdgeCategory = "category"
+	// DiscountFieldID holds the string denoting the ID field of the Discount.
+	DiscountFieldID = "id"
+	// CategoryFieldID holds the string denoting the ID field of the Category.
+	CategoryFieldID = "id"
 	// Table holds the table name of the discountcategory in the database.
 	Table = "discount_categories"
 	// DiscountTable is the table that holds the discount relation/edge.
@@ -24,26 +30,20 @@ const (
 	// It exists in this package in order to avoid circular dependency with the "discount" package.
 	DiscountInverseTable = "discounts"
 	// DiscountColumn is the table column denoting the discount relation/edge.
-	DiscountColumn = "discount_categories"
+	DiscountColumn = "discount_id"
 	// CategoryTable is the table that holds the category relation/edge.
 	CategoryTable = "discount_categories"
 	// CategoryInverseTable is the table name for the Category entity.
 	// It exists in this package in order to avoid circular dependency with the "category" package.
 	CategoryInverseTable = "categories"
 	// CategoryColumn is the table column denoting the category relation/edge.
-	CategoryColumn = "category_discounts"
+	CategoryColumn = "category_id"
 )
 
 // Columns holds all SQL columns for discountcategory fields.
 var Columns = []string{
-	FieldID,
-}
-
-// ForeignKeys holds the SQL foreign-keys that are owned by the "discount_categories"
-// table and are not defined as standalone fields in the schema.
-var ForeignKeys = []string{
-	"category_discounts",
-	"discount_categories",
+	FieldDiscountID,
+	FieldCategoryID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -53,20 +53,20 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
-	for i := range ForeignKeys {
-		if column == ForeignKeys[i] {
-			return true
-		}
-	}
 	return false
 }
 
 // OrderOption defines the ordering options for the DiscountCategory queries.
 type OrderOption func(*sql.Selector)
 
-// ByID orders the results by the id field.
-func ByID(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldID, opts...).ToFunc()
+// ByDiscountID orders the results by the discount_id field.
+func ByDiscountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscountID, opts...).ToFunc()
+}
+
+// ByCategoryID orders the results by the category_id field.
+func ByCategoryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategoryID, opts...).ToFunc()
 }
 
 // ByDiscountField orders the results by discount field.
@@ -84,15 +84,15 @@ func ByCategoryField(field string, opts ...sql.OrderTermOption) OrderOption {
 }
 func newDiscountStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
-		sqlgraph.From(Table, FieldID),
-		sqlgraph.To(DiscountInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.M2O, true, DiscountTable, DiscountColumn),
+		sqlgraph.From(Table, DiscountColumn),
+		sqlgraph.To(DiscountInverseTable, DiscountFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, DiscountTable, DiscountColumn),
 	)
 }
 func newCategoryStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
-		sqlgraph.From(Table, FieldID),
-		sqlgraph.To(CategoryInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.M2O, true, CategoryTable, CategoryColumn),
+		sqlgraph.From(Table, CategoryColumn),
+		sqlgraph.To(CategoryInverseTable, CategoryFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, CategoryTable, CategoryColumn),
 	)
 }
@@ -8,57 +8,62 @@ import (
 	"github.com/thang1834/go-goss/ent/gen/predicate"
 )
 
-// ID filters vertices based on their ID field.
-func ID(id int) predicate.DiscountCategory {
-	return predicate.DiscountCategory(sql.FieldEQ(FieldID, id))
+// DiscountID applies equality check predicate on the "discount_id" field. It's identical to DiscountIDEQ.
+func DiscountID(v uint64) predicate.DiscountCategory {
+	return predicate.DiscountCategory(sql.FieldEQ(FieldDiscountID, v))
 }
 
-// IDEQ applies the EQ predicate on the ID field.
-func IDEQ(id int) predicate.DiscountCategory {
-	return predicate.DiscountCategory(sql.FieldEQ(FieldID, id))
+// CategoryID applies equality check predicate on the "category_id" field. It's identical to CategoryIDEQ.
+func CategoryID(v uint64) predicate.DiscountCategory {
+	return predicate.DiscountCategory(sql.FieldEQ(FieldCategoryID, v))
 }
 
-// IDNEQ applies the NEQ predicate on the ID field.
-func IDNEQ(id int) predicate.DiscountCategory {
-	return predicate.DiscountCategory(sql.FieldNEQ(FieldID, id))
+// DiscountIDEQ applies the EQ predicate on the "discount_id" field.
+func DiscountIDEQ(v uint64) predicate.DiscountCategory {
+	return predicate.DiscountCategory(sql.FieldEQ(FieldDiscountID, v))
 }
 
-// IDIn applies the In predicate on the ID field.
-func IDIn(ids ...int) predicate.DiscountCategory {
-	return predicate.DiscountCategory(sql.FieldIn(FieldID, ids...))
+// DiscountIDNEQ applies the NEQ predicate on the "discount_id" field.
+func DiscountIDNEQ(v uint64) predicate.DiscountCategory {
+	return predicate.DiscountCategory(sql.FieldNEQ(FieldDiscountID, v))
 }
 
-// IDNotIn applies the NotIn predicate on the ID field.
-func IDNotIn(ids ...int) predicate.DiscountCategory {
-	return predicate.DiscountCategory(sql.FieldNotIn(FieldID, ids...))
+// DiscountIDIn applies the In predicate on the "discount_id" field.
+func DiscountIDIn(vs ...uint64) predicate.DiscountCategory {
+	return predicate.DiscountCategory(sql.FieldIn(FieldDiscountID, vs...))
 }
 
-// IDGT applies the GT predicate on the ID field.
-func IDGT(id int) predicate.DiscountCategory {
-	return predicate.DiscountCategory(sql.FieldGT(FieldID, id))
+// DiscountIDNotIn applies the NotIn predicate on the "discount_id" field.
+func DiscountIDNotIn(vs ...uint64) predicate.DiscountCategory {
+	return predicate.DiscountCategory(sql.FieldNotIn(FieldDiscountID, vs...))
 }
 
-// IDGTE applies the GTE predicate on the ID field.
-func IDGTE(id int) predicate.DiscountCategory {
-	return predicate.DiscountCategory(sql.FieldGTE(FieldID, id))
+// CategoryIDEQ applies the EQ predicate on the "category_id" field.
+func CategoryIDEQ(v uint64) predicate.DiscountCategory {
+	return predicate.DiscountCategory(sql.FieldEQ(FieldCategoryID, v))
 }
 
-// IDLT applies the LT predicate on the ID field.
-func IDLT(id int) predicate.DiscountCategory {
-	return predicate.DiscountCategory(sql.FieldLT(FieldID, id))
+// CategoryIDNEQ applies the NEQ predicate on the "category_id" field.
+func CategoryIDNEQ(v uint64) predicate.DiscountCategory {
+	return predicate.DiscountCategory(sql.FieldNEQ(FieldCategoryID, v))
 }
 
-// IDLTE applies the LTE predicate on the ID field.
-func IDLTE(id int) predicate.DiscountCategory {
-	return predicate.DiscountCategory(sql.FieldLTE(FieldID, id))
+// CategoryIDIn applies the In predicate on the "category_id" field.
+func CategoryIDIn(vs ...uint64) predicate.DiscountCategory {
+	return predicate.DiscountCategory(sql.FieldIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDNotIn applies the NotIn predicate on the "category_id" field.
+func CategoryIDNotIn(vs ...uint64) predicate.DiscountCategory {
+	return predicate.DiscountCategory(sql.FieldNotIn(FieldCategoryID, vs...))
 }
 
 // HasDiscount applies the HasEdge predicate on the "discount" edge.
 func HasDiscount() predicate.DiscountCategory {
 	return predicate.DiscountCategory(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, DiscountTable, DiscountColumn),
+			sqlgraph.From(Table, DiscountColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, DiscountTable, DiscountColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -80,8 +85,8 @@ func HasDiscountWith(preds ...predicate.Discount) predicate.DiscountCategory {
 func HasCategory() predicate.DiscountCategory {
 	return predicate.DiscountCategory(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, CategoryTable, CategoryColumn),
+			sqlgraph.From(Table, CategoryColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, CategoryTable, CategoryColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
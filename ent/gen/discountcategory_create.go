@@ -4,6 +4,7 @@ package gen
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -20,17 +21,15 @@ type DiscountCategoryCreate struct {
 	hooks    []Hook
 }
 
-// SetDiscountID sets the "discount" edge to the Discount entity by ID.
-func (dcc *DiscountCategoryCreate) SetDiscountID(id uint64) *DiscountCategoryCreate {
-	dcc.mutation.SetDiscountID(id)
+// SetDiscountID sets the "discount_id" field.
+func (dcc *DiscountCategoryCreate) SetDiscountID(u uint64) *DiscountCategoryCreate {
+	dcc.mutation.SetDiscountID(u)
 	return dcc
 }
 
-// SetNillableDiscountID sets the "discount" edge to the Discount entity by ID if the given value is not nil.
-func (dcc *DiscountCategoryCreate) SetNillableDiscountID(id *uint64) *DiscountCategoryCreate {
-	if id != nil {
-		dcc = dcc.SetDiscountID(*id)
-	}
+// SetCategoryID sets the "category_id" field.
+func (dcc *DiscountCategoryCreate) SetCategoryID(u uint64) *DiscountCategoryCreate {
+	dcc.mutation.SetCategoryID(u)
 	return dcc
 }
 
@@ -39,20 +38,6 @@ func (dcc *DiscountCategoryCreate) SetDiscount(d *Discount) *DiscountCategoryCre
 	return dcc.SetDiscountID(d.ID)
 }
 
-// SetCategoryID sets the "category" edge to the Category entity by ID.
-func (dcc *DiscountCategoryCreate) SetCategoryID(id uint64) *DiscountCategoryCreate {
-	dcc.mutation.SetCategoryID(id)
-	return dcc
-}
-
-// SetNillableCategoryID sets the "category" edge to the Category entity by ID if the given value is not nil.
-func (dcc *DiscountCategoryCreate) SetNillableCategoryID(id *uint64) *DiscountCategoryCreate {
-	if id != nil {
-		dcc = dcc.SetCategoryID(*id)
-	}
-	return dcc
-}
-
 // SetCategory sets the "category" edge to the Category entity.
 func (dcc *DiscountCategoryCreate) SetCategory(c *Category) *DiscountCategoryCreate {
 	return dcc.SetCategoryID(c.ID)
@@ -92,6 +77,18 @@ func (dcc *DiscountCategoryCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (dcc *DiscountCategoryCreate) check() error {
+	if _, ok := dcc.mutation.DiscountID(); !ok {
+		return &ValidationError{Name: "discount_id", err: errors.New(`gen: missing required field "DiscountCategory.discount_id"`)}
+	}
+	if _, ok := dcc.mutation.CategoryID(); !ok {
+		return &ValidationError{Name: "category_id", err: errors.New(`gen: missing required field "DiscountCategory.category_id"`)}
+	}
+	if len(dcc.mutation.DiscountIDs()) == 0 {
+		return &ValidationError{Name: "discount", err: errors.New(`gen: missing required edge "DiscountCategory.discount"`)}
+	}
+	if len(dcc.mutation.CategoryIDs()) == 0 {
+		return &ValidationError{Name: "category", err: errors.New(`gen: missing required edge "DiscountCategory.category"`)}
+	}
 	return nil
 }
 
@@ -106,22 +103,18 @@ func (dcc *DiscountCategoryCreate) sqlSave(ctx context.Context) (*DiscountCatego
 		}
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	_node.ID = int(id)
-	dcc.mutation.id = &_node.ID
-	dcc.mutation.done = true
 	return _node, nil
 }
 
 func (dcc *DiscountCategoryCreate) createSpec() (*DiscountCategory, *sqlgraph.CreateSpec) {
 	var (
 		_node = &DiscountCategory{config: dcc.config}
-		_spec = sqlgraph.NewCreateSpec(discountcategory.Table, sqlgraph.NewFieldSpec(discountcategory.FieldID, field.TypeInt))
+		_spec = sqlgraph.NewCreateSpec(discountcategory.Table, nil)
 	)
 	if nodes := dcc.mutation.DiscountIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   discountcategory.DiscountTable,
 			Columns: []string{discountcategory.DiscountColumn},
 			Bidi:    false,
@@ -132,13 +125,13 @@ func (dcc *DiscountCategoryCreate) createSpec() (*DiscountCategory, *sqlgraph.Cr
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.discount_categories = &nodes[0]
+		_node.DiscountID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := dcc.mutation.CategoryIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   discountcategory.CategoryTable,
 			Columns: []string{discountcategory.CategoryColumn},
 			Bidi:    false,
@@ -149,7 +142,7 @@ func (dcc *DiscountCategoryCreate) createSpec() (*DiscountCategory, *sqlgraph.Cr
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.category_discounts = &nodes[0]
+		_node.CategoryID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
@@ -198,11 +191,6 @@ func (dccb *DiscountCategoryCreateBulk) Save(ctx context.Context) ([]*DiscountCa
 				if err != nil {
 					return nil, err
 				}
-				mutation.id = &nodes[i].ID
-				if specs[i].ID.Value != nil {
-					id := specs[i].ID.Value.(int64)
-					nodes[i].ID = int(id)
-				}
 				mutation.done = true
 				return nodes[i], nil
 			})
@@ -7,7 +7,6 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
-	"entgo.io/ent/schema/field"
 	"github.com/thang1834/go-goss/ent/gen/discountcategory"
 	"github.com/thang1834/go-goss/ent/gen/predicate"
 )
@@ -40,7 +39,7 @@ func (dcd *DiscountCategoryDelete) ExecX(ctx context.Context) int {
 }
 
 func (dcd *DiscountCategoryDelete) sqlExec(ctx context.Context) (int, error) {
-	_spec := sqlgraph.NewDeleteSpec(discountcategory.Table, sqlgraph.NewFieldSpec(discountcategory.FieldID, field.TypeInt))
+	_spec := sqlgraph.NewDeleteSpec(discountcategory.Table, nil)
 	if ps := dcd.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -8,9 +8,9 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
-	"entgo.io/ent/schema/field"
 	"github.com/thang1834/go-goss/ent/gen/category"
 	"github.com/thang1834/go-goss/ent/gen/discount"
 	"github.com/thang1834/go-goss/ent/gen/discountcategory"
@@ -26,7 +26,7 @@ type DiscountCategoryQuery struct {
 	predicates   []predicate.DiscountCategory
 	withDiscount *DiscountQuery
 	withCategory *CategoryQuery
-	withFKs      bool
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -75,9 +75,9 @@ func (dcq *DiscountCategoryQuery) QueryDiscount() *DiscountQuery {
 			return nil, err
 		}
 		step := sqlgraph.NewStep(
-			sqlgraph.From(discountcategory.Table, discountcategory.FieldID, selector),
+			sqlgraph.From(discountcategory.Table, discountcategory.DiscountColumn, selector),
 			sqlgraph.To(discount.Table, discount.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, discountcategory.DiscountTable, discountcategory.DiscountColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, discountcategory.DiscountTable, discountcategory.DiscountColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(dcq.driver.Dialect(), step)
 		return fromU, nil
@@ -97,9 +97,9 @@ func (dcq *DiscountCategoryQuery) QueryCategory() *CategoryQuery {
 			return nil, err
 		}
 		step := sqlgraph.NewStep(
-			sqlgraph.From(discountcategory.Table, discountcategory.FieldID, selector),
+			sqlgraph.From(discountcategory.Table, discountcategory.CategoryColumn, selector),
 			sqlgraph.To(category.Table, category.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, discountcategory.CategoryTable, discountcategory.CategoryColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, discountcategory.CategoryTable, discountcategory.CategoryColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(dcq.driver.Dialect(), step)
 		return fromU, nil
@@ -129,29 +129,6 @@ func (dcq *DiscountCategoryQuery) FirstX(ctx context.Context) *DiscountCategory
 	return node
 }
 
-// FirstID returns the first DiscountCategory ID from the query.
-// Returns a *NotFoundError when no DiscountCategory ID was found.
-func (dcq *DiscountCategoryQuery) FirstID(ctx context.Context) (id int, err error) {
-	var ids []int
-	if ids, err = dcq.Limit(1).IDs(setContextOp(ctx, dcq.ctx, ent.OpQueryFirstID)); err != nil {
-		return
-	}
-	if len(ids) == 0 {
-		err = &NotFoundError{discountcategory.Label}
-		return
-	}
-	return ids[0], nil
-}
-
-// FirstIDX is like FirstID, but panics if an error occurs.
-func (dcq *DiscountCategoryQuery) FirstIDX(ctx context.Context) int {
-	id, err := dcq.FirstID(ctx)
-	if err != nil && !IsNotFound(err) {
-		panic(err)
-	}
-	return id
-}
-
 // Only returns a single DiscountCategory entity found by the query, ensuring it only returns one.
 // Returns a *NotSingularError when more than one DiscountCategory entity is found.
 // Returns a *NotFoundError when no DiscountCategory entities are found.
@@ -179,34 +156,6 @@ func (dcq *DiscountCategoryQuery) OnlyX(ctx context.Context) *DiscountCategory {
 	return node
 }
 
-// OnlyID is like Only, but returns the only DiscountCategory ID in the query.
-// Returns a *NotSingularError when more than one DiscountCategory ID is found.
-// Returns a *NotFoundError when no entities are found.
-func (dcq *DiscountCategoryQuery) OnlyID(ctx context.Context) (id int, err error) {
-	var ids []int
-	if ids, err = dcq.Limit(2).IDs(setContextOp(ctx, dcq.ctx, ent.OpQueryOnlyID)); err != nil {
-		return
-	}
-	switch len(ids) {
-	case 1:
-		id = ids[0]
-	case 0:
-		err = &NotFoundError{discountcategory.Label}
-	default:
-		err = &NotSingularError{discountcategory.Label}
-	}
-	return
-}
-
-// OnlyIDX is like OnlyID, but panics if an error occurs.
-func (dcq *DiscountCategoryQuery) OnlyIDX(ctx context.Context) int {
-	id, err := dcq.OnlyID(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return id
-}
-
 // All executes the query and returns a list of DiscountCategories.
 func (dcq *DiscountCategoryQuery) All(ctx context.Context) ([]*DiscountCategory, error) {
 	ctx = setContextOp(ctx, dcq.ctx, ent.OpQueryAll)
@@ -226,27 +175,6 @@ func (dcq *DiscountCategoryQuery) AllX(ctx context.Context) []*DiscountCategory
 	return nodes
 }
 
-// IDs executes the query and returns a list of DiscountCategory IDs.
-func (dcq *DiscountCategoryQuery) IDs(ctx context.Context) (ids []int, err error) {
-	if dcq.ctx.Unique == nil && dcq.path != nil {
-		dcq.Unique(true)
-	}
-	ctx = setContextOp(ctx, dcq.ctx, ent.OpQueryIDs)
-	if err = dcq.Select(discountcategory.FieldID).Scan(ctx, &ids); err != nil {
-		return nil, err
-	}
-	return ids, nil
-}
-
-// IDsX is like IDs, but panics if an error occurs.
-func (dcq *DiscountCategoryQuery) IDsX(ctx context.Context) []int {
-	ids, err := dcq.IDs(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return ids
-}
-
 // Count returns the count of the given query.
 func (dcq *DiscountCategoryQuery) Count(ctx context.Context) (int, error) {
 	ctx = setContextOp(ctx, dcq.ctx, ent.OpQueryCount)
@@ -268,7 +196,7 @@ func (dcq *DiscountCategoryQuery) CountX(ctx context.Context) int {
 // Exist returns true if the query has elements in the graph.
 func (dcq *DiscountCategoryQuery) Exist(ctx context.Context) (bool, error) {
 	ctx = setContextOp(ctx, dcq.ctx, ent.OpQueryExist)
-	switch _, err := dcq.FirstID(ctx); {
+	switch _, err := dcq.First(ctx); {
 	case IsNotFound(err):
 		return false, nil
 	case err != nil:
@@ -331,6 +259,18 @@ func (dcq *DiscountCategoryQuery) WithCategory(opts ...func(*CategoryQuery)) *Di
 
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		DiscountID uint64 `json:"discount_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DiscountCategory.Query().
+//		GroupBy(discountcategory.FieldDiscountID).
+//		Aggregate(gen.Count()).
+//		Scan(ctx, &v)
 func (dcq *DiscountCategoryQuery) GroupBy(field string, fields ...string) *DiscountCategoryGroupBy {
 	dcq.ctx.Fields = append([]string{field}, fields...)
 	grbuild := &DiscountCategoryGroupBy{build: dcq}
@@ -342,6 +282,16 @@ func (dcq *DiscountCategoryQuery) GroupBy(field string, fields ...string) *Disco
 
 // Select allows the selection one or more fields/columns for the given query,
 // instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		DiscountID uint64 `json:"discount_id,omitempty"`
+//	}
+//
+//	client.DiscountCategory.Query().
+//		Select(discountcategory.FieldDiscountID).
+//		Scan(ctx, &v)
 func (dcq *DiscountCategoryQuery) Select(fields ...string) *DiscountCategorySelect {
 	dcq.ctx.Fields = append(dcq.ctx.Fields, fields...)
 	sbuild := &DiscountCategorySelect{DiscountCategoryQuery: dcq}
@@ -384,19 +334,12 @@ func (dcq *DiscountCategoryQuery) prepareQuery(ctx context.Context) error {
 func (dcq *DiscountCategoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DiscountCategory, error) {
 	var (
 		nodes       = []*DiscountCategory{}
-		withFKs     = dcq.withFKs
 		_spec       = dcq.querySpec()
 		loadedTypes = [2]bool{
 			dcq.withDiscount != nil,
 			dcq.withCategory != nil,
 		}
 	)
-	if dcq.withDiscount != nil || dcq.withCategory != nil {
-		withFKs = true
-	}
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, discountcategory.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*DiscountCategory).scanValues(nil, columns)
 	}
@@ -406,6 +349,9 @@ func (dcq *DiscountCategoryQuery) sqlAll(ctx context.Context, hooks ...queryHook
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(dcq.modifiers) > 0 {
+		_spec.Modifiers = dcq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -434,10 +380,7 @@ func (dcq *DiscountCategoryQuery) loadDiscount(ctx context.Context, query *Disco
 	ids := make([]uint64, 0, len(nodes))
 	nodeids := make(map[uint64][]*DiscountCategory)
 	for i := range nodes {
-		if nodes[i].discount_categories == nil {
-			continue
-		}
-		fk := *nodes[i].discount_categories
+		fk := nodes[i].DiscountID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -454,7 +397,7 @@ func (dcq *DiscountCategoryQuery) loadDiscount(ctx context.Context, query *Disco
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "discount_categories" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "discount_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -466,10 +409,7 @@ func (dcq *DiscountCategoryQuery) loadCategory(ctx context.Context, query *Categ
 	ids := make([]uint64, 0, len(nodes))
 	nodeids := make(map[uint64][]*DiscountCategory)
 	for i := range nodes {
-		if nodes[i].category_discounts == nil {
-			continue
-		}
-		fk := *nodes[i].category_discounts
+		fk := nodes[i].CategoryID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -486,7 +426,7 @@ func (dcq *DiscountCategoryQuery) loadCategory(ctx context.Context, query *Categ
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "category_discounts" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "category_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -497,15 +437,16 @@ func (dcq *DiscountCategoryQuery) loadCategory(ctx context.Context, query *Categ
 
 func (dcq *DiscountCategoryQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := dcq.querySpec()
-	_spec.Node.Columns = dcq.ctx.Fields
-	if len(dcq.ctx.Fields) > 0 {
-		_spec.Unique = dcq.ctx.Unique != nil && *dcq.ctx.Unique
+	if len(dcq.modifiers) > 0 {
+		_spec.Modifiers = dcq.modifiers
 	}
+	_spec.Unique = false
+	_spec.Node.Columns = nil
 	return sqlgraph.CountNodes(ctx, dcq.driver, _spec)
 }
 
 func (dcq *DiscountCategoryQuery) querySpec() *sqlgraph.QuerySpec {
-	_spec := sqlgraph.NewQuerySpec(discountcategory.Table, discountcategory.Columns, sqlgraph.NewFieldSpec(discountcategory.FieldID, field.TypeInt))
+	_spec := sqlgraph.NewQuerySpec(discountcategory.Table, discountcategory.Columns, nil)
 	_spec.From = dcq.sql
 	if unique := dcq.ctx.Unique; unique != nil {
 		_spec.Unique = *unique
@@ -514,11 +455,14 @@ func (dcq *DiscountCategoryQuery) querySpec() *sqlgraph.QuerySpec {
 	}
 	if fields := dcq.ctx.Fields; len(fields) > 0 {
 		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, discountcategory.FieldID)
 		for i := range fields {
-			if fields[i] != discountcategory.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
-			}
+			_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+		}
+		if dcq.withDiscount != nil {
+			_spec.Node.AddColumnOnce(discountcategory.FieldDiscountID)
+		}
+		if dcq.withCategory != nil {
+			_spec.Node.AddColumnOnce(discountcategory.FieldCategoryID)
 		}
 	}
 	if ps := dcq.predicates; len(ps) > 0 {
@@ -559,6 +503,9 @@ func (dcq *DiscountCategoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if dcq.ctx.Unique != nil && *dcq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range dcq.modifiers {
+		m(selector)
+	}
 	for _, p := range dcq.predicates {
 		p(selector)
 	}
@@ -576,6 +523,32 @@ func (dcq *DiscountCategoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (dcq *DiscountCategoryQuery) ForUpdate(opts ...sql.LockOption) *DiscountCategoryQuery {
+	if dcq.driver.Dialect() == dialect.Postgres {
+		dcq.Unique(false)
+	}
+	dcq.modifiers = append(dcq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return dcq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (dcq *DiscountCategoryQuery) ForShare(opts ...sql.LockOption) *DiscountCategoryQuery {
+	if dcq.driver.Dialect() == dialect.Postgres {
+		dcq.Unique(false)
+	}
+	dcq.modifiers = append(dcq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return dcq
+}
+
 // DiscountCategoryGroupBy is the group-by builder for DiscountCategory entities.
 type DiscountCategoryGroupBy struct {
 	selector
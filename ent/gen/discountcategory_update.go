@@ -29,39 +29,39 @@ func (dcu *DiscountCategoryUpdate) Where(ps ...predicate.DiscountCategory) *Disc
 	return dcu
 }
 
-// SetDiscountID sets the "discount" edge to the Discount entity by ID.
-func (dcu *DiscountCategoryUpdate) SetDiscountID(id uint64) *DiscountCategoryUpdate {
-	dcu.mutation.SetDiscountID(id)
+// SetDiscountID sets the "discount_id" field.
+func (dcu *DiscountCategoryUpdate) SetDiscountID(u uint64) *DiscountCategoryUpdate {
+	dcu.mutation.SetDiscountID(u)
 	return dcu
 }
 
-// SetNillableDiscountID sets the "discount" edge to the Discount entity by ID if the given value is not nil.
-func (dcu *DiscountCategoryUpdate) SetNillableDiscountID(id *uint64) *DiscountCategoryUpdate {
-	if id != nil {
-		dcu = dcu.SetDiscountID(*id)
+// SetNillableDiscountID sets the "discount_id" field if the given value is not nil.
+func (dcu *DiscountCategoryUpdate) SetNillableDiscountID(u *uint64) *DiscountCategoryUpdate {
+	if u != nil {
+		dcu.SetDiscountID(*u)
 	}
 	return dcu
 }
 
-// SetDiscount sets the "discount" edge to the Discount entity.
-func (dcu *DiscountCategoryUpdate) SetDiscount(d *Discount) *DiscountCategoryUpdate {
-	return dcu.SetDiscountID(d.ID)
-}
-
-// SetCategoryID sets the "category" edge to the Category entity by ID.
-func (dcu *DiscountCategoryUpdate) SetCategoryID(id uint64) *DiscountCategoryUpdate {
-	dcu.mutation.SetCategoryID(id)
+// SetCategoryID sets the "category_id" field.
+func (dcu *DiscountCategoryUpdate) SetCategoryID(u uint64) *DiscountCategoryUpdate {
+	dcu.mutation.SetCategoryID(u)
 	return dcu
 }
 
-// SetNillableCategoryID sets the "category" edge to the Category entity by ID if the given value is not nil.
-func (dcu *DiscountCategoryUpdate) SetNillableCategoryID(id *uint64) *DiscountCategoryUpdate {
-	if id != nil {
-		dcu = dcu.SetCategoryID(*id)
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (dcu *DiscountCategoryUpdate) SetNillableCategoryID(u *uint64) *DiscountCategoryUpdate {
+	if u != nil {
+		dcu.SetCategoryID(*u)
 	}
 	return dcu
 }
 
+// SetDiscount sets the "discount" edge to the Discount entity.
+func (dcu *DiscountCategoryUpdate) SetDiscount(d *Discount) *DiscountCategoryUpdate {
+	return dcu.SetDiscountID(d.ID)
+}
+
 // SetCategory sets the "category" edge to the Category entity.
 func (dcu *DiscountCategoryUpdate) SetCategory(c *Category) *DiscountCategoryUpdate {
 	return dcu.SetCategoryID(c.ID)
@@ -111,8 +111,22 @@ func (dcu *DiscountCategoryUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (dcu *DiscountCategoryUpdate) check() error {
+	if dcu.mutation.DiscountCleared() && len(dcu.mutation.DiscountIDs()) > 0 {
+		return errors.New(`gen: clearing a required unique edge "DiscountCategory.discount"`)
+	}
+	if dcu.mutation.CategoryCleared() && len(dcu.mutation.CategoryIDs()) > 0 {
+		return errors.New(`gen: clearing a required unique edge "DiscountCategory.category"`)
+	}
+	return nil
+}
+
 func (dcu *DiscountCategoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	_spec := sqlgraph.NewUpdateSpec(discountcategory.Table, discountcategory.Columns, sqlgraph.NewFieldSpec(discountcategory.FieldID, field.TypeInt))
+	if err := dcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(discountcategory.Table, discountcategory.Columns, sqlgraph.NewFieldSpec(discountcategory.FieldDiscountID, field.TypeUint64), sqlgraph.NewFieldSpec(discountcategory.FieldCategoryID, field.TypeUint64))
 	if ps := dcu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -123,7 +137,7 @@ func (dcu *DiscountCategoryUpdate) sqlSave(ctx context.Context) (n int, err erro
 	if dcu.mutation.DiscountCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   discountcategory.DiscountTable,
 			Columns: []string{discountcategory.DiscountColumn},
 			Bidi:    false,
@@ -136,7 +150,7 @@ func (dcu *DiscountCategoryUpdate) sqlSave(ctx context.Context) (n int, err erro
 	if nodes := dcu.mutation.DiscountIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   discountcategory.DiscountTable,
 			Columns: []string{discountcategory.DiscountColumn},
 			Bidi:    false,
@@ -152,7 +166,7 @@ func (dcu *DiscountCategoryUpdate) sqlSave(ctx context.Context) (n int, err erro
 	if dcu.mutation.CategoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   discountcategory.CategoryTable,
 			Columns: []string{discountcategory.CategoryColumn},
 			Bidi:    false,
@@ -165,7 +179,7 @@ func (dcu *DiscountCategoryUpdate) sqlSave(ctx context.Context) (n int, err erro
 	if nodes := dcu.mutation.CategoryIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   discountcategory.CategoryTable,
 			Columns: []string{discountcategory.CategoryColumn},
 			Bidi:    false,
@@ -198,39 +212,39 @@ type DiscountCategoryUpdateOne struct {
 	mutation *DiscountCategoryMutation
 }
 
-// SetDiscountID sets the "discount" edge to the Discount entity by ID.
-func (dcuo *DiscountCategoryUpdateOne) SetDiscountID(id uint64) *DiscountCategoryUpdateOne {
-	dcuo.mutation.SetDiscountID(id)
+// SetDiscountID sets the "discount_id" field.
+func (dcuo *DiscountCategoryUpdateOne) SetDiscountID(u uint64) *DiscountCategoryUpdateOne {
+	dcuo.mutation.SetDiscountID(u)
 	return dcuo
 }
 
-// SetNillableDiscountID sets the "discount" edge to the Discount entity by ID if the given value is not nil.
-func (dcuo *DiscountCategoryUpdateOne) SetNillableDiscountID(id *uint64) *DiscountCategoryUpdateOne {
-	if id != nil {
-		dcuo = dcuo.SetDiscountID(*id)
+// SetNillableDiscountID sets the "discount_id" field if the given value is not nil.
+func (dcuo *DiscountCategoryUpdateOne) SetNillableDiscountID(u *uint64) *DiscountCategoryUpdateOne {
+	if u != nil {
+		dcuo.SetDiscountID(*u)
 	}
 	return dcuo
 }
 
-// SetDiscount sets the "discount" edge to the Discount entity.
-func (dcuo *DiscountCategoryUpdateOne) SetDiscount(d *Discount) *DiscountCategoryUpdateOne {
-	return dcuo.SetDiscountID(d.ID)
-}
-
-// SetCategoryID sets the "category" edge to the Category entity by ID.
-func (dcuo *DiscountCategoryUpdateOne) SetCategoryID(id uint64) *DiscountCategoryUpdateOne {
-	dcuo.mutation.SetCategoryID(id)
+// SetCategoryID sets the "category_id" field.
+func (dcuo *DiscountCategoryUpdateOne) SetCategoryID(u uint64) *DiscountCategoryUpdateOne {
+	dcuo.mutation.SetCategoryID(u)
 	return dcuo
 }
 
-// SetNillableCategoryID sets the "category" edge to the Category entity by ID if the given value is not nil.
-func (dcuo *DiscountCategoryUpdateOne) SetNillableCategoryID(id *uint64) *DiscountCategoryUpdateOne {
-	if id != nil {
-		dcuo = dcuo.SetCategoryID(*id)
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (dcuo *DiscountCategoryUpdateOne) SetNillableCategoryID(u *uint64) *DiscountCategoryUpdateOne {
+	if u != nil {
+		dcuo.SetCategoryID(*u)
 	}
 	return dcuo
 }
 
+// SetDiscount sets the "discount" edge to the Discount entity.
+func (dcuo *DiscountCategoryUpdateOne) SetDiscount(d *Discount) *DiscountCategoryUpdateOne {
+	return dcuo.SetDiscountID(d.ID)
+}
+
 // SetCategory sets the "category" edge to the Category entity.
 func (dcuo *DiscountCategoryUpdateOne) SetCategory(c *Category) *DiscountCategoryUpdateOne {
 	return dcuo.SetCategoryID(c.ID)
@@ -293,23 +307,39 @@ func (dcuo *DiscountCategoryUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (dcuo *DiscountCategoryUpdateOne) check() error {
+	if dcuo.mutation.DiscountCleared() && len(dcuo.mutation.DiscountIDs()) > 0 {
+		return errors.New(`gen: clearing a required unique edge "DiscountCategory.discount"`)
+	}
+	if dcuo.mutation.CategoryCleared() && len(dcuo.mutation.CategoryIDs()) > 0 {
+		return errors.New(`gen: clearing a required unique edge "DiscountCategory.category"`)
+	}
+	return nil
+}
+
 func (dcuo *DiscountCategoryUpdateOne) sqlSave(ctx context.Context) (_node *DiscountCategory, err error) {
-	_spec := sqlgraph.NewUpdateSpec(discountcategory.Table, discountcategory.Columns, sqlgraph.NewFieldSpec(discountcategory.FieldID, field.TypeInt))
-	id, ok := dcuo.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`gen: missing "DiscountCategory.id" for update`)}
+	if err := dcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(discountcategory.Table, discountcategory.Columns, sqlgraph.NewFieldSpec(discountcategory.FieldDiscountID, field.TypeUint64), sqlgraph.NewFieldSpec(discountcategory.FieldCategoryID, field.TypeUint64))
+	if id, ok := dcuo.mutation.DiscountID(); !ok {
+		return nil, &ValidationError{Name: "discount_id", err: errors.New(`gen: missing "DiscountCategory.discount_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[0].Value = id
+	}
+	if id, ok := dcuo.mutation.CategoryID(); !ok {
+		return nil, &ValidationError{Name: "category_id", err: errors.New(`gen: missing "DiscountCategory.category_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[1].Value = id
 	}
-	_spec.Node.ID.Value = id
 	if fields := dcuo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, discountcategory.FieldID)
-		for _, f := range fields {
+		_spec.Node.Columns = make([]string, len(fields))
+		for i, f := range fields {
 			if !discountcategory.ValidColumn(f) {
 				return nil, &ValidationError{Name: f, err: fmt.Errorf("gen: invalid field %q for query", f)}
 			}
-			if f != discountcategory.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+			_spec.Node.Columns[i] = f
 		}
 	}
 	if ps := dcuo.mutation.predicates; len(ps) > 0 {
@@ -322,7 +352,7 @@ func (dcuo *DiscountCategoryUpdateOne) sqlSave(ctx context.Context) (_node *Disc
 	if dcuo.mutation.DiscountCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   discountcategory.DiscountTable,
 			Columns: []string{discountcategory.DiscountColumn},
 			Bidi:    false,
@@ -335,7 +365,7 @@ func (dcuo *DiscountCategoryUpdateOne) sqlSave(ctx context.Context) (_node *Disc
 	if nodes := dcuo.mutation.DiscountIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   discountcategory.DiscountTable,
 			Columns: []string{discountcategory.DiscountColumn},
 			Bidi:    false,
@@ -351,7 +381,7 @@ func (dcuo *DiscountCategoryUpdateOne) sqlSave(ctx context.Context) (_node *Disc
 	if dcuo.mutation.CategoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   discountcategory.CategoryTable,
 			Columns: []string{discountcategory.CategoryColumn},
 			Bidi:    false,
@@ -364,7 +394,7 @@ func (dcuo *DiscountCategoryUpdateOne) sqlSave(ctx context.Context) (_node *Disc
 	if nodes := dcuo.mutation.CategoryIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   discountcategory.CategoryTable,
 			Columns: []string{discountcategory.CategoryColumn},
 			Bidi:    false,
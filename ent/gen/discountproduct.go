@@ -15,15 +15,15 @@ import (
 
 // DiscountProduct is the model entity for the DiscountProduct schema.
 type DiscountProduct struct {
-	config
-	// ID of the ent.
-	ID int `json:"id,omitempty"`
+	config `json:"-"`
+	// DiscountID holds the value of the "discount_id" field.
+	DiscountID uint64 `json:"discount_id,omitempty"`
+	// ProductID holds the value of the "product_id" field.
+	ProductID uint64 `json:"product_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DiscountProductQuery when eager-loading is set.
-	Edges             DiscountProductEdges `json:"edges"`
-	discount_products *uint64
-	product_discounts *uint64
-	selectValues      sql.SelectValues
+	Edges        DiscountProductEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // DiscountProductEdges holds the relations/edges for other nodes in the graph.
@@ -64,11 +64,7 @@ func (*DiscountProduct) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case discountproduct.FieldID:
-			values[i] = new(sql.NullInt64)
-		case discountproduct.ForeignKeys[0]: // discount_products
-			values[i] = new(sql.NullInt64)
-		case discountproduct.ForeignKeys[1]: // product_discounts
+		case discountproduct.FieldDiscountID, discountproduct.FieldProductID:
 			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -85,25 +81,17 @@ func (dp *DiscountProduct) assignValues(columns []string, values []any) error {
 	}
 	for i := range columns {
 		switch columns[i] {
-		case discountproduct.FieldID:
-			value, ok := values[i].(*sql.NullInt64)
-			if !ok {
-				return fmt.Errorf("unexpected type %T for field id", value)
-			}
-			dp.ID = int(value.Int64)
-		case discountproduct.ForeignKeys[0]:
+		case discountproduct.FieldDiscountID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field discount_products", value)
+				return fmt.Errorf("unexpected type %T for field discount_id", values[i])
 			} else if value.Valid {
-				dp.discount_products = new(uint64)
-				*dp.discount_products = uint64(value.Int64)
+				dp.DiscountID = uint64(value.Int64)
 			}
-		case discountproduct.ForeignKeys[1]:
+		case discountproduct.FieldProductID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field product_discounts", value)
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
 			} else if value.Valid {
-				dp.product_discounts = new(uint64)
-				*dp.product_discounts = uint64(value.Int64)
+				dp.ProductID = uint64(value.Int64)
 			}
 		default:
 			dp.selectValues.Set(columns[i], values[i])
@@ -150,7 +138,11 @@ func (dp *DiscountProduct) Unwrap() *DiscountProduct {
 func (dp *DiscountProduct) String() string {
 	var builder strings.Builder
 	builder.WriteString("DiscountProduct(")
-	builder.WriteString(fmt.Sprintf("id=%v", dp.ID))
+	builder.WriteString("discount_id=")
+	builder.WriteString(fmt.Sprintf("%v", dp.DiscountID))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", dp.ProductID))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -10,12 +10,18 @@ import (
 const (
 	// Label holds the string label denoting the discountproduct type in the database.
 	Label = "discount_product"
-	// FieldID holds the string denoting the id field in the database.
-	FieldID = "id"
+	// FieldDiscountID holds the string denoting the discount_id field in the database.
+	FieldDiscountID = "discount_id"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
 	// EdgeDiscount holds the string denoting the discount edge name in mutations.
 	EdgeDiscount = "discount"
 	// EdgeProduct holds the string denoting the product edge name in mutations.
 	EdgeProduct = "product"
+	// DiscountFieldID holds the string denoting the ID field of the Discount.
+	DiscountFieldID = "id"
+	// ProductFieldID holds the string denoting the ID field of the Product.
+	ProductFieldID = "id"
 	// Table holds the table name of the discountproduct in the database.
 	Table = "discount_products"
 	// DiscountTable is the table that holds the discount relation/edge.
@@ -24,26 +30,20 @@ const (
 	// It exists in this package in order to avoid circular dependency with the "discount" package.
 	DiscountInverseTable = "discounts"
 	// DiscountColumn is the table column denoting the discount relation/edge.
-	DiscountColumn = "discount_products"
+	DiscountColumn = "discount_id"
 	// ProductTable is the table that holds the product relation/edge.
 	ProductTable = "discount_products"
 	// ProductInverseTable is the table name for the Product entity.
 	// It exists in this package in order to avoid circular dependency with the "product" package.
 	ProductInverseTable = "products"
 	// ProductColumn is the table column denoting the product relation/edge.
-	ProductColumn = "product_discounts"
+	ProductColumn = "product_id"
 )
 
 // Columns holds all SQL columns for discountproduct fields.
 var Columns = []string{
-	FieldID,
-}
-
-// ForeignKeys holds the SQL foreign-keys that are owned by the "discount_products"
-// table and are not defined as standalone fields in the schema.
-var ForeignKeys = []string{
-	"discount_products",
-	"product_discounts",
+	FieldDiscountID,
+	FieldProductID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -53,20 +53,20 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
-	for i := range ForeignKeys {
-		if column == ForeignKeys[i] {
-			return true
-		}
-	}
 	return false
 }
 
 // OrderOption defines the ordering options for the DiscountProduct queries.
 type OrderOption func(*sql.Selector)
 
-// ByID orders the results by the id field.
-func ByID(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldID, opts...).ToFunc()
+// ByDiscountID orders the results by the discount_id field.
+func ByDiscountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscountID, opts...).ToFunc()
+}
+
+// ByProductID orders the results by the product_id field.
+func ByProductID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProductID, opts...).ToFunc()
 }
 
 // ByDiscountField orders the results by discount field.
@@ -84,15 +84,15 @@ func ByProductField(field string, opts ...sql.OrderTermOption) OrderOption {
 }
 func newDiscountStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
-		sqlgraph.From(Table, FieldID),
-		sqlgraph.To(DiscountInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.M2O, true, DiscountTable, DiscountColumn),
+		sqlgraph.From(Table, DiscountColumn),
+		sqlgraph.To(DiscountInverseTable, DiscountFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, DiscountTable, DiscountColumn),
 	)
 }
 func newProductStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
-		sqlgraph.From(Table, FieldID),
-		sqlgraph.To(ProductInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.M2O, true, ProductTable, ProductColumn),
+		sqlgraph.From(Table, ProductColumn),
+		sqlgraph.To(ProductInverseTable, ProductFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ProductTable, ProductColumn),
 	)
 }
@@ -8,57 +8,62 @@ import (
 	"github.com/thang1834/go-goss/ent/gen/predicate"
 )
 
-// ID filters vertices based on their ID field.
-func ID(id int) predicate.DiscountProduct {
-	return predicate.DiscountProduct(sql.FieldEQ(FieldID, id))
+// DiscountID applies equality check predicate on the "discount_id" field. It's identical to DiscountIDEQ.
+func DiscountID(v uint64) predicate.DiscountProduct {
+	return predicate.DiscountProduct(sql.FieldEQ(FieldDiscountID, v))
 }
 
-// IDEQ applies the EQ predicate on the ID field.
-func IDEQ(id int) predicate.DiscountProduct {
-	return predicate.DiscountProduct(sql.FieldEQ(FieldID, id))
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v uint64) predicate.DiscountProduct {
+	return predicate.DiscountProduct(sql.FieldEQ(FieldProductID, v))
 }
 
-// IDNEQ applies the NEQ predicate on the ID field.
-func IDNEQ(id int) predicate.DiscountProduct {
-	return predicate.DiscountProduct(sql.FieldNEQ(FieldID, id))
+// DiscountIDEQ applies the EQ predicate on the "discount_id" field.
+func DiscountIDEQ(v uint64) predicate.DiscountProduct {
+	return predicate.DiscountProduct(sql.FieldEQ(FieldDiscountID, v))
 }
 
-// IDIn applies the In predicate on the ID field.
-func IDIn(ids ...int) predicate.DiscountProduct {
-	return predicate.DiscountProduct(sql.FieldIn(FieldID, ids...))
+// DiscountIDNEQ applies the NEQ predicate on the "discount_id" field.
+func DiscountIDNEQ(v uint64) predicate.DiscountProduct {
+	return predicate.DiscountProduct(sql.FieldNEQ(FieldDiscountID, v))
 }
 
-// IDNotIn applies the NotIn predicate on the ID field.
-func IDNotIn(ids ...int) predicate.DiscountProduct {
-	return predicate.DiscountProduct(sql.FieldNotIn(FieldID, ids...))
+// DiscountIDIn applies the In predicate on the "discount_id" field.
+func DiscountIDIn(vs ...uint64) predicate.DiscountProduct {
+	return predicate.DiscountProduct(sql.FieldIn(FieldDiscountID, vs...))
 }
 
-// IDGT applies the GT predicate on the ID field.
-func IDGT(id int) predicate.DiscountProduct {
-	return predicate.DiscountProduct(sql.FieldGT(FieldID, id))
+// DiscountIDNotIn applies the NotIn predicate on the "discount_id" field.
+func DiscountIDNotIn(vs ...uint64) predicate.DiscountProduct {
+	return predicate.DiscountProduct(sql.FieldNotIn(FieldDiscountID, vs...))
 }
 
-// IDGTE applies the GTE predicate on the ID field.
-func IDGTE(id int) predicate.DiscountProduct {
-	return predicate.DiscountProduct(sql.FieldGTE(FieldID, id))
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v uint64) predicate.DiscountProduct {
+	return predicate.DiscountProduct(sql.FieldEQ(FieldProductID, v))
 }
 
-// IDLT applies the LT predicate on the ID field.
-func IDLT(id int) predicate.DiscountProduct {
-	return predicate.DiscountProduct(sql.FieldLT(FieldID, id))
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v uint64) predicate.DiscountProduct {
+	return predicate.DiscountProduct(sql.FieldNEQ(FieldProductID, v))
 }
 
-// IDLTE applies the LTE predicate on the ID field.
-func IDLTE(id int) predicate.DiscountProduct {
-	return predicate.DiscountProduct(sql.FieldLTE(FieldID, id))
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...uint64) predicate.DiscountProduct {
+	return predicate.DiscountProduct(sql.FieldIn(FieldProductID, vs...))
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...uint64) predicate.DiscountProduct {
+	return predicate.DiscountProduct(sql.FieldNotIn(FieldProductID, vs...))
 }
 
 // HasDiscount applies the HasEdge predicate on the "discount" edge.
 func HasDiscount() predicate.DiscountProduct {
 	return predicate.DiscountProduct(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, DiscountTable, DiscountColumn),
+			sqlgraph.From(Table, DiscountColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, DiscountTable, DiscountColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -80,8 +85,8 @@ func HasDiscountWith(preds ...predicate.Discount) predicate.DiscountProduct {
 func HasProduct() predicate.DiscountProduct {
 	return predicate.DiscountProduct(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, ProductTable, ProductColumn),
+			sqlgraph.From(Table, ProductColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, ProductTable, ProductColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -4,6 +4,7 @@ package gen
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -20,17 +21,15 @@ type DiscountProductCreate struct {
 	hooks    []Hook
 }
 
-// SetDiscountID sets the "discount" edge to the Discount entity by ID.
-func (dpc *DiscountProductCreate) SetDiscountID(id uint64) *DiscountProductCreate {
-	dpc.mutation.SetDiscountID(id)
+// SetDiscountID sets the "discount_id" field.
+func (dpc *DiscountProductCreate) SetDiscountID(u uint64) *DiscountProductCreate {
+	dpc.mutation.SetDiscountID(u)
 	return dpc
 }
 
-// SetNillableDiscountID sets the "discount" edge to the Discount entity by ID if the given value is not nil.
-func (dpc *DiscountProductCreate) SetNillableDiscountID(id *uint64) *DiscountProductCreate {
-	if id != nil {
-		dpc = dpc.SetDiscountID(*id)
-	}
+// SetProductID sets the "product_id" field.
+func (dpc *DiscountProductCreate) SetProductID(u uint64) *DiscountProductCreate {
+	dpc.mutation.SetProductID(u)
 	return dpc
 }
 
@@ -39,20 +38,6 @@ func (dpc *DiscountProductCreate) SetDiscount(d *Discount) *DiscountProductCreat
 	return dpc.SetDiscountID(d.ID)
 }
 
-// SetProductID sets the "product" edge to the Product entity by ID.
-func (dpc *DiscountProductCreate) SetProductID(id uint64) *DiscountProductCreate {
-	dpc.mutation.SetProductID(id)
-	return dpc
-}
-
-// SetNillableProductID sets the "product" edge to the Product entity by ID if the given value is not nil.
-func (dpc *DiscountProductCreate) SetNillableProductID(id *uint64) *DiscountProductCreate {
-	if id != nil {
-		dpc = dpc.SetProductID(*id)
-	}
-	return dpc
-}
-
 // SetProduct sets the "product" edge to the Product entity.
 func (dpc *DiscountProductCreate) SetProduct(p *Product) *DiscountProductCreate {
 	return dpc.SetProductID(p.ID)
@@ -92,6 +77,18 @@ func (dpc *DiscountProductCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (dpc *DiscountProductCreate) check() error {
+	if _, ok := dpc.mutation.DiscountID(); !ok {
+		return &ValidationError{Name: "discount_id", err: errors.New(`gen: missing required field "DiscountProduct.discount_id"`)}
+	}
+	if _, ok := dpc.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`gen: missing required field "DiscountProduct.product_id"`)}
+	}
+	if len(dpc.mutation.DiscountIDs()) == 0 {
+		return &ValidationError{Name: "discount", err: errors.New(`gen: missing required edge "DiscountProduct.discount"`)}
+	}
+	if len(dpc.mutation.ProductIDs()) == 0 {
+		return &ValidationError{Name: "product", err: errors.New(`gen: missing required edge "DiscountProduct.product"`)}
+	}
 	return nil
 }
 
@@ -106,22 +103,18 @@ func (dpc *DiscountProductCreate) sqlSave(ctx context.Context) (*DiscountProduct
 		}
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	_node.ID = int(id)
-	dpc.mutation.id = &_node.ID
-	dpc.mutation.done = true
 	return _node, nil
 }
 
 func (dpc *DiscountProductCreate) createSpec() (*DiscountProduct, *sqlgraph.CreateSpec) {
 	var (
 		_node = &DiscountProduct{config: dpc.config}
-		_spec = sqlgraph.NewCreateSpec(discountproduct.Table, sqlgraph.NewFieldSpec(discountproduct.FieldID, field.TypeInt))
+		_spec = sqlgraph.NewCreateSpec(discountproduct.Table, nil)
 	)
 	if nodes := dpc.mutation.DiscountIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   discountproduct.DiscountTable,
 			Columns: []string{discountproduct.DiscountColumn},
 			Bidi:    false,
@@ -132,13 +125,13 @@ func (dpc *DiscountProductCreate) createSpec() (*DiscountProduct, *sqlgraph.Crea
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.discount_products = &nodes[0]
+		_node.DiscountID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := dpc.mutation.ProductIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   discountproduct.ProductTable,
 			Columns: []string{discountproduct.ProductColumn},
 			Bidi:    false,
@@ -149,7 +142,7 @@ func (dpc *DiscountProductCreate) createSpec() (*DiscountProduct, *sqlgraph.Crea
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.product_discounts = &nodes[0]
+		_node.ProductID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
@@ -198,11 +191,6 @@ func (dpcb *DiscountProductCreateBulk) Save(ctx context.Context) ([]*DiscountPro
 				if err != nil {
 					return nil, err
 				}
-				mutation.id = &nodes[i].ID
-				if specs[i].ID.Value != nil {
-					id := specs[i].ID.Value.(int64)
-					nodes[i].ID = int(id)
-				}
 				mutation.done = true
 				return nodes[i], nil
 			})
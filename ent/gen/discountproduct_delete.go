@@ -7,7 +7,6 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
-	"entgo.io/ent/schema/field"
 	"github.com/thang1834/go-goss/ent/gen/discountproduct"
 	"github.com/thang1834/go-goss/ent/gen/predicate"
 )
@@ -40,7 +39,7 @@ func (dpd *DiscountProductDelete) ExecX(ctx context.Context) int {
 }
 
 func (dpd *DiscountProductDelete) sqlExec(ctx context.Context) (int, error) {
-	_spec := sqlgraph.NewDeleteSpec(discountproduct.Table, sqlgraph.NewFieldSpec(discountproduct.FieldID, field.TypeInt))
+	_spec := sqlgraph.NewDeleteSpec(discountproduct.Table, nil)
 	if ps := dpd.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
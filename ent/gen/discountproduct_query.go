@@ -8,9 +8,9 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
-	"entgo.io/ent/schema/field"
 	"github.com/thang1834/go-goss/ent/gen/discount"
 	"github.com/thang1834/go-goss/ent/gen/discountproduct"
 	"github.com/thang1834/go-goss/ent/gen/predicate"
@@ -26,7 +26,7 @@ type DiscountProductQuery struct {
 	predicates   []predicate.DiscountProduct
 	withDiscount *DiscountQuery
 	withProduct  *ProductQuery
-	withFKs      bool
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -75,9 +75,9 @@ func (dpq *DiscountProductQuery) QueryDiscount() *DiscountQuery {
 			return nil, err
 		}
 		step := sqlgraph.NewStep(
-			sqlgraph.From(discountproduct.Table, discountproduct.FieldID, selector),
+			sqlgraph.From(discountproduct.Table, discountproduct.DiscountColumn, selector),
 			sqlgraph.To(discount.Table, discount.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, discountproduct.DiscountTable, discountproduct.DiscountColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, discountproduct.DiscountTable, discountproduct.DiscountColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(dpq.driver.Dialect(), step)
 		return fromU, nil
@@ -97,9 +97,9 @@ func (dpq *DiscountProductQuery) QueryProduct() *ProductQuery {
 			return nil, err
 		}
 		step := sqlgraph.NewStep(
-			sqlgraph.From(discountproduct.Table, discountproduct.FieldID, selector),
+			sqlgraph.From(discountproduct.Table, discountproduct.ProductColumn, selector),
 			sqlgraph.To(product.Table, product.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, discountproduct.ProductTable, discountproduct.ProductColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, discountproduct.ProductTable, discountproduct.ProductColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(dpq.driver.Dialect(), step)
 		return fromU, nil
@@ -129,29 +129,6 @@ func (dpq *DiscountProductQuery) FirstX(ctx context.Context) *DiscountProduct {
 	return node
 }
 
-// FirstID returns the first DiscountProduct ID from the query.
-// Returns a *NotFoundError when no DiscountProduct ID was found.
-func (dpq *DiscountProductQuery) FirstID(ctx context.Context) (id int, err error) {
-	var ids []int
-	if ids, err = dpq.Limit(1).IDs(setContextOp(ctx, dpq.ctx, ent.OpQueryFirstID)); err != nil {
-		return
-	}
-	if len(ids) == 0 {
-		err = &NotFoundError{discountproduct.Label}
-		return
-	}
-	return ids[0], nil
-}
-
-// FirstIDX is like FirstID, but panics if an error occurs.
-func (dpq *DiscountProductQuery) FirstIDX(ctx context.Context) int {
-	id, err := dpq.FirstID(ctx)
-	if err != nil && !IsNotFound(err) {
-		panic(err)
-	}
-	return id
-}
-
 // Only returns a single DiscountProduct entity found by the query, ensuring it only returns one.
 // Returns a *NotSingularError when more than one DiscountProduct entity is found.
 // Returns a *NotFoundError when no DiscountProduct entities are found.
@@ -179,34 +156,6 @@ func (dpq *DiscountProductQuery) OnlyX(ctx context.Context) *DiscountProduct {
 	return node
 }
 
-// OnlyID is like Only, but returns the only DiscountProduct ID in the query.
-// Returns a *NotSingularError when more than one DiscountProduct ID is found.
-// Returns a *NotFoundError when no entities are found.
-func (dpq *DiscountProductQuery) OnlyID(ctx context.Context) (id int, err error) {
-	var ids []int
-	if ids, err = dpq.Limit(2).IDs(setContextOp(ctx, dpq.ctx, ent.OpQueryOnlyID)); err != nil {
-		return
-	}
-	switch len(ids) {
-	case 1:
-		id = ids[0]
-	case 0:
-		err = &NotFoundError{discountproduct.Label}
-	default:
-		err = &NotSingularError{discountproduct.Label}
-	}
-	return
-}
-
-// OnlyIDX is like OnlyID, but panics if an error occurs.
-func (dpq *DiscountProductQuery) OnlyIDX(ctx context.Context) int {
-	id, err := dpq.OnlyID(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return id
-}
-
 // All executes the query and returns a list of DiscountProducts.
 func (dpq *DiscountProductQuery) All(ctx context.Context) ([]*DiscountProduct, error) {
 	ctx = setContextOp(ctx, dpq.ctx, ent.OpQueryAll)
@@ -226,27 +175,6 @@ func (dpq *DiscountProductQuery) AllX(ctx context.Context) []*DiscountProduct {
 	return nodes
 }
 
-// IDs executes the query and returns a list of DiscountProduct IDs.
-func (dpq *DiscountProductQuery) IDs(ctx context.Context) (ids []int, err error) {
-	if dpq.ctx.Unique == nil && dpq.path != nil {
-		dpq.Unique(true)
-	}
-	ctx = setContextOp(ctx, dpq.ctx, ent.OpQueryIDs)
-	if err = dpq.Select(discountproduct.FieldID).Scan(ctx, &ids); err != nil {
-		return nil, err
-	}
-	return ids, nil
-}
-
-// IDsX is like IDs, but panics if an error occurs.
-func (dpq *DiscountProductQuery) IDsX(ctx context.Context) []int {
-	ids, err := dpq.IDs(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return ids
-}
-
 // Count returns the count of the given query.
 func (dpq *DiscountProductQuery) Count(ctx context.Context) (int, error) {
 	ctx = setContextOp(ctx, dpq.ctx, ent.OpQueryCount)
@@ -268,7 +196,7 @@ func (dpq *DiscountProductQuery) CountX(ctx context.Context) int {
 // Exist returns true if the query has elements in the graph.
 func (dpq *DiscountProductQuery) Exist(ctx context.Context) (bool, error) {
 	ctx = setContextOp(ctx, dpq.ctx, ent.OpQueryExist)
-	switch _, err := dpq.FirstID(ctx); {
+	switch _, err := dpq.First(ctx); {
 	case IsNotFound(err):
 		return false, nil
 	case err != nil:
@@ -331,6 +259,18 @@ func (dpq *DiscountProductQuery) WithProduct(opts ...func(*ProductQuery)) *Disco
 
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		DiscountID uint64 `json:"discount_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DiscountProduct.Query().
+//		GroupBy(discountproduct.FieldDiscountID).
+//		Aggregate(gen.Count()).
+//		Scan(ctx, &v)
 func (dpq *DiscountProductQuery) GroupBy(field string, fields ...string) *DiscountProductGroupBy {
 	dpq.ctx.Fields = append([]string{field}, fields...)
 	grbuild := &DiscountProductGroupBy{build: dpq}
@@ -342,6 +282,16 @@ func (dpq *DiscountProductQuery) GroupBy(field string, fields ...string) *Discou
 
 // Select allows the selection one or more fields/columns for the given query,
 // instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		DiscountID uint64 `json:"discount_id,omitempty"`
+//	}
+//
+//	client.DiscountProduct.Query().
+//		Select(discountproduct.FieldDiscountID).
+//		Scan(ctx, &v)
 func (dpq *DiscountProductQuery) Select(fields ...string) *DiscountProductSelect {
 	dpq.ctx.Fields = append(dpq.ctx.Fields, fields...)
 	sbuild := &DiscountProductSelect{DiscountProductQuery: dpq}
@@ -384,19 +334,12 @@ func (dpq *DiscountProductQuery) prepareQuery(ctx context.Context) error {
 func (dpq *DiscountProductQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DiscountProduct, error) {
 	var (
 		nodes       = []*DiscountProduct{}
-		withFKs     = dpq.withFKs
 		_spec       = dpq.querySpec()
 		loadedTypes = [2]bool{
 			dpq.withDiscount != nil,
 			dpq.withProduct != nil,
 		}
 	)
-	if dpq.withDiscount != nil || dpq.withProduct != nil {
-		withFKs = true
-	}
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, discountproduct.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*DiscountProduct).scanValues(nil, columns)
 	}
@@ -406,6 +349,9 @@ func (dpq *DiscountProductQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(dpq.modifiers) > 0 {
+		_spec.Modifiers = dpq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -434,10 +380,7 @@ func (dpq *DiscountProductQuery) loadDiscount(ctx context.Context, query *Discou
 	ids := make([]uint64, 0, len(nodes))
 	nodeids := make(map[uint64][]*DiscountProduct)
 	for i := range nodes {
-		if nodes[i].discount_products == nil {
-			continue
-		}
-		fk := *nodes[i].discount_products
+		fk := nodes[i].DiscountID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -454,7 +397,7 @@ func (dpq *DiscountProductQuery) loadDiscount(ctx context.Context, query *Discou
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "discount_products" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "discount_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -466,10 +409,7 @@ func (dpq *DiscountProductQuery) loadProduct(ctx context.Context, query *Product
 	ids := make([]uint64, 0, len(nodes))
 	nodeids := make(map[uint64][]*DiscountProduct)
 	for i := range nodes {
-		if nodes[i].product_discounts == nil {
-			continue
-		}
-		fk := *nodes[i].product_discounts
+		fk := nodes[i].ProductID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -486,7 +426,7 @@ func (dpq *DiscountProductQuery) loadProduct(ctx context.Context, query *Product
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "product_discounts" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "product_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -497,15 +437,16 @@ func (dpq *DiscountProductQuery) loadProduct(ctx context.Context, query *Product
 
 func (dpq *DiscountProductQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := dpq.querySpec()
-	_spec.Node.Columns = dpq.ctx.Fields
-	if len(dpq.ctx.Fields) > 0 {
-		_spec.Unique = dpq.ctx.Unique != nil && *dpq.ctx.Unique
+	if len(dpq.modifiers) > 0 {
+		_spec.Modifiers = dpq.modifiers
 	}
+	_spec.Unique = false
+	_spec.Node.Columns = nil
 	return sqlgraph.CountNodes(ctx, dpq.driver, _spec)
 }
 
 func (dpq *DiscountProductQuery) querySpec() *sqlgraph.QuerySpec {
-	_spec := sqlgraph.NewQuerySpec(discountproduct.Table, discountproduct.Columns, sqlgraph.NewFieldSpec(discountproduct.FieldID, field.TypeInt))
+	_spec := sqlgraph.NewQuerySpec(discountproduct.Table, discountproduct.Columns, nil)
 	_spec.From = dpq.sql
 	if unique := dpq.ctx.Unique; unique != nil {
 		_spec.Unique = *unique
@@ -514,11 +455,14 @@ func (dpq *DiscountProductQuery) querySpec() *sqlgraph.QuerySpec {
 	}
 	if fields := dpq.ctx.Fields; len(fields) > 0 {
 		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, discountproduct.FieldID)
 		for i := range fields {
-			if fields[i] != discountproduct.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
-			}
+			_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+		}
+		if dpq.withDiscount != nil {
+			_spec.Node.AddColumnOnce(discountproduct.FieldDiscountID)
+		}
+		if dpq.withProduct != nil {
+			_spec.Node.AddColumnOnce(discountproduct.FieldProductID)
 		}
 	}
 	if ps := dpq.predicates; len(ps) > 0 {
@@ -559,6 +503,9 @@ func (dpq *DiscountProductQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if dpq.ctx.Unique != nil && *dpq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range dpq.modifiers {
+		m(selector)
+	}
 	for _, p := range dpq.predicates {
 		p(selector)
 	}
@@ -576,6 +523,32 @@ func (dpq *DiscountProductQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (dpq *DiscountProductQuery) ForUpdate(opts ...sql.LockOption) *DiscountProductQuery {
+	if dpq.driver.Dialect() == dialect.Postgres {
+		dpq.Unique(false)
+	}
+	dpq.modifiers = append(dpq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return dpq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (dpq *DiscountProductQuery) ForShare(opts ...sql.LockOption) *DiscountProductQuery {
+	if dpq.driver.Dialect() == dialect.Postgres {
+		dpq.Unique(false)
+	}
+	dpq.modifiers = append(dpq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return dpq
+}
+
 // DiscountProductGroupBy is the group-by builder for DiscountProduct entities.
 type DiscountProductGroupBy struct {
 	selector
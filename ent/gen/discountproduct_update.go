@@ -29,39 +29,39 @@ func (dpu *DiscountProductUpdate) Where(ps ...predicate.DiscountProduct) *Discou
 	return dpu
 }
 
-// SetDiscountID sets the "discount" edge to the Discount entity by ID.
-func (dpu *DiscountProductUpdate) SetDiscountID(id uint64) *DiscountProductUpdate {
-	dpu.mutation.SetDiscountID(id)
+// SetDiscountID sets the "discount_id" field.
+func (dpu *DiscountProductUpdate) SetDiscountID(u uint64) *DiscountProductUpdate {
+	dpu.mutation.SetDiscountID(u)
 	return dpu
 }
 
-// SetNillableDiscountID sets the "discount" edge to the Discount entity by ID if the given value is not nil.
-func (dpu *DiscountProductUpdate) SetNillableDiscountID(id *uint64) *DiscountProductUpdate {
-	if id != nil {
-		dpu = dpu.SetDiscountID(*id)
+// SetNillableDiscountID sets the "discount_id" field if the given value is not nil.
+func (dpu *DiscountProductUpdate) SetNillableDiscountID(u *uint64) *DiscountProductUpdate {
+	if u != nil {
+		dpu.SetDiscountID(*u)
 	}
 	return dpu
 }
 
-// SetDiscount sets the "discount" edge to the Discount entity.
-func (dpu *DiscountProductUpdate) SetDiscount(d *Discount) *DiscountProductUpdate {
-	return dpu.SetDiscountID(d.ID)
-}
-
-// SetProductID sets the "product" edge to the Product entity by ID.
-func (dpu *DiscountProductUpdate) SetProductID(id uint64) *DiscountProductUpdate {
-	dpu.mutation.SetProductID(id)
+// SetProductID sets the "product_id" field.
+func (dpu *DiscountProductUpdate) SetProductID(u uint64) *DiscountProductUpdate {
+	dpu.mutation.SetProductID(u)
 	return dpu
 }
 
-// SetNillableProductID sets the "product" edge to the Product entity by ID if the given value is not nil.
-func (dpu *DiscountProductUpdate) SetNillableProductID(id *uint64) *DiscountProductUpdate {
-	if id != nil {
-		dpu = dpu.SetProductID(*id)
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (dpu *DiscountProductUpdate) SetNillableProductID(u *uint64) *DiscountProductUpdate {
+	if u != nil {
+		dpu.SetProductID(*u)
 	}
 	return dpu
 }
 
+// SetDiscount sets the "discount" edge to the Discount entity.
+func (dpu *DiscountProductUpdate) SetDiscount(d *Discount) *DiscountProductUpdate {
+	return dpu.SetDiscountID(d.ID)
+}
+
 // SetProduct sets the "product" edge to the Product entity.
 func (dpu *DiscountProductUpdate) SetProduct(p *Product) *DiscountProductUpdate {
 	return dpu.SetProductID(p.ID)
@@ -111,8 +111,22 @@ func (dpu *DiscountProductUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (dpu *DiscountProductUpdate) check() error {
+	if dpu.mutation.DiscountCleared() && len(dpu.mutation.DiscountIDs()) > 0 {
+		return errors.New(`gen: clearing a required unique edge "DiscountProduct.discount"`)
+	}
+	if dpu.mutation.ProductCleared() && len(dpu.mutation.ProductIDs()) > 0 {
+		return errors.New(`gen: clearing a required unique edge "DiscountProduct.product"`)
+	}
+	return nil
+}
+
 func (dpu *DiscountProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	_spec := sqlgraph.NewUpdateSpec(discountproduct.Table, discountproduct.Columns, sqlgraph.NewFieldSpec(discountproduct.FieldID, field.TypeInt))
+	if err := dpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(discountproduct.Table, discountproduct.Columns, sqlgraph.NewFieldSpec(discountproduct.FieldDiscountID, field.TypeUint64), sqlgraph.NewFieldSpec(discountproduct.FieldProductID, field.TypeUint64))
 	if ps := dpu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -123,7 +137,7 @@ func (dpu *DiscountProductUpdate) sqlSave(ctx context.Context) (n int, err error
 	if dpu.mutation.DiscountCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   discountproduct.DiscountTable,
 			Columns: []string{discountproduct.DiscountColumn},
 			Bidi:    false,
@@ -136,7 +150,7 @@ func (dpu *DiscountProductUpdate) sqlSave(ctx context.Context) (n int, err error
 	if nodes := dpu.mutation.DiscountIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   discountproduct.DiscountTable,
 			Columns: []string{discountproduct.DiscountColumn},
 			Bidi:    false,
@@ -152,7 +166,7 @@ func (dpu *DiscountProductUpdate) sqlSave(ctx context.Context) (n int, err error
 	if dpu.mutation.ProductCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   discountproduct.ProductTable,
 			Columns: []string{discountproduct.ProductColumn},
 			Bidi:    false,
@@ -165,7 +179,7 @@ func (dpu *DiscountProductUpdate) sqlSave(ctx context.Context) (n int, err error
 	if nodes := dpu.mutation.ProductIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   discountproduct.ProductTable,
 			Columns: []string{discountproduct.ProductColumn},
 			Bidi:    false,
@@ -198,39 +212,39 @@ type DiscountProductUpdateOne struct {
 	mutation *DiscountProductMutation
 }
 
-// SetDiscountID sets the "discount" edge to the Discount entity by ID.
-func (dpuo *DiscountProductUpdateOne) SetDiscountID(id uint64) *DiscountProductUpdateOne {
-	dpuo.mutation.SetDiscountID(id)
+// SetDiscountID sets the "discount_id" field.
+func (dpuo *DiscountProductUpdateOne) SetDiscountID(u uint64) *DiscountProductUpdateOne {
+	dpuo.mutation.SetDiscountID(u)
 	return dpuo
 }
 
-// SetNillableDiscountID sets the "discount" edge to the Discount entity by ID if the given value is not nil.
-func (dpuo *DiscountProductUpdateOne) SetNillableDiscountID(id *uint64) *DiscountProductUpdateOne {
-	if id != nil {
-		dpuo = dpuo.SetDiscountID(*id)
+// SetNillableDiscountID sets the "discount_id" field if the given value is not nil.
+func (dpuo *DiscountProductUpdateOne) SetNillableDiscountID(u *uint64) *DiscountProductUpdateOne {
+	if u != nil {
+		dpuo.SetDiscountID(*u)
 	}
 	return dpuo
 }
 
-// SetDiscount sets the "discount" edge to the Discount entity.
-func (dpuo *DiscountProductUpdateOne) SetDiscount(d *Discount) *DiscountProductUpdateOne {
-	return dpuo.SetDiscountID(d.ID)
-}
-
-// SetProductID sets the "product" edge to the Product entity by ID.
-func (dpuo *DiscountProductUpdateOne) SetProductID(id uint64) *DiscountProductUpdateOne {
-	dpuo.mutation.SetProductID(id)
+// SetProductID sets the "product_id" field.
+func (dpuo *DiscountProductUpdateOne) SetProductID(u uint64) *DiscountProductUpdateOne {
+	dpuo.mutation.SetProductID(u)
 	return dpuo
 }
 
-// SetNillableProductID sets the "product" edge to the Product entity by ID if the given value is not nil.
-func (dpuo *DiscountProductUpdateOne) SetNillableProductID(id *uint64) *DiscountProductUpdateOne {
-	if id != nil {
-		dpuo = dpuo.SetProductID(*id)
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (dpuo *DiscountProductUpdateOne) SetNillableProductID(u *uint64) *DiscountProductUpdateOne {
+	if u != nil {
+		dpuo.SetProductID(*u)
 	}
 	return dpuo
 }
 
+// SetDiscount sets the "discount" edge to the Discount entity.
+func (dpuo *DiscountProductUpdateOne) SetDiscount(d *Discount) *DiscountProductUpdateOne {
+	return dpuo.SetDiscountID(d.ID)
+}
+
 // SetProduct sets the "product" edge to the Product entity.
 func (dpuo *DiscountProductUpdateOne) SetProduct(p *Product) *DiscountProductUpdateOne {
 	return dpuo.SetProductID(p.ID)
@@ -293,23 +307,39 @@ func (dpuo *DiscountProductUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (dpuo *DiscountProductUpdateOne) check() error {
+	if dpuo.mutation.DiscountCleared() && len(dpuo.mutation.DiscountIDs()) > 0 {
+		return errors.New(`gen: clearing a required unique edge "DiscountProduct.discount"`)
+	}
+	if dpuo.mutation.ProductCleared() && len(dpuo.mutation.ProductIDs()) > 0 {
+		return errors.New(`gen: clearing a required unique edge "DiscountProduct.product"`)
+	}
+	return nil
+}
+
 func (dpuo *DiscountProductUpdateOne) sqlSave(ctx context.Context) (_node *DiscountProduct, err error) {
-	_spec := sqlgraph.NewUpdateSpec(discountproduct.Table, discountproduct.Columns, sqlgraph.NewFieldSpec(discountproduct.FieldID, field.TypeInt))
-	id, ok := dpuo.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`gen: missing "DiscountProduct.id" for update`)}
+	if err := dpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(discountproduct.Table, discountproduct.Columns, sqlgraph.NewFieldSpec(discountproduct.FieldDiscountID, field.TypeUint64), sqlgraph.NewFieldSpec(discountproduct.FieldProductID, field.TypeUint64))
+	if id, ok := dpuo.mutation.DiscountID(); !ok {
+		return nil, &ValidationError{Name: "discount_id", err: errors.New(`gen: missing "DiscountProduct.discount_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[0].Value = id
+	}
+	if id, ok := dpuo.mutation.ProductID(); !ok {
+		return nil, &ValidationError{Name: "product_id", err: errors.New(`gen: missing "DiscountProduct.product_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[1].Value = id
 	}
-	_spec.Node.ID.Value = id
 	if fields := dpuo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, discountproduct.FieldID)
-		for _, f := range fields {
+		_spec.Node.Columns = make([]string, len(fields))
+		for i, f := range fields {
 			if !discountproduct.ValidColumn(f) {
 				return nil, &ValidationError{Name: f, err: fmt.Errorf("gen: invalid field %q for query", f)}
 			}
-			if f != discountproduct.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+			_spec.Node.Columns[i] = f
 		}
 	}
 	if ps := dpuo.mutation.predicates; len(ps) > 0 {
@@ -322,7 +352,7 @@ func (dpuo *DiscountProductUpdateOne) sqlSave(ctx context.Context) (_node *Disco
 	if dpuo.mutation.DiscountCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   discountproduct.DiscountTable,
 			Columns: []string{discountproduct.DiscountColumn},
 			Bidi:    false,
@@ -335,7 +365,7 @@ func (dpuo *DiscountProductUpdateOne) sqlSave(ctx context.Context) (_node *Disco
 	if nodes := dpuo.mutation.DiscountIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   discountproduct.DiscountTable,
 			Columns: []string{discountproduct.DiscountColumn},
 			Bidi:    false,
@@ -351,7 +381,7 @@ func (dpuo *DiscountProductUpdateOne) sqlSave(ctx context.Context) (_node *Disco
 	if dpuo.mutation.ProductCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   discountproduct.ProductTable,
 			Columns: []string{discountproduct.ProductColumn},
 			Bidi:    false,
@@ -364,7 +394,7 @@ func (dpuo *DiscountProductUpdateOne) sqlSave(ctx context.Context) (_node *Disco
 	if nodes := dpuo.mutation.ProductIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   discountproduct.ProductTable,
 			Columns: []string{discountproduct.ProductColumn},
 			Bidi:    false,
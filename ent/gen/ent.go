@@ -12,26 +12,35 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/thang1834/go-goss/ent/gen/apikey"
+	"github.com/thang1834/go-goss/ent/gen/auditlog"
 	"github.com/thang1834/go-goss/ent/gen/cart"
 	"github.com/thang1834/go-goss/ent/gen/cartitem"
 	"github.com/thang1834/go-goss/ent/gen/category"
 	"github.com/thang1834/go-goss/ent/gen/discount"
 	"github.com/thang1834/go-goss/ent/gen/discountcategory"
 	"github.com/thang1834/go-goss/ent/gen/discountproduct"
+	"github.com/thang1834/go-goss/ent/gen/idempotencykey"
+	"github.com/thang1834/go-goss/ent/gen/loginattempt"
 	"github.com/thang1834/go-goss/ent/gen/order"
 	"github.com/thang1834/go-goss/ent/gen/orderitem"
+	"github.com/thang1834/go-goss/ent/gen/orderstatushistory"
 	"github.com/thang1834/go-goss/ent/gen/payment"
 	"github.com/thang1834/go-goss/ent/gen/permission"
 	"github.com/thang1834/go-goss/ent/gen/product"
 	"github.com/thang1834/go-goss/ent/gen/productimage"
+	"github.com/thang1834/go-goss/ent/gen/recoverycode"
 	"github.com/thang1834/go-goss/ent/gen/review"
 	"github.com/thang1834/go-goss/ent/gen/role"
 	"github.com/thang1834/go-goss/ent/gen/rolepermission"
 	"github.com/thang1834/go-goss/ent/gen/session"
 	"github.com/thang1834/go-goss/ent/gen/user"
+	"github.com/thang1834/go-goss/ent/gen/useridentity"
 	"github.com/thang1834/go-goss/ent/gen/userpermission"
 	"github.com/thang1834/go-goss/ent/gen/userrole"
+	"github.com/thang1834/go-goss/ent/gen/usertoken"
 	"github.com/thang1834/go-goss/ent/gen/uservoucher"
+	"github.com/thang1834/go-goss/ent/gen/webhookevent"
 	"github.com/thang1834/go-goss/ent/gen/wishlist"
 	"github.com/thang1834/go-goss/ent/gen/wishlistitem"
 )
@@ -94,28 +103,37 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			cart.Table:             cart.ValidColumn,
-			cartitem.Table:         cartitem.ValidColumn,
-			category.Table:         category.ValidColumn,
-			discount.Table:         discount.ValidColumn,
-			discountcategory.Table: discountcategory.ValidColumn,
-			discountproduct.Table:  discountproduct.ValidColumn,
-			order.Table:            order.ValidColumn,
-			orderitem.Table:        orderitem.ValidColumn,
-			payment.Table:          payment.ValidColumn,
-			permission.Table:       permission.ValidColumn,
-			product.Table:          product.ValidColumn,
-			productimage.Table:     productimage.ValidColumn,
-			review.Table:           review.ValidColumn,
-			role.Table:             role.ValidColumn,
-			rolepermission.Table:   rolepermission.ValidColumn,
-			session.Table:          session.ValidColumn,
-			user.Table:             user.ValidColumn,
-			userpermission.Table:   userpermission.ValidColumn,
-			userrole.Table:         userrole.ValidColumn,
-			uservoucher.Table:      uservoucher.ValidColumn,
-			wishlist.Table:         wishlist.ValidColumn,
-			wishlistitem.Table:     wishlistitem.ValidColumn,
+			apikey.Table:             apikey.ValidColumn,
+			auditlog.Table:           auditlog.ValidColumn,
+			cart.Table:               cart.ValidColumn,
+			cartitem.Table:           cartitem.ValidColumn,
+			category.Table:           category.ValidColumn,
+			discount.Table:           discount.ValidColumn,
+			discountcategory.Table:   discountcategory.ValidColumn,
+			discountproduct.Table:    discountproduct.ValidColumn,
+			idempotencykey.Table:     idempotencykey.ValidColumn,
+			loginattempt.Table:       loginattempt.ValidColumn,
+			order.Table:              order.ValidColumn,
+			orderitem.Table:          orderitem.ValidColumn,
+			orderstatushistory.Table: orderstatushistory.ValidColumn,
+			payment.Table:            payment.ValidColumn,
+			permission.Table:         permission.ValidColumn,
+			product.Table:            product.ValidColumn,
+			productimage.Table:       productimage.ValidColumn,
+			recoverycode.Table:       recoverycode.ValidColumn,
+			review.Table:             review.ValidColumn,
+			role.Table:               role.ValidColumn,
+			rolepermission.Table:     rolepermission.ValidColumn,
+			session.Table:            session.ValidColumn,
+			user.Table:               user.ValidColumn,
+			useridentity.Table:       useridentity.ValidColumn,
+			userpermission.Table:     userpermission.ValidColumn,
+			userrole.Table:           userrole.ValidColumn,
+			usertoken.Table:          usertoken.ValidColumn,
+			uservoucher.Table:        uservoucher.ValidColumn,
+			webhookevent.Table:       webhookevent.ValidColumn,
+			wishlist.Table:           wishlist.ValidColumn,
+			wishlistitem.Table:       wishlistitem.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
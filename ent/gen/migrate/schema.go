@@ -3,17 +3,84 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "prefix", Type: field.TypeString, Size: 20},
+		{Name: "key_hash", Type: field.TypeString, Unique: true},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUint64},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_keys_users_api_keys",
+				Columns:    []*schema.Column{APIKeysColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "actor_id", Type: field.TypeUint64, Nullable: true},
+		{Name: "table_name", Type: field.TypeString, Size: 100},
+		{Name: "table_row_id", Type: field.TypeUint64, Nullable: true},
+		{Name: "action", Type: field.TypeString, Size: 20},
+		{Name: "old_values", Type: field.TypeString, Nullable: true, Size: 2147483647, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "new_values", Type: field.TypeString, Nullable: true, Size: 2147483647, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "diff", Type: field.TypeString, Nullable: true, Size: 2147483647, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "http_method", Type: field.TypeString, Size: 10},
+		{Name: "url", Type: field.TypeString, Size: 2147483647},
+		{Name: "ip_address", Type: field.TypeString, Size: 45},
+		{Name: "user_agent", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_table_name_table_row_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[2], AuditLogsColumns[3]},
+			},
+			{
+				Name:    "auditlog_actor_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1]},
+			},
+			{
+				Name:    "auditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[12]},
+			},
+		},
+	}
 	// CartsColumns holds the columns for the "carts" table.
 	CartsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint64, Increment: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "user_carts", Type: field.TypeUint64, Nullable: true},
+		{Name: "user_id", Type: field.TypeUint64, Nullable: true},
 	}
 	// CartsTable holds the schema information for the "carts" table.
 	CartsTable = &schema.Table{
@@ -28,31 +95,37 @@ var (
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "cart_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{CartsColumns[3]},
+			},
+		},
 	}
 	// CartItemsColumns holds the columns for the "cart_items" table.
 	CartItemsColumns = []*schema.Column{
-		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "quantity", Type: field.TypeInt},
-		{Name: "cart_items", Type: field.TypeUint64, Nullable: true},
-		{Name: "product_cart_items", Type: field.TypeUint64, Nullable: true},
+		{Name: "cart_id", Type: field.TypeUint64},
+		{Name: "product_id", Type: field.TypeUint64},
 	}
 	// CartItemsTable holds the schema information for the "cart_items" table.
 	CartItemsTable = &schema.Table{
 		Name:       "cart_items",
 		Columns:    CartItemsColumns,
-		PrimaryKey: []*schema.Column{CartItemsColumns[0]},
+		PrimaryKey: []*schema.Column{CartItemsColumns[1], CartItemsColumns[2]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "cart_items_carts_items",
-				Columns:    []*schema.Column{CartItemsColumns[2]},
+				Symbol:     "cart_items_carts_cart",
+				Columns:    []*schema.Column{CartItemsColumns[1]},
 				RefColumns: []*schema.Column{CartsColumns[0]},
-				OnDelete:   schema.SetNull,
+				OnDelete:   schema.NoAction,
 			},
 			{
-				Symbol:     "cart_items_products_cart_items",
-				Columns:    []*schema.Column{CartItemsColumns[3]},
+				Symbol:     "cart_items_products_product",
+				Columns:    []*schema.Column{CartItemsColumns[2]},
 				RefColumns: []*schema.Column{ProductsColumns[0]},
-				OnDelete:   schema.SetNull,
+				OnDelete:   schema.NoAction,
 			},
 		},
 	}
@@ -61,15 +134,23 @@ var (
 		{Name: "id", Type: field.TypeUint64, Increment: true},
 		{Name: "name", Type: field.TypeString, Size: 100},
 		{Name: "slug", Type: field.TypeString, Unique: true, Size: 150},
-		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "parent_id", Type: field.TypeUint64, Nullable: true},
 	}
 	// CategoriesTable holds the schema information for the "categories" table.
 	CategoriesTable = &schema.Table{
 		Name:       "categories",
 		Columns:    CategoriesColumns,
 		PrimaryKey: []*schema.Column{CategoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "categories_categories_children",
+				Columns:    []*schema.Column{CategoriesColumns[5]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 	}
 	// DiscountsColumns holds the columns for the "discounts" table.
 	DiscountsColumns = []*schema.Column{
@@ -82,7 +163,7 @@ var (
 		{Name: "end_date", Type: field.TypeTime},
 		{Name: "usage_limit", Type: field.TypeInt, Nullable: true},
 		{Name: "usage_count", Type: field.TypeInt, Default: 0},
-		{Name: "min_order_value", Type: field.TypeInt, Nullable: true},
+		{Name: "min_order_value", Type: field.TypeFloat64, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -94,56 +175,88 @@ var (
 	}
 	// DiscountCategoriesColumns holds the columns for the "discount_categories" table.
 	DiscountCategoriesColumns = []*schema.Column{
-		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "category_discounts", Type: field.TypeUint64, Nullable: true},
-		{Name: "discount_categories", Type: field.TypeUint64, Nullable: true},
+		{Name: "discount_id", Type: field.TypeUint64},
+		{Name: "category_id", Type: field.TypeUint64},
 	}
 	// DiscountCategoriesTable holds the schema information for the "discount_categories" table.
 	DiscountCategoriesTable = &schema.Table{
 		Name:       "discount_categories",
 		Columns:    DiscountCategoriesColumns,
-		PrimaryKey: []*schema.Column{DiscountCategoriesColumns[0]},
+		PrimaryKey: []*schema.Column{DiscountCategoriesColumns[0], DiscountCategoriesColumns[1]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "discount_categories_categories_discounts",
-				Columns:    []*schema.Column{DiscountCategoriesColumns[1]},
-				RefColumns: []*schema.Column{CategoriesColumns[0]},
-				OnDelete:   schema.SetNull,
+				Symbol:     "discount_categories_discounts_discount",
+				Columns:    []*schema.Column{DiscountCategoriesColumns[0]},
+				RefColumns: []*schema.Column{DiscountsColumns[0]},
+				OnDelete:   schema.NoAction,
 			},
 			{
-				Symbol:     "discount_categories_discounts_categories",
-				Columns:    []*schema.Column{DiscountCategoriesColumns[2]},
-				RefColumns: []*schema.Column{DiscountsColumns[0]},
-				OnDelete:   schema.SetNull,
+				Symbol:     "discount_categories_categories_category",
+				Columns:    []*schema.Column{DiscountCategoriesColumns[1]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.NoAction,
 			},
 		},
 	}
 	// DiscountProductsColumns holds the columns for the "discount_products" table.
 	DiscountProductsColumns = []*schema.Column{
-		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "discount_products", Type: field.TypeUint64, Nullable: true},
-		{Name: "product_discounts", Type: field.TypeUint64, Nullable: true},
+		{Name: "discount_id", Type: field.TypeUint64},
+		{Name: "product_id", Type: field.TypeUint64},
 	}
 	// DiscountProductsTable holds the schema information for the "discount_products" table.
 	DiscountProductsTable = &schema.Table{
 		Name:       "discount_products",
 		Columns:    DiscountProductsColumns,
-		PrimaryKey: []*schema.Column{DiscountProductsColumns[0]},
+		PrimaryKey: []*schema.Column{DiscountProductsColumns[0], DiscountProductsColumns[1]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "discount_products_discounts_products",
-				Columns:    []*schema.Column{DiscountProductsColumns[1]},
+				Symbol:     "discount_products_discounts_discount",
+				Columns:    []*schema.Column{DiscountProductsColumns[0]},
 				RefColumns: []*schema.Column{DiscountsColumns[0]},
-				OnDelete:   schema.SetNull,
+				OnDelete:   schema.NoAction,
 			},
 			{
-				Symbol:     "discount_products_products_discounts",
-				Columns:    []*schema.Column{DiscountProductsColumns[2]},
+				Symbol:     "discount_products_products_product",
+				Columns:    []*schema.Column{DiscountProductsColumns[1]},
 				RefColumns: []*schema.Column{ProductsColumns[0]},
-				OnDelete:   schema.SetNull,
+				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// IdempotencyKeysColumns holds the columns for the "idempotency_keys" table.
+	IdempotencyKeysColumns = []*schema.Column{
+		{Name: "key", Type: field.TypeString},
+		{Name: "fingerprint", Type: field.TypeString, Size: 64},
+		{Name: "status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "header", Type: field.TypeJSON, Nullable: true},
+		{Name: "body", Type: field.TypeBytes, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// IdempotencyKeysTable holds the schema information for the "idempotency_keys" table.
+	IdempotencyKeysTable = &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    IdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{IdempotencyKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyKeysColumns[5]},
+			},
+		},
+	}
+	// LoginAttemptsColumns holds the columns for the "login_attempts" table.
+	LoginAttemptsColumns = []*schema.Column{
+		{Name: "key", Type: field.TypeString},
+		{Name: "failures", Type: field.TypeInt},
+		{Name: "last_failed_at", Type: field.TypeTime},
+	}
+	// LoginAttemptsTable holds the schema information for the "login_attempts" table.
+	LoginAttemptsTable = &schema.Table{
+		Name:       "login_attempts",
+		Columns:    LoginAttemptsColumns,
+		PrimaryKey: []*schema.Column{LoginAttemptsColumns[0]},
+	}
 	// OrdersColumns holds the columns for the "orders" table.
 	OrdersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint64, Increment: true},
@@ -151,9 +264,11 @@ var (
 		{Name: "total_price", Type: field.TypeFloat64, Default: 0},
 		{Name: "payment_method", Type: field.TypeString, Nullable: true},
 		{Name: "shipping_address", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "discount_amount", Type: field.TypeFloat64, Default: 0},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "user_orders", Type: field.TypeUint64, Nullable: true},
+		{Name: "discount_id", Type: field.TypeUint64, Nullable: true},
+		{Name: "user_id", Type: field.TypeUint64, Nullable: true},
 	}
 	// OrdersTable holds the schema information for the "orders" table.
 	OrdersTable = &schema.Table{
@@ -161,9 +276,15 @@ var (
 		Columns:    OrdersColumns,
 		PrimaryKey: []*schema.Column{OrdersColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "orders_discounts_orders",
+				Columns:    []*schema.Column{OrdersColumns[8]},
+				RefColumns: []*schema.Column{DiscountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "orders_users_orders",
-				Columns:    []*schema.Column{OrdersColumns[7]},
+				Columns:    []*schema.Column{OrdersColumns[9]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -174,8 +295,8 @@ var (
 		{Name: "id", Type: field.TypeUint64, Increment: true},
 		{Name: "quantity", Type: field.TypeInt},
 		{Name: "unit_price", Type: field.TypeFloat64},
-		{Name: "order_items", Type: field.TypeUint64, Nullable: true},
-		{Name: "product_order_items", Type: field.TypeUint64, Nullable: true},
+		{Name: "order_id", Type: field.TypeUint64, Nullable: true},
+		{Name: "product_id", Type: field.TypeUint64, Nullable: true},
 	}
 	// OrderItemsTable holds the schema information for the "order_items" table.
 	OrderItemsTable = &schema.Table{
@@ -197,15 +318,52 @@ var (
 			},
 		},
 	}
+	// OrderStatusHistoryColumns holds the columns for the "order_status_history" table.
+	OrderStatusHistoryColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "from_status", Type: field.TypeString, Nullable: true},
+		{Name: "to_status", Type: field.TypeString},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "order_id", Type: field.TypeUint64},
+		{Name: "changed_by", Type: field.TypeUint64, Nullable: true},
+	}
+	// OrderStatusHistoryTable holds the schema information for the "order_status_history" table.
+	OrderStatusHistoryTable = &schema.Table{
+		Name:       "order_status_history",
+		Columns:    OrderStatusHistoryColumns,
+		PrimaryKey: []*schema.Column{OrderStatusHistoryColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "order_status_history_orders_status_history",
+				Columns:    []*schema.Column{OrderStatusHistoryColumns[5]},
+				RefColumns: []*schema.Column{OrdersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "order_status_history_users_order_status_changes",
+				Columns:    []*schema.Column{OrderStatusHistoryColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "orderstatushistory_order_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{OrderStatusHistoryColumns[5], OrderStatusHistoryColumns[4]},
+			},
+		},
+	}
 	// PaymentsColumns holds the columns for the "payments" table.
 	PaymentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint64, Increment: true},
 		{Name: "amount", Type: field.TypeFloat64},
 		{Name: "method", Type: field.TypeString, Nullable: true},
 		{Name: "status", Type: field.TypeString, Default: "pending"},
-		{Name: "transaction_id", Type: field.TypeString, Nullable: true},
+		{Name: "transaction_id", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
-		{Name: "order_payments", Type: field.TypeUint64, Nullable: true},
+		{Name: "order_id", Type: field.TypeUint64, Nullable: true},
 	}
 	// PaymentsTable holds the schema information for the "payments" table.
 	PaymentsTable = &schema.Table{
@@ -249,7 +407,7 @@ var (
 		{Name: "review_count", Type: field.TypeInt, Default: 0},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "category_products", Type: field.TypeUint64, Nullable: true},
+		{Name: "category_id", Type: field.TypeUint64, Nullable: true},
 	}
 	// ProductsTable holds the schema information for the "products" table.
 	ProductsTable = &schema.Table{
@@ -270,7 +428,12 @@ var (
 		{Name: "id", Type: field.TypeUint64, Increment: true},
 		{Name: "image_url", Type: field.TypeString},
 		{Name: "is_primary", Type: field.TypeBool, Default: false},
-		{Name: "product_images", Type: field.TypeUint64, Nullable: true},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "storage_key", Type: field.TypeString, Nullable: true},
+		{Name: "content_type", Type: field.TypeString, Nullable: true},
+		{Name: "thumbnails", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "product_id", Type: field.TypeUint64, Nullable: true},
 	}
 	// ProductImagesTable holds the schema information for the "product_images" table.
 	ProductImagesTable = &schema.Table{
@@ -280,11 +443,55 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "product_images_products_images",
-				Columns:    []*schema.Column{ProductImagesColumns[3]},
+				Columns:    []*schema.Column{ProductImagesColumns[8]},
 				RefColumns: []*schema.Column{ProductsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "productimage_product_id_position",
+				Unique:  false,
+				Columns: []*schema.Column{ProductImagesColumns[8], ProductImagesColumns[3]},
+			},
+			{
+				Name:    "product_images_primary_key",
+				Unique:  true,
+				Columns: []*schema.Column{ProductImagesColumns[8]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "is_primary",
+				},
+			},
+		},
+	}
+	// UserRecoveryCodesColumns holds the columns for the "user_recovery_codes" table.
+	UserRecoveryCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "code_hash", Type: field.TypeString},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUint64},
+	}
+	// UserRecoveryCodesTable holds the schema information for the "user_recovery_codes" table.
+	UserRecoveryCodesTable = &schema.Table{
+		Name:       "user_recovery_codes",
+		Columns:    UserRecoveryCodesColumns,
+		PrimaryKey: []*schema.Column{UserRecoveryCodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_recovery_codes_users_recovery_codes",
+				Columns:    []*schema.Column{UserRecoveryCodesColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "recoverycode_user_id_code_hash",
+				Unique:  true,
+				Columns: []*schema.Column{UserRecoveryCodesColumns[4], UserRecoveryCodesColumns[1]},
+			},
+		},
 	}
 	// ReviewsColumns holds the columns for the "reviews" table.
 	ReviewsColumns = []*schema.Column{
@@ -293,8 +500,8 @@ var (
 		{Name: "comment", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "product_reviews", Type: field.TypeUint64, Nullable: true},
-		{Name: "user_reviews", Type: field.TypeUint64, Nullable: true},
+		{Name: "product_id", Type: field.TypeUint64, Nullable: true},
+		{Name: "user_id", Type: field.TypeUint64, Nullable: true},
 	}
 	// ReviewsTable holds the schema information for the "reviews" table.
 	ReviewsTable = &schema.Table{
@@ -351,7 +558,7 @@ var (
 				OnDelete:   schema.NoAction,
 			},
 			{
-				Symbol:     "role_permissions_roles_permissions",
+				Symbol:     "role_permissions_roles_role_permissions",
 				Columns:    []*schema.Column{RolePermissionsColumns[3]},
 				RefColumns: []*schema.Column{RolesColumns[0]},
 				OnDelete:   schema.NoAction,
@@ -371,12 +578,23 @@ var (
 		{Name: "user_id", Type: field.TypeUint64, Nullable: true},
 		{Name: "data", Type: field.TypeBytes},
 		{Name: "expiry", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_seen_at", Type: field.TypeTime},
+		{Name: "ip_address", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
 	}
 	// SessionsTable holds the schema information for the "sessions" table.
 	SessionsTable = &schema.Table{
 		Name:       "sessions",
 		Columns:    SessionsColumns,
 		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "session_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[1]},
+			},
+		},
 	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
@@ -391,6 +609,9 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "verified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "totp_secret", Type: field.TypeString, Nullable: true},
+		{Name: "totp_enabled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "totp_last_step", Type: field.TypeInt64, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -398,6 +619,37 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// UserIdentitiesColumns holds the columns for the "user_identities" table.
+	UserIdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "provider", Type: field.TypeString, Size: 50},
+		{Name: "subject", Type: field.TypeString, Size: 255},
+		{Name: "email", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUint64},
+	}
+	// UserIdentitiesTable holds the schema information for the "user_identities" table.
+	UserIdentitiesTable = &schema.Table{
+		Name:       "user_identities",
+		Columns:    UserIdentitiesColumns,
+		PrimaryKey: []*schema.Column{UserIdentitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_identities_users_identities",
+				Columns:    []*schema.Column{UserIdentitiesColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "useridentity_provider_subject",
+				Unique:  true,
+				Columns: []*schema.Column{UserIdentitiesColumns[1], UserIdentitiesColumns[2]},
+			},
+		},
+	}
 	// UserPermissionsColumns holds the columns for the "user_permissions" table.
 	UserPermissionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint64, Increment: true},
@@ -421,7 +673,7 @@ var (
 				OnDelete:   schema.NoAction,
 			},
 			{
-				Symbol:     "user_permissions_users_permissions",
+				Symbol:     "user_permissions_users_user_permissions",
 				Columns:    []*schema.Column{UserPermissionsColumns[6]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
@@ -458,7 +710,7 @@ var (
 				OnDelete:   schema.NoAction,
 			},
 			{
-				Symbol:     "user_roles_users_roles",
+				Symbol:     "user_roles_users_user_roles",
 				Columns:    []*schema.Column{UserRolesColumns[6]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
@@ -472,13 +724,44 @@ var (
 			},
 		},
 	}
+	// UserTokensColumns holds the columns for the "user_tokens" table.
+	UserTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "purpose", Type: field.TypeString, Size: 50},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUint64},
+	}
+	// UserTokensTable holds the schema information for the "user_tokens" table.
+	UserTokensTable = &schema.Table{
+		Name:       "user_tokens",
+		Columns:    UserTokensColumns,
+		PrimaryKey: []*schema.Column{UserTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_tokens_users_tokens",
+				Columns:    []*schema.Column{UserTokensColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usertoken_user_id_purpose_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{UserTokensColumns[6], UserTokensColumns[1], UserTokensColumns[5]},
+			},
+		},
+	}
 	// UserVouchersColumns holds the columns for the "user_vouchers" table.
 	UserVouchersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint64, Increment: true},
 		{Name: "is_used", Type: field.TypeBool, Default: false},
 		{Name: "used_at", Type: field.TypeTime, Nullable: true},
-		{Name: "discount_user_vouchers", Type: field.TypeUint64, Nullable: true},
-		{Name: "user_vouchers", Type: field.TypeUint64, Nullable: true},
+		{Name: "discount_id", Type: field.TypeUint64, Nullable: true},
+		{Name: "user_id", Type: field.TypeUint64, Nullable: true},
 	}
 	// UserVouchersTable holds the schema information for the "user_vouchers" table.
 	UserVouchersTable = &schema.Table{
@@ -500,11 +783,38 @@ var (
 			},
 		},
 	}
+	// PaymentWebhookEventsColumns holds the columns for the "payment_webhook_events" table.
+	PaymentWebhookEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "provider", Type: field.TypeString, Size: 50},
+		{Name: "event_id", Type: field.TypeString, Size: 100},
+		{Name: "transaction_id", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "status", Type: field.TypeString, Nullable: true, Size: 20},
+		{Name: "amount", Type: field.TypeFloat64, Nullable: true},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "received_at", Type: field.TypeTime},
+		{Name: "processed_at", Type: field.TypeTime, Nullable: true},
+	}
+	// PaymentWebhookEventsTable holds the schema information for the "payment_webhook_events" table.
+	PaymentWebhookEventsTable = &schema.Table{
+		Name:       "payment_webhook_events",
+		Columns:    PaymentWebhookEventsColumns,
+		PrimaryKey: []*schema.Column{PaymentWebhookEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookevent_provider_event_id",
+				Unique:  true,
+				Columns: []*schema.Column{PaymentWebhookEventsColumns[1], PaymentWebhookEventsColumns[2]},
+			},
+		},
+	}
 	// WishlistsColumns holds the columns for the "wishlists" table.
 	WishlistsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint64, Increment: true},
 		{Name: "created_at", Type: field.TypeTime},
-		{Name: "user_wishlists", Type: field.TypeUint64, Nullable: true},
+		{Name: "user_id", Type: field.TypeUint64, Nullable: true},
 	}
 	// WishlistsTable holds the schema information for the "wishlists" table.
 	WishlistsTable = &schema.Table{
@@ -519,86 +829,122 @@ var (
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "wishlist_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{WishlistsColumns[2]},
+			},
+		},
 	}
 	// WishlistItemsColumns holds the columns for the "wishlist_items" table.
 	WishlistItemsColumns = []*schema.Column{
-		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "price_at_add", Type: field.TypeFloat64, Nullable: true},
 		{Name: "added_at", Type: field.TypeTime},
-		{Name: "product_wishlist_items", Type: field.TypeUint64, Nullable: true},
-		{Name: "wishlist_items", Type: field.TypeUint64, Nullable: true},
+		{Name: "wishlist_id", Type: field.TypeUint64},
+		{Name: "product_id", Type: field.TypeUint64},
 	}
 	// WishlistItemsTable holds the schema information for the "wishlist_items" table.
 	WishlistItemsTable = &schema.Table{
 		Name:       "wishlist_items",
 		Columns:    WishlistItemsColumns,
-		PrimaryKey: []*schema.Column{WishlistItemsColumns[0]},
+		PrimaryKey: []*schema.Column{WishlistItemsColumns[2], WishlistItemsColumns[3]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "wishlist_items_products_wishlist_items",
+				Symbol:     "wishlist_items_wishlists_wishlist",
 				Columns:    []*schema.Column{WishlistItemsColumns[2]},
-				RefColumns: []*schema.Column{ProductsColumns[0]},
-				OnDelete:   schema.SetNull,
+				RefColumns: []*schema.Column{WishlistsColumns[0]},
+				OnDelete:   schema.NoAction,
 			},
 			{
-				Symbol:     "wishlist_items_wishlists_items",
+				Symbol:     "wishlist_items_products_product",
 				Columns:    []*schema.Column{WishlistItemsColumns[3]},
-				RefColumns: []*schema.Column{WishlistsColumns[0]},
-				OnDelete:   schema.SetNull,
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.NoAction,
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
+		AuditLogsTable,
 		CartsTable,
 		CartItemsTable,
 		CategoriesTable,
 		DiscountsTable,
 		DiscountCategoriesTable,
 		DiscountProductsTable,
+		IdempotencyKeysTable,
+		LoginAttemptsTable,
 		OrdersTable,
 		OrderItemsTable,
+		OrderStatusHistoryTable,
 		PaymentsTable,
 		PermissionsTable,
 		ProductsTable,
 		ProductImagesTable,
+		UserRecoveryCodesTable,
 		ReviewsTable,
 		RolesTable,
 		RolePermissionsTable,
 		SessionsTable,
 		UsersTable,
+		UserIdentitiesTable,
 		UserPermissionsTable,
 		UserRolesTable,
+		UserTokensTable,
 		UserVouchersTable,
+		PaymentWebhookEventsTable,
 		WishlistsTable,
 		WishlistItemsTable,
 	}
 )
 
 func init() {
+	APIKeysTable.ForeignKeys[0].RefTable = UsersTable
 	CartsTable.ForeignKeys[0].RefTable = UsersTable
 	CartItemsTable.ForeignKeys[0].RefTable = CartsTable
 	CartItemsTable.ForeignKeys[1].RefTable = ProductsTable
-	DiscountCategoriesTable.ForeignKeys[0].RefTable = CategoriesTable
-	DiscountCategoriesTable.ForeignKeys[1].RefTable = DiscountsTable
+	CategoriesTable.ForeignKeys[0].RefTable = CategoriesTable
+	DiscountCategoriesTable.ForeignKeys[0].RefTable = DiscountsTable
+	DiscountCategoriesTable.ForeignKeys[1].RefTable = CategoriesTable
 	DiscountProductsTable.ForeignKeys[0].RefTable = DiscountsTable
 	DiscountProductsTable.ForeignKeys[1].RefTable = ProductsTable
-	OrdersTable.ForeignKeys[0].RefTable = UsersTable
+	OrdersTable.ForeignKeys[0].RefTable = DiscountsTable
+	OrdersTable.ForeignKeys[1].RefTable = UsersTable
 	OrderItemsTable.ForeignKeys[0].RefTable = OrdersTable
 	OrderItemsTable.ForeignKeys[1].RefTable = ProductsTable
+	OrderStatusHistoryTable.ForeignKeys[0].RefTable = OrdersTable
+	OrderStatusHistoryTable.ForeignKeys[1].RefTable = UsersTable
+	OrderStatusHistoryTable.Annotation = &entsql.Annotation{
+		Table: "order_status_history",
+	}
 	PaymentsTable.ForeignKeys[0].RefTable = OrdersTable
 	ProductsTable.ForeignKeys[0].RefTable = CategoriesTable
 	ProductImagesTable.ForeignKeys[0].RefTable = ProductsTable
+	UserRecoveryCodesTable.ForeignKeys[0].RefTable = UsersTable
+	UserRecoveryCodesTable.Annotation = &entsql.Annotation{
+		Table: "user_recovery_codes",
+	}
 	ReviewsTable.ForeignKeys[0].RefTable = ProductsTable
 	ReviewsTable.ForeignKeys[1].RefTable = UsersTable
 	RolePermissionsTable.ForeignKeys[0].RefTable = PermissionsTable
 	RolePermissionsTable.ForeignKeys[1].RefTable = RolesTable
+	UserIdentitiesTable.ForeignKeys[0].RefTable = UsersTable
+	UserIdentitiesTable.Annotation = &entsql.Annotation{
+		Table: "user_identities",
+	}
 	UserPermissionsTable.ForeignKeys[0].RefTable = PermissionsTable
 	UserPermissionsTable.ForeignKeys[1].RefTable = UsersTable
 	UserRolesTable.ForeignKeys[0].RefTable = RolesTable
 	UserRolesTable.ForeignKeys[1].RefTable = UsersTable
+	UserTokensTable.ForeignKeys[0].RefTable = UsersTable
 	UserVouchersTable.ForeignKeys[0].RefTable = DiscountsTable
 	UserVouchersTable.ForeignKeys[1].RefTable = UsersTable
+	PaymentWebhookEventsTable.Annotation = &entsql.Annotation{
+		Table: "payment_webhook_events",
+	}
 	WishlistsTable.ForeignKeys[0].RefTable = UsersTable
-	WishlistItemsTable.ForeignKeys[0].RefTable = ProductsTable
-	WishlistItemsTable.ForeignKeys[1].RefTable = WishlistsTable
+	WishlistItemsTable.ForeignKeys[0].RefTable = WishlistsTable
+	WishlistItemsTable.ForeignKeys[1].RefTable = ProductsTable
 }
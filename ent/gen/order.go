@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/thang1834/go-goss/ent/gen/discount"
 	"github.com/thang1834/go-goss/ent/gen/order"
 	"github.com/thang1834/go-goss/ent/gen/user"
 )
@@ -18,6 +19,8 @@ type Order struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uint64 `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uint64 `json:"user_id,omitempty"`
 	// Status holds the value of the "status" field.
 	Status string `json:"status,omitempty"`
 	// TotalPrice holds the value of the "total_price" field.
@@ -26,6 +29,10 @@ type Order struct {
 	PaymentMethod string `json:"payment_method,omitempty"`
 	// ShippingAddress holds the value of the "shipping_address" field.
 	ShippingAddress string `json:"shipping_address,omitempty"`
+	// DiscountID holds the value of the "discount_id" field.
+	DiscountID *uint64 `json:"discount_id,omitempty"`
+	// DiscountAmount holds the value of the "discount_amount" field.
+	DiscountAmount float64 `json:"discount_amount,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -33,7 +40,6 @@ type Order struct {
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the OrderQuery when eager-loading is set.
 	Edges        OrderEdges `json:"edges"`
-	user_orders  *uint64
 	selectValues sql.SelectValues
 }
 
@@ -41,13 +47,17 @@ type Order struct {
 type OrderEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// Discount holds the value of the discount edge.
+	Discount *Discount `json:"discount,omitempty"`
 	// Items holds the value of the items edge.
 	Items []*OrderItem `json:"items,omitempty"`
 	// Payments holds the value of the payments edge.
 	Payments []*Payment `json:"payments,omitempty"`
+	// StatusHistory holds the value of the status_history edge.
+	StatusHistory []*OrderStatusHistory `json:"status_history,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [5]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -61,10 +71,21 @@ func (e OrderEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// DiscountOrErr returns the Discount value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderEdges) DiscountOrErr() (*Discount, error) {
+	if e.Discount != nil {
+		return e.Discount, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: discount.Label}
+	}
+	return nil, &NotLoadedError{edge: "discount"}
+}
+
 // ItemsOrErr returns the Items value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrderEdges) ItemsOrErr() ([]*OrderItem, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.Items, nil
 	}
 	return nil, &NotLoadedError{edge: "items"}
@@ -73,27 +94,34 @@ func (e OrderEdges) ItemsOrErr() ([]*OrderItem, error) {
 // PaymentsOrErr returns the Payments value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrderEdges) PaymentsOrErr() ([]*Payment, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.Payments, nil
 	}
 	return nil, &NotLoadedError{edge: "payments"}
 }
 
+// StatusHistoryOrErr returns the StatusHistory value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrderEdges) StatusHistoryOrErr() ([]*OrderStatusHistory, error) {
+	if e.loadedTypes[4] {
+		return e.StatusHistory, nil
+	}
+	return nil, &NotLoadedError{edge: "status_history"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Order) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case order.FieldTotalPrice:
+		case order.FieldTotalPrice, order.FieldDiscountAmount:
 			values[i] = new(sql.NullFloat64)
-		case order.FieldID:
+		case order.FieldID, order.FieldUserID, order.FieldDiscountID:
 			values[i] = new(sql.NullInt64)
 		case order.FieldStatus, order.FieldPaymentMethod, order.FieldShippingAddress:
 			values[i] = new(sql.NullString)
 		case order.FieldCreatedAt, order.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
-		case order.ForeignKeys[0]: // user_orders
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -115,6 +143,12 @@ func (o *Order) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			o.ID = uint64(value.Int64)
+		case order.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				o.UserID = uint64(value.Int64)
+			}
 		case order.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
@@ -139,6 +173,19 @@ func (o *Order) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				o.ShippingAddress = value.String
 			}
+		case order.FieldDiscountID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_id", values[i])
+			} else if value.Valid {
+				o.DiscountID = new(uint64)
+				*o.DiscountID = uint64(value.Int64)
+			}
+		case order.FieldDiscountAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_amount", values[i])
+			} else if value.Valid {
+				o.DiscountAmount = value.Float64
+			}
 		case order.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -151,13 +198,6 @@ func (o *Order) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				o.UpdatedAt = value.Time
 			}
-		case order.ForeignKeys[0]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_orders", value)
-			} else if value.Valid {
-				o.user_orders = new(uint64)
-				*o.user_orders = uint64(value.Int64)
-			}
 		default:
 			o.selectValues.Set(columns[i], values[i])
 		}
@@ -176,6 +216,11 @@ func (o *Order) QueryUser() *UserQuery {
 	return NewOrderClient(o.config).QueryUser(o)
 }
 
+// QueryDiscount queries the "discount" edge of the Order entity.
+func (o *Order) QueryDiscount() *DiscountQuery {
+	return NewOrderClient(o.config).QueryDiscount(o)
+}
+
 // QueryItems queries the "items" edge of the Order entity.
 func (o *Order) QueryItems() *OrderItemQuery {
 	return NewOrderClient(o.config).QueryItems(o)
@@ -186,6 +231,11 @@ func (o *Order) QueryPayments() *PaymentQuery {
 	return NewOrderClient(o.config).QueryPayments(o)
 }
 
+// QueryStatusHistory queries the "status_history" edge of the Order entity.
+func (o *Order) QueryStatusHistory() *OrderStatusHistoryQuery {
+	return NewOrderClient(o.config).QueryStatusHistory(o)
+}
+
 // Update returns a builder for updating this Order.
 // Note that you need to call Order.Unwrap() before calling this method if this Order
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -209,6 +259,9 @@ func (o *Order) String() string {
 	var builder strings.Builder
 	builder.WriteString("Order(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", o.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", o.UserID))
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(o.Status)
 	builder.WriteString(", ")
@@ -221,6 +274,14 @@ func (o *Order) String() string {
 	builder.WriteString("shipping_address=")
 	builder.WriteString(o.ShippingAddress)
 	builder.WriteString(", ")
+	if v := o.DiscountID; v != nil {
+		builder.WriteString("discount_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("discount_amount=")
+	builder.WriteString(fmt.Sprintf("%v", o.DiscountAmount))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(o.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -55,6 +55,11 @@ func IDLTE(id uint64) predicate.Order {
 	return predicate.Order(sql.FieldLTE(FieldID, id))
 }
 
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uint64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldUserID, v))
+}
+
 // Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
 func Status(v string) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldStatus, v))
@@ -75,6 +80,16 @@ func ShippingAddress(v string) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldShippingAddress, v))
 }
 
+// DiscountID applies equality check predicate on the "discount_id" field. It's identical to DiscountIDEQ.
+func DiscountID(v uint64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDiscountID, v))
+}
+
+// DiscountAmount applies equality check predicate on the "discount_amount" field. It's identical to DiscountAmountEQ.
+func DiscountAmount(v float64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDiscountAmount, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldCreatedAt, v))
@@ -85,6 +100,36 @@ func UpdatedAt(v time.Time) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uint64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uint64) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uint64) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uint64) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldUserID))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v string) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldStatus, v))
@@ -340,6 +385,76 @@ func ShippingAddressContainsFold(v string) predicate.Order {
 	return predicate.Order(sql.FieldContainsFold(FieldShippingAddress, v))
 }
 
+// DiscountIDEQ applies the EQ predicate on the "discount_id" field.
+func DiscountIDEQ(v uint64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDiscountID, v))
+}
+
+// DiscountIDNEQ applies the NEQ predicate on the "discount_id" field.
+func DiscountIDNEQ(v uint64) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldDiscountID, v))
+}
+
+// DiscountIDIn applies the In predicate on the "discount_id" field.
+func DiscountIDIn(vs ...uint64) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldDiscountID, vs...))
+}
+
+// DiscountIDNotIn applies the NotIn predicate on the "discount_id" field.
+func DiscountIDNotIn(vs ...uint64) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldDiscountID, vs...))
+}
+
+// DiscountIDIsNil applies the IsNil predicate on the "discount_id" field.
+func DiscountIDIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldDiscountID))
+}
+
+// DiscountIDNotNil applies the NotNil predicate on the "discount_id" field.
+func DiscountIDNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldDiscountID))
+}
+
+// DiscountAmountEQ applies the EQ predicate on the "discount_amount" field.
+func DiscountAmountEQ(v float64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDiscountAmount, v))
+}
+
+// DiscountAmountNEQ applies the NEQ predicate on the "discount_amount" field.
+func DiscountAmountNEQ(v float64) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldDiscountAmount, v))
+}
+
+// DiscountAmountIn applies the In predicate on the "discount_amount" field.
+func DiscountAmountIn(vs ...float64) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldDiscountAmount, vs...))
+}
+
+// DiscountAmountNotIn applies the NotIn predicate on the "discount_amount" field.
+func DiscountAmountNotIn(vs ...float64) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldDiscountAmount, vs...))
+}
+
+// DiscountAmountGT applies the GT predicate on the "discount_amount" field.
+func DiscountAmountGT(v float64) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldDiscountAmount, v))
+}
+
+// DiscountAmountGTE applies the GTE predicate on the "discount_amount" field.
+func DiscountAmountGTE(v float64) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldDiscountAmount, v))
+}
+
+// DiscountAmountLT applies the LT predicate on the "discount_amount" field.
+func DiscountAmountLT(v float64) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldDiscountAmount, v))
+}
+
+// DiscountAmountLTE applies the LTE predicate on the "discount_amount" field.
+func DiscountAmountLTE(v float64) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldDiscountAmount, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldCreatedAt, v))
@@ -443,6 +558,29 @@ func HasUserWith(preds ...predicate.User) predicate.Order {
 	})
 }
 
+// HasDiscount applies the HasEdge predicate on the "discount" edge.
+func HasDiscount() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DiscountTable, DiscountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDiscountWith applies the HasEdge predicate on the "discount" edge with a given conditions (other predicates).
+func HasDiscountWith(preds ...predicate.Discount) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newDiscountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasItems applies the HasEdge predicate on the "items" edge.
 func HasItems() predicate.Order {
 	return predicate.Order(func(s *sql.Selector) {
@@ -489,6 +627,29 @@ func HasPaymentsWith(preds ...predicate.Payment) predicate.Order {
 	})
 }
 
+// HasStatusHistory applies the HasEdge predicate on the "status_history" edge.
+func HasStatusHistory() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StatusHistoryTable, StatusHistoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStatusHistoryWith applies the HasEdge predicate on the "status_history" edge with a given conditions (other predicates).
+func HasStatusHistoryWith(preds ...predicate.OrderStatusHistory) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newStatusHistoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Order) predicate.Order {
 	return predicate.Order(sql.AndPredicates(predicates...))
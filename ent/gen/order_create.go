@@ -10,8 +10,10 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/thang1834/go-goss/ent/gen/discount"
 	"github.com/thang1834/go-goss/ent/gen/order"
 	"github.com/thang1834/go-goss/ent/gen/orderitem"
+	"github.com/thang1834/go-goss/ent/gen/orderstatushistory"
 	"github.com/thang1834/go-goss/ent/gen/payment"
 	"github.com/thang1834/go-goss/ent/gen/user"
 )
@@ -23,6 +25,20 @@ type OrderCreate struct {
 	hooks    []Hook
 }
 
+// SetUserID sets the "user_id" field.
+func (oc *OrderCreate) SetUserID(u uint64) *OrderCreate {
+	oc.mutation.SetUserID(u)
+	return oc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableUserID(u *uint64) *OrderCreate {
+	if u != nil {
+		oc.SetUserID(*u)
+	}
+	return oc
+}
+
 // SetStatus sets the "status" field.
 func (oc *OrderCreate) SetStatus(s string) *OrderCreate {
 	oc.mutation.SetStatus(s)
@@ -79,6 +95,34 @@ func (oc *OrderCreate) SetNillableShippingAddress(s *string) *OrderCreate {
 	return oc
 }
 
+// SetDiscountID sets the "discount_id" field.
+func (oc *OrderCreate) SetDiscountID(u uint64) *OrderCreate {
+	oc.mutation.SetDiscountID(u)
+	return oc
+}
+
+// SetNillableDiscountID sets the "discount_id" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableDiscountID(u *uint64) *OrderCreate {
+	if u != nil {
+		oc.SetDiscountID(*u)
+	}
+	return oc
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (oc *OrderCreate) SetDiscountAmount(f float64) *OrderCreate {
+	oc.mutation.SetDiscountAmount(f)
+	return oc
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableDiscountAmount(f *float64) *OrderCreate {
+	if f != nil {
+		oc.SetDiscountAmount(*f)
+	}
+	return oc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (oc *OrderCreate) SetCreatedAt(t time.Time) *OrderCreate {
 	oc.mutation.SetCreatedAt(t)
@@ -113,25 +157,16 @@ func (oc *OrderCreate) SetID(u uint64) *OrderCreate {
 	return oc
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (oc *OrderCreate) SetUserID(id uint64) *OrderCreate {
-	oc.mutation.SetUserID(id)
-	return oc
-}
-
-// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
-func (oc *OrderCreate) SetNillableUserID(id *uint64) *OrderCreate {
-	if id != nil {
-		oc = oc.SetUserID(*id)
-	}
-	return oc
-}
-
 // SetUser sets the "user" edge to the User entity.
 func (oc *OrderCreate) SetUser(u *User) *OrderCreate {
 	return oc.SetUserID(u.ID)
 }
 
+// SetDiscount sets the "discount" edge to the Discount entity.
+func (oc *OrderCreate) SetDiscount(d *Discount) *OrderCreate {
+	return oc.SetDiscountID(d.ID)
+}
+
 // AddItemIDs adds the "items" edge to the OrderItem entity by IDs.
 func (oc *OrderCreate) AddItemIDs(ids ...uint64) *OrderCreate {
 	oc.mutation.AddItemIDs(ids...)
@@ -162,6 +197,21 @@ func (oc *OrderCreate) AddPayments(p ...*Payment) *OrderCreate {
 	return oc.AddPaymentIDs(ids...)
 }
 
+// AddStatusHistoryIDs adds the "status_history" edge to the OrderStatusHistory entity by IDs.
+func (oc *OrderCreate) AddStatusHistoryIDs(ids ...uint64) *OrderCreate {
+	oc.mutation.AddStatusHistoryIDs(ids...)
+	return oc
+}
+
+// AddStatusHistory adds the "status_history" edges to the OrderStatusHistory entity.
+func (oc *OrderCreate) AddStatusHistory(o ...*OrderStatusHistory) *OrderCreate {
+	ids := make([]uint64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return oc.AddStatusHistoryIDs(ids...)
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (oc *OrderCreate) Mutation() *OrderMutation {
 	return oc.mutation
@@ -205,6 +255,10 @@ func (oc *OrderCreate) defaults() {
 		v := order.DefaultTotalPrice
 		oc.mutation.SetTotalPrice(v)
 	}
+	if _, ok := oc.mutation.DiscountAmount(); !ok {
+		v := order.DefaultDiscountAmount
+		oc.mutation.SetDiscountAmount(v)
+	}
 	if _, ok := oc.mutation.CreatedAt(); !ok {
 		v := order.DefaultCreatedAt()
 		oc.mutation.SetCreatedAt(v)
@@ -223,6 +277,9 @@ func (oc *OrderCreate) check() error {
 	if _, ok := oc.mutation.TotalPrice(); !ok {
 		return &ValidationError{Name: "total_price", err: errors.New(`gen: missing required field "Order.total_price"`)}
 	}
+	if _, ok := oc.mutation.DiscountAmount(); !ok {
+		return &ValidationError{Name: "discount_amount", err: errors.New(`gen: missing required field "Order.discount_amount"`)}
+	}
 	if _, ok := oc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`gen: missing required field "Order.created_at"`)}
 	}
@@ -277,6 +334,10 @@ func (oc *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
 		_spec.SetField(order.FieldShippingAddress, field.TypeString, value)
 		_node.ShippingAddress = value
 	}
+	if value, ok := oc.mutation.DiscountAmount(); ok {
+		_spec.SetField(order.FieldDiscountAmount, field.TypeFloat64, value)
+		_node.DiscountAmount = value
+	}
 	if value, ok := oc.mutation.CreatedAt(); ok {
 		_spec.SetField(order.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -299,7 +360,24 @@ func (oc *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.user_orders = &nodes[0]
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := oc.mutation.DiscountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.DiscountTable,
+			Columns: []string{order.DiscountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DiscountID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := oc.mutation.ItemsIDs(); len(nodes) > 0 {
@@ -334,6 +412,22 @@ func (oc *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := oc.mutation.StatusHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusHistoryTable,
+			Columns: []string{order.StatusHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatushistory.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -9,11 +9,14 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/thang1834/go-goss/ent/gen/discount"
 	"github.com/thang1834/go-goss/ent/gen/order"
 	"github.com/thang1834/go-goss/ent/gen/orderitem"
+	"github.com/thang1834/go-goss/ent/gen/orderstatushistory"
 	"github.com/thang1834/go-goss/ent/gen/payment"
 	"github.com/thang1834/go-goss/ent/gen/predicate"
 	"github.com/thang1834/go-goss/ent/gen/user"
@@ -22,14 +25,16 @@ import (
 // OrderQuery is the builder for querying Order entities.
 type OrderQuery struct {
 	config
-	ctx          *QueryContext
-	order        []order.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.Order
-	withUser     *UserQuery
-	withItems    *OrderItemQuery
-	withPayments *PaymentQuery
-	withFKs      bool
+	ctx               *QueryContext
+	order             []order.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.Order
+	withUser          *UserQuery
+	withDiscount      *DiscountQuery
+	withItems         *OrderItemQuery
+	withPayments      *PaymentQuery
+	withStatusHistory *OrderStatusHistoryQuery
+	modifiers         []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -88,6 +93,28 @@ func (oq *OrderQuery) QueryUser() *UserQuery {
 	return query
 }
 
+// QueryDiscount chains the current query on the "discount" edge.
+func (oq *OrderQuery) QueryDiscount() *DiscountQuery {
+	query := (&DiscountClient{config: oq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, selector),
+			sqlgraph.To(discount.Table, discount.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, order.DiscountTable, order.DiscountColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryItems chains the current query on the "items" edge.
 func (oq *OrderQuery) QueryItems() *OrderItemQuery {
 	query := (&OrderItemClient{config: oq.config}).Query()
@@ -132,6 +159,28 @@ func (oq *OrderQuery) QueryPayments() *PaymentQuery {
 	return query
 }
 
+// QueryStatusHistory chains the current query on the "status_history" edge.
+func (oq *OrderQuery) QueryStatusHistory() *OrderStatusHistoryQuery {
+	query := (&OrderStatusHistoryClient{config: oq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, selector),
+			sqlgraph.To(orderstatushistory.Table, orderstatushistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, order.StatusHistoryTable, order.StatusHistoryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Order entity from the query.
 // Returns a *NotFoundError when no Order was found.
 func (oq *OrderQuery) First(ctx context.Context) (*Order, error) {
@@ -319,14 +368,16 @@ func (oq *OrderQuery) Clone() *OrderQuery {
 		return nil
 	}
 	return &OrderQuery{
-		config:       oq.config,
-		ctx:          oq.ctx.Clone(),
-		order:        append([]order.OrderOption{}, oq.order...),
-		inters:       append([]Interceptor{}, oq.inters...),
-		predicates:   append([]predicate.Order{}, oq.predicates...),
-		withUser:     oq.withUser.Clone(),
-		withItems:    oq.withItems.Clone(),
-		withPayments: oq.withPayments.Clone(),
+		config:            oq.config,
+		ctx:               oq.ctx.Clone(),
+		order:             append([]order.OrderOption{}, oq.order...),
+		inters:            append([]Interceptor{}, oq.inters...),
+		predicates:        append([]predicate.Order{}, oq.predicates...),
+		withUser:          oq.withUser.Clone(),
+		withDiscount:      oq.withDiscount.Clone(),
+		withItems:         oq.withItems.Clone(),
+		withPayments:      oq.withPayments.Clone(),
+		withStatusHistory: oq.withStatusHistory.Clone(),
 		// clone intermediate query.
 		sql:  oq.sql.Clone(),
 		path: oq.path,
@@ -344,6 +395,17 @@ func (oq *OrderQuery) WithUser(opts ...func(*UserQuery)) *OrderQuery {
 	return oq
 }
 
+// WithDiscount tells the query-builder to eager-load the nodes that are connected to
+// the "discount" edge. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrderQuery) WithDiscount(opts ...func(*DiscountQuery)) *OrderQuery {
+	query := (&DiscountClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oq.withDiscount = query
+	return oq
+}
+
 // WithItems tells the query-builder to eager-load the nodes that are connected to
 // the "items" edge. The optional arguments are used to configure the query builder of the edge.
 func (oq *OrderQuery) WithItems(opts ...func(*OrderItemQuery)) *OrderQuery {
@@ -366,18 +428,29 @@ func (oq *OrderQuery) WithPayments(opts ...func(*PaymentQuery)) *OrderQuery {
 	return oq
 }
 
+// WithStatusHistory tells the query-builder to eager-load the nodes that are connected to
+// the "status_history" edge. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrderQuery) WithStatusHistory(opts ...func(*OrderStatusHistoryQuery)) *OrderQuery {
+	query := (&OrderStatusHistoryClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oq.withStatusHistory = query
+	return oq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Status string `json:"status,omitempty"`
+//		UserID uint64 `json:"user_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Order.Query().
-//		GroupBy(order.FieldStatus).
+//		GroupBy(order.FieldUserID).
 //		Aggregate(gen.Count()).
 //		Scan(ctx, &v)
 func (oq *OrderQuery) GroupBy(field string, fields ...string) *OrderGroupBy {
@@ -395,11 +468,11 @@ func (oq *OrderQuery) GroupBy(field string, fields ...string) *OrderGroupBy {
 // Example:
 //
 //	var v []struct {
-//		Status string `json:"status,omitempty"`
+//		UserID uint64 `json:"user_id,omitempty"`
 //	}
 //
 //	client.Order.Query().
-//		Select(order.FieldStatus).
+//		Select(order.FieldUserID).
 //		Scan(ctx, &v)
 func (oq *OrderQuery) Select(fields ...string) *OrderSelect {
 	oq.ctx.Fields = append(oq.ctx.Fields, fields...)
@@ -443,20 +516,15 @@ func (oq *OrderQuery) prepareQuery(ctx context.Context) error {
 func (oq *OrderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Order, error) {
 	var (
 		nodes       = []*Order{}
-		withFKs     = oq.withFKs
 		_spec       = oq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [5]bool{
 			oq.withUser != nil,
+			oq.withDiscount != nil,
 			oq.withItems != nil,
 			oq.withPayments != nil,
+			oq.withStatusHistory != nil,
 		}
 	)
-	if oq.withUser != nil {
-		withFKs = true
-	}
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, order.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Order).scanValues(nil, columns)
 	}
@@ -466,6 +534,9 @@ func (oq *OrderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Order,
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(oq.modifiers) > 0 {
+		_spec.Modifiers = oq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -481,6 +552,12 @@ func (oq *OrderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Order,
 			return nil, err
 		}
 	}
+	if query := oq.withDiscount; query != nil {
+		if err := oq.loadDiscount(ctx, query, nodes, nil,
+			func(n *Order, e *Discount) { n.Edges.Discount = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := oq.withItems; query != nil {
 		if err := oq.loadItems(ctx, query, nodes,
 			func(n *Order) { n.Edges.Items = []*OrderItem{} },
@@ -495,6 +572,13 @@ func (oq *OrderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Order,
 			return nil, err
 		}
 	}
+	if query := oq.withStatusHistory; query != nil {
+		if err := oq.loadStatusHistory(ctx, query, nodes,
+			func(n *Order) { n.Edges.StatusHistory = []*OrderStatusHistory{} },
+			func(n *Order, e *OrderStatusHistory) { n.Edges.StatusHistory = append(n.Edges.StatusHistory, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -502,10 +586,39 @@ func (oq *OrderQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*O
 	ids := make([]uint64, 0, len(nodes))
 	nodeids := make(map[uint64][]*Order)
 	for i := range nodes {
-		if nodes[i].user_orders == nil {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (oq *OrderQuery) loadDiscount(ctx context.Context, query *DiscountQuery, nodes []*Order, init func(*Order), assign func(*Order, *Discount)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*Order)
+	for i := range nodes {
+		if nodes[i].DiscountID == nil {
 			continue
 		}
-		fk := *nodes[i].user_orders
+		fk := *nodes[i].DiscountID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -514,7 +627,7 @@ func (oq *OrderQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*O
 	if len(ids) == 0 {
 		return nil
 	}
-	query.Where(user.IDIn(ids...))
+	query.Where(discount.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
@@ -522,7 +635,7 @@ func (oq *OrderQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*O
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "user_orders" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "discount_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -540,7 +653,9 @@ func (oq *OrderQuery) loadItems(ctx context.Context, query *OrderItemQuery, node
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(orderitem.FieldOrderID)
+	}
 	query.Where(predicate.OrderItem(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(order.ItemsColumn), fks...))
 	}))
@@ -549,13 +664,10 @@ func (oq *OrderQuery) loadItems(ctx context.Context, query *OrderItemQuery, node
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.order_items
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "order_items" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.OrderID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "order_items" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "order_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -571,7 +683,9 @@ func (oq *OrderQuery) loadPayments(ctx context.Context, query *PaymentQuery, nod
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(payment.FieldOrderID)
+	}
 	query.Where(predicate.Payment(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(order.PaymentsColumn), fks...))
 	}))
@@ -580,13 +694,40 @@ func (oq *OrderQuery) loadPayments(ctx context.Context, query *PaymentQuery, nod
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.order_payments
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "order_payments" is nil for node %v`, n.ID)
+		fk := n.OrderID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "order_id" returned %v for node %v`, fk, n.ID)
 		}
-		node, ok := nodeids[*fk]
+		assign(node, n)
+	}
+	return nil
+}
+func (oq *OrderQuery) loadStatusHistory(ctx context.Context, query *OrderStatusHistoryQuery, nodes []*Order, init func(*Order), assign func(*Order, *OrderStatusHistory)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*Order)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(orderstatushistory.FieldOrderID)
+	}
+	query.Where(predicate.OrderStatusHistory(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(order.StatusHistoryColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OrderID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "order_payments" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "order_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -595,6 +736,9 @@ func (oq *OrderQuery) loadPayments(ctx context.Context, query *PaymentQuery, nod
 
 func (oq *OrderQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := oq.querySpec()
+	if len(oq.modifiers) > 0 {
+		_spec.Modifiers = oq.modifiers
+	}
 	_spec.Node.Columns = oq.ctx.Fields
 	if len(oq.ctx.Fields) > 0 {
 		_spec.Unique = oq.ctx.Unique != nil && *oq.ctx.Unique
@@ -618,6 +762,12 @@ func (oq *OrderQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if oq.withUser != nil {
+			_spec.Node.AddColumnOnce(order.FieldUserID)
+		}
+		if oq.withDiscount != nil {
+			_spec.Node.AddColumnOnce(order.FieldDiscountID)
+		}
 	}
 	if ps := oq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -657,6 +807,9 @@ func (oq *OrderQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if oq.ctx.Unique != nil && *oq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range oq.modifiers {
+		m(selector)
+	}
 	for _, p := range oq.predicates {
 		p(selector)
 	}
@@ -674,6 +827,32 @@ func (oq *OrderQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (oq *OrderQuery) ForUpdate(opts ...sql.LockOption) *OrderQuery {
+	if oq.driver.Dialect() == dialect.Postgres {
+		oq.Unique(false)
+	}
+	oq.modifiers = append(oq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return oq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (oq *OrderQuery) ForShare(opts ...sql.LockOption) *OrderQuery {
+	if oq.driver.Dialect() == dialect.Postgres {
+		oq.Unique(false)
+	}
+	oq.modifiers = append(oq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return oq
+}
+
 // OrderGroupBy is the group-by builder for Order entities.
 type OrderGroupBy struct {
 	selector
@@ -11,8 +11,10 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/thang1834/go-goss/ent/gen/discount"
 	"github.com/thang1834/go-goss/ent/gen/order"
 	"github.com/thang1834/go-goss/ent/gen/orderitem"
+	"github.com/thang1834/go-goss/ent/gen/orderstatushistory"
 	"github.com/thang1834/go-goss/ent/gen/payment"
 	"github.com/thang1834/go-goss/ent/gen/predicate"
 	"github.com/thang1834/go-goss/ent/gen/user"
@@ -31,6 +33,26 @@ func (ou *OrderUpdate) Where(ps ...predicate.Order) *OrderUpdate {
 	return ou
 }
 
+// SetUserID sets the "user_id" field.
+func (ou *OrderUpdate) SetUserID(u uint64) *OrderUpdate {
+	ou.mutation.SetUserID(u)
+	return ou
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableUserID(u *uint64) *OrderUpdate {
+	if u != nil {
+		ou.SetUserID(*u)
+	}
+	return ou
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (ou *OrderUpdate) ClearUserID() *OrderUpdate {
+	ou.mutation.ClearUserID()
+	return ou
+}
+
 // SetStatus sets the "status" field.
 func (ou *OrderUpdate) SetStatus(s string) *OrderUpdate {
 	ou.mutation.SetStatus(s)
@@ -106,6 +128,47 @@ func (ou *OrderUpdate) ClearShippingAddress() *OrderUpdate {
 	return ou
 }
 
+// SetDiscountID sets the "discount_id" field.
+func (ou *OrderUpdate) SetDiscountID(u uint64) *OrderUpdate {
+	ou.mutation.SetDiscountID(u)
+	return ou
+}
+
+// SetNillableDiscountID sets the "discount_id" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableDiscountID(u *uint64) *OrderUpdate {
+	if u != nil {
+		ou.SetDiscountID(*u)
+	}
+	return ou
+}
+
+// ClearDiscountID clears the value of the "discount_id" field.
+func (ou *OrderUpdate) ClearDiscountID() *OrderUpdate {
+	ou.mutation.ClearDiscountID()
+	return ou
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (ou *OrderUpdate) SetDiscountAmount(f float64) *OrderUpdate {
+	ou.mutation.ResetDiscountAmount()
+	ou.mutation.SetDiscountAmount(f)
+	return ou
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableDiscountAmount(f *float64) *OrderUpdate {
+	if f != nil {
+		ou.SetDiscountAmount(*f)
+	}
+	return ou
+}
+
+// AddDiscountAmount adds f to the "discount_amount" field.
+func (ou *OrderUpdate) AddDiscountAmount(f float64) *OrderUpdate {
+	ou.mutation.AddDiscountAmount(f)
+	return ou
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (ou *OrderUpdate) SetCreatedAt(t time.Time) *OrderUpdate {
 	ou.mutation.SetCreatedAt(t)
@@ -126,25 +189,16 @@ func (ou *OrderUpdate) SetUpdatedAt(t time.Time) *OrderUpdate {
 	return ou
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (ou *OrderUpdate) SetUserID(id uint64) *OrderUpdate {
-	ou.mutation.SetUserID(id)
-	return ou
-}
-
-// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
-func (ou *OrderUpdate) SetNillableUserID(id *uint64) *OrderUpdate {
-	if id != nil {
-		ou = ou.SetUserID(*id)
-	}
-	return ou
-}
-
 // SetUser sets the "user" edge to the User entity.
 func (ou *OrderUpdate) SetUser(u *User) *OrderUpdate {
 	return ou.SetUserID(u.ID)
 }
 
+// SetDiscount sets the "discount" edge to the Discount entity.
+func (ou *OrderUpdate) SetDiscount(d *Discount) *OrderUpdate {
+	return ou.SetDiscountID(d.ID)
+}
+
 // AddItemIDs adds the "items" edge to the OrderItem entity by IDs.
 func (ou *OrderUpdate) AddItemIDs(ids ...uint64) *OrderUpdate {
 	ou.mutation.AddItemIDs(ids...)
@@ -175,6 +229,21 @@ func (ou *OrderUpdate) AddPayments(p ...*Payment) *OrderUpdate {
 	return ou.AddPaymentIDs(ids...)
 }
 
+// AddStatusHistoryIDs adds the "status_history" edge to the OrderStatusHistory entity by IDs.
+func (ou *OrderUpdate) AddStatusHistoryIDs(ids ...uint64) *OrderUpdate {
+	ou.mutation.AddStatusHistoryIDs(ids...)
+	return ou
+}
+
+// AddStatusHistory adds the "status_history" edges to the OrderStatusHistory entity.
+func (ou *OrderUpdate) AddStatusHistory(o ...*OrderStatusHistory) *OrderUpdate {
+	ids := make([]uint64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ou.AddStatusHistoryIDs(ids...)
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (ou *OrderUpdate) Mutation() *OrderMutation {
 	return ou.mutation
@@ -186,6 +255,12 @@ func (ou *OrderUpdate) ClearUser() *OrderUpdate {
 	return ou
 }
 
+// ClearDiscount clears the "discount" edge to the Discount entity.
+func (ou *OrderUpdate) ClearDiscount() *OrderUpdate {
+	ou.mutation.ClearDiscount()
+	return ou
+}
+
 // ClearItems clears all "items" edges to the OrderItem entity.
 func (ou *OrderUpdate) ClearItems() *OrderUpdate {
 	ou.mutation.ClearItems()
@@ -228,6 +303,27 @@ func (ou *OrderUpdate) RemovePayments(p ...*Payment) *OrderUpdate {
 	return ou.RemovePaymentIDs(ids...)
 }
 
+// ClearStatusHistory clears all "status_history" edges to the OrderStatusHistory entity.
+func (ou *OrderUpdate) ClearStatusHistory() *OrderUpdate {
+	ou.mutation.ClearStatusHistory()
+	return ou
+}
+
+// RemoveStatusHistoryIDs removes the "status_history" edge to OrderStatusHistory entities by IDs.
+func (ou *OrderUpdate) RemoveStatusHistoryIDs(ids ...uint64) *OrderUpdate {
+	ou.mutation.RemoveStatusHistoryIDs(ids...)
+	return ou
+}
+
+// RemoveStatusHistory removes "status_history" edges to OrderStatusHistory entities.
+func (ou *OrderUpdate) RemoveStatusHistory(o ...*OrderStatusHistory) *OrderUpdate {
+	ids := make([]uint64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ou.RemoveStatusHistoryIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ou *OrderUpdate) Save(ctx context.Context) (int, error) {
 	ou.defaults()
@@ -294,6 +390,12 @@ func (ou *OrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ou.mutation.ShippingAddressCleared() {
 		_spec.ClearField(order.FieldShippingAddress, field.TypeString)
 	}
+	if value, ok := ou.mutation.DiscountAmount(); ok {
+		_spec.SetField(order.FieldDiscountAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ou.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(order.FieldDiscountAmount, field.TypeFloat64, value)
+	}
 	if value, ok := ou.mutation.CreatedAt(); ok {
 		_spec.SetField(order.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -329,6 +431,35 @@ func (ou *OrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ou.mutation.DiscountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.DiscountTable,
+			Columns: []string{order.DiscountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.DiscountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.DiscountTable,
+			Columns: []string{order.DiscountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if ou.mutation.ItemsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -419,6 +550,51 @@ func (ou *OrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ou.mutation.StatusHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusHistoryTable,
+			Columns: []string{order.StatusHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatushistory.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedStatusHistoryIDs(); len(nodes) > 0 && !ou.mutation.StatusHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusHistoryTable,
+			Columns: []string{order.StatusHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatushistory.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.StatusHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusHistoryTable,
+			Columns: []string{order.StatusHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatushistory.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ou.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{order.Label}
@@ -439,6 +615,26 @@ type OrderUpdateOne struct {
 	mutation *OrderMutation
 }
 
+// SetUserID sets the "user_id" field.
+func (ouo *OrderUpdateOne) SetUserID(u uint64) *OrderUpdateOne {
+	ouo.mutation.SetUserID(u)
+	return ouo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableUserID(u *uint64) *OrderUpdateOne {
+	if u != nil {
+		ouo.SetUserID(*u)
+	}
+	return ouo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (ouo *OrderUpdateOne) ClearUserID() *OrderUpdateOne {
+	ouo.mutation.ClearUserID()
+	return ouo
+}
+
 // SetStatus sets the "status" field.
 func (ouo *OrderUpdateOne) SetStatus(s string) *OrderUpdateOne {
 	ouo.mutation.SetStatus(s)
@@ -514,6 +710,47 @@ func (ouo *OrderUpdateOne) ClearShippingAddress() *OrderUpdateOne {
 	return ouo
 }
 
+// SetDiscountID sets the "discount_id" field.
+func (ouo *OrderUpdateOne) SetDiscountID(u uint64) *OrderUpdateOne {
+	ouo.mutation.SetDiscountID(u)
+	return ouo
+}
+
+// SetNillableDiscountID sets the "discount_id" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableDiscountID(u *uint64) *OrderUpdateOne {
+	if u != nil {
+		ouo.SetDiscountID(*u)
+	}
+	return ouo
+}
+
+// ClearDiscountID clears the value of the "discount_id" field.
+func (ouo *OrderUpdateOne) ClearDiscountID() *OrderUpdateOne {
+	ouo.mutation.ClearDiscountID()
+	return ouo
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (ouo *OrderUpdateOne) SetDiscountAmount(f float64) *OrderUpdateOne {
+	ouo.mutation.ResetDiscountAmount()
+	ouo.mutation.SetDiscountAmount(f)
+	return ouo
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableDiscountAmount(f *float64) *OrderUpdateOne {
+	if f != nil {
+		ouo.SetDiscountAmount(*f)
+	}
+	return ouo
+}
+
+// AddDiscountAmount adds f to the "discount_amount" field.
+func (ouo *OrderUpdateOne) AddDiscountAmount(f float64) *OrderUpdateOne {
+	ouo.mutation.AddDiscountAmount(f)
+	return ouo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (ouo *OrderUpdateOne) SetCreatedAt(t time.Time) *OrderUpdateOne {
 	ouo.mutation.SetCreatedAt(t)
@@ -534,25 +771,16 @@ func (ouo *OrderUpdateOne) SetUpdatedAt(t time.Time) *OrderUpdateOne {
 	return ouo
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (ouo *OrderUpdateOne) SetUserID(id uint64) *OrderUpdateOne {
-	ouo.mutation.SetUserID(id)
-	return ouo
-}
-
-// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
-func (ouo *OrderUpdateOne) SetNillableUserID(id *uint64) *OrderUpdateOne {
-	if id != nil {
-		ouo = ouo.SetUserID(*id)
-	}
-	return ouo
-}
-
 // SetUser sets the "user" edge to the User entity.
 func (ouo *OrderUpdateOne) SetUser(u *User) *OrderUpdateOne {
 	return ouo.SetUserID(u.ID)
 }
 
+// SetDiscount sets the "discount" edge to the Discount entity.
+func (ouo *OrderUpdateOne) SetDiscount(d *Discount) *OrderUpdateOne {
+	return ouo.SetDiscountID(d.ID)
+}
+
 // AddItemIDs adds the "items" edge to the OrderItem entity by IDs.
 func (ouo *OrderUpdateOne) AddItemIDs(ids ...uint64) *OrderUpdateOne {
 	ouo.mutation.AddItemIDs(ids...)
@@ -583,6 +811,21 @@ func (ouo *OrderUpdateOne) AddPayments(p ...*Payment) *OrderUpdateOne {
 	return ouo.AddPaymentIDs(ids...)
 }
 
+// AddStatusHistoryIDs adds the "status_history" edge to the OrderStatusHistory entity by IDs.
+func (ouo *OrderUpdateOne) AddStatusHistoryIDs(ids ...uint64) *OrderUpdateOne {
+	ouo.mutation.AddStatusHistoryIDs(ids...)
+	return ouo
+}
+
+// AddStatusHistory adds the "status_history" edges to the OrderStatusHistory entity.
+func (ouo *OrderUpdateOne) AddStatusHistory(o ...*OrderStatusHistory) *OrderUpdateOne {
+	ids := make([]uint64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ouo.AddStatusHistoryIDs(ids...)
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (ouo *OrderUpdateOne) Mutation() *OrderMutation {
 	return ouo.mutation
@@ -594,6 +837,12 @@ func (ouo *OrderUpdateOne) ClearUser() *OrderUpdateOne {
 	return ouo
 }
 
+// ClearDiscount clears the "discount" edge to the Discount entity.
+func (ouo *OrderUpdateOne) ClearDiscount() *OrderUpdateOne {
+	ouo.mutation.ClearDiscount()
+	return ouo
+}
+
 // ClearItems clears all "items" edges to the OrderItem entity.
 func (ouo *OrderUpdateOne) ClearItems() *OrderUpdateOne {
 	ouo.mutation.ClearItems()
@@ -636,6 +885,27 @@ func (ouo *OrderUpdateOne) RemovePayments(p ...*Payment) *OrderUpdateOne {
 	return ouo.RemovePaymentIDs(ids...)
 }
 
+// ClearStatusHistory clears all "status_history" edges to the OrderStatusHistory entity.
+func (ouo *OrderUpdateOne) ClearStatusHistory() *OrderUpdateOne {
+	ouo.mutation.ClearStatusHistory()
+	return ouo
+}
+
+// RemoveStatusHistoryIDs removes the "status_history" edge to OrderStatusHistory entities by IDs.
+func (ouo *OrderUpdateOne) RemoveStatusHistoryIDs(ids ...uint64) *OrderUpdateOne {
+	ouo.mutation.RemoveStatusHistoryIDs(ids...)
+	return ouo
+}
+
+// RemoveStatusHistory removes "status_history" edges to OrderStatusHistory entities.
+func (ouo *OrderUpdateOne) RemoveStatusHistory(o ...*OrderStatusHistory) *OrderUpdateOne {
+	ids := make([]uint64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ouo.RemoveStatusHistoryIDs(ids...)
+}
+
 // Where appends a list predicates to the OrderUpdate builder.
 func (ouo *OrderUpdateOne) Where(ps ...predicate.Order) *OrderUpdateOne {
 	ouo.mutation.Where(ps...)
@@ -732,6 +1002,12 @@ func (ouo *OrderUpdateOne) sqlSave(ctx context.Context) (_node *Order, err error
 	if ouo.mutation.ShippingAddressCleared() {
 		_spec.ClearField(order.FieldShippingAddress, field.TypeString)
 	}
+	if value, ok := ouo.mutation.DiscountAmount(); ok {
+		_spec.SetField(order.FieldDiscountAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ouo.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(order.FieldDiscountAmount, field.TypeFloat64, value)
+	}
 	if value, ok := ouo.mutation.CreatedAt(); ok {
 		_spec.SetField(order.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -767,6 +1043,35 @@ func (ouo *OrderUpdateOne) sqlSave(ctx context.Context) (_node *Order, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ouo.mutation.DiscountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.DiscountTable,
+			Columns: []string{order.DiscountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.DiscountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.DiscountTable,
+			Columns: []string{order.DiscountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if ouo.mutation.ItemsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -857,6 +1162,51 @@ func (ouo *OrderUpdateOne) sqlSave(ctx context.Context) (_node *Order, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ouo.mutation.StatusHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusHistoryTable,
+			Columns: []string{order.StatusHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatushistory.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedStatusHistoryIDs(); len(nodes) > 0 && !ouo.mutation.StatusHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusHistoryTable,
+			Columns: []string{order.StatusHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatushistory.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.StatusHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusHistoryTable,
+			Columns: []string{order.StatusHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatushistory.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Order{config: ouo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,16 +18,18 @@ type OrderItem struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uint64 `json:"id,omitempty"`
+	// OrderID holds the value of the "order_id" field.
+	OrderID uint64 `json:"order_id,omitempty"`
+	// ProductID holds the value of the "product_id" field.
+	ProductID uint64 `json:"product_id,omitempty"`
 	// Quantity holds the value of the "quantity" field.
 	Quantity int `json:"quantity,omitempty"`
 	// UnitPrice holds the value of the "unit_price" field.
 	UnitPrice float64 `json:"unit_price,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the OrderItemQuery when eager-loading is set.
-	Edges               OrderItemEdges `json:"edges"`
-	order_items         *uint64
-	product_order_items *uint64
-	selectValues        sql.SelectValues
+	Edges        OrderItemEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // OrderItemEdges holds the relations/edges for other nodes in the graph.
@@ -70,11 +72,7 @@ func (*OrderItem) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case orderitem.FieldUnitPrice:
 			values[i] = new(sql.NullFloat64)
-		case orderitem.FieldID, orderitem.FieldQuantity:
-			values[i] = new(sql.NullInt64)
-		case orderitem.ForeignKeys[0]: // order_items
-			values[i] = new(sql.NullInt64)
-		case orderitem.ForeignKeys[1]: // product_order_items
+		case orderitem.FieldID, orderitem.FieldOrderID, orderitem.FieldProductID, orderitem.FieldQuantity:
 			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -97,6 +95,18 @@ func (oi *OrderItem) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			oi.ID = uint64(value.Int64)
+		case orderitem.FieldOrderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value.Valid {
+				oi.OrderID = uint64(value.Int64)
+			}
+		case orderitem.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				oi.ProductID = uint64(value.Int64)
+			}
 		case orderitem.FieldQuantity:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field quantity", values[i])
@@ -109,20 +119,6 @@ func (oi *OrderItem) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				oi.UnitPrice = value.Float64
 			}
-		case orderitem.ForeignKeys[0]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field order_items", value)
-			} else if value.Valid {
-				oi.order_items = new(uint64)
-				*oi.order_items = uint64(value.Int64)
-			}
-		case orderitem.ForeignKeys[1]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field product_order_items", value)
-			} else if value.Valid {
-				oi.product_order_items = new(uint64)
-				*oi.product_order_items = uint64(value.Int64)
-			}
 		default:
 			oi.selectValues.Set(columns[i], values[i])
 		}
@@ -169,6 +165,12 @@ func (oi *OrderItem) String() string {
 	var builder strings.Builder
 	builder.WriteString("OrderItem(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", oi.ID))
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", oi.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", oi.ProductID))
+	builder.WriteString(", ")
 	builder.WriteString("quantity=")
 	builder.WriteString(fmt.Sprintf("%v", oi.Quantity))
 	builder.WriteString(", ")
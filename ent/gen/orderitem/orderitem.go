@@ -12,6 +12,10 @@ const (
 	Label = "order_item"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldOrderID holds the string denoting the order_id field in the database.
+	FieldOrderID = "order_id"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
 	// FieldQuantity holds the string denoting the quantity field in the database.
 	FieldQuantity = "quantity"
 	// FieldUnitPrice holds the string denoting the unit_price field in the database.
@@ -28,30 +32,25 @@ const (
 	// It exists in this package in order to avoid circular dependency with the "order" package.
 	OrderInverseTable = "orders"
 	// OrderColumn is the table column denoting the order relation/edge.
-	OrderColumn = "order_items"
+	OrderColumn = "order_id"
 	// ProductTable is the table that holds the product relation/edge.
 	ProductTable = "order_items"
 	// ProductInverseTable is the table name for the Product entity.
 	// It exists in this package in order to avoid circular dependency with the "product" package.
 	ProductInverseTable = "products"
 	// ProductColumn is the table column denoting the product relation/edge.
-	ProductColumn = "product_order_items"
+	ProductColumn = "product_id"
 )
 
 // Columns holds all SQL columns for orderitem fields.
 var Columns = []string{
 	FieldID,
+	FieldOrderID,
+	FieldProductID,
 	FieldQuantity,
 	FieldUnitPrice,
 }
 
-// ForeignKeys holds the SQL foreign-keys that are owned by the "order_items"
-// table and are not defined as standalone fields in the schema.
-var ForeignKeys = []string{
-	"order_items",
-	"product_order_items",
-}
-
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -59,11 +58,6 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
-	for i := range ForeignKeys {
-		if column == ForeignKeys[i] {
-			return true
-		}
-	}
 	return false
 }
 
@@ -75,6 +69,16 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByOrderID orders the results by the order_id field.
+func ByOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderID, opts...).ToFunc()
+}
+
+// ByProductID orders the results by the product_id field.
+func ByProductID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProductID, opts...).ToFunc()
+}
+
 // ByQuantity orders the results by the quantity field.
 func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
@@ -21,6 +21,34 @@ type OrderItemCreate struct {
 	hooks    []Hook
 }
 
+// SetOrderID sets the "order_id" field.
+func (oic *OrderItemCreate) SetOrderID(u uint64) *OrderItemCreate {
+	oic.mutation.SetOrderID(u)
+	return oic
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (oic *OrderItemCreate) SetNillableOrderID(u *uint64) *OrderItemCreate {
+	if u != nil {
+		oic.SetOrderID(*u)
+	}
+	return oic
+}
+
+// SetProductID sets the "product_id" field.
+func (oic *OrderItemCreate) SetProductID(u uint64) *OrderItemCreate {
+	oic.mutation.SetProductID(u)
+	return oic
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (oic *OrderItemCreate) SetNillableProductID(u *uint64) *OrderItemCreate {
+	if u != nil {
+		oic.SetProductID(*u)
+	}
+	return oic
+}
+
 // SetQuantity sets the "quantity" field.
 func (oic *OrderItemCreate) SetQuantity(i int) *OrderItemCreate {
 	oic.mutation.SetQuantity(i)
@@ -39,39 +67,11 @@ func (oic *OrderItemCreate) SetID(u uint64) *OrderItemCreate {
 	return oic
 }
 
-// SetOrderID sets the "order" edge to the Order entity by ID.
-func (oic *OrderItemCreate) SetOrderID(id uint64) *OrderItemCreate {
-	oic.mutation.SetOrderID(id)
-	return oic
-}
-
-// SetNillableOrderID sets the "order" edge to the Order entity by ID if the given value is not nil.
-func (oic *OrderItemCreate) SetNillableOrderID(id *uint64) *OrderItemCreate {
-	if id != nil {
-		oic = oic.SetOrderID(*id)
-	}
-	return oic
-}
-
 // SetOrder sets the "order" edge to the Order entity.
 func (oic *OrderItemCreate) SetOrder(o *Order) *OrderItemCreate {
 	return oic.SetOrderID(o.ID)
 }
 
-// SetProductID sets the "product" edge to the Product entity by ID.
-func (oic *OrderItemCreate) SetProductID(id uint64) *OrderItemCreate {
-	oic.mutation.SetProductID(id)
-	return oic
-}
-
-// SetNillableProductID sets the "product" edge to the Product entity by ID if the given value is not nil.
-func (oic *OrderItemCreate) SetNillableProductID(id *uint64) *OrderItemCreate {
-	if id != nil {
-		oic = oic.SetProductID(*id)
-	}
-	return oic
-}
-
 // SetProduct sets the "product" edge to the Product entity.
 func (oic *OrderItemCreate) SetProduct(p *Product) *OrderItemCreate {
 	return oic.SetProductID(p.ID)
@@ -171,7 +171,7 @@ func (oic *OrderItemCreate) createSpec() (*OrderItem, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.order_items = &nodes[0]
+		_node.OrderID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := oic.mutation.ProductIDs(); len(nodes) > 0 {
@@ -188,7 +188,7 @@ func (oic *OrderItemCreate) createSpec() (*OrderItem, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.product_order_items = &nodes[0]
+		_node.ProductID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
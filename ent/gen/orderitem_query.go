@@ -8,6 +8,7 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -26,7 +27,7 @@ type OrderItemQuery struct {
 	predicates  []predicate.OrderItem
 	withOrder   *OrderQuery
 	withProduct *ProductQuery
-	withFKs     bool
+	modifiers   []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -335,12 +336,12 @@ func (oiq *OrderItemQuery) WithProduct(opts ...func(*ProductQuery)) *OrderItemQu
 // Example:
 //
 //	var v []struct {
-//		Quantity int `json:"quantity,omitempty"`
+//		OrderID uint64 `json:"order_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.OrderItem.Query().
-//		GroupBy(orderitem.FieldQuantity).
+//		GroupBy(orderitem.FieldOrderID).
 //		Aggregate(gen.Count()).
 //		Scan(ctx, &v)
 func (oiq *OrderItemQuery) GroupBy(field string, fields ...string) *OrderItemGroupBy {
@@ -358,11 +359,11 @@ func (oiq *OrderItemQuery) GroupBy(field string, fields ...string) *OrderItemGro
 // Example:
 //
 //	var v []struct {
-//		Quantity int `json:"quantity,omitempty"`
+//		OrderID uint64 `json:"order_id,omitempty"`
 //	}
 //
 //	client.OrderItem.Query().
-//		Select(orderitem.FieldQuantity).
+//		Select(orderitem.FieldOrderID).
 //		Scan(ctx, &v)
 func (oiq *OrderItemQuery) Select(fields ...string) *OrderItemSelect {
 	oiq.ctx.Fields = append(oiq.ctx.Fields, fields...)
@@ -406,19 +407,12 @@ func (oiq *OrderItemQuery) prepareQuery(ctx context.Context) error {
 func (oiq *OrderItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OrderItem, error) {
 	var (
 		nodes       = []*OrderItem{}
-		withFKs     = oiq.withFKs
 		_spec       = oiq.querySpec()
 		loadedTypes = [2]bool{
 			oiq.withOrder != nil,
 			oiq.withProduct != nil,
 		}
 	)
-	if oiq.withOrder != nil || oiq.withProduct != nil {
-		withFKs = true
-	}
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, orderitem.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*OrderItem).scanValues(nil, columns)
 	}
@@ -428,6 +422,9 @@ func (oiq *OrderItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*O
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(oiq.modifiers) > 0 {
+		_spec.Modifiers = oiq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -456,10 +453,7 @@ func (oiq *OrderItemQuery) loadOrder(ctx context.Context, query *OrderQuery, nod
 	ids := make([]uint64, 0, len(nodes))
 	nodeids := make(map[uint64][]*OrderItem)
 	for i := range nodes {
-		if nodes[i].order_items == nil {
-			continue
-		}
-		fk := *nodes[i].order_items
+		fk := nodes[i].OrderID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -476,7 +470,7 @@ func (oiq *OrderItemQuery) loadOrder(ctx context.Context, query *OrderQuery, nod
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "order_items" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "order_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -488,10 +482,7 @@ func (oiq *OrderItemQuery) loadProduct(ctx context.Context, query *ProductQuery,
 	ids := make([]uint64, 0, len(nodes))
 	nodeids := make(map[uint64][]*OrderItem)
 	for i := range nodes {
-		if nodes[i].product_order_items == nil {
-			continue
-		}
-		fk := *nodes[i].product_order_items
+		fk := nodes[i].ProductID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -508,7 +499,7 @@ func (oiq *OrderItemQuery) loadProduct(ctx context.Context, query *ProductQuery,
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "product_order_items" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "product_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -519,6 +510,9 @@ func (oiq *OrderItemQuery) loadProduct(ctx context.Context, query *ProductQuery,
 
 func (oiq *OrderItemQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := oiq.querySpec()
+	if len(oiq.modifiers) > 0 {
+		_spec.Modifiers = oiq.modifiers
+	}
 	_spec.Node.Columns = oiq.ctx.Fields
 	if len(oiq.ctx.Fields) > 0 {
 		_spec.Unique = oiq.ctx.Unique != nil && *oiq.ctx.Unique
@@ -542,6 +536,12 @@ func (oiq *OrderItemQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if oiq.withOrder != nil {
+			_spec.Node.AddColumnOnce(orderitem.FieldOrderID)
+		}
+		if oiq.withProduct != nil {
+			_spec.Node.AddColumnOnce(orderitem.FieldProductID)
+		}
 	}
 	if ps := oiq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -581,6 +581,9 @@ func (oiq *OrderItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if oiq.ctx.Unique != nil && *oiq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range oiq.modifiers {
+		m(selector)
+	}
 	for _, p := range oiq.predicates {
 		p(selector)
 	}
@@ -598,6 +601,32 @@ func (oiq *OrderItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (oiq *OrderItemQuery) ForUpdate(opts ...sql.LockOption) *OrderItemQuery {
+	if oiq.driver.Dialect() == dialect.Postgres {
+		oiq.Unique(false)
+	}
+	oiq.modifiers = append(oiq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return oiq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (oiq *OrderItemQuery) ForShare(opts ...sql.LockOption) *OrderItemQuery {
+	if oiq.driver.Dialect() == dialect.Postgres {
+		oiq.Unique(false)
+	}
+	oiq.modifiers = append(oiq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return oiq
+}
+
 // OrderItemGroupBy is the group-by builder for OrderItem entities.
 type OrderItemGroupBy struct {
 	selector
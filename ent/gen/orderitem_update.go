@@ -29,6 +29,46 @@ func (oiu *OrderItemUpdate) Where(ps ...predicate.OrderItem) *OrderItemUpdate {
 	return oiu
 }
 
+// SetOrderID sets the "order_id" field.
+func (oiu *OrderItemUpdate) SetOrderID(u uint64) *OrderItemUpdate {
+	oiu.mutation.SetOrderID(u)
+	return oiu
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (oiu *OrderItemUpdate) SetNillableOrderID(u *uint64) *OrderItemUpdate {
+	if u != nil {
+		oiu.SetOrderID(*u)
+	}
+	return oiu
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (oiu *OrderItemUpdate) ClearOrderID() *OrderItemUpdate {
+	oiu.mutation.ClearOrderID()
+	return oiu
+}
+
+// SetProductID sets the "product_id" field.
+func (oiu *OrderItemUpdate) SetProductID(u uint64) *OrderItemUpdate {
+	oiu.mutation.SetProductID(u)
+	return oiu
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (oiu *OrderItemUpdate) SetNillableProductID(u *uint64) *OrderItemUpdate {
+	if u != nil {
+		oiu.SetProductID(*u)
+	}
+	return oiu
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (oiu *OrderItemUpdate) ClearProductID() *OrderItemUpdate {
+	oiu.mutation.ClearProductID()
+	return oiu
+}
+
 // SetQuantity sets the "quantity" field.
 func (oiu *OrderItemUpdate) SetQuantity(i int) *OrderItemUpdate {
 	oiu.mutation.ResetQuantity()
@@ -71,39 +111,11 @@ func (oiu *OrderItemUpdate) AddUnitPrice(f float64) *OrderItemUpdate {
 	return oiu
 }
 
-// SetOrderID sets the "order" edge to the Order entity by ID.
-func (oiu *OrderItemUpdate) SetOrderID(id uint64) *OrderItemUpdate {
-	oiu.mutation.SetOrderID(id)
-	return oiu
-}
-
-// SetNillableOrderID sets the "order" edge to the Order entity by ID if the given value is not nil.
-func (oiu *OrderItemUpdate) SetNillableOrderID(id *uint64) *OrderItemUpdate {
-	if id != nil {
-		oiu = oiu.SetOrderID(*id)
-	}
-	return oiu
-}
-
 // SetOrder sets the "order" edge to the Order entity.
 func (oiu *OrderItemUpdate) SetOrder(o *Order) *OrderItemUpdate {
 	return oiu.SetOrderID(o.ID)
 }
 
-// SetProductID sets the "product" edge to the Product entity by ID.
-func (oiu *OrderItemUpdate) SetProductID(id uint64) *OrderItemUpdate {
-	oiu.mutation.SetProductID(id)
-	return oiu
-}
-
-// SetNillableProductID sets the "product" edge to the Product entity by ID if the given value is not nil.
-func (oiu *OrderItemUpdate) SetNillableProductID(id *uint64) *OrderItemUpdate {
-	if id != nil {
-		oiu = oiu.SetProductID(*id)
-	}
-	return oiu
-}
-
 // SetProduct sets the "product" edge to the Product entity.
 func (oiu *OrderItemUpdate) SetProduct(p *Product) *OrderItemUpdate {
 	return oiu.SetProductID(p.ID)
@@ -252,6 +264,46 @@ type OrderItemUpdateOne struct {
 	mutation *OrderItemMutation
 }
 
+// SetOrderID sets the "order_id" field.
+func (oiuo *OrderItemUpdateOne) SetOrderID(u uint64) *OrderItemUpdateOne {
+	oiuo.mutation.SetOrderID(u)
+	return oiuo
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (oiuo *OrderItemUpdateOne) SetNillableOrderID(u *uint64) *OrderItemUpdateOne {
+	if u != nil {
+		oiuo.SetOrderID(*u)
+	}
+	return oiuo
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (oiuo *OrderItemUpdateOne) ClearOrderID() *OrderItemUpdateOne {
+	oiuo.mutation.ClearOrderID()
+	return oiuo
+}
+
+// SetProductID sets the "product_id" field.
+func (oiuo *OrderItemUpdateOne) SetProductID(u uint64) *OrderItemUpdateOne {
+	oiuo.mutation.SetProductID(u)
+	return oiuo
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (oiuo *OrderItemUpdateOne) SetNillableProductID(u *uint64) *OrderItemUpdateOne {
+	if u != nil {
+		oiuo.SetProductID(*u)
+	}
+	return oiuo
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (oiuo *OrderItemUpdateOne) ClearProductID() *OrderItemUpdateOne {
+	oiuo.mutation.ClearProductID()
+	return oiuo
+}
+
 // SetQuantity sets the "quantity" field.
 func (oiuo *OrderItemUpdateOne) SetQuantity(i int) *OrderItemUpdateOne {
 	oiuo.mutation.ResetQuantity()
@@ -294,39 +346,11 @@ func (oiuo *OrderItemUpdateOne) AddUnitPrice(f float64) *OrderItemUpdateOne {
 	return oiuo
 }
 
-// SetOrderID sets the "order" edge to the Order entity by ID.
-func (oiuo *OrderItemUpdateOne) SetOrderID(id uint64) *OrderItemUpdateOne {
-	oiuo.mutation.SetOrderID(id)
-	return oiuo
-}
-
-// SetNillableOrderID sets the "order" edge to the Order entity by ID if the given value is not nil.
-func (oiuo *OrderItemUpdateOne) SetNillableOrderID(id *uint64) *OrderItemUpdateOne {
-	if id != nil {
-		oiuo = oiuo.SetOrderID(*id)
-	}
-	return oiuo
-}
-
 // SetOrder sets the "order" edge to the Order entity.
 func (oiuo *OrderItemUpdateOne) SetOrder(o *Order) *OrderItemUpdateOne {
 	return oiuo.SetOrderID(o.ID)
 }
 
-// SetProductID sets the "product" edge to the Product entity by ID.
-func (oiuo *OrderItemUpdateOne) SetProductID(id uint64) *OrderItemUpdateOne {
-	oiuo.mutation.SetProductID(id)
-	return oiuo
-}
-
-// SetNillableProductID sets the "product" edge to the Product entity by ID if the given value is not nil.
-func (oiuo *OrderItemUpdateOne) SetNillableProductID(id *uint64) *OrderItemUpdateOne {
-	if id != nil {
-		oiuo = oiuo.SetProductID(*id)
-	}
-	return oiuo
-}
-
 // SetProduct sets the "product" edge to the Product entity.
 func (oiuo *OrderItemUpdateOne) SetProduct(p *Product) *OrderItemUpdateOne {
 	return oiuo.SetProductID(p.ID)
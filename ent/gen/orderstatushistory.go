@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/thang1834/go-goss/ent/gen/order"
+	"github.com/thang1834/go-goss/ent/gen/orderstatushistory"
+	"github.com/thang1834/go-goss/ent/gen/user"
+)
+
+// OrderStatusHistory is the model entity for the OrderStatusHistory schema.
+type OrderStatusHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// OrderID holds the value of the "order_id" field.
+	OrderID uint64 `json:"order_id,omitempty"`
+	// FromStatus holds the value of the "from_status" field.
+	FromStatus string `json:"from_status,omitempty"`
+	// ToStatus holds the value of the "to_status" field.
+	ToStatus string `json:"to_status,omitempty"`
+	// ChangedBy holds the value of the "changed_by" field.
+	ChangedBy *uint64 `json:"changed_by,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OrderStatusHistoryQuery when eager-loading is set.
+	Edges        OrderStatusHistoryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OrderStatusHistoryEdges holds the relations/edges for other nodes in the graph.
+type OrderStatusHistoryEdges struct {
+	// Order holds the value of the order edge.
+	Order *Order `json:"order,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OrderOrErr returns the Order value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderStatusHistoryEdges) OrderOrErr() (*Order, error) {
+	if e.Order != nil {
+		return e.Order, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: order.Label}
+	}
+	return nil, &NotLoadedError{edge: "order"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderStatusHistoryEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OrderStatusHistory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case orderstatushistory.FieldID, orderstatushistory.FieldOrderID, orderstatushistory.FieldChangedBy:
+			values[i] = new(sql.NullInt64)
+		case orderstatushistory.FieldFromStatus, orderstatushistory.FieldToStatus, orderstatushistory.FieldNote:
+			values[i] = new(sql.NullString)
+		case orderstatushistory.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OrderStatusHistory fields.
+func (osh *OrderStatusHistory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case orderstatushistory.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			osh.ID = uint64(value.Int64)
+		case orderstatushistory.FieldOrderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value.Valid {
+				osh.OrderID = uint64(value.Int64)
+			}
+		case orderstatushistory.FieldFromStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_status", values[i])
+			} else if value.Valid {
+				osh.FromStatus = value.String
+			}
+		case orderstatushistory.FieldToStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_status", values[i])
+			} else if value.Valid {
+				osh.ToStatus = value.String
+			}
+		case orderstatushistory.FieldChangedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_by", values[i])
+			} else if value.Valid {
+				osh.ChangedBy = new(uint64)
+				*osh.ChangedBy = uint64(value.Int64)
+			}
+		case orderstatushistory.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				osh.Note = value.String
+			}
+		case orderstatushistory.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				osh.CreatedAt = value.Time
+			}
+		default:
+			osh.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OrderStatusHistory.
+// This includes values selected through modifiers, order, etc.
+func (osh *OrderStatusHistory) Value(name string) (ent.Value, error) {
+	return osh.selectValues.Get(name)
+}
+
+// QueryOrder queries the "order" edge of the OrderStatusHistory entity.
+func (osh *OrderStatusHistory) QueryOrder() *OrderQuery {
+	return NewOrderStatusHistoryClient(osh.config).QueryOrder(osh)
+}
+
+// QueryUser queries the "user" edge of the OrderStatusHistory entity.
+func (osh *OrderStatusHistory) QueryUser() *UserQuery {
+	return NewOrderStatusHistoryClient(osh.config).QueryUser(osh)
+}
+
+// Update returns a builder for updating this OrderStatusHistory.
+// Note that you need to call OrderStatusHistory.Unwrap() before calling this method if this OrderStatusHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (osh *OrderStatusHistory) Update() *OrderStatusHistoryUpdateOne {
+	return NewOrderStatusHistoryClient(osh.config).UpdateOne(osh)
+}
+
+// Unwrap unwraps the OrderStatusHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (osh *OrderStatusHistory) Unwrap() *OrderStatusHistory {
+	_tx, ok := osh.config.driver.(*txDriver)
+	if !ok {
+		panic("gen: OrderStatusHistory is not a transactional entity")
+	}
+	osh.config.driver = _tx.drv
+	return osh
+}
+
+// String implements the fmt.Stringer.
+func (osh *OrderStatusHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("OrderStatusHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", osh.ID))
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", osh.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("from_status=")
+	builder.WriteString(osh.FromStatus)
+	builder.WriteString(", ")
+	builder.WriteString("to_status=")
+	builder.WriteString(osh.ToStatus)
+	builder.WriteString(", ")
+	if v := osh.ChangedBy; v != nil {
+		builder.WriteString("changed_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(osh.Note)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(osh.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OrderStatusHistories is a parsable slice of OrderStatusHistory.
+type OrderStatusHistories []*OrderStatusHistory
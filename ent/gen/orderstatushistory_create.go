@@ -0,0 +1,349 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/thang1834/go-goss/ent/gen/order"
+	"github.com/thang1834/go-goss/ent/gen/orderstatushistory"
+	"github.com/thang1834/go-goss/ent/gen/user"
+)
+
+// OrderStatusHistoryCreate is the builder for creating a OrderStatusHistory entity.
+type OrderStatusHistoryCreate struct {
+	config
+	mutation *OrderStatusHistoryMutation
+	hooks    []Hook
+}
+
+// SetOrderID sets the "order_id" field.
+func (oshc *OrderStatusHistoryCreate) SetOrderID(u uint64) *OrderStatusHistoryCreate {
+	oshc.mutation.SetOrderID(u)
+	return oshc
+}
+
+// SetFromStatus sets the "from_status" field.
+func (oshc *OrderStatusHistoryCreate) SetFromStatus(s string) *OrderStatusHistoryCreate {
+	oshc.mutation.SetFromStatus(s)
+	return oshc
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (oshc *OrderStatusHistoryCreate) SetNillableFromStatus(s *string) *OrderStatusHistoryCreate {
+	if s != nil {
+		oshc.SetFromStatus(*s)
+	}
+	return oshc
+}
+
+// SetToStatus sets the "to_status" field.
+func (oshc *OrderStatusHistoryCreate) SetToStatus(s string) *OrderStatusHistoryCreate {
+	oshc.mutation.SetToStatus(s)
+	return oshc
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (oshc *OrderStatusHistoryCreate) SetChangedBy(u uint64) *OrderStatusHistoryCreate {
+	oshc.mutation.SetChangedBy(u)
+	return oshc
+}
+
+// SetNillableChangedBy sets the "changed_by" field if the given value is not nil.
+func (oshc *OrderStatusHistoryCreate) SetNillableChangedBy(u *uint64) *OrderStatusHistoryCreate {
+	if u != nil {
+		oshc.SetChangedBy(*u)
+	}
+	return oshc
+}
+
+// SetNote sets the "note" field.
+func (oshc *OrderStatusHistoryCreate) SetNote(s string) *OrderStatusHistoryCreate {
+	oshc.mutation.SetNote(s)
+	return oshc
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (oshc *OrderStatusHistoryCreate) SetNillableNote(s *string) *OrderStatusHistoryCreate {
+	if s != nil {
+		oshc.SetNote(*s)
+	}
+	return oshc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (oshc *OrderStatusHistoryCreate) SetCreatedAt(t time.Time) *OrderStatusHistoryCreate {
+	oshc.mutation.SetCreatedAt(t)
+	return oshc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (oshc *OrderStatusHistoryCreate) SetNillableCreatedAt(t *time.Time) *OrderStatusHistoryCreate {
+	if t != nil {
+		oshc.SetCreatedAt(*t)
+	}
+	return oshc
+}
+
+// SetID sets the "id" field.
+func (oshc *OrderStatusHistoryCreate) SetID(u uint64) *OrderStatusHistoryCreate {
+	oshc.mutation.SetID(u)
+	return oshc
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (oshc *OrderStatusHistoryCreate) SetOrder(o *Order) *OrderStatusHistoryCreate {
+	return oshc.SetOrderID(o.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (oshc *OrderStatusHistoryCreate) SetUserID(id uint64) *OrderStatusHistoryCreate {
+	oshc.mutation.SetUserID(id)
+	return oshc
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (oshc *OrderStatusHistoryCreate) SetNillableUserID(id *uint64) *OrderStatusHistoryCreate {
+	if id != nil {
+		oshc = oshc.SetUserID(*id)
+	}
+	return oshc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (oshc *OrderStatusHistoryCreate) SetUser(u *User) *OrderStatusHistoryCreate {
+	return oshc.SetUserID(u.ID)
+}
+
+// Mutation returns the OrderStatusHistoryMutation object of the builder.
+func (oshc *OrderStatusHistoryCreate) Mutation() *OrderStatusHistoryMutation {
+	return oshc.mutation
+}
+
+// Save creates the OrderStatusHistory in the database.
+func (oshc *OrderStatusHistoryCreate) Save(ctx context.Context) (*OrderStatusHistory, error) {
+	oshc.defaults()
+	return withHooks(ctx, oshc.sqlSave, oshc.mutation, oshc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (oshc *OrderStatusHistoryCreate) SaveX(ctx context.Context) *OrderStatusHistory {
+	v, err := oshc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oshc *OrderStatusHistoryCreate) Exec(ctx context.Context) error {
+	_, err := oshc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oshc *OrderStatusHistoryCreate) ExecX(ctx context.Context) {
+	if err := oshc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oshc *OrderStatusHistoryCreate) defaults() {
+	if _, ok := oshc.mutation.CreatedAt(); !ok {
+		v := orderstatushistory.DefaultCreatedAt()
+		oshc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oshc *OrderStatusHistoryCreate) check() error {
+	if _, ok := oshc.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`gen: missing required field "OrderStatusHistory.order_id"`)}
+	}
+	if _, ok := oshc.mutation.ToStatus(); !ok {
+		return &ValidationError{Name: "to_status", err: errors.New(`gen: missing required field "OrderStatusHistory.to_status"`)}
+	}
+	if _, ok := oshc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`gen: missing required field "OrderStatusHistory.created_at"`)}
+	}
+	if len(oshc.mutation.OrderIDs()) == 0 {
+		return &ValidationError{Name: "order", err: errors.New(`gen: missing required edge "OrderStatusHistory.order"`)}
+	}
+	return nil
+}
+
+func (oshc *OrderStatusHistoryCreate) sqlSave(ctx context.Context) (*OrderStatusHistory, error) {
+	if err := oshc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := oshc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, oshc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	oshc.mutation.id = &_node.ID
+	oshc.mutation.done = true
+	return _node, nil
+}
+
+func (oshc *OrderStatusHistoryCreate) createSpec() (*OrderStatusHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OrderStatusHistory{config: oshc.config}
+		_spec = sqlgraph.NewCreateSpec(orderstatushistory.Table, sqlgraph.NewFieldSpec(orderstatushistory.FieldID, field.TypeUint64))
+	)
+	if id, ok := oshc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := oshc.mutation.FromStatus(); ok {
+		_spec.SetField(orderstatushistory.FieldFromStatus, field.TypeString, value)
+		_node.FromStatus = value
+	}
+	if value, ok := oshc.mutation.ToStatus(); ok {
+		_spec.SetField(orderstatushistory.FieldToStatus, field.TypeString, value)
+		_node.ToStatus = value
+	}
+	if value, ok := oshc.mutation.Note(); ok {
+		_spec.SetField(orderstatushistory.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := oshc.mutation.CreatedAt(); ok {
+		_spec.SetField(orderstatushistory.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := oshc.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderstatushistory.OrderTable,
+			Columns: []string{orderstatushistory.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := oshc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderstatushistory.UserTable,
+			Columns: []string{orderstatushistory.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ChangedBy = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OrderStatusHistoryCreateBulk is the builder for creating many OrderStatusHistory entities in bulk.
+type OrderStatusHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*OrderStatusHistoryCreate
+}
+
+// Save creates the OrderStatusHistory entities in the database.
+func (oshcb *OrderStatusHistoryCreateBulk) Save(ctx context.Context) ([]*OrderStatusHistory, error) {
+	if oshcb.err != nil {
+		return nil, oshcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(oshcb.builders))
+	nodes := make([]*OrderStatusHistory, len(oshcb.builders))
+	mutators := make([]Mutator, len(oshcb.builders))
+	for i := range oshcb.builders {
+		func(i int, root context.Context) {
+			builder := oshcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OrderStatusHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, oshcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, oshcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, oshcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oshcb *OrderStatusHistoryCreateBulk) SaveX(ctx context.Context) []*OrderStatusHistory {
+	v, err := oshcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oshcb *OrderStatusHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := oshcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oshcb *OrderStatusHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := oshcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/thang1834/go-goss/ent/gen/orderstatushistory"
+	"github.com/thang1834/go-goss/ent/gen/predicate"
+)
+
+// OrderStatusHistoryDelete is the builder for deleting a OrderStatusHistory entity.
+type OrderStatusHistoryDelete struct {
+	config
+	hooks    []Hook
+	mutation *OrderStatusHistoryMutation
+}
+
+// Where appends a list predicates to the OrderStatusHistoryDelete builder.
+func (oshd *OrderStatusHistoryDelete) Where(ps ...predicate.OrderStatusHistory) *OrderStatusHistoryDelete {
+	oshd.mutation.Where(ps...)
+	return oshd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (oshd *OrderStatusHistoryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, oshd.sqlExec, oshd.mutation, oshd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oshd *OrderStatusHistoryDelete) ExecX(ctx context.Context) int {
+	n, err := oshd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (oshd *OrderStatusHistoryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(orderstatushistory.Table, sqlgraph.NewFieldSpec(orderstatushistory.FieldID, field.TypeUint64))
+	if ps := oshd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, oshd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	oshd.mutation.done = true
+	return affected, err
+}
+
+// OrderStatusHistoryDeleteOne is the builder for deleting a single OrderStatusHistory entity.
+type OrderStatusHistoryDeleteOne struct {
+	oshd *OrderStatusHistoryDelete
+}
+
+// Where appends a list predicates to the OrderStatusHistoryDelete builder.
+func (oshdo *OrderStatusHistoryDeleteOne) Where(ps ...predicate.OrderStatusHistory) *OrderStatusHistoryDeleteOne {
+	oshdo.oshd.mutation.Where(ps...)
+	return oshdo
+}
+
+// Exec executes the deletion query.
+func (oshdo *OrderStatusHistoryDeleteOne) Exec(ctx context.Context) error {
+	n, err := oshdo.oshd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{orderstatushistory.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oshdo *OrderStatusHistoryDeleteOne) ExecX(ctx context.Context) {
+	if err := oshdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,721 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/thang1834/go-goss/ent/gen/order"
+	"github.com/thang1834/go-goss/ent/gen/orderstatushistory"
+	"github.com/thang1834/go-goss/ent/gen/predicate"
+	"github.com/thang1834/go-goss/ent/gen/user"
+)
+
+// OrderStatusHistoryQuery is the builder for querying OrderStatusHistory entities.
+type OrderStatusHistoryQuery struct {
+	config
+	ctx        *QueryContext
+	order      []orderstatushistory.OrderOption
+	inters     []Interceptor
+	predicates []predicate.OrderStatusHistory
+	withOrder  *OrderQuery
+	withUser   *UserQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OrderStatusHistoryQuery builder.
+func (oshq *OrderStatusHistoryQuery) Where(ps ...predicate.OrderStatusHistory) *OrderStatusHistoryQuery {
+	oshq.predicates = append(oshq.predicates, ps...)
+	return oshq
+}
+
+// Limit the number of records to be returned by this query.
+func (oshq *OrderStatusHistoryQuery) Limit(limit int) *OrderStatusHistoryQuery {
+	oshq.ctx.Limit = &limit
+	return oshq
+}
+
+// Offset to start from.
+func (oshq *OrderStatusHistoryQuery) Offset(offset int) *OrderStatusHistoryQuery {
+	oshq.ctx.Offset = &offset
+	return oshq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (oshq *OrderStatusHistoryQuery) Unique(unique bool) *OrderStatusHistoryQuery {
+	oshq.ctx.Unique = &unique
+	return oshq
+}
+
+// Order specifies how the records should be ordered.
+func (oshq *OrderStatusHistoryQuery) Order(o ...orderstatushistory.OrderOption) *OrderStatusHistoryQuery {
+	oshq.order = append(oshq.order, o...)
+	return oshq
+}
+
+// QueryOrder chains the current query on the "order" edge.
+func (oshq *OrderStatusHistoryQuery) QueryOrder() *OrderQuery {
+	query := (&OrderClient{config: oshq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oshq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oshq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderstatushistory.Table, orderstatushistory.FieldID, selector),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderstatushistory.OrderTable, orderstatushistory.OrderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oshq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (oshq *OrderStatusHistoryQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: oshq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oshq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oshq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderstatushistory.Table, orderstatushistory.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderstatushistory.UserTable, orderstatushistory.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oshq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first OrderStatusHistory entity from the query.
+// Returns a *NotFoundError when no OrderStatusHistory was found.
+func (oshq *OrderStatusHistoryQuery) First(ctx context.Context) (*OrderStatusHistory, error) {
+	nodes, err := oshq.Limit(1).All(setContextOp(ctx, oshq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{orderstatushistory.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (oshq *OrderStatusHistoryQuery) FirstX(ctx context.Context) *OrderStatusHistory {
+	node, err := oshq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OrderStatusHistory ID from the query.
+// Returns a *NotFoundError when no OrderStatusHistory ID was found.
+func (oshq *OrderStatusHistoryQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = oshq.Limit(1).IDs(setContextOp(ctx, oshq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{orderstatushistory.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (oshq *OrderStatusHistoryQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := oshq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OrderStatusHistory entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OrderStatusHistory entity is found.
+// Returns a *NotFoundError when no OrderStatusHistory entities are found.
+func (oshq *OrderStatusHistoryQuery) Only(ctx context.Context) (*OrderStatusHistory, error) {
+	nodes, err := oshq.Limit(2).All(setContextOp(ctx, oshq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{orderstatushistory.Label}
+	default:
+		return nil, &NotSingularError{orderstatushistory.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (oshq *OrderStatusHistoryQuery) OnlyX(ctx context.Context) *OrderStatusHistory {
+	node, err := oshq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OrderStatusHistory ID in the query.
+// Returns a *NotSingularError when more than one OrderStatusHistory ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (oshq *OrderStatusHistoryQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = oshq.Limit(2).IDs(setContextOp(ctx, oshq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{orderstatushistory.Label}
+	default:
+		err = &NotSingularError{orderstatushistory.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (oshq *OrderStatusHistoryQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := oshq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OrderStatusHistories.
+func (oshq *OrderStatusHistoryQuery) All(ctx context.Context) ([]*OrderStatusHistory, error) {
+	ctx = setContextOp(ctx, oshq.ctx, ent.OpQueryAll)
+	if err := oshq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*OrderStatusHistory, *OrderStatusHistoryQuery]()
+	return withInterceptors[[]*OrderStatusHistory](ctx, oshq, qr, oshq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (oshq *OrderStatusHistoryQuery) AllX(ctx context.Context) []*OrderStatusHistory {
+	nodes, err := oshq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OrderStatusHistory IDs.
+func (oshq *OrderStatusHistoryQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if oshq.ctx.Unique == nil && oshq.path != nil {
+		oshq.Unique(true)
+	}
+	ctx = setContextOp(ctx, oshq.ctx, ent.OpQueryIDs)
+	if err = oshq.Select(orderstatushistory.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (oshq *OrderStatusHistoryQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := oshq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (oshq *OrderStatusHistoryQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, oshq.ctx, ent.OpQueryCount)
+	if err := oshq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, oshq, querierCount[*OrderStatusHistoryQuery](), oshq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (oshq *OrderStatusHistoryQuery) CountX(ctx context.Context) int {
+	count, err := oshq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (oshq *OrderStatusHistoryQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, oshq.ctx, ent.OpQueryExist)
+	switch _, err := oshq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("gen: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (oshq *OrderStatusHistoryQuery) ExistX(ctx context.Context) bool {
+	exist, err := oshq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OrderStatusHistoryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (oshq *OrderStatusHistoryQuery) Clone() *OrderStatusHistoryQuery {
+	if oshq == nil {
+		return nil
+	}
+	return &OrderStatusHistoryQuery{
+		config:     oshq.config,
+		ctx:        oshq.ctx.Clone(),
+		order:      append([]orderstatushistory.OrderOption{}, oshq.order...),
+		inters:     append([]Interceptor{}, oshq.inters...),
+		predicates: append([]predicate.OrderStatusHistory{}, oshq.predicates...),
+		withOrder:  oshq.withOrder.Clone(),
+		withUser:   oshq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  oshq.sql.Clone(),
+		path: oshq.path,
+	}
+}
+
+// WithOrder tells the query-builder to eager-load the nodes that are connected to
+// the "order" edge. The optional arguments are used to configure the query builder of the edge.
+func (oshq *OrderStatusHistoryQuery) WithOrder(opts ...func(*OrderQuery)) *OrderStatusHistoryQuery {
+	query := (&OrderClient{config: oshq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oshq.withOrder = query
+	return oshq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (oshq *OrderStatusHistoryQuery) WithUser(opts ...func(*UserQuery)) *OrderStatusHistoryQuery {
+	query := (&UserClient{config: oshq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oshq.withUser = query
+	return oshq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		OrderID uint64 `json:"order_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OrderStatusHistory.Query().
+//		GroupBy(orderstatushistory.FieldOrderID).
+//		Aggregate(gen.Count()).
+//		Scan(ctx, &v)
+func (oshq *OrderStatusHistoryQuery) GroupBy(field string, fields ...string) *OrderStatusHistoryGroupBy {
+	oshq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OrderStatusHistoryGroupBy{build: oshq}
+	grbuild.flds = &oshq.ctx.Fields
+	grbuild.label = orderstatushistory.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		OrderID uint64 `json:"order_id,omitempty"`
+//	}
+//
+//	client.OrderStatusHistory.Query().
+//		Select(orderstatushistory.FieldOrderID).
+//		Scan(ctx, &v)
+func (oshq *OrderStatusHistoryQuery) Select(fields ...string) *OrderStatusHistorySelect {
+	oshq.ctx.Fields = append(oshq.ctx.Fields, fields...)
+	sbuild := &OrderStatusHistorySelect{OrderStatusHistoryQuery: oshq}
+	sbuild.label = orderstatushistory.Label
+	sbuild.flds, sbuild.scan = &oshq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OrderStatusHistorySelect configured with the given aggregations.
+func (oshq *OrderStatusHistoryQuery) Aggregate(fns ...AggregateFunc) *OrderStatusHistorySelect {
+	return oshq.Select().Aggregate(fns...)
+}
+
+func (oshq *OrderStatusHistoryQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range oshq.inters {
+		if inter == nil {
+			return fmt.Errorf("gen: uninitialized interceptor (forgotten import gen/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, oshq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range oshq.ctx.Fields {
+		if !orderstatushistory.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("gen: invalid field %q for query", f)}
+		}
+	}
+	if oshq.path != nil {
+		prev, err := oshq.path(ctx)
+		if err != nil {
+			return err
+		}
+		oshq.sql = prev
+	}
+	return nil
+}
+
+func (oshq *OrderStatusHistoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OrderStatusHistory, error) {
+	var (
+		nodes       = []*OrderStatusHistory{}
+		_spec       = oshq.querySpec()
+		loadedTypes = [2]bool{
+			oshq.withOrder != nil,
+			oshq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*OrderStatusHistory).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &OrderStatusHistory{config: oshq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(oshq.modifiers) > 0 {
+		_spec.Modifiers = oshq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, oshq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := oshq.withOrder; query != nil {
+		if err := oshq.loadOrder(ctx, query, nodes, nil,
+			func(n *OrderStatusHistory, e *Order) { n.Edges.Order = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := oshq.withUser; query != nil {
+		if err := oshq.loadUser(ctx, query, nodes, nil,
+			func(n *OrderStatusHistory, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (oshq *OrderStatusHistoryQuery) loadOrder(ctx context.Context, query *OrderQuery, nodes []*OrderStatusHistory, init func(*OrderStatusHistory), assign func(*OrderStatusHistory, *Order)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*OrderStatusHistory)
+	for i := range nodes {
+		fk := nodes[i].OrderID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(order.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "order_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (oshq *OrderStatusHistoryQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*OrderStatusHistory, init func(*OrderStatusHistory), assign func(*OrderStatusHistory, *User)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*OrderStatusHistory)
+	for i := range nodes {
+		if nodes[i].ChangedBy == nil {
+			continue
+		}
+		fk := *nodes[i].ChangedBy
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "changed_by" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (oshq *OrderStatusHistoryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := oshq.querySpec()
+	if len(oshq.modifiers) > 0 {
+		_spec.Modifiers = oshq.modifiers
+	}
+	_spec.Node.Columns = oshq.ctx.Fields
+	if len(oshq.ctx.Fields) > 0 {
+		_spec.Unique = oshq.ctx.Unique != nil && *oshq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, oshq.driver, _spec)
+}
+
+func (oshq *OrderStatusHistoryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(orderstatushistory.Table, orderstatushistory.Columns, sqlgraph.NewFieldSpec(orderstatushistory.FieldID, field.TypeUint64))
+	_spec.From = oshq.sql
+	if unique := oshq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if oshq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := oshq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, orderstatushistory.FieldID)
+		for i := range fields {
+			if fields[i] != orderstatushistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if oshq.withOrder != nil {
+			_spec.Node.AddColumnOnce(orderstatushistory.FieldOrderID)
+		}
+		if oshq.withUser != nil {
+			_spec.Node.AddColumnOnce(orderstatushistory.FieldChangedBy)
+		}
+	}
+	if ps := oshq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := oshq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := oshq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := oshq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (oshq *OrderStatusHistoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(oshq.driver.Dialect())
+	t1 := builder.Table(orderstatushistory.Table)
+	columns := oshq.ctx.Fields
+	if len(columns) == 0 {
+		columns = orderstatushistory.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if oshq.sql != nil {
+		selector = oshq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if oshq.ctx.Unique != nil && *oshq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range oshq.modifiers {
+		m(selector)
+	}
+	for _, p := range oshq.predicates {
+		p(selector)
+	}
+	for _, p := range oshq.order {
+		p(selector)
+	}
+	if offset := oshq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := oshq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (oshq *OrderStatusHistoryQuery) ForUpdate(opts ...sql.LockOption) *OrderStatusHistoryQuery {
+	if oshq.driver.Dialect() == dialect.Postgres {
+		oshq.Unique(false)
+	}
+	oshq.modifiers = append(oshq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return oshq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (oshq *OrderStatusHistoryQuery) ForShare(opts ...sql.LockOption) *OrderStatusHistoryQuery {
+	if oshq.driver.Dialect() == dialect.Postgres {
+		oshq.Unique(false)
+	}
+	oshq.modifiers = append(oshq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return oshq
+}
+
+// OrderStatusHistoryGroupBy is the group-by builder for OrderStatusHistory entities.
+type OrderStatusHistoryGroupBy struct {
+	selector
+	build *OrderStatusHistoryQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (oshgb *OrderStatusHistoryGroupBy) Aggregate(fns ...AggregateFunc) *OrderStatusHistoryGroupBy {
+	oshgb.fns = append(oshgb.fns, fns...)
+	return oshgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (oshgb *OrderStatusHistoryGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, oshgb.build.ctx, ent.OpQueryGroupBy)
+	if err := oshgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OrderStatusHistoryQuery, *OrderStatusHistoryGroupBy](ctx, oshgb.build, oshgb, oshgb.build.inters, v)
+}
+
+func (oshgb *OrderStatusHistoryGroupBy) sqlScan(ctx context.Context, root *OrderStatusHistoryQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(oshgb.fns))
+	for _, fn := range oshgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*oshgb.flds)+len(oshgb.fns))
+		for _, f := range *oshgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*oshgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := oshgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OrderStatusHistorySelect is the builder for selecting fields of OrderStatusHistory entities.
+type OrderStatusHistorySelect struct {
+	*OrderStatusHistoryQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (oshs *OrderStatusHistorySelect) Aggregate(fns ...AggregateFunc) *OrderStatusHistorySelect {
+	oshs.fns = append(oshs.fns, fns...)
+	return oshs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (oshs *OrderStatusHistorySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, oshs.ctx, ent.OpQuerySelect)
+	if err := oshs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OrderStatusHistoryQuery, *OrderStatusHistorySelect](ctx, oshs.OrderStatusHistoryQuery, oshs, oshs.inters, v)
+}
+
+func (oshs *OrderStatusHistorySelect) sqlScan(ctx context.Context, root *OrderStatusHistoryQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(oshs.fns))
+	for _, fn := range oshs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*oshs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := oshs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
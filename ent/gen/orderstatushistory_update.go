@@ -0,0 +1,628 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/thang1834/go-goss/ent/gen/order"
+	"github.com/thang1834/go-goss/ent/gen/orderstatushistory"
+	"github.com/thang1834/go-goss/ent/gen/predicate"
+	"github.com/thang1834/go-goss/ent/gen/user"
+)
+
+// OrderStatusHistoryUpdate is the builder for updating OrderStatusHistory entities.
+type OrderStatusHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OrderStatusHistoryMutation
+}
+
+// Where appends a list predicates to the OrderStatusHistoryUpdate builder.
+func (oshu *OrderStatusHistoryUpdate) Where(ps ...predicate.OrderStatusHistory) *OrderStatusHistoryUpdate {
+	oshu.mutation.Where(ps...)
+	return oshu
+}
+
+// SetOrderID sets the "order_id" field.
+func (oshu *OrderStatusHistoryUpdate) SetOrderID(u uint64) *OrderStatusHistoryUpdate {
+	oshu.mutation.SetOrderID(u)
+	return oshu
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (oshu *OrderStatusHistoryUpdate) SetNillableOrderID(u *uint64) *OrderStatusHistoryUpdate {
+	if u != nil {
+		oshu.SetOrderID(*u)
+	}
+	return oshu
+}
+
+// SetFromStatus sets the "from_status" field.
+func (oshu *OrderStatusHistoryUpdate) SetFromStatus(s string) *OrderStatusHistoryUpdate {
+	oshu.mutation.SetFromStatus(s)
+	return oshu
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (oshu *OrderStatusHistoryUpdate) SetNillableFromStatus(s *string) *OrderStatusHistoryUpdate {
+	if s != nil {
+		oshu.SetFromStatus(*s)
+	}
+	return oshu
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (oshu *OrderStatusHistoryUpdate) ClearFromStatus() *OrderStatusHistoryUpdate {
+	oshu.mutation.ClearFromStatus()
+	return oshu
+}
+
+// SetToStatus sets the "to_status" field.
+func (oshu *OrderStatusHistoryUpdate) SetToStatus(s string) *OrderStatusHistoryUpdate {
+	oshu.mutation.SetToStatus(s)
+	return oshu
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (oshu *OrderStatusHistoryUpdate) SetNillableToStatus(s *string) *OrderStatusHistoryUpdate {
+	if s != nil {
+		oshu.SetToStatus(*s)
+	}
+	return oshu
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (oshu *OrderStatusHistoryUpdate) SetChangedBy(u uint64) *OrderStatusHistoryUpdate {
+	oshu.mutation.SetChangedBy(u)
+	return oshu
+}
+
+// SetNillableChangedBy sets the "changed_by" field if the given value is not nil.
+func (oshu *OrderStatusHistoryUpdate) SetNillableChangedBy(u *uint64) *OrderStatusHistoryUpdate {
+	if u != nil {
+		oshu.SetChangedBy(*u)
+	}
+	return oshu
+}
+
+// ClearChangedBy clears the value of the "changed_by" field.
+func (oshu *OrderStatusHistoryUpdate) ClearChangedBy() *OrderStatusHistoryUpdate {
+	oshu.mutation.ClearChangedBy()
+	return oshu
+}
+
+// SetNote sets the "note" field.
+func (oshu *OrderStatusHistoryUpdate) SetNote(s string) *OrderStatusHistoryUpdate {
+	oshu.mutation.SetNote(s)
+	return oshu
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (oshu *OrderStatusHistoryUpdate) SetNillableNote(s *string) *OrderStatusHistoryUpdate {
+	if s != nil {
+		oshu.SetNote(*s)
+	}
+	return oshu
+}
+
+// ClearNote clears the value of the "note" field.
+func (oshu *OrderStatusHistoryUpdate) ClearNote() *OrderStatusHistoryUpdate {
+	oshu.mutation.ClearNote()
+	return oshu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (oshu *OrderStatusHistoryUpdate) SetCreatedAt(t time.Time) *OrderStatusHistoryUpdate {
+	oshu.mutation.SetCreatedAt(t)
+	return oshu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (oshu *OrderStatusHistoryUpdate) SetNillableCreatedAt(t *time.Time) *OrderStatusHistoryUpdate {
+	if t != nil {
+		oshu.SetCreatedAt(*t)
+	}
+	return oshu
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (oshu *OrderStatusHistoryUpdate) SetOrder(o *Order) *OrderStatusHistoryUpdate {
+	return oshu.SetOrderID(o.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (oshu *OrderStatusHistoryUpdate) SetUserID(id uint64) *OrderStatusHistoryUpdate {
+	oshu.mutation.SetUserID(id)
+	return oshu
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (oshu *OrderStatusHistoryUpdate) SetNillableUserID(id *uint64) *OrderStatusHistoryUpdate {
+	if id != nil {
+		oshu = oshu.SetUserID(*id)
+	}
+	return oshu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (oshu *OrderStatusHistoryUpdate) SetUser(u *User) *OrderStatusHistoryUpdate {
+	return oshu.SetUserID(u.ID)
+}
+
+// Mutation returns the OrderStatusHistoryMutation object of the builder.
+func (oshu *OrderStatusHistoryUpdate) Mutation() *OrderStatusHistoryMutation {
+	return oshu.mutation
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (oshu *OrderStatusHistoryUpdate) ClearOrder() *OrderStatusHistoryUpdate {
+	oshu.mutation.ClearOrder()
+	return oshu
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (oshu *OrderStatusHistoryUpdate) ClearUser() *OrderStatusHistoryUpdate {
+	oshu.mutation.ClearUser()
+	return oshu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (oshu *OrderStatusHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, oshu.sqlSave, oshu.mutation, oshu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oshu *OrderStatusHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := oshu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (oshu *OrderStatusHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := oshu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oshu *OrderStatusHistoryUpdate) ExecX(ctx context.Context) {
+	if err := oshu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oshu *OrderStatusHistoryUpdate) check() error {
+	if oshu.mutation.OrderCleared() && len(oshu.mutation.OrderIDs()) > 0 {
+		return errors.New(`gen: clearing a required unique edge "OrderStatusHistory.order"`)
+	}
+	return nil
+}
+
+func (oshu *OrderStatusHistoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := oshu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(orderstatushistory.Table, orderstatushistory.Columns, sqlgraph.NewFieldSpec(orderstatushistory.FieldID, field.TypeUint64))
+	if ps := oshu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oshu.mutation.FromStatus(); ok {
+		_spec.SetField(orderstatushistory.FieldFromStatus, field.TypeString, value)
+	}
+	if oshu.mutation.FromStatusCleared() {
+		_spec.ClearField(orderstatushistory.FieldFromStatus, field.TypeString)
+	}
+	if value, ok := oshu.mutation.ToStatus(); ok {
+		_spec.SetField(orderstatushistory.FieldToStatus, field.TypeString, value)
+	}
+	if value, ok := oshu.mutation.Note(); ok {
+		_spec.SetField(orderstatushistory.FieldNote, field.TypeString, value)
+	}
+	if oshu.mutation.NoteCleared() {
+		_spec.ClearField(orderstatushistory.FieldNote, field.TypeString)
+	}
+	if value, ok := oshu.mutation.CreatedAt(); ok {
+		_spec.SetField(orderstatushistory.FieldCreatedAt, field.TypeTime, value)
+	}
+	if oshu.mutation.OrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderstatushistory.OrderTable,
+			Columns: []string{orderstatushistory.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oshu.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderstatushistory.OrderTable,
+			Columns: []string{orderstatushistory.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if oshu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderstatushistory.UserTable,
+			Columns: []string{orderstatushistory.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oshu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderstatushistory.UserTable,
+			Columns: []string{orderstatushistory.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, oshu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{orderstatushistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	oshu.mutation.done = true
+	return n, nil
+}
+
+// OrderStatusHistoryUpdateOne is the builder for updating a single OrderStatusHistory entity.
+type OrderStatusHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OrderStatusHistoryMutation
+}
+
+// SetOrderID sets the "order_id" field.
+func (oshuo *OrderStatusHistoryUpdateOne) SetOrderID(u uint64) *OrderStatusHistoryUpdateOne {
+	oshuo.mutation.SetOrderID(u)
+	return oshuo
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (oshuo *OrderStatusHistoryUpdateOne) SetNillableOrderID(u *uint64) *OrderStatusHistoryUpdateOne {
+	if u != nil {
+		oshuo.SetOrderID(*u)
+	}
+	return oshuo
+}
+
+// SetFromStatus sets the "from_status" field.
+func (oshuo *OrderStatusHistoryUpdateOne) SetFromStatus(s string) *OrderStatusHistoryUpdateOne {
+	oshuo.mutation.SetFromStatus(s)
+	return oshuo
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (oshuo *OrderStatusHistoryUpdateOne) SetNillableFromStatus(s *string) *OrderStatusHistoryUpdateOne {
+	if s != nil {
+		oshuo.SetFromStatus(*s)
+	}
+	return oshuo
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (oshuo *OrderStatusHistoryUpdateOne) ClearFromStatus() *OrderStatusHistoryUpdateOne {
+	oshuo.mutation.ClearFromStatus()
+	return oshuo
+}
+
+// SetToStatus sets the "to_status" field.
+func (oshuo *OrderStatusHistoryUpdateOne) SetToStatus(s string) *OrderStatusHistoryUpdateOne {
+	oshuo.mutation.SetToStatus(s)
+	return oshuo
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (oshuo *OrderStatusHistoryUpdateOne) SetNillableToStatus(s *string) *OrderStatusHistoryUpdateOne {
+	if s != nil {
+		oshuo.SetToStatus(*s)
+	}
+	return oshuo
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (oshuo *OrderStatusHistoryUpdateOne) SetChangedBy(u uint64) *OrderStatusHistoryUpdateOne {
+	oshuo.mutation.SetChangedBy(u)
+	return oshuo
+}
+
+// SetNillableChangedBy sets the "changed_by" field if the given value is not nil.
+func (oshuo *OrderStatusHistoryUpdateOne) SetNillableChangedBy(u *uint64) *OrderStatusHistoryUpdateOne {
+	if u != nil {
+		oshuo.SetChangedBy(*u)
+	}
+	return oshuo
+}
+
+// ClearChangedBy clears the value of the "changed_by" field.
+func (oshuo *OrderStatusHistoryUpdateOne) ClearChangedBy() *OrderStatusHistoryUpdateOne {
+	oshuo.mutation.ClearChangedBy()
+	return oshuo
+}
+
+// SetNote sets the "note" field.
+func (oshuo *OrderStatusHistoryUpdateOne) SetNote(s string) *OrderStatusHistoryUpdateOne {
+	oshuo.mutation.SetNote(s)
+	return oshuo
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (oshuo *OrderStatusHistoryUpdateOne) SetNillableNote(s *string) *OrderStatusHistoryUpdateOne {
+	if s != nil {
+		oshuo.SetNote(*s)
+	}
+	return oshuo
+}
+
+// ClearNote clears the value of the "note" field.
+func (oshuo *OrderStatusHistoryUpdateOne) ClearNote() *OrderStatusHistoryUpdateOne {
+	oshuo.mutation.ClearNote()
+	return oshuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (oshuo *OrderStatusHistoryUpdateOne) SetCreatedAt(t time.Time) *OrderStatusHistoryUpdateOne {
+	oshuo.mutation.SetCreatedAt(t)
+	return oshuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (oshuo *OrderStatusHistoryUpdateOne) SetNillableCreatedAt(t *time.Time) *OrderStatusHistoryUpdateOne {
+	if t != nil {
+		oshuo.SetCreatedAt(*t)
+	}
+	return oshuo
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (oshuo *OrderStatusHistoryUpdateOne) SetOrder(o *Order) *OrderStatusHistoryUpdateOne {
+	return oshuo.SetOrderID(o.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (oshuo *OrderStatusHistoryUpdateOne) SetUserID(id uint64) *OrderStatusHistoryUpdateOne {
+	oshuo.mutation.SetUserID(id)
+	return oshuo
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (oshuo *OrderStatusHistoryUpdateOne) SetNillableUserID(id *uint64) *OrderStatusHistoryUpdateOne {
+	if id != nil {
+		oshuo = oshuo.SetUserID(*id)
+	}
+	return oshuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (oshuo *OrderStatusHistoryUpdateOne) SetUser(u *User) *OrderStatusHistoryUpdateOne {
+	return oshuo.SetUserID(u.ID)
+}
+
+// Mutation returns the OrderStatusHistoryMutation object of the builder.
+func (oshuo *OrderStatusHistoryUpdateOne) Mutation() *OrderStatusHistoryMutation {
+	return oshuo.mutation
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (oshuo *OrderStatusHistoryUpdateOne) ClearOrder() *OrderStatusHistoryUpdateOne {
+	oshuo.mutation.ClearOrder()
+	return oshuo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (oshuo *OrderStatusHistoryUpdateOne) ClearUser() *OrderStatusHistoryUpdateOne {
+	oshuo.mutation.ClearUser()
+	return oshuo
+}
+
+// Where appends a list predicates to the OrderStatusHistoryUpdate builder.
+func (oshuo *OrderStatusHistoryUpdateOne) Where(ps ...predicate.OrderStatusHistory) *OrderStatusHistoryUpdateOne {
+	oshuo.mutation.Where(ps...)
+	return oshuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (oshuo *OrderStatusHistoryUpdateOne) Select(field string, fields ...string) *OrderStatusHistoryUpdateOne {
+	oshuo.fields = append([]string{field}, fields...)
+	return oshuo
+}
+
+// Save executes the query and returns the updated OrderStatusHistory entity.
+func (oshuo *OrderStatusHistoryUpdateOne) Save(ctx context.Context) (*OrderStatusHistory, error) {
+	return withHooks(ctx, oshuo.sqlSave, oshuo.mutation, oshuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oshuo *OrderStatusHistoryUpdateOne) SaveX(ctx context.Context) *OrderStatusHistory {
+	node, err := oshuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (oshuo *OrderStatusHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := oshuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oshuo *OrderStatusHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := oshuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oshuo *OrderStatusHistoryUpdateOne) check() error {
+	if oshuo.mutation.OrderCleared() && len(oshuo.mutation.OrderIDs()) > 0 {
+		return errors.New(`gen: clearing a required unique edge "OrderStatusHistory.order"`)
+	}
+	return nil
+}
+
+func (oshuo *OrderStatusHistoryUpdateOne) sqlSave(ctx context.Context) (_node *OrderStatusHistory, err error) {
+	if err := oshuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(orderstatushistory.Table, orderstatushistory.Columns, sqlgraph.NewFieldSpec(orderstatushistory.FieldID, field.TypeUint64))
+	id, ok := oshuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`gen: missing "OrderStatusHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := oshuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, orderstatushistory.FieldID)
+		for _, f := range fields {
+			if !orderstatushistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("gen: invalid field %q for query", f)}
+			}
+			if f != orderstatushistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := oshuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oshuo.mutation.FromStatus(); ok {
+		_spec.SetField(orderstatushistory.FieldFromStatus, field.TypeString, value)
+	}
+	if oshuo.mutation.FromStatusCleared() {
+		_spec.ClearField(orderstatushistory.FieldFromStatus, field.TypeString)
+	}
+	if value, ok := oshuo.mutation.ToStatus(); ok {
+		_spec.SetField(orderstatushistory.FieldToStatus, field.TypeString, value)
+	}
+	if value, ok := oshuo.mutation.Note(); ok {
+		_spec.SetField(orderstatushistory.FieldNote, field.TypeString, value)
+	}
+	if oshuo.mutation.NoteCleared() {
+		_spec.ClearField(orderstatushistory.FieldNote, field.TypeString)
+	}
+	if value, ok := oshuo.mutation.CreatedAt(); ok {
+		_spec.SetField(orderstatushistory.FieldCreatedAt, field.TypeTime, value)
+	}
+	if oshuo.mutation.OrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderstatushistory.OrderTable,
+			Columns: []string{orderstatushistory.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oshuo.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderstatushistory.OrderTable,
+			Columns: []string{orderstatushistory.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if oshuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderstatushistory.UserTable,
+			Columns: []string{orderstatushistory.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oshuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderstatushistory.UserTable,
+			Columns: []string{orderstatushistory.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &OrderStatusHistory{config: oshuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, oshuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{orderstatushistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	oshuo.mutation.done = true
+	return _node, nil
+}
@@ -18,6 +18,8 @@ type Payment struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uint64 `json:"id,omitempty"`
+	// OrderID holds the value of the "order_id" field.
+	OrderID uint64 `json:"order_id,omitempty"`
 	// Amount holds the value of the "amount" field.
 	Amount float64 `json:"amount,omitempty"`
 	// Method holds the value of the "method" field.
@@ -25,14 +27,13 @@ type Payment struct {
 	// Status holds the value of the "status" field.
 	Status string `json:"status,omitempty"`
 	// TransactionID holds the value of the "transaction_id" field.
-	TransactionID string `json:"transaction_id,omitempty"`
+	TransactionID *string `json:"transaction_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PaymentQuery when eager-loading is set.
-	Edges          PaymentEdges `json:"edges"`
-	order_payments *uint64
-	selectValues   sql.SelectValues
+	Edges        PaymentEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // PaymentEdges holds the relations/edges for other nodes in the graph.
@@ -62,14 +63,12 @@ func (*Payment) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case payment.FieldAmount:
 			values[i] = new(sql.NullFloat64)
-		case payment.FieldID:
+		case payment.FieldID, payment.FieldOrderID:
 			values[i] = new(sql.NullInt64)
 		case payment.FieldMethod, payment.FieldStatus, payment.FieldTransactionID:
 			values[i] = new(sql.NullString)
 		case payment.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
-		case payment.ForeignKeys[0]: // order_payments
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -91,6 +90,12 @@ func (pa *Payment) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			pa.ID = uint64(value.Int64)
+		case payment.FieldOrderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value.Valid {
+				pa.OrderID = uint64(value.Int64)
+			}
 		case payment.FieldAmount:
 			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field amount", values[i])
@@ -113,7 +118,8 @@ func (pa *Payment) assignValues(columns []string, values []any) error {
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field transaction_id", values[i])
 			} else if value.Valid {
-				pa.TransactionID = value.String
+				pa.TransactionID = new(string)
+				*pa.TransactionID = value.String
 			}
 		case payment.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -121,13 +127,6 @@ func (pa *Payment) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pa.CreatedAt = value.Time
 			}
-		case payment.ForeignKeys[0]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field order_payments", value)
-			} else if value.Valid {
-				pa.order_payments = new(uint64)
-				*pa.order_payments = uint64(value.Int64)
-			}
 		default:
 			pa.selectValues.Set(columns[i], values[i])
 		}
@@ -169,6 +168,9 @@ func (pa *Payment) String() string {
 	var builder strings.Builder
 	builder.WriteString("Payment(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", pa.ID))
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", pa.OrderID))
+	builder.WriteString(", ")
 	builder.WriteString("amount=")
 	builder.WriteString(fmt.Sprintf("%v", pa.Amount))
 	builder.WriteString(", ")
@@ -178,8 +180,10 @@ func (pa *Payment) String() string {
 	builder.WriteString("status=")
 	builder.WriteString(pa.Status)
 	builder.WriteString(", ")
-	builder.WriteString("transaction_id=")
-	builder.WriteString(pa.TransactionID)
+	if v := pa.TransactionID; v != nil {
+		builder.WriteString("transaction_id=")
+		builder.WriteString(*v)
+	}
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(pa.CreatedAt.Format(time.ANSIC))
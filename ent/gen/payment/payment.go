@@ -14,6 +14,8 @@ const (
 	Label = "payment"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldOrderID holds the string denoting the order_id field in the database.
+	FieldOrderID = "order_id"
 	// FieldAmount holds the string denoting the amount field in the database.
 	FieldAmount = "amount"
 	// FieldMethod holds the string denoting the method field in the database.
@@ -34,12 +36,13 @@ const (
 	// It exists in this package in order to avoid circular dependency with the "order" package.
 	OrderInverseTable = "orders"
 	// OrderColumn is the table column denoting the order relation/edge.
-	OrderColumn = "order_payments"
+	OrderColumn = "order_id"
 )
 
 // Columns holds all SQL columns for payment fields.
 var Columns = []string{
 	FieldID,
+	FieldOrderID,
 	FieldAmount,
 	FieldMethod,
 	FieldStatus,
@@ -47,12 +50,6 @@ var Columns = []string{
 	FieldCreatedAt,
 }
 
-// ForeignKeys holds the SQL foreign-keys that are owned by the "payments"
-// table and are not defined as standalone fields in the schema.
-var ForeignKeys = []string{
-	"order_payments",
-}
-
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -60,11 +57,6 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
-	for i := range ForeignKeys {
-		if column == ForeignKeys[i] {
-			return true
-		}
-	}
 	return false
 }
 
@@ -83,6 +75,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByOrderID orders the results by the order_id field.
+func ByOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderID, opts...).ToFunc()
+}
+
 // ByAmount orders the results by the amount field.
 func ByAmount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAmount, opts...).ToFunc()
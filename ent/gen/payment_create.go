@@ -21,6 +21,20 @@ type PaymentCreate struct {
 	hooks    []Hook
 }
 
+// SetOrderID sets the "order_id" field.
+func (pc *PaymentCreate) SetOrderID(u uint64) *PaymentCreate {
+	pc.mutation.SetOrderID(u)
+	return pc
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (pc *PaymentCreate) SetNillableOrderID(u *uint64) *PaymentCreate {
+	if u != nil {
+		pc.SetOrderID(*u)
+	}
+	return pc
+}
+
 // SetAmount sets the "amount" field.
 func (pc *PaymentCreate) SetAmount(f float64) *PaymentCreate {
 	pc.mutation.SetAmount(f)
@@ -89,20 +103,6 @@ func (pc *PaymentCreate) SetID(u uint64) *PaymentCreate {
 	return pc
 }
 
-// SetOrderID sets the "order" edge to the Order entity by ID.
-func (pc *PaymentCreate) SetOrderID(id uint64) *PaymentCreate {
-	pc.mutation.SetOrderID(id)
-	return pc
-}
-
-// SetNillableOrderID sets the "order" edge to the Order entity by ID if the given value is not nil.
-func (pc *PaymentCreate) SetNillableOrderID(id *uint64) *PaymentCreate {
-	if id != nil {
-		pc = pc.SetOrderID(*id)
-	}
-	return pc
-}
-
 // SetOrder sets the "order" edge to the Order entity.
 func (pc *PaymentCreate) SetOrder(o *Order) *PaymentCreate {
 	return pc.SetOrderID(o.ID)
@@ -210,7 +210,7 @@ func (pc *PaymentCreate) createSpec() (*Payment, *sqlgraph.CreateSpec) {
 	}
 	if value, ok := pc.mutation.TransactionID(); ok {
 		_spec.SetField(payment.FieldTransactionID, field.TypeString, value)
-		_node.TransactionID = value
+		_node.TransactionID = &value
 	}
 	if value, ok := pc.mutation.CreatedAt(); ok {
 		_spec.SetField(payment.FieldCreatedAt, field.TypeTime, value)
@@ -230,7 +230,7 @@ func (pc *PaymentCreate) createSpec() (*Payment, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.order_payments = &nodes[0]
+		_node.OrderID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
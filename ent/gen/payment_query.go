@@ -8,6 +8,7 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -24,7 +25,7 @@ type PaymentQuery struct {
 	inters     []Interceptor
 	predicates []predicate.Payment
 	withOrder  *OrderQuery
-	withFKs    bool
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -299,12 +300,12 @@ func (pq *PaymentQuery) WithOrder(opts ...func(*OrderQuery)) *PaymentQuery {
 // Example:
 //
 //	var v []struct {
-//		Amount float64 `json:"amount,omitempty"`
+//		OrderID uint64 `json:"order_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Payment.Query().
-//		GroupBy(payment.FieldAmount).
+//		GroupBy(payment.FieldOrderID).
 //		Aggregate(gen.Count()).
 //		Scan(ctx, &v)
 func (pq *PaymentQuery) GroupBy(field string, fields ...string) *PaymentGroupBy {
@@ -322,11 +323,11 @@ func (pq *PaymentQuery) GroupBy(field string, fields ...string) *PaymentGroupBy
 // Example:
 //
 //	var v []struct {
-//		Amount float64 `json:"amount,omitempty"`
+//		OrderID uint64 `json:"order_id,omitempty"`
 //	}
 //
 //	client.Payment.Query().
-//		Select(payment.FieldAmount).
+//		Select(payment.FieldOrderID).
 //		Scan(ctx, &v)
 func (pq *PaymentQuery) Select(fields ...string) *PaymentSelect {
 	pq.ctx.Fields = append(pq.ctx.Fields, fields...)
@@ -370,18 +371,11 @@ func (pq *PaymentQuery) prepareQuery(ctx context.Context) error {
 func (pq *PaymentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Payment, error) {
 	var (
 		nodes       = []*Payment{}
-		withFKs     = pq.withFKs
 		_spec       = pq.querySpec()
 		loadedTypes = [1]bool{
 			pq.withOrder != nil,
 		}
 	)
-	if pq.withOrder != nil {
-		withFKs = true
-	}
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, payment.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Payment).scanValues(nil, columns)
 	}
@@ -391,6 +385,9 @@ func (pq *PaymentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Paym
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(pq.modifiers) > 0 {
+		_spec.Modifiers = pq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -413,10 +410,7 @@ func (pq *PaymentQuery) loadOrder(ctx context.Context, query *OrderQuery, nodes
 	ids := make([]uint64, 0, len(nodes))
 	nodeids := make(map[uint64][]*Payment)
 	for i := range nodes {
-		if nodes[i].order_payments == nil {
-			continue
-		}
-		fk := *nodes[i].order_payments
+		fk := nodes[i].OrderID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -433,7 +427,7 @@ func (pq *PaymentQuery) loadOrder(ctx context.Context, query *OrderQuery, nodes
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "order_payments" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "order_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -444,6 +438,9 @@ func (pq *PaymentQuery) loadOrder(ctx context.Context, query *OrderQuery, nodes
 
 func (pq *PaymentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pq.querySpec()
+	if len(pq.modifiers) > 0 {
+		_spec.Modifiers = pq.modifiers
+	}
 	_spec.Node.Columns = pq.ctx.Fields
 	if len(pq.ctx.Fields) > 0 {
 		_spec.Unique = pq.ctx.Unique != nil && *pq.ctx.Unique
@@ -467,6 +464,9 @@ func (pq *PaymentQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if pq.withOrder != nil {
+			_spec.Node.AddColumnOnce(payment.FieldOrderID)
+		}
 	}
 	if ps := pq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -506,6 +506,9 @@ func (pq *PaymentQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if pq.ctx.Unique != nil && *pq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range pq.modifiers {
+		m(selector)
+	}
 	for _, p := range pq.predicates {
 		p(selector)
 	}
@@ -523,6 +526,32 @@ func (pq *PaymentQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (pq *PaymentQuery) ForUpdate(opts ...sql.LockOption) *PaymentQuery {
+	if pq.driver.Dialect() == dialect.Postgres {
+		pq.Unique(false)
+	}
+	pq.modifiers = append(pq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return pq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (pq *PaymentQuery) ForShare(opts ...sql.LockOption) *PaymentQuery {
+	if pq.driver.Dialect() == dialect.Postgres {
+		pq.Unique(false)
+	}
+	pq.modifiers = append(pq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return pq
+}
+
 // PaymentGroupBy is the group-by builder for Payment entities.
 type PaymentGroupBy struct {
 	selector
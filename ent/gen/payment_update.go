@@ -29,6 +29,26 @@ func (pu *PaymentUpdate) Where(ps ...predicate.Payment) *PaymentUpdate {
 	return pu
 }
 
+// SetOrderID sets the "order_id" field.
+func (pu *PaymentUpdate) SetOrderID(u uint64) *PaymentUpdate {
+	pu.mutation.SetOrderID(u)
+	return pu
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (pu *PaymentUpdate) SetNillableOrderID(u *uint64) *PaymentUpdate {
+	if u != nil {
+		pu.SetOrderID(*u)
+	}
+	return pu
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (pu *PaymentUpdate) ClearOrderID() *PaymentUpdate {
+	pu.mutation.ClearOrderID()
+	return pu
+}
+
 // SetAmount sets the "amount" field.
 func (pu *PaymentUpdate) SetAmount(f float64) *PaymentUpdate {
 	pu.mutation.ResetAmount()
@@ -118,20 +138,6 @@ func (pu *PaymentUpdate) SetNillableCreatedAt(t *time.Time) *PaymentUpdate {
 	return pu
 }
 
-// SetOrderID sets the "order" edge to the Order entity by ID.
-func (pu *PaymentUpdate) SetOrderID(id uint64) *PaymentUpdate {
-	pu.mutation.SetOrderID(id)
-	return pu
-}
-
-// SetNillableOrderID sets the "order" edge to the Order entity by ID if the given value is not nil.
-func (pu *PaymentUpdate) SetNillableOrderID(id *uint64) *PaymentUpdate {
-	if id != nil {
-		pu = pu.SetOrderID(*id)
-	}
-	return pu
-}
-
 // SetOrder sets the "order" edge to the Order entity.
 func (pu *PaymentUpdate) SetOrder(o *Order) *PaymentUpdate {
 	return pu.SetOrderID(o.ID)
@@ -257,6 +263,26 @@ type PaymentUpdateOne struct {
 	mutation *PaymentMutation
 }
 
+// SetOrderID sets the "order_id" field.
+func (puo *PaymentUpdateOne) SetOrderID(u uint64) *PaymentUpdateOne {
+	puo.mutation.SetOrderID(u)
+	return puo
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (puo *PaymentUpdateOne) SetNillableOrderID(u *uint64) *PaymentUpdateOne {
+	if u != nil {
+		puo.SetOrderID(*u)
+	}
+	return puo
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (puo *PaymentUpdateOne) ClearOrderID() *PaymentUpdateOne {
+	puo.mutation.ClearOrderID()
+	return puo
+}
+
 // SetAmount sets the "amount" field.
 func (puo *PaymentUpdateOne) SetAmount(f float64) *PaymentUpdateOne {
 	puo.mutation.ResetAmount()
@@ -346,20 +372,6 @@ func (puo *PaymentUpdateOne) SetNillableCreatedAt(t *time.Time) *PaymentUpdateOn
 	return puo
 }
 
-// SetOrderID sets the "order" edge to the Order entity by ID.
-func (puo *PaymentUpdateOne) SetOrderID(id uint64) *PaymentUpdateOne {
-	puo.mutation.SetOrderID(id)
-	return puo
-}
-
-// SetNillableOrderID sets the "order" edge to the Order entity by ID if the given value is not nil.
-func (puo *PaymentUpdateOne) SetNillableOrderID(id *uint64) *PaymentUpdateOne {
-	if id != nil {
-		puo = puo.SetOrderID(*id)
-	}
-	return puo
-}
-
 // SetOrder sets the "order" edge to the Order entity.
 func (puo *PaymentUpdateOne) SetOrder(o *Order) *PaymentUpdateOne {
 	return puo.SetOrderID(o.ID)
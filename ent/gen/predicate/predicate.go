@@ -6,6 +6,12 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIKey is the predicate function for apikey builders.
+type APIKey func(*sql.Selector)
+
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
 // Cart is the predicate function for cart builders.
 type Cart func(*sql.Selector)
 
@@ -24,12 +30,21 @@ type DiscountCategory func(*sql.Selector)
 // DiscountProduct is the predicate function for discountproduct builders.
 type DiscountProduct func(*sql.Selector)
 
+// IdempotencyKey is the predicate function for idempotencykey builders.
+type IdempotencyKey func(*sql.Selector)
+
+// LoginAttempt is the predicate function for loginattempt builders.
+type LoginAttempt func(*sql.Selector)
+
 // Order is the predicate function for order builders.
 type Order func(*sql.Selector)
 
 // OrderItem is the predicate function for orderitem builders.
 type OrderItem func(*sql.Selector)
 
+// OrderStatusHistory is the predicate function for orderstatushistory builders.
+type OrderStatusHistory func(*sql.Selector)
+
 // Payment is the predicate function for payment builders.
 type Payment func(*sql.Selector)
 
@@ -42,6 +57,9 @@ type Product func(*sql.Selector)
 // ProductImage is the predicate function for productimage builders.
 type ProductImage func(*sql.Selector)
 
+// RecoveryCode is the predicate function for recoverycode builders.
+type RecoveryCode func(*sql.Selector)
+
 // Review is the predicate function for review builders.
 type Review func(*sql.Selector)
 
@@ -57,15 +75,24 @@ type Session func(*sql.Selector)
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
+// UserIdentity is the predicate function for useridentity builders.
+type UserIdentity func(*sql.Selector)
+
 // UserPermission is the predicate function for userpermission builders.
 type UserPermission func(*sql.Selector)
 
 // UserRole is the predicate function for userrole builders.
 type UserRole func(*sql.Selector)
 
+// UserToken is the predicate function for usertoken builders.
+type UserToken func(*sql.Selector)
+
 // UserVoucher is the predicate function for uservoucher builders.
 type UserVoucher func(*sql.Selector)
 
+// WebhookEvent is the predicate function for webhookevent builders.
+type WebhookEvent func(*sql.Selector)
+
 // Wishlist is the predicate function for wishlist builders.
 type Wishlist func(*sql.Selector)
 
@@ -32,15 +32,16 @@ type Product struct {
 	AvgRating float64 `json:"avg_rating,omitempty"`
 	// ReviewCount holds the value of the "review_count" field.
 	ReviewCount int `json:"review_count,omitempty"`
+	// CategoryID holds the value of the "category_id" field.
+	CategoryID uint64 `json:"category_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ProductQuery when eager-loading is set.
-	Edges             ProductEdges `json:"edges"`
-	category_products *uint64
-	selectValues      sql.SelectValues
+	Edges        ProductEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // ProductEdges holds the relations/edges for other nodes in the graph.
@@ -51,17 +52,23 @@ type ProductEdges struct {
 	Images []*ProductImage `json:"images,omitempty"`
 	// Reviews holds the value of the reviews edge.
 	Reviews []*Review `json:"reviews,omitempty"`
-	// CartItems holds the value of the cart_items edge.
-	CartItems []*CartItem `json:"cart_items,omitempty"`
+	// Carts holds the value of the carts edge.
+	Carts []*Cart `json:"carts,omitempty"`
 	// OrderItems holds the value of the order_items edge.
 	OrderItems []*OrderItem `json:"order_items,omitempty"`
 	// Discounts holds the value of the discounts edge.
-	Discounts []*DiscountProduct `json:"discounts,omitempty"`
+	Discounts []*Discount `json:"discounts,omitempty"`
+	// Wishlists holds the value of the wishlists edge.
+	Wishlists []*Wishlist `json:"wishlists,omitempty"`
+	// CartItems holds the value of the cart_items edge.
+	CartItems []*CartItem `json:"cart_items,omitempty"`
+	// DiscountProducts holds the value of the discount_products edge.
+	DiscountProducts []*DiscountProduct `json:"discount_products,omitempty"`
 	// WishlistItems holds the value of the wishlist_items edge.
 	WishlistItems []*WishlistItem `json:"wishlist_items,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [10]bool
 }
 
 // CategoryOrErr returns the Category value or an error if the edge
@@ -93,13 +100,13 @@ func (e ProductEdges) ReviewsOrErr() ([]*Review, error) {
 	return nil, &NotLoadedError{edge: "reviews"}
 }
 
-// CartItemsOrErr returns the CartItems value or an error if the edge
+// CartsOrErr returns the Carts value or an error if the edge
 // was not loaded in eager-loading.
-func (e ProductEdges) CartItemsOrErr() ([]*CartItem, error) {
+func (e ProductEdges) CartsOrErr() ([]*Cart, error) {
 	if e.loadedTypes[3] {
-		return e.CartItems, nil
+		return e.Carts, nil
 	}
-	return nil, &NotLoadedError{edge: "cart_items"}
+	return nil, &NotLoadedError{edge: "carts"}
 }
 
 // OrderItemsOrErr returns the OrderItems value or an error if the edge
@@ -113,17 +120,44 @@ func (e ProductEdges) OrderItemsOrErr() ([]*OrderItem, error) {
 
 // DiscountsOrErr returns the Discounts value or an error if the edge
 // was not loaded in eager-loading.
-func (e ProductEdges) DiscountsOrErr() ([]*DiscountProduct, error) {
+func (e ProductEdges) DiscountsOrErr() ([]*Discount, error) {
 	if e.loadedTypes[5] {
 		return e.Discounts, nil
 	}
 	return nil, &NotLoadedError{edge: "discounts"}
 }
 
+// WishlistsOrErr returns the Wishlists value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) WishlistsOrErr() ([]*Wishlist, error) {
+	if e.loadedTypes[6] {
+		return e.Wishlists, nil
+	}
+	return nil, &NotLoadedError{edge: "wishlists"}
+}
+
+// CartItemsOrErr returns the CartItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) CartItemsOrErr() ([]*CartItem, error) {
+	if e.loadedTypes[7] {
+		return e.CartItems, nil
+	}
+	return nil, &NotLoadedError{edge: "cart_items"}
+}
+
+// DiscountProductsOrErr returns the DiscountProducts value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) DiscountProductsOrErr() ([]*DiscountProduct, error) {
+	if e.loadedTypes[8] {
+		return e.DiscountProducts, nil
+	}
+	return nil, &NotLoadedError{edge: "discount_products"}
+}
+
 // WishlistItemsOrErr returns the WishlistItems value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProductEdges) WishlistItemsOrErr() ([]*WishlistItem, error) {
-	if e.loadedTypes[6] {
+	if e.loadedTypes[9] {
 		return e.WishlistItems, nil
 	}
 	return nil, &NotLoadedError{edge: "wishlist_items"}
@@ -136,14 +170,12 @@ func (*Product) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case product.FieldPrice, product.FieldAvgRating:
 			values[i] = new(sql.NullFloat64)
-		case product.FieldID, product.FieldStockQuantity, product.FieldReviewCount:
+		case product.FieldID, product.FieldStockQuantity, product.FieldReviewCount, product.FieldCategoryID:
 			values[i] = new(sql.NullInt64)
 		case product.FieldName, product.FieldSlug, product.FieldDescription:
 			values[i] = new(sql.NullString)
 		case product.FieldCreatedAt, product.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
-		case product.ForeignKeys[0]: // category_products
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -207,6 +239,12 @@ func (pr *Product) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pr.ReviewCount = int(value.Int64)
 			}
+		case product.FieldCategoryID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field category_id", values[i])
+			} else if value.Valid {
+				pr.CategoryID = uint64(value.Int64)
+			}
 		case product.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -219,13 +257,6 @@ func (pr *Product) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pr.UpdatedAt = value.Time
 			}
-		case product.ForeignKeys[0]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field category_products", value)
-			} else if value.Valid {
-				pr.category_products = new(uint64)
-				*pr.category_products = uint64(value.Int64)
-			}
 		default:
 			pr.selectValues.Set(columns[i], values[i])
 		}
@@ -254,9 +285,9 @@ func (pr *Product) QueryReviews() *ReviewQuery {
 	return NewProductClient(pr.config).QueryReviews(pr)
 }
 
-// QueryCartItems queries the "cart_items" edge of the Product entity.
-func (pr *Product) QueryCartItems() *CartItemQuery {
-	return NewProductClient(pr.config).QueryCartItems(pr)
+// QueryCarts queries the "carts" edge of the Product entity.
+func (pr *Product) QueryCarts() *CartQuery {
+	return NewProductClient(pr.config).QueryCarts(pr)
 }
 
 // QueryOrderItems queries the "order_items" edge of the Product entity.
@@ -265,10 +296,25 @@ func (pr *Product) QueryOrderItems() *OrderItemQuery {
 }
 
 // QueryDiscounts queries the "discounts" edge of the Product entity.
-func (pr *Product) QueryDiscounts() *DiscountProductQuery {
+func (pr *Product) QueryDiscounts() *DiscountQuery {
 	return NewProductClient(pr.config).QueryDiscounts(pr)
 }
 
+// QueryWishlists queries the "wishlists" edge of the Product entity.
+func (pr *Product) QueryWishlists() *WishlistQuery {
+	return NewProductClient(pr.config).QueryWishlists(pr)
+}
+
+// QueryCartItems queries the "cart_items" edge of the Product entity.
+func (pr *Product) QueryCartItems() *CartItemQuery {
+	return NewProductClient(pr.config).QueryCartItems(pr)
+}
+
+// QueryDiscountProducts queries the "discount_products" edge of the Product entity.
+func (pr *Product) QueryDiscountProducts() *DiscountProductQuery {
+	return NewProductClient(pr.config).QueryDiscountProducts(pr)
+}
+
 // QueryWishlistItems queries the "wishlist_items" edge of the Product entity.
 func (pr *Product) QueryWishlistItems() *WishlistItemQuery {
 	return NewProductClient(pr.config).QueryWishlistItems(pr)
@@ -318,6 +364,9 @@ func (pr *Product) String() string {
 	builder.WriteString("review_count=")
 	builder.WriteString(fmt.Sprintf("%v", pr.ReviewCount))
 	builder.WriteString(", ")
+	builder.WriteString("category_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.CategoryID))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -28,6 +28,8 @@ const (
 	FieldAvgRating = "avg_rating"
 	// FieldReviewCount holds the string denoting the review_count field in the database.
 	FieldReviewCount = "review_count"
+	// FieldCategoryID holds the string denoting the category_id field in the database.
+	FieldCategoryID = "category_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -38,12 +40,18 @@ const (
 	EdgeImages = "images"
 	// EdgeReviews holds the string denoting the reviews edge name in mutations.
 	EdgeReviews = "reviews"
-	// EdgeCartItems holds the string denoting the cart_items edge name in mutations.
-	EdgeCartItems = "cart_items"
+	// EdgeCarts holds the string denoting the carts edge name in mutations.
+	EdgeCarts = "carts"
 	// EdgeOrderItems holds the string denoting the order_items edge name in mutations.
 	EdgeOrderItems = "order_items"
 	// EdgeDiscounts holds the string denoting the discounts edge name in mutations.
 	EdgeDiscounts = "discounts"
+	// EdgeWishlists holds the string denoting the wishlists edge name in mutations.
+	EdgeWishlists = "wishlists"
+	// EdgeCartItems holds the string denoting the cart_items edge name in mutations.
+	EdgeCartItems = "cart_items"
+	// EdgeDiscountProducts holds the string denoting the discount_products edge name in mutations.
+	EdgeDiscountProducts = "discount_products"
 	// EdgeWishlistItems holds the string denoting the wishlist_items edge name in mutations.
 	EdgeWishlistItems = "wishlist_items"
 	// Table holds the table name of the product in the database.
@@ -54,49 +62,64 @@ const (
 	// It exists in this package in order to avoid circular dependency with the "category" package.
 	CategoryInverseTable = "categories"
 	// CategoryColumn is the table column denoting the category relation/edge.
-	CategoryColumn = "category_products"
+	CategoryColumn = "category_id"
 	// ImagesTable is the table that holds the images relation/edge.
 	ImagesTable = "product_images"
 	// ImagesInverseTable is the table name for the ProductImage entity.
 	// It exists in this package in order to avoid circular dependency with the "productimage" package.
 	ImagesInverseTable = "product_images"
 	// ImagesColumn is the table column denoting the images relation/edge.
-	ImagesColumn = "product_images"
+	ImagesColumn = "product_id"
 	// ReviewsTable is the table that holds the reviews relation/edge.
 	ReviewsTable = "reviews"
 	// ReviewsInverseTable is the table name for the Review entity.
 	// It exists in this package in order to avoid circular dependency with the "review" package.
 	ReviewsInverseTable = "reviews"
 	// ReviewsColumn is the table column denoting the reviews relation/edge.
-	ReviewsColumn = "product_reviews"
-	// CartItemsTable is the table that holds the cart_items relation/edge.
-	CartItemsTable = "cart_items"
-	// CartItemsInverseTable is the table name for the CartItem entity.
-	// It exists in this package in order to avoid circular dependency with the "cartitem" package.
-	CartItemsInverseTable = "cart_items"
-	// CartItemsColumn is the table column denoting the cart_items relation/edge.
-	CartItemsColumn = "product_cart_items"
+	ReviewsColumn = "product_id"
+	// CartsTable is the table that holds the carts relation/edge. The primary key declared below.
+	CartsTable = "cart_items"
+	// CartsInverseTable is the table name for the Cart entity.
+	// It exists in this package in order to avoid circular dependency with the "cart" package.
+	CartsInverseTable = "carts"
 	// OrderItemsTable is the table that holds the order_items relation/edge.
 	OrderItemsTable = "order_items"
 	// OrderItemsInverseTable is the table name for the OrderItem entity.
 	// It exists in this package in order to avoid circular dependency with the "orderitem" package.
 	OrderItemsInverseTable = "order_items"
 	// OrderItemsColumn is the table column denoting the order_items relation/edge.
-	OrderItemsColumn = "product_order_items"
-	// DiscountsTable is the table that holds the discounts relation/edge.
+	OrderItemsColumn = "product_id"
+	// DiscountsTable is the table that holds the discounts relation/edge. The primary key declared below.
 	DiscountsTable = "discount_products"
-	// DiscountsInverseTable is the table name for the DiscountProduct entity.
+	// DiscountsInverseTable is the table name for the Discount entity.
+	// It exists in this package in order to avoid circular dependency with the "discount" package.
+	DiscountsInverseTable = "discounts"
+	// WishlistsTable is the table that holds the wishlists relation/edge. The primary key declared below.
+	WishlistsTable = "wishlist_items"
+	// WishlistsInverseTable is the table name for the Wishlist entity.
+	// It exists in this package in order to avoid circular dependency with the "wishlist" package.
+	WishlistsInverseTable = "wishlists"
+	// CartItemsTable is the table that holds the cart_items relation/edge.
+	CartItemsTable = "cart_items"
+	// CartItemsInverseTable is the table name for the CartItem entity.
+	// It exists in this package in order to avoid circular dependency with the "cartitem" package.
+	CartItemsInverseTable = "cart_items"
+	// CartItemsColumn is the table column denoting the cart_items relation/edge.
+	CartItemsColumn = "product_id"
+	// DiscountProductsTable is the table that holds the discount_products relation/edge.
+	DiscountProductsTable = "discount_products"
+	// DiscountProductsInverseTable is the table name for the DiscountProduct entity.
 	// It exists in this package in order to avoid circular dependency with the "discountproduct" package.
-	DiscountsInverseTable = "discount_products"
-	// DiscountsColumn is the table column denoting the discounts relation/edge.
-	DiscountsColumn = "product_discounts"
+	DiscountProductsInverseTable = "discount_products"
+	// DiscountProductsColumn is the table column denoting the discount_products relation/edge.
+	DiscountProductsColumn = "product_id"
 	// WishlistItemsTable is the table that holds the wishlist_items relation/edge.
 	WishlistItemsTable = "wishlist_items"
 	// WishlistItemsInverseTable is the table name for the WishlistItem entity.
 	// It exists in this package in order to avoid circular dependency with the "wishlistitem" package.
 	WishlistItemsInverseTable = "wishlist_items"
 	// WishlistItemsColumn is the table column denoting the wishlist_items relation/edge.
-	WishlistItemsColumn = "product_wishlist_items"
+	WishlistItemsColumn = "product_id"
 )
 
 // Columns holds all SQL columns for product fields.
@@ -109,15 +132,22 @@ var Columns = []string{
 	FieldStockQuantity,
 	FieldAvgRating,
 	FieldReviewCount,
+	FieldCategoryID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
 
-// ForeignKeys holds the SQL foreign-keys that are owned by the "products"
-// table and are not defined as standalone fields in the schema.
-var ForeignKeys = []string{
-	"category_products",
-}
+var (
+	// CartsPrimaryKey and CartsColumn2 are the table columns denoting the
+	// primary key for the carts relation (M2M).
+	CartsPrimaryKey = []string{"cart_id", "product_id"}
+	// DiscountsPrimaryKey and DiscountsColumn2 are the table columns denoting the
+	// primary key for the discounts relation (M2M).
+	DiscountsPrimaryKey = []string{"discount_id", "product_id"}
+	// WishlistsPrimaryKey and WishlistsColumn2 are the table columns denoting the
+	// primary key for the wishlists relation (M2M).
+	WishlistsPrimaryKey = []string{"wishlist_id", "product_id"}
+)
 
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
@@ -126,11 +156,6 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
-	for i := range ForeignKeys {
-		if column == ForeignKeys[i] {
-			return true
-		}
-	}
 	return false
 }
 
@@ -196,6 +221,11 @@ func ByReviewCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldReviewCount, opts...).ToFunc()
 }
 
+// ByCategoryID orders the results by the category_id field.
+func ByCategoryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategoryID, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -241,17 +271,17 @@ func ByReviews(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
-// ByCartItemsCount orders the results by cart_items count.
-func ByCartItemsCount(opts ...sql.OrderTermOption) OrderOption {
+// ByCartsCount orders the results by carts count.
+func ByCartsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborsCount(s, newCartItemsStep(), opts...)
+		sqlgraph.OrderByNeighborsCount(s, newCartsStep(), opts...)
 	}
 }
 
-// ByCartItems orders the results by cart_items terms.
-func ByCartItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+// ByCarts orders the results by carts terms.
+func ByCarts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newCartItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
+		sqlgraph.OrderByNeighborTerms(s, newCartsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
 
@@ -283,6 +313,48 @@ func ByDiscounts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByWishlistsCount orders the results by wishlists count.
+func ByWishlistsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWishlistsStep(), opts...)
+	}
+}
+
+// ByWishlists orders the results by wishlists terms.
+func ByWishlists(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWishlistsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCartItemsCount orders the results by cart_items count.
+func ByCartItemsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCartItemsStep(), opts...)
+	}
+}
+
+// ByCartItems orders the results by cart_items terms.
+func ByCartItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCartItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDiscountProductsCount orders the results by discount_products count.
+func ByDiscountProductsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDiscountProductsStep(), opts...)
+	}
+}
+
+// ByDiscountProducts orders the results by discount_products terms.
+func ByDiscountProducts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDiscountProductsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByWishlistItemsCount orders the results by wishlist_items count.
 func ByWishlistItemsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -317,11 +389,11 @@ func newReviewsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ReviewsTable, ReviewsColumn),
 	)
 }
-func newCartItemsStep() *sqlgraph.Step {
+func newCartsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
-		sqlgraph.To(CartItemsInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, CartItemsTable, CartItemsColumn),
+		sqlgraph.To(CartsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, CartsTable, CartsPrimaryKey...),
 	)
 }
 func newOrderItemsStep() *sqlgraph.Step {
@@ -335,13 +407,34 @@ func newDiscountsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(DiscountsInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, DiscountsTable, DiscountsColumn),
+		sqlgraph.Edge(sqlgraph.M2M, true, DiscountsTable, DiscountsPrimaryKey...),
+	)
+}
+func newWishlistsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WishlistsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, WishlistsTable, WishlistsPrimaryKey...),
+	)
+}
+func newCartItemsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CartItemsInverseTable, CartItemsColumn),
+		sqlgraph.Edge(sqlgraph.O2M, true, CartItemsTable, CartItemsColumn),
+	)
+}
+func newDiscountProductsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DiscountProductsInverseTable, DiscountProductsColumn),
+		sqlgraph.Edge(sqlgraph.O2M, true, DiscountProductsTable, DiscountProductsColumn),
 	)
 }
 func newWishlistItemsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
-		sqlgraph.To(WishlistItemsInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, WishlistItemsTable, WishlistItemsColumn),
+		sqlgraph.To(WishlistItemsInverseTable, WishlistItemsColumn),
+		sqlgraph.Edge(sqlgraph.O2M, true, WishlistItemsTable, WishlistItemsColumn),
 	)
 }
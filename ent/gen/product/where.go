@@ -90,6 +90,11 @@ func ReviewCount(v int) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldReviewCount, v))
 }
 
+// CategoryID applies equality check predicate on the "category_id" field. It's identical to CategoryIDEQ.
+func CategoryID(v uint64) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldCategoryID, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldCreatedAt, v))
@@ -465,6 +470,36 @@ func ReviewCountLTE(v int) predicate.Product {
 	return predicate.Product(sql.FieldLTE(FieldReviewCount, v))
 }
 
+// CategoryIDEQ applies the EQ predicate on the "category_id" field.
+func CategoryIDEQ(v uint64) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// CategoryIDNEQ applies the NEQ predicate on the "category_id" field.
+func CategoryIDNEQ(v uint64) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldCategoryID, v))
+}
+
+// CategoryIDIn applies the In predicate on the "category_id" field.
+func CategoryIDIn(vs ...uint64) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDNotIn applies the NotIn predicate on the "category_id" field.
+func CategoryIDNotIn(vs ...uint64) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDIsNil applies the IsNil predicate on the "category_id" field.
+func CategoryIDIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldCategoryID))
+}
+
+// CategoryIDNotNil applies the NotNil predicate on the "category_id" field.
+func CategoryIDNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldCategoryID))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldCreatedAt, v))
@@ -614,21 +649,21 @@ func HasReviewsWith(preds ...predicate.Review) predicate.Product {
 	})
 }
 
-// HasCartItems applies the HasEdge predicate on the "cart_items" edge.
-func HasCartItems() predicate.Product {
+// HasCarts applies the HasEdge predicate on the "carts" edge.
+func HasCarts() predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, CartItemsTable, CartItemsColumn),
+			sqlgraph.Edge(sqlgraph.M2M, true, CartsTable, CartsPrimaryKey...),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasCartItemsWith applies the HasEdge predicate on the "cart_items" edge with a given conditions (other predicates).
-func HasCartItemsWith(preds ...predicate.CartItem) predicate.Product {
+// HasCartsWith applies the HasEdge predicate on the "carts" edge with a given conditions (other predicates).
+func HasCartsWith(preds ...predicate.Cart) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
-		step := newCartItemsStep()
+		step := newCartsStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -665,14 +700,14 @@ func HasDiscounts() predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, DiscountsTable, DiscountsColumn),
+			sqlgraph.Edge(sqlgraph.M2M, true, DiscountsTable, DiscountsPrimaryKey...),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
 // HasDiscountsWith applies the HasEdge predicate on the "discounts" edge with a given conditions (other predicates).
-func HasDiscountsWith(preds ...predicate.DiscountProduct) predicate.Product {
+func HasDiscountsWith(preds ...predicate.Discount) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		step := newDiscountsStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
@@ -683,12 +718,81 @@ func HasDiscountsWith(preds ...predicate.DiscountProduct) predicate.Product {
 	})
 }
 
+// HasWishlists applies the HasEdge predicate on the "wishlists" edge.
+func HasWishlists() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, WishlistsTable, WishlistsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWishlistsWith applies the HasEdge predicate on the "wishlists" edge with a given conditions (other predicates).
+func HasWishlistsWith(preds ...predicate.Wishlist) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := newWishlistsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCartItems applies the HasEdge predicate on the "cart_items" edge.
+func HasCartItems() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, CartItemsTable, CartItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCartItemsWith applies the HasEdge predicate on the "cart_items" edge with a given conditions (other predicates).
+func HasCartItemsWith(preds ...predicate.CartItem) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := newCartItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDiscountProducts applies the HasEdge predicate on the "discount_products" edge.
+func HasDiscountProducts() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, DiscountProductsTable, DiscountProductsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDiscountProductsWith applies the HasEdge predicate on the "discount_products" edge with a given conditions (other predicates).
+func HasDiscountProductsWith(preds ...predicate.DiscountProduct) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := newDiscountProductsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasWishlistItems applies the HasEdge predicate on the "wishlist_items" edge.
 func HasWishlistItems() predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, WishlistItemsTable, WishlistItemsColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, WishlistItemsTable, WishlistItemsColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -10,14 +10,14 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
-	"github.com/thang1834/go-goss/ent/gen/cartitem"
+	"github.com/thang1834/go-goss/ent/gen/cart"
 	"github.com/thang1834/go-goss/ent/gen/category"
-	"github.com/thang1834/go-goss/ent/gen/discountproduct"
+	"github.com/thang1834/go-goss/ent/gen/discount"
 	"github.com/thang1834/go-goss/ent/gen/orderitem"
 	"github.com/thang1834/go-goss/ent/gen/product"
 	"github.com/thang1834/go-goss/ent/gen/productimage"
 	"github.com/thang1834/go-goss/ent/gen/review"
-	"github.com/thang1834/go-goss/ent/gen/wishlistitem"
+	"github.com/thang1834/go-goss/ent/gen/wishlist"
 )
 
 // ProductCreate is the builder for creating a Product entity.
@@ -101,6 +101,20 @@ func (pc *ProductCreate) SetNillableReviewCount(i *int) *ProductCreate {
 	return pc
 }
 
+// SetCategoryID sets the "category_id" field.
+func (pc *ProductCreate) SetCategoryID(u uint64) *ProductCreate {
+	pc.mutation.SetCategoryID(u)
+	return pc
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableCategoryID(u *uint64) *ProductCreate {
+	if u != nil {
+		pc.SetCategoryID(*u)
+	}
+	return pc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (pc *ProductCreate) SetCreatedAt(t time.Time) *ProductCreate {
 	pc.mutation.SetCreatedAt(t)
@@ -135,20 +149,6 @@ func (pc *ProductCreate) SetID(u uint64) *ProductCreate {
 	return pc
 }
 
-// SetCategoryID sets the "category" edge to the Category entity by ID.
-func (pc *ProductCreate) SetCategoryID(id uint64) *ProductCreate {
-	pc.mutation.SetCategoryID(id)
-	return pc
-}
-
-// SetNillableCategoryID sets the "category" edge to the Category entity by ID if the given value is not nil.
-func (pc *ProductCreate) SetNillableCategoryID(id *uint64) *ProductCreate {
-	if id != nil {
-		pc = pc.SetCategoryID(*id)
-	}
-	return pc
-}
-
 // SetCategory sets the "category" edge to the Category entity.
 func (pc *ProductCreate) SetCategory(c *Category) *ProductCreate {
 	return pc.SetCategoryID(c.ID)
@@ -184,19 +184,19 @@ func (pc *ProductCreate) AddReviews(r ...*Review) *ProductCreate {
 	return pc.AddReviewIDs(ids...)
 }
 
-// AddCartItemIDs adds the "cart_items" edge to the CartItem entity by IDs.
-func (pc *ProductCreate) AddCartItemIDs(ids ...int) *ProductCreate {
-	pc.mutation.AddCartItemIDs(ids...)
+// AddCartIDs adds the "carts" edge to the Cart entity by IDs.
+func (pc *ProductCreate) AddCartIDs(ids ...uint64) *ProductCreate {
+	pc.mutation.AddCartIDs(ids...)
 	return pc
 }
 
-// AddCartItems adds the "cart_items" edges to the CartItem entity.
-func (pc *ProductCreate) AddCartItems(c ...*CartItem) *ProductCreate {
-	ids := make([]int, len(c))
+// AddCarts adds the "carts" edges to the Cart entity.
+func (pc *ProductCreate) AddCarts(c ...*Cart) *ProductCreate {
+	ids := make([]uint64, len(c))
 	for i := range c {
 		ids[i] = c[i].ID
 	}
-	return pc.AddCartItemIDs(ids...)
+	return pc.AddCartIDs(ids...)
 }
 
 // AddOrderItemIDs adds the "order_items" edge to the OrderItem entity by IDs.
@@ -214,34 +214,34 @@ func (pc *ProductCreate) AddOrderItems(o ...*OrderItem) *ProductCreate {
 	return pc.AddOrderItemIDs(ids...)
 }
 
-// AddDiscountIDs adds the "discounts" edge to the DiscountProduct entity by IDs.
-func (pc *ProductCreate) AddDiscountIDs(ids ...int) *ProductCreate {
+// AddDiscountIDs adds the "discounts" edge to the Discount entity by IDs.
+func (pc *ProductCreate) AddDiscountIDs(ids ...uint64) *ProductCreate {
 	pc.mutation.AddDiscountIDs(ids...)
 	return pc
 }
 
-// AddDiscounts adds the "discounts" edges to the DiscountProduct entity.
-func (pc *ProductCreate) AddDiscounts(d ...*DiscountProduct) *ProductCreate {
-	ids := make([]int, len(d))
+// AddDiscounts adds the "discounts" edges to the Discount entity.
+func (pc *ProductCreate) AddDiscounts(d ...*Discount) *ProductCreate {
+	ids := make([]uint64, len(d))
 	for i := range d {
 		ids[i] = d[i].ID
 	}
 	return pc.AddDiscountIDs(ids...)
 }
 
-// AddWishlistItemIDs adds the "wishlist_items" edge to the WishlistItem entity by IDs.
-func (pc *ProductCreate) AddWishlistItemIDs(ids ...int) *ProductCreate {
-	pc.mutation.AddWishlistItemIDs(ids...)
+// AddWishlistIDs adds the "wishlists" edge to the Wishlist entity by IDs.
+func (pc *ProductCreate) AddWishlistIDs(ids ...uint64) *ProductCreate {
+	pc.mutation.AddWishlistIDs(ids...)
 	return pc
 }
 
-// AddWishlistItems adds the "wishlist_items" edges to the WishlistItem entity.
-func (pc *ProductCreate) AddWishlistItems(w ...*WishlistItem) *ProductCreate {
-	ids := make([]int, len(w))
+// AddWishlists adds the "wishlists" edges to the Wishlist entity.
+func (pc *ProductCreate) AddWishlists(w ...*Wishlist) *ProductCreate {
+	ids := make([]uint64, len(w))
 	for i := range w {
 		ids[i] = w[i].ID
 	}
-	return pc.AddWishlistItemIDs(ids...)
+	return pc.AddWishlistIDs(ids...)
 }
 
 // Mutation returns the ProductMutation object of the builder.
@@ -419,7 +419,7 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.category_products = &nodes[0]
+		_node.CategoryID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := pc.mutation.ImagesIDs(); len(nodes) > 0 {
@@ -454,15 +454,15 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := pc.mutation.CartItemsIDs(); len(nodes) > 0 {
+	if nodes := pc.mutation.CartsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   product.CartItemsTable,
-			Columns: []string{product.CartItemsColumn},
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.CartsTable,
+			Columns: product.CartsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(cart.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -488,13 +488,13 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 	}
 	if nodes := pc.mutation.DiscountsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
 			Table:   product.DiscountsTable,
-			Columns: []string{product.DiscountsColumn},
+			Columns: product.DiscountsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountproduct.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -502,20 +502,24 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := pc.mutation.WishlistItemsIDs(); len(nodes) > 0 {
+	if nodes := pc.mutation.WishlistsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   product.WishlistItemsTable,
-			Columns: []string{product.WishlistItemsColumn},
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.WishlistsTable,
+			Columns: product.WishlistsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(wishlist.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &WishlistItemCreate{config: pc.config, mutation: newWishlistItemMutation(pc.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
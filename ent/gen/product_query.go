@@ -9,35 +9,42 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/thang1834/go-goss/ent/gen/cart"
 	"github.com/thang1834/go-goss/ent/gen/cartitem"
 	"github.com/thang1834/go-goss/ent/gen/category"
+	"github.com/thang1834/go-goss/ent/gen/discount"
 	"github.com/thang1834/go-goss/ent/gen/discountproduct"
 	"github.com/thang1834/go-goss/ent/gen/orderitem"
 	"github.com/thang1834/go-goss/ent/gen/predicate"
 	"github.com/thang1834/go-goss/ent/gen/product"
 	"github.com/thang1834/go-goss/ent/gen/productimage"
 	"github.com/thang1834/go-goss/ent/gen/review"
+	"github.com/thang1834/go-goss/ent/gen/wishlist"
 	"github.com/thang1834/go-goss/ent/gen/wishlistitem"
 )
 
 // ProductQuery is the builder for querying Product entities.
 type ProductQuery struct {
 	config
-	ctx               *QueryContext
-	order             []product.OrderOption
-	inters            []Interceptor
-	predicates        []predicate.Product
-	withCategory      *CategoryQuery
-	withImages        *ProductImageQuery
-	withReviews       *ReviewQuery
-	withCartItems     *CartItemQuery
-	withOrderItems    *OrderItemQuery
-	withDiscounts     *DiscountProductQuery
-	withWishlistItems *WishlistItemQuery
-	withFKs           bool
+	ctx                  *QueryContext
+	order                []product.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.Product
+	withCategory         *CategoryQuery
+	withImages           *ProductImageQuery
+	withReviews          *ReviewQuery
+	withCarts            *CartQuery
+	withOrderItems       *OrderItemQuery
+	withDiscounts        *DiscountQuery
+	withWishlists        *WishlistQuery
+	withCartItems        *CartItemQuery
+	withDiscountProducts *DiscountProductQuery
+	withWishlistItems    *WishlistItemQuery
+	modifiers            []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -140,9 +147,9 @@ func (pq *ProductQuery) QueryReviews() *ReviewQuery {
 	return query
 }
 
-// QueryCartItems chains the current query on the "cart_items" edge.
-func (pq *ProductQuery) QueryCartItems() *CartItemQuery {
-	query := (&CartItemClient{config: pq.config}).Query()
+// QueryCarts chains the current query on the "carts" edge.
+func (pq *ProductQuery) QueryCarts() *CartQuery {
+	query := (&CartClient{config: pq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := pq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -153,8 +160,8 @@ func (pq *ProductQuery) QueryCartItems() *CartItemQuery {
 		}
 		step := sqlgraph.NewStep(
 			sqlgraph.From(product.Table, product.FieldID, selector),
-			sqlgraph.To(cartitem.Table, cartitem.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, product.CartItemsTable, product.CartItemsColumn),
+			sqlgraph.To(cart.Table, cart.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, product.CartsTable, product.CartsPrimaryKey...),
 		)
 		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
 		return fromU, nil
@@ -185,7 +192,73 @@ func (pq *ProductQuery) QueryOrderItems() *OrderItemQuery {
 }
 
 // QueryDiscounts chains the current query on the "discounts" edge.
-func (pq *ProductQuery) QueryDiscounts() *DiscountProductQuery {
+func (pq *ProductQuery) QueryDiscounts() *DiscountQuery {
+	query := (&DiscountClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, selector),
+			sqlgraph.To(discount.Table, discount.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, product.DiscountsTable, product.DiscountsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWishlists chains the current query on the "wishlists" edge.
+func (pq *ProductQuery) QueryWishlists() *WishlistQuery {
+	query := (&WishlistClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, selector),
+			sqlgraph.To(wishlist.Table, wishlist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, product.WishlistsTable, product.WishlistsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCartItems chains the current query on the "cart_items" edge.
+func (pq *ProductQuery) QueryCartItems() *CartItemQuery {
+	query := (&CartItemClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, selector),
+			sqlgraph.To(cartitem.Table, cartitem.ProductColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, product.CartItemsTable, product.CartItemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDiscountProducts chains the current query on the "discount_products" edge.
+func (pq *ProductQuery) QueryDiscountProducts() *DiscountProductQuery {
 	query := (&DiscountProductClient{config: pq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := pq.prepareQuery(ctx); err != nil {
@@ -197,8 +270,8 @@ func (pq *ProductQuery) QueryDiscounts() *DiscountProductQuery {
 		}
 		step := sqlgraph.NewStep(
 			sqlgraph.From(product.Table, product.FieldID, selector),
-			sqlgraph.To(discountproduct.Table, discountproduct.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, product.DiscountsTable, product.DiscountsColumn),
+			sqlgraph.To(discountproduct.Table, discountproduct.ProductColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, product.DiscountProductsTable, product.DiscountProductsColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
 		return fromU, nil
@@ -219,8 +292,8 @@ func (pq *ProductQuery) QueryWishlistItems() *WishlistItemQuery {
 		}
 		step := sqlgraph.NewStep(
 			sqlgraph.From(product.Table, product.FieldID, selector),
-			sqlgraph.To(wishlistitem.Table, wishlistitem.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, product.WishlistItemsTable, product.WishlistItemsColumn),
+			sqlgraph.To(wishlistitem.Table, wishlistitem.ProductColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, product.WishlistItemsTable, product.WishlistItemsColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
 		return fromU, nil
@@ -415,18 +488,21 @@ func (pq *ProductQuery) Clone() *ProductQuery {
 		return nil
 	}
 	return &ProductQuery{
-		config:            pq.config,
-		ctx:               pq.ctx.Clone(),
-		order:             append([]product.OrderOption{}, pq.order...),
-		inters:            append([]Interceptor{}, pq.inters...),
-		predicates:        append([]predicate.Product{}, pq.predicates...),
-		withCategory:      pq.withCategory.Clone(),
-		withImages:        pq.withImages.Clone(),
-		withReviews:       pq.withReviews.Clone(),
-		withCartItems:     pq.withCartItems.Clone(),
-		withOrderItems:    pq.withOrderItems.Clone(),
-		withDiscounts:     pq.withDiscounts.Clone(),
-		withWishlistItems: pq.withWishlistItems.Clone(),
+		config:               pq.config,
+		ctx:                  pq.ctx.Clone(),
+		order:                append([]product.OrderOption{}, pq.order...),
+		inters:               append([]Interceptor{}, pq.inters...),
+		predicates:           append([]predicate.Product{}, pq.predicates...),
+		withCategory:         pq.withCategory.Clone(),
+		withImages:           pq.withImages.Clone(),
+		withReviews:          pq.withReviews.Clone(),
+		withCarts:            pq.withCarts.Clone(),
+		withOrderItems:       pq.withOrderItems.Clone(),
+		withDiscounts:        pq.withDiscounts.Clone(),
+		withWishlists:        pq.withWishlists.Clone(),
+		withCartItems:        pq.withCartItems.Clone(),
+		withDiscountProducts: pq.withDiscountProducts.Clone(),
+		withWishlistItems:    pq.withWishlistItems.Clone(),
 		// clone intermediate query.
 		sql:  pq.sql.Clone(),
 		path: pq.path,
@@ -466,14 +542,14 @@ func (pq *ProductQuery) WithReviews(opts ...func(*ReviewQuery)) *ProductQuery {
 	return pq
 }
 
-// WithCartItems tells the query-builder to eager-load the nodes that are connected to
-// the "cart_items" edge. The optional arguments are used to configure the query builder of the edge.
-func (pq *ProductQuery) WithCartItems(opts ...func(*CartItemQuery)) *ProductQuery {
-	query := (&CartItemClient{config: pq.config}).Query()
+// WithCarts tells the query-builder to eager-load the nodes that are connected to
+// the "carts" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProductQuery) WithCarts(opts ...func(*CartQuery)) *ProductQuery {
+	query := (&CartClient{config: pq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	pq.withCartItems = query
+	pq.withCarts = query
 	return pq
 }
 
@@ -490,8 +566,8 @@ func (pq *ProductQuery) WithOrderItems(opts ...func(*OrderItemQuery)) *ProductQu
 
 // WithDiscounts tells the query-builder to eager-load the nodes that are connected to
 // the "discounts" edge. The optional arguments are used to configure the query builder of the edge.
-func (pq *ProductQuery) WithDiscounts(opts ...func(*DiscountProductQuery)) *ProductQuery {
-	query := (&DiscountProductClient{config: pq.config}).Query()
+func (pq *ProductQuery) WithDiscounts(opts ...func(*DiscountQuery)) *ProductQuery {
+	query := (&DiscountClient{config: pq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -499,6 +575,39 @@ func (pq *ProductQuery) WithDiscounts(opts ...func(*DiscountProductQuery)) *Prod
 	return pq
 }
 
+// WithWishlists tells the query-builder to eager-load the nodes that are connected to
+// the "wishlists" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProductQuery) WithWishlists(opts ...func(*WishlistQuery)) *ProductQuery {
+	query := (&WishlistClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withWishlists = query
+	return pq
+}
+
+// WithCartItems tells the query-builder to eager-load the nodes that are connected to
+// the "cart_items" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProductQuery) WithCartItems(opts ...func(*CartItemQuery)) *ProductQuery {
+	query := (&CartItemClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withCartItems = query
+	return pq
+}
+
+// WithDiscountProducts tells the query-builder to eager-load the nodes that are connected to
+// the "discount_products" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProductQuery) WithDiscountProducts(opts ...func(*DiscountProductQuery)) *ProductQuery {
+	query := (&DiscountProductClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withDiscountProducts = query
+	return pq
+}
+
 // WithWishlistItems tells the query-builder to eager-load the nodes that are connected to
 // the "wishlist_items" edge. The optional arguments are used to configure the query builder of the edge.
 func (pq *ProductQuery) WithWishlistItems(opts ...func(*WishlistItemQuery)) *ProductQuery {
@@ -587,24 +696,20 @@ func (pq *ProductQuery) prepareQuery(ctx context.Context) error {
 func (pq *ProductQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Product, error) {
 	var (
 		nodes       = []*Product{}
-		withFKs     = pq.withFKs
 		_spec       = pq.querySpec()
-		loadedTypes = [7]bool{
+		loadedTypes = [10]bool{
 			pq.withCategory != nil,
 			pq.withImages != nil,
 			pq.withReviews != nil,
-			pq.withCartItems != nil,
+			pq.withCarts != nil,
 			pq.withOrderItems != nil,
 			pq.withDiscounts != nil,
+			pq.withWishlists != nil,
+			pq.withCartItems != nil,
+			pq.withDiscountProducts != nil,
 			pq.withWishlistItems != nil,
 		}
 	)
-	if pq.withCategory != nil {
-		withFKs = true
-	}
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, product.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Product).scanValues(nil, columns)
 	}
@@ -614,6 +719,9 @@ func (pq *ProductQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Prod
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(pq.modifiers) > 0 {
+		_spec.Modifiers = pq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -643,10 +751,10 @@ func (pq *ProductQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Prod
 			return nil, err
 		}
 	}
-	if query := pq.withCartItems; query != nil {
-		if err := pq.loadCartItems(ctx, query, nodes,
-			func(n *Product) { n.Edges.CartItems = []*CartItem{} },
-			func(n *Product, e *CartItem) { n.Edges.CartItems = append(n.Edges.CartItems, e) }); err != nil {
+	if query := pq.withCarts; query != nil {
+		if err := pq.loadCarts(ctx, query, nodes,
+			func(n *Product) { n.Edges.Carts = []*Cart{} },
+			func(n *Product, e *Cart) { n.Edges.Carts = append(n.Edges.Carts, e) }); err != nil {
 			return nil, err
 		}
 	}
@@ -659,8 +767,29 @@ func (pq *ProductQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Prod
 	}
 	if query := pq.withDiscounts; query != nil {
 		if err := pq.loadDiscounts(ctx, query, nodes,
-			func(n *Product) { n.Edges.Discounts = []*DiscountProduct{} },
-			func(n *Product, e *DiscountProduct) { n.Edges.Discounts = append(n.Edges.Discounts, e) }); err != nil {
+			func(n *Product) { n.Edges.Discounts = []*Discount{} },
+			func(n *Product, e *Discount) { n.Edges.Discounts = append(n.Edges.Discounts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withWishlists; query != nil {
+		if err := pq.loadWishlists(ctx, query, nodes,
+			func(n *Product) { n.Edges.Wishlists = []*Wishlist{} },
+			func(n *Product, e *Wishlist) { n.Edges.Wishlists = append(n.Edges.Wishlists, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withCartItems; query != nil {
+		if err := pq.loadCartItems(ctx, query, nodes,
+			func(n *Product) { n.Edges.CartItems = []*CartItem{} },
+			func(n *Product, e *CartItem) { n.Edges.CartItems = append(n.Edges.CartItems, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withDiscountProducts; query != nil {
+		if err := pq.loadDiscountProducts(ctx, query, nodes,
+			func(n *Product) { n.Edges.DiscountProducts = []*DiscountProduct{} },
+			func(n *Product, e *DiscountProduct) { n.Edges.DiscountProducts = append(n.Edges.DiscountProducts, e) }); err != nil {
 			return nil, err
 		}
 	}
@@ -678,10 +807,7 @@ func (pq *ProductQuery) loadCategory(ctx context.Context, query *CategoryQuery,
 	ids := make([]uint64, 0, len(nodes))
 	nodeids := make(map[uint64][]*Product)
 	for i := range nodes {
-		if nodes[i].category_products == nil {
-			continue
-		}
-		fk := *nodes[i].category_products
+		fk := nodes[i].CategoryID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -698,7 +824,7 @@ func (pq *ProductQuery) loadCategory(ctx context.Context, query *CategoryQuery,
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "category_products" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "category_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -716,7 +842,9 @@ func (pq *ProductQuery) loadImages(ctx context.Context, query *ProductImageQuery
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(productimage.FieldProductID)
+	}
 	query.Where(predicate.ProductImage(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(product.ImagesColumn), fks...))
 	}))
@@ -725,13 +853,10 @@ func (pq *ProductQuery) loadImages(ctx context.Context, query *ProductImageQuery
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.product_images
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "product_images" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.ProductID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "product_images" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "product_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -747,7 +872,9 @@ func (pq *ProductQuery) loadReviews(ctx context.Context, query *ReviewQuery, nod
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(review.FieldProductID)
+	}
 	query.Where(predicate.Review(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(product.ReviewsColumn), fks...))
 	}))
@@ -756,19 +883,77 @@ func (pq *ProductQuery) loadReviews(ctx context.Context, query *ReviewQuery, nod
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.product_reviews
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "product_reviews" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.ProductID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "product_reviews" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "product_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (pq *ProductQuery) loadCartItems(ctx context.Context, query *CartItemQuery, nodes []*Product, init func(*Product), assign func(*Product, *CartItem)) error {
+func (pq *ProductQuery) loadCarts(ctx context.Context, query *CartQuery, nodes []*Product, init func(*Product), assign func(*Product, *Cart)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uint64]*Product)
+	nids := make(map[uint64]map[*Product]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(product.CartsTable)
+		s.Join(joinT).On(s.C(cart.FieldID), joinT.C(product.CartsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(product.CartsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(product.CartsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := uint64(values[0].(*sql.NullInt64).Int64)
+				inValue := uint64(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Product]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Cart](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "carts" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (pq *ProductQuery) loadOrderItems(ctx context.Context, query *OrderItemQuery, nodes []*Product, init func(*Product), assign func(*Product, *OrderItem)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uint64]*Product)
 	for i := range nodes {
@@ -778,28 +963,149 @@ func (pq *ProductQuery) loadCartItems(ctx context.Context, query *CartItemQuery,
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
-	query.Where(predicate.CartItem(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(product.CartItemsColumn), fks...))
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(orderitem.FieldProductID)
+	}
+	query.Where(predicate.OrderItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(product.OrderItemsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.product_cart_items
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "product_cart_items" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.ProductID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "product_cart_items" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "product_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (pq *ProductQuery) loadOrderItems(ctx context.Context, query *OrderItemQuery, nodes []*Product, init func(*Product), assign func(*Product, *OrderItem)) error {
+func (pq *ProductQuery) loadDiscounts(ctx context.Context, query *DiscountQuery, nodes []*Product, init func(*Product), assign func(*Product, *Discount)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uint64]*Product)
+	nids := make(map[uint64]map[*Product]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(product.DiscountsTable)
+		s.Join(joinT).On(s.C(discount.FieldID), joinT.C(product.DiscountsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(product.DiscountsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(product.DiscountsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := uint64(values[0].(*sql.NullInt64).Int64)
+				inValue := uint64(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Product]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Discount](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "discounts" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (pq *ProductQuery) loadWishlists(ctx context.Context, query *WishlistQuery, nodes []*Product, init func(*Product), assign func(*Product, *Wishlist)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uint64]*Product)
+	nids := make(map[uint64]map[*Product]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(product.WishlistsTable)
+		s.Join(joinT).On(s.C(wishlist.FieldID), joinT.C(product.WishlistsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(product.WishlistsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(product.WishlistsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := uint64(values[0].(*sql.NullInt64).Int64)
+				inValue := uint64(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Product]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Wishlist](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "wishlists" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (pq *ProductQuery) loadCartItems(ctx context.Context, query *CartItemQuery, nodes []*Product, init func(*Product), assign func(*Product, *CartItem)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uint64]*Product)
 	for i := range nodes {
@@ -809,28 +1115,27 @@ func (pq *ProductQuery) loadOrderItems(ctx context.Context, query *OrderItemQuer
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
-	query.Where(predicate.OrderItem(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(product.OrderItemsColumn), fks...))
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(cartitem.FieldProductID)
+	}
+	query.Where(predicate.CartItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(product.CartItemsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.product_order_items
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "product_order_items" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.ProductID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "product_order_items" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "product_id" returned %v for node %v`, fk, n)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (pq *ProductQuery) loadDiscounts(ctx context.Context, query *DiscountProductQuery, nodes []*Product, init func(*Product), assign func(*Product, *DiscountProduct)) error {
+func (pq *ProductQuery) loadDiscountProducts(ctx context.Context, query *DiscountProductQuery, nodes []*Product, init func(*Product), assign func(*Product, *DiscountProduct)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uint64]*Product)
 	for i := range nodes {
@@ -840,22 +1145,21 @@ func (pq *ProductQuery) loadDiscounts(ctx context.Context, query *DiscountProduc
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(discountproduct.FieldProductID)
+	}
 	query.Where(predicate.DiscountProduct(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(product.DiscountsColumn), fks...))
+		s.Where(sql.InValues(s.C(product.DiscountProductsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.product_discounts
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "product_discounts" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.ProductID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "product_discounts" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "product_id" returned %v for node %v`, fk, n)
 		}
 		assign(node, n)
 	}
@@ -871,7 +1175,9 @@ func (pq *ProductQuery) loadWishlistItems(ctx context.Context, query *WishlistIt
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(wishlistitem.FieldProductID)
+	}
 	query.Where(predicate.WishlistItem(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(product.WishlistItemsColumn), fks...))
 	}))
@@ -880,13 +1186,10 @@ func (pq *ProductQuery) loadWishlistItems(ctx context.Context, query *WishlistIt
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.product_wishlist_items
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "product_wishlist_items" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.ProductID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "product_wishlist_items" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "product_id" returned %v for node %v`, fk, n)
 		}
 		assign(node, n)
 	}
@@ -895,6 +1198,9 @@ func (pq *ProductQuery) loadWishlistItems(ctx context.Context, query *WishlistIt
 
 func (pq *ProductQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pq.querySpec()
+	if len(pq.modifiers) > 0 {
+		_spec.Modifiers = pq.modifiers
+	}
 	_spec.Node.Columns = pq.ctx.Fields
 	if len(pq.ctx.Fields) > 0 {
 		_spec.Unique = pq.ctx.Unique != nil && *pq.ctx.Unique
@@ -918,6 +1224,9 @@ func (pq *ProductQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if pq.withCategory != nil {
+			_spec.Node.AddColumnOnce(product.FieldCategoryID)
+		}
 	}
 	if ps := pq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -957,6 +1266,9 @@ func (pq *ProductQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if pq.ctx.Unique != nil && *pq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range pq.modifiers {
+		m(selector)
+	}
 	for _, p := range pq.predicates {
 		p(selector)
 	}
@@ -974,6 +1286,32 @@ func (pq *ProductQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (pq *ProductQuery) ForUpdate(opts ...sql.LockOption) *ProductQuery {
+	if pq.driver.Dialect() == dialect.Postgres {
+		pq.Unique(false)
+	}
+	pq.modifiers = append(pq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return pq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (pq *ProductQuery) ForShare(opts ...sql.LockOption) *ProductQuery {
+	if pq.driver.Dialect() == dialect.Postgres {
+		pq.Unique(false)
+	}
+	pq.modifiers = append(pq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return pq
+}
+
 // ProductGroupBy is the group-by builder for Product entities.
 type ProductGroupBy struct {
 	selector
@@ -11,15 +11,15 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
-	"github.com/thang1834/go-goss/ent/gen/cartitem"
+	"github.com/thang1834/go-goss/ent/gen/cart"
 	"github.com/thang1834/go-goss/ent/gen/category"
-	"github.com/thang1834/go-goss/ent/gen/discountproduct"
+	"github.com/thang1834/go-goss/ent/gen/discount"
 	"github.com/thang1834/go-goss/ent/gen/orderitem"
 	"github.com/thang1834/go-goss/ent/gen/predicate"
 	"github.com/thang1834/go-goss/ent/gen/product"
 	"github.com/thang1834/go-goss/ent/gen/productimage"
 	"github.com/thang1834/go-goss/ent/gen/review"
-	"github.com/thang1834/go-goss/ent/gen/wishlistitem"
+	"github.com/thang1834/go-goss/ent/gen/wishlist"
 )
 
 // ProductUpdate is the builder for updating Product entities.
@@ -167,6 +167,26 @@ func (pu *ProductUpdate) AddReviewCount(i int) *ProductUpdate {
 	return pu
 }
 
+// SetCategoryID sets the "category_id" field.
+func (pu *ProductUpdate) SetCategoryID(u uint64) *ProductUpdate {
+	pu.mutation.SetCategoryID(u)
+	return pu
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableCategoryID(u *uint64) *ProductUpdate {
+	if u != nil {
+		pu.SetCategoryID(*u)
+	}
+	return pu
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (pu *ProductUpdate) ClearCategoryID() *ProductUpdate {
+	pu.mutation.ClearCategoryID()
+	return pu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (pu *ProductUpdate) SetCreatedAt(t time.Time) *ProductUpdate {
 	pu.mutation.SetCreatedAt(t)
@@ -187,20 +207,6 @@ func (pu *ProductUpdate) SetUpdatedAt(t time.Time) *ProductUpdate {
 	return pu
 }
 
-// SetCategoryID sets the "category" edge to the Category entity by ID.
-func (pu *ProductUpdate) SetCategoryID(id uint64) *ProductUpdate {
-	pu.mutation.SetCategoryID(id)
-	return pu
-}
-
-// SetNillableCategoryID sets the "category" edge to the Category entity by ID if the given value is not nil.
-func (pu *ProductUpdate) SetNillableCategoryID(id *uint64) *ProductUpdate {
-	if id != nil {
-		pu = pu.SetCategoryID(*id)
-	}
-	return pu
-}
-
 // SetCategory sets the "category" edge to the Category entity.
 func (pu *ProductUpdate) SetCategory(c *Category) *ProductUpdate {
 	return pu.SetCategoryID(c.ID)
@@ -236,19 +242,19 @@ func (pu *ProductUpdate) AddReviews(r ...*Review) *ProductUpdate {
 	return pu.AddReviewIDs(ids...)
 }
 
-// AddCartItemIDs adds the "cart_items" edge to the CartItem entity by IDs.
-func (pu *ProductUpdate) AddCartItemIDs(ids ...int) *ProductUpdate {
-	pu.mutation.AddCartItemIDs(ids...)
+// AddCartIDs adds the "carts" edge to the Cart entity by IDs.
+func (pu *ProductUpdate) AddCartIDs(ids ...uint64) *ProductUpdate {
+	pu.mutation.AddCartIDs(ids...)
 	return pu
 }
 
-// AddCartItems adds the "cart_items" edges to the CartItem entity.
-func (pu *ProductUpdate) AddCartItems(c ...*CartItem) *ProductUpdate {
-	ids := make([]int, len(c))
+// AddCarts adds the "carts" edges to the Cart entity.
+func (pu *ProductUpdate) AddCarts(c ...*Cart) *ProductUpdate {
+	ids := make([]uint64, len(c))
 	for i := range c {
 		ids[i] = c[i].ID
 	}
-	return pu.AddCartItemIDs(ids...)
+	return pu.AddCartIDs(ids...)
 }
 
 // AddOrderItemIDs adds the "order_items" edge to the OrderItem entity by IDs.
@@ -266,34 +272,34 @@ func (pu *ProductUpdate) AddOrderItems(o ...*OrderItem) *ProductUpdate {
 	return pu.AddOrderItemIDs(ids...)
 }
 
-// AddDiscountIDs adds the "discounts" edge to the DiscountProduct entity by IDs.
-func (pu *ProductUpdate) AddDiscountIDs(ids ...int) *ProductUpdate {
+// AddDiscountIDs adds the "discounts" edge to the Discount entity by IDs.
+func (pu *ProductUpdate) AddDiscountIDs(ids ...uint64) *ProductUpdate {
 	pu.mutation.AddDiscountIDs(ids...)
 	return pu
 }
 
-// AddDiscounts adds the "discounts" edges to the DiscountProduct entity.
-func (pu *ProductUpdate) AddDiscounts(d ...*DiscountProduct) *ProductUpdate {
-	ids := make([]int, len(d))
+// AddDiscounts adds the "discounts" edges to the Discount entity.
+func (pu *ProductUpdate) AddDiscounts(d ...*Discount) *ProductUpdate {
+	ids := make([]uint64, len(d))
 	for i := range d {
 		ids[i] = d[i].ID
 	}
 	return pu.AddDiscountIDs(ids...)
 }
 
-// AddWishlistItemIDs adds the "wishlist_items" edge to the WishlistItem entity by IDs.
-func (pu *ProductUpdate) AddWishlistItemIDs(ids ...int) *ProductUpdate {
-	pu.mutation.AddWishlistItemIDs(ids...)
+// AddWishlistIDs adds the "wishlists" edge to the Wishlist entity by IDs.
+func (pu *ProductUpdate) AddWishlistIDs(ids ...uint64) *ProductUpdate {
+	pu.mutation.AddWishlistIDs(ids...)
 	return pu
 }
 
-// AddWishlistItems adds the "wishlist_items" edges to the WishlistItem entity.
-func (pu *ProductUpdate) AddWishlistItems(w ...*WishlistItem) *ProductUpdate {
-	ids := make([]int, len(w))
+// AddWishlists adds the "wishlists" edges to the Wishlist entity.
+func (pu *ProductUpdate) AddWishlists(w ...*Wishlist) *ProductUpdate {
+	ids := make([]uint64, len(w))
 	for i := range w {
 		ids[i] = w[i].ID
 	}
-	return pu.AddWishlistItemIDs(ids...)
+	return pu.AddWishlistIDs(ids...)
 }
 
 // Mutation returns the ProductMutation object of the builder.
@@ -349,25 +355,25 @@ func (pu *ProductUpdate) RemoveReviews(r ...*Review) *ProductUpdate {
 	return pu.RemoveReviewIDs(ids...)
 }
 
-// ClearCartItems clears all "cart_items" edges to the CartItem entity.
-func (pu *ProductUpdate) ClearCartItems() *ProductUpdate {
-	pu.mutation.ClearCartItems()
+// ClearCarts clears all "carts" edges to the Cart entity.
+func (pu *ProductUpdate) ClearCarts() *ProductUpdate {
+	pu.mutation.ClearCarts()
 	return pu
 }
 
-// RemoveCartItemIDs removes the "cart_items" edge to CartItem entities by IDs.
-func (pu *ProductUpdate) RemoveCartItemIDs(ids ...int) *ProductUpdate {
-	pu.mutation.RemoveCartItemIDs(ids...)
+// RemoveCartIDs removes the "carts" edge to Cart entities by IDs.
+func (pu *ProductUpdate) RemoveCartIDs(ids ...uint64) *ProductUpdate {
+	pu.mutation.RemoveCartIDs(ids...)
 	return pu
 }
 
-// RemoveCartItems removes "cart_items" edges to CartItem entities.
-func (pu *ProductUpdate) RemoveCartItems(c ...*CartItem) *ProductUpdate {
-	ids := make([]int, len(c))
+// RemoveCarts removes "carts" edges to Cart entities.
+func (pu *ProductUpdate) RemoveCarts(c ...*Cart) *ProductUpdate {
+	ids := make([]uint64, len(c))
 	for i := range c {
 		ids[i] = c[i].ID
 	}
-	return pu.RemoveCartItemIDs(ids...)
+	return pu.RemoveCartIDs(ids...)
 }
 
 // ClearOrderItems clears all "order_items" edges to the OrderItem entity.
@@ -391,46 +397,46 @@ func (pu *ProductUpdate) RemoveOrderItems(o ...*OrderItem) *ProductUpdate {
 	return pu.RemoveOrderItemIDs(ids...)
 }
 
-// ClearDiscounts clears all "discounts" edges to the DiscountProduct entity.
+// ClearDiscounts clears all "discounts" edges to the Discount entity.
 func (pu *ProductUpdate) ClearDiscounts() *ProductUpdate {
 	pu.mutation.ClearDiscounts()
 	return pu
 }
 
-// RemoveDiscountIDs removes the "discounts" edge to DiscountProduct entities by IDs.
-func (pu *ProductUpdate) RemoveDiscountIDs(ids ...int) *ProductUpdate {
+// RemoveDiscountIDs removes the "discounts" edge to Discount entities by IDs.
+func (pu *ProductUpdate) RemoveDiscountIDs(ids ...uint64) *ProductUpdate {
 	pu.mutation.RemoveDiscountIDs(ids...)
 	return pu
 }
 
-// RemoveDiscounts removes "discounts" edges to DiscountProduct entities.
-func (pu *ProductUpdate) RemoveDiscounts(d ...*DiscountProduct) *ProductUpdate {
-	ids := make([]int, len(d))
+// RemoveDiscounts removes "discounts" edges to Discount entities.
+func (pu *ProductUpdate) RemoveDiscounts(d ...*Discount) *ProductUpdate {
+	ids := make([]uint64, len(d))
 	for i := range d {
 		ids[i] = d[i].ID
 	}
 	return pu.RemoveDiscountIDs(ids...)
 }
 
-// ClearWishlistItems clears all "wishlist_items" edges to the WishlistItem entity.
-func (pu *ProductUpdate) ClearWishlistItems() *ProductUpdate {
-	pu.mutation.ClearWishlistItems()
+// ClearWishlists clears all "wishlists" edges to the Wishlist entity.
+func (pu *ProductUpdate) ClearWishlists() *ProductUpdate {
+	pu.mutation.ClearWishlists()
 	return pu
 }
 
-// RemoveWishlistItemIDs removes the "wishlist_items" edge to WishlistItem entities by IDs.
-func (pu *ProductUpdate) RemoveWishlistItemIDs(ids ...int) *ProductUpdate {
-	pu.mutation.RemoveWishlistItemIDs(ids...)
+// RemoveWishlistIDs removes the "wishlists" edge to Wishlist entities by IDs.
+func (pu *ProductUpdate) RemoveWishlistIDs(ids ...uint64) *ProductUpdate {
+	pu.mutation.RemoveWishlistIDs(ids...)
 	return pu
 }
 
-// RemoveWishlistItems removes "wishlist_items" edges to WishlistItem entities.
-func (pu *ProductUpdate) RemoveWishlistItems(w ...*WishlistItem) *ProductUpdate {
-	ids := make([]int, len(w))
+// RemoveWishlists removes "wishlists" edges to Wishlist entities.
+func (pu *ProductUpdate) RemoveWishlists(w ...*Wishlist) *ProductUpdate {
+	ids := make([]uint64, len(w))
 	for i := range w {
 		ids[i] = w[i].ID
 	}
-	return pu.RemoveWishlistItemIDs(ids...)
+	return pu.RemoveWishlistIDs(ids...)
 }
 
 // Save executes the query and returns the number of nodes affected by the update operation.
@@ -657,28 +663,28 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if pu.mutation.CartItemsCleared() {
+	if pu.mutation.CartsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   product.CartItemsTable,
-			Columns: []string{product.CartItemsColumn},
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.CartsTable,
+			Columns: product.CartsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(cart.FieldID, field.TypeUint64),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.RemovedCartItemsIDs(); len(nodes) > 0 && !pu.mutation.CartItemsCleared() {
+	if nodes := pu.mutation.RemovedCartsIDs(); len(nodes) > 0 && !pu.mutation.CartsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   product.CartItemsTable,
-			Columns: []string{product.CartItemsColumn},
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.CartsTable,
+			Columns: product.CartsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(cart.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -686,15 +692,15 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.CartItemsIDs(); len(nodes) > 0 {
+	if nodes := pu.mutation.CartsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   product.CartItemsTable,
-			Columns: []string{product.CartItemsColumn},
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.CartsTable,
+			Columns: product.CartsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(cart.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -749,26 +755,26 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if pu.mutation.DiscountsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
 			Table:   product.DiscountsTable,
-			Columns: []string{product.DiscountsColumn},
+			Columns: product.DiscountsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountproduct.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeUint64),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := pu.mutation.RemovedDiscountsIDs(); len(nodes) > 0 && !pu.mutation.DiscountsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
 			Table:   product.DiscountsTable,
-			Columns: []string{product.DiscountsColumn},
+			Columns: product.DiscountsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountproduct.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -778,13 +784,13 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if nodes := pu.mutation.DiscountsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
 			Table:   product.DiscountsTable,
-			Columns: []string{product.DiscountsColumn},
+			Columns: product.DiscountsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountproduct.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -792,49 +798,61 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if pu.mutation.WishlistItemsCleared() {
+	if pu.mutation.WishlistsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   product.WishlistItemsTable,
-			Columns: []string{product.WishlistItemsColumn},
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.WishlistsTable,
+			Columns: product.WishlistsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(wishlist.FieldID, field.TypeUint64),
 			},
 		}
+		createE := &WishlistItemCreate{config: pu.config, mutation: newWishlistItemMutation(pu.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.RemovedWishlistItemsIDs(); len(nodes) > 0 && !pu.mutation.WishlistItemsCleared() {
+	if nodes := pu.mutation.RemovedWishlistsIDs(); len(nodes) > 0 && !pu.mutation.WishlistsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   product.WishlistItemsTable,
-			Columns: []string{product.WishlistItemsColumn},
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.WishlistsTable,
+			Columns: product.WishlistsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(wishlist.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &WishlistItemCreate{config: pu.config, mutation: newWishlistItemMutation(pu.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.WishlistItemsIDs(); len(nodes) > 0 {
+	if nodes := pu.mutation.WishlistsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   product.WishlistItemsTable,
-			Columns: []string{product.WishlistItemsColumn},
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.WishlistsTable,
+			Columns: product.WishlistsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(wishlist.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &WishlistItemCreate{config: pu.config, mutation: newWishlistItemMutation(pu.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
@@ -989,6 +1007,26 @@ func (puo *ProductUpdateOne) AddReviewCount(i int) *ProductUpdateOne {
 	return puo
 }
 
+// SetCategoryID sets the "category_id" field.
+func (puo *ProductUpdateOne) SetCategoryID(u uint64) *ProductUpdateOne {
+	puo.mutation.SetCategoryID(u)
+	return puo
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableCategoryID(u *uint64) *ProductUpdateOne {
+	if u != nil {
+		puo.SetCategoryID(*u)
+	}
+	return puo
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (puo *ProductUpdateOne) ClearCategoryID() *ProductUpdateOne {
+	puo.mutation.ClearCategoryID()
+	return puo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (puo *ProductUpdateOne) SetCreatedAt(t time.Time) *ProductUpdateOne {
 	puo.mutation.SetCreatedAt(t)
@@ -1009,20 +1047,6 @@ func (puo *ProductUpdateOne) SetUpdatedAt(t time.Time) *ProductUpdateOne {
 	return puo
 }
 
-// SetCategoryID sets the "category" edge to the Category entity by ID.
-func (puo *ProductUpdateOne) SetCategoryID(id uint64) *ProductUpdateOne {
-	puo.mutation.SetCategoryID(id)
-	return puo
-}
-
-// SetNillableCategoryID sets the "category" edge to the Category entity by ID if the given value is not nil.
-func (puo *ProductUpdateOne) SetNillableCategoryID(id *uint64) *ProductUpdateOne {
-	if id != nil {
-		puo = puo.SetCategoryID(*id)
-	}
-	return puo
-}
-
 // SetCategory sets the "category" edge to the Category entity.
 func (puo *ProductUpdateOne) SetCategory(c *Category) *ProductUpdateOne {
 	return puo.SetCategoryID(c.ID)
@@ -1058,19 +1082,19 @@ func (puo *ProductUpdateOne) AddReviews(r ...*Review) *ProductUpdateOne {
 	return puo.AddReviewIDs(ids...)
 }
 
-// AddCartItemIDs adds the "cart_items" edge to the CartItem entity by IDs.
-func (puo *ProductUpdateOne) AddCartItemIDs(ids ...int) *ProductUpdateOne {
-	puo.mutation.AddCartItemIDs(ids...)
+// AddCartIDs adds the "carts" edge to the Cart entity by IDs.
+func (puo *ProductUpdateOne) AddCartIDs(ids ...uint64) *ProductUpdateOne {
+	puo.mutation.AddCartIDs(ids...)
 	return puo
 }
 
-// AddCartItems adds the "cart_items" edges to the CartItem entity.
-func (puo *ProductUpdateOne) AddCartItems(c ...*CartItem) *ProductUpdateOne {
-	ids := make([]int, len(c))
+// AddCarts adds the "carts" edges to the Cart entity.
+func (puo *ProductUpdateOne) AddCarts(c ...*Cart) *ProductUpdateOne {
+	ids := make([]uint64, len(c))
 	for i := range c {
 		ids[i] = c[i].ID
 	}
-	return puo.AddCartItemIDs(ids...)
+	return puo.AddCartIDs(ids...)
 }
 
 // AddOrderItemIDs adds the "order_items" edge to the OrderItem entity by IDs.
@@ -1088,34 +1112,34 @@ func (puo *ProductUpdateOne) AddOrderItems(o ...*OrderItem) *ProductUpdateOne {
 	return puo.AddOrderItemIDs(ids...)
 }
 
-// AddDiscountIDs adds the "discounts" edge to the DiscountProduct entity by IDs.
-func (puo *ProductUpdateOne) AddDiscountIDs(ids ...int) *ProductUpdateOne {
+// AddDiscountIDs adds the "discounts" edge to the Discount entity by IDs.
+func (puo *ProductUpdateOne) AddDiscountIDs(ids ...uint64) *ProductUpdateOne {
 	puo.mutation.AddDiscountIDs(ids...)
 	return puo
 }
 
-// AddDiscounts adds the "discounts" edges to the DiscountProduct entity.
-func (puo *ProductUpdateOne) AddDiscounts(d ...*DiscountProduct) *ProductUpdateOne {
-	ids := make([]int, len(d))
+// AddDiscounts adds the "discounts" edges to the Discount entity.
+func (puo *ProductUpdateOne) AddDiscounts(d ...*Discount) *ProductUpdateOne {
+	ids := make([]uint64, len(d))
 	for i := range d {
 		ids[i] = d[i].ID
 	}
 	return puo.AddDiscountIDs(ids...)
 }
 
-// AddWishlistItemIDs adds the "wishlist_items" edge to the WishlistItem entity by IDs.
-func (puo *ProductUpdateOne) AddWishlistItemIDs(ids ...int) *ProductUpdateOne {
-	puo.mutation.AddWishlistItemIDs(ids...)
+// AddWishlistIDs adds the "wishlists" edge to the Wishlist entity by IDs.
+func (puo *ProductUpdateOne) AddWishlistIDs(ids ...uint64) *ProductUpdateOne {
+	puo.mutation.AddWishlistIDs(ids...)
 	return puo
 }
 
-// AddWishlistItems adds the "wishlist_items" edges to the WishlistItem entity.
-func (puo *ProductUpdateOne) AddWishlistItems(w ...*WishlistItem) *ProductUpdateOne {
-	ids := make([]int, len(w))
+// AddWishlists adds the "wishlists" edges to the Wishlist entity.
+func (puo *ProductUpdateOne) AddWishlists(w ...*Wishlist) *ProductUpdateOne {
+	ids := make([]uint64, len(w))
 	for i := range w {
 		ids[i] = w[i].ID
 	}
-	return puo.AddWishlistItemIDs(ids...)
+	return puo.AddWishlistIDs(ids...)
 }
 
 // Mutation returns the ProductMutation object of the builder.
@@ -1171,25 +1195,25 @@ func (puo *ProductUpdateOne) RemoveReviews(r ...*Review) *ProductUpdateOne {
 	return puo.RemoveReviewIDs(ids...)
 }
 
-// ClearCartItems clears all "cart_items" edges to the CartItem entity.
-func (puo *ProductUpdateOne) ClearCartItems() *ProductUpdateOne {
-	puo.mutation.ClearCartItems()
+// ClearCarts clears all "carts" edges to the Cart entity.
+func (puo *ProductUpdateOne) ClearCarts() *ProductUpdateOne {
+	puo.mutation.ClearCarts()
 	return puo
 }
 
-// RemoveCartItemIDs removes the "cart_items" edge to CartItem entities by IDs.
-func (puo *ProductUpdateOne) RemoveCartItemIDs(ids ...int) *ProductUpdateOne {
-	puo.mutation.RemoveCartItemIDs(ids...)
+// RemoveCartIDs removes the "carts" edge to Cart entities by IDs.
+func (puo *ProductUpdateOne) RemoveCartIDs(ids ...uint64) *ProductUpdateOne {
+	puo.mutation.RemoveCartIDs(ids...)
 	return puo
 }
 
-// RemoveCartItems removes "cart_items" edges to CartItem entities.
-func (puo *ProductUpdateOne) RemoveCartItems(c ...*CartItem) *ProductUpdateOne {
-	ids := make([]int, len(c))
+// RemoveCarts removes "carts" edges to Cart entities.
+func (puo *ProductUpdateOne) RemoveCarts(c ...*Cart) *ProductUpdateOne {
+	ids := make([]uint64, len(c))
 	for i := range c {
 		ids[i] = c[i].ID
 	}
-	return puo.RemoveCartItemIDs(ids...)
+	return puo.RemoveCartIDs(ids...)
 }
 
 // ClearOrderItems clears all "order_items" edges to the OrderItem entity.
@@ -1213,46 +1237,46 @@ func (puo *ProductUpdateOne) RemoveOrderItems(o ...*OrderItem) *ProductUpdateOne
 	return puo.RemoveOrderItemIDs(ids...)
 }
 
-// ClearDiscounts clears all "discounts" edges to the DiscountProduct entity.
+// ClearDiscounts clears all "discounts" edges to the Discount entity.
 func (puo *ProductUpdateOne) ClearDiscounts() *ProductUpdateOne {
 	puo.mutation.ClearDiscounts()
 	return puo
 }
 
-// RemoveDiscountIDs removes the "discounts" edge to DiscountProduct entities by IDs.
-func (puo *ProductUpdateOne) RemoveDiscountIDs(ids ...int) *ProductUpdateOne {
+// RemoveDiscountIDs removes the "discounts" edge to Discount entities by IDs.
+func (puo *ProductUpdateOne) RemoveDiscountIDs(ids ...uint64) *ProductUpdateOne {
 	puo.mutation.RemoveDiscountIDs(ids...)
 	return puo
 }
 
-// RemoveDiscounts removes "discounts" edges to DiscountProduct entities.
-func (puo *ProductUpdateOne) RemoveDiscounts(d ...*DiscountProduct) *ProductUpdateOne {
-	ids := make([]int, len(d))
+// RemoveDiscounts removes "discounts" edges to Discount entities.
+func (puo *ProductUpdateOne) RemoveDiscounts(d ...*Discount) *ProductUpdateOne {
+	ids := make([]uint64, len(d))
 	for i := range d {
 		ids[i] = d[i].ID
 	}
 	return puo.RemoveDiscountIDs(ids...)
 }
 
-// ClearWishlistItems clears all "wishlist_items" edges to the WishlistItem entity.
-func (puo *ProductUpdateOne) ClearWishlistItems() *ProductUpdateOne {
-	puo.mutation.ClearWishlistItems()
+// ClearWishlists clears all "wishlists" edges to the Wishlist entity.
+func (puo *ProductUpdateOne) ClearWishlists() *ProductUpdateOne {
+	puo.mutation.ClearWishlists()
 	return puo
 }
 
-// RemoveWishlistItemIDs removes the "wishlist_items" edge to WishlistItem entities by IDs.
-func (puo *ProductUpdateOne) RemoveWishlistItemIDs(ids ...int) *ProductUpdateOne {
-	puo.mutation.RemoveWishlistItemIDs(ids...)
+// RemoveWishlistIDs removes the "wishlists" edge to Wishlist entities by IDs.
+func (puo *ProductUpdateOne) RemoveWishlistIDs(ids ...uint64) *ProductUpdateOne {
+	puo.mutation.RemoveWishlistIDs(ids...)
 	return puo
 }
 
-// RemoveWishlistItems removes "wishlist_items" edges to WishlistItem entities.
-func (puo *ProductUpdateOne) RemoveWishlistItems(w ...*WishlistItem) *ProductUpdateOne {
-	ids := make([]int, len(w))
+// RemoveWishlists removes "wishlists" edges to Wishlist entities.
+func (puo *ProductUpdateOne) RemoveWishlists(w ...*Wishlist) *ProductUpdateOne {
+	ids := make([]uint64, len(w))
 	for i := range w {
 		ids[i] = w[i].ID
 	}
-	return puo.RemoveWishlistItemIDs(ids...)
+	return puo.RemoveWishlistIDs(ids...)
 }
 
 // Where appends a list predicates to the ProductUpdate builder.
@@ -1509,28 +1533,28 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if puo.mutation.CartItemsCleared() {
+	if puo.mutation.CartsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   product.CartItemsTable,
-			Columns: []string{product.CartItemsColumn},
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.CartsTable,
+			Columns: product.CartsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(cart.FieldID, field.TypeUint64),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := puo.mutation.RemovedCartItemsIDs(); len(nodes) > 0 && !puo.mutation.CartItemsCleared() {
+	if nodes := puo.mutation.RemovedCartsIDs(); len(nodes) > 0 && !puo.mutation.CartsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   product.CartItemsTable,
-			Columns: []string{product.CartItemsColumn},
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.CartsTable,
+			Columns: product.CartsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(cart.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -1538,15 +1562,15 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := puo.mutation.CartItemsIDs(); len(nodes) > 0 {
+	if nodes := puo.mutation.CartsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   product.CartItemsTable,
-			Columns: []string{product.CartItemsColumn},
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.CartsTable,
+			Columns: product.CartsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(cart.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -1601,26 +1625,26 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 	}
 	if puo.mutation.DiscountsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
 			Table:   product.DiscountsTable,
-			Columns: []string{product.DiscountsColumn},
+			Columns: product.DiscountsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountproduct.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeUint64),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := puo.mutation.RemovedDiscountsIDs(); len(nodes) > 0 && !puo.mutation.DiscountsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
 			Table:   product.DiscountsTable,
-			Columns: []string{product.DiscountsColumn},
+			Columns: product.DiscountsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountproduct.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -1630,13 +1654,13 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 	}
 	if nodes := puo.mutation.DiscountsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
 			Table:   product.DiscountsTable,
-			Columns: []string{product.DiscountsColumn},
+			Columns: product.DiscountsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(discountproduct.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(discount.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
@@ -1644,49 +1668,61 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if puo.mutation.WishlistItemsCleared() {
+	if puo.mutation.WishlistsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   product.WishlistItemsTable,
-			Columns: []string{product.WishlistItemsColumn},
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.WishlistsTable,
+			Columns: product.WishlistsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(wishlist.FieldID, field.TypeUint64),
 			},
 		}
+		createE := &WishlistItemCreate{config: puo.config, mutation: newWishlistItemMutation(puo.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := puo.mutation.RemovedWishlistItemsIDs(); len(nodes) > 0 && !puo.mutation.WishlistItemsCleared() {
+	if nodes := puo.mutation.RemovedWishlistsIDs(); len(nodes) > 0 && !puo.mutation.WishlistsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   product.WishlistItemsTable,
-			Columns: []string{product.WishlistItemsColumn},
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.WishlistsTable,
+			Columns: product.WishlistsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(wishlist.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &WishlistItemCreate{config: puo.config, mutation: newWishlistItemMutation(puo.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := puo.mutation.WishlistItemsIDs(); len(nodes) > 0 {
+	if nodes := puo.mutation.WishlistsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   product.WishlistItemsTable,
-			Columns: []string{product.WishlistItemsColumn},
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.WishlistsTable,
+			Columns: product.WishlistsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(wishlist.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &WishlistItemCreate{config: puo.config, mutation: newWishlistItemMutation(puo.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	_node = &Product{config: puo.config}
@@ -3,8 +3,10 @@
 package gen
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -17,15 +19,26 @@ type ProductImage struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uint64 `json:"id,omitempty"`
+	// ProductID holds the value of the "product_id" field.
+	ProductID uint64 `json:"product_id,omitempty"`
 	// ImageURL holds the value of the "image_url" field.
 	ImageURL string `json:"image_url,omitempty"`
 	// IsPrimary holds the value of the "is_primary" field.
 	IsPrimary bool `json:"is_primary,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
+	// StorageKey holds the value of the "storage_key" field.
+	StorageKey string `json:"storage_key,omitempty"`
+	// ContentType holds the value of the "content_type" field.
+	ContentType string `json:"content_type,omitempty"`
+	// Thumbnails holds the value of the "thumbnails" field.
+	Thumbnails map[string]string `json:"thumbnails,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ProductImageQuery when eager-loading is set.
-	Edges          ProductImageEdges `json:"edges"`
-	product_images *uint64
-	selectValues   sql.SelectValues
+	Edges        ProductImageEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // ProductImageEdges holds the relations/edges for other nodes in the graph.
@@ -53,14 +66,16 @@ func (*ProductImage) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case productimage.FieldThumbnails:
+			values[i] = new([]byte)
 		case productimage.FieldIsPrimary:
 			values[i] = new(sql.NullBool)
-		case productimage.FieldID:
+		case productimage.FieldID, productimage.FieldProductID, productimage.FieldPosition:
 			values[i] = new(sql.NullInt64)
-		case productimage.FieldImageURL:
+		case productimage.FieldImageURL, productimage.FieldStorageKey, productimage.FieldContentType:
 			values[i] = new(sql.NullString)
-		case productimage.ForeignKeys[0]: // product_images
-			values[i] = new(sql.NullInt64)
+		case productimage.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -82,6 +97,12 @@ func (pi *ProductImage) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			pi.ID = uint64(value.Int64)
+		case productimage.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				pi.ProductID = uint64(value.Int64)
+			}
 		case productimage.FieldImageURL:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field image_url", values[i])
@@ -94,12 +115,37 @@ func (pi *ProductImage) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pi.IsPrimary = value.Bool
 			}
-		case productimage.ForeignKeys[0]:
+		case productimage.FieldPosition:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field product_images", value)
+				return fmt.Errorf("unexpected type %T for field position", values[i])
 			} else if value.Valid {
-				pi.product_images = new(uint64)
-				*pi.product_images = uint64(value.Int64)
+				pi.Position = int(value.Int64)
+			}
+		case productimage.FieldStorageKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_key", values[i])
+			} else if value.Valid {
+				pi.StorageKey = value.String
+			}
+		case productimage.FieldContentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_type", values[i])
+			} else if value.Valid {
+				pi.ContentType = value.String
+			}
+		case productimage.FieldThumbnails:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field thumbnails", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pi.Thumbnails); err != nil {
+					return fmt.Errorf("unmarshal field thumbnails: %w", err)
+				}
+			}
+		case productimage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pi.CreatedAt = value.Time
 			}
 		default:
 			pi.selectValues.Set(columns[i], values[i])
@@ -142,11 +188,29 @@ func (pi *ProductImage) String() string {
 	var builder strings.Builder
 	builder.WriteString("ProductImage(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", pi.ID))
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", pi.ProductID))
+	builder.WriteString(", ")
 	builder.WriteString("image_url=")
 	builder.WriteString(pi.ImageURL)
 	builder.WriteString(", ")
 	builder.WriteString("is_primary=")
 	builder.WriteString(fmt.Sprintf("%v", pi.IsPrimary))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", pi.Position))
+	builder.WriteString(", ")
+	builder.WriteString("storage_key=")
+	builder.WriteString(pi.StorageKey)
+	builder.WriteString(", ")
+	builder.WriteString("content_type=")
+	builder.WriteString(pi.ContentType)
+	builder.WriteString(", ")
+	builder.WriteString("thumbnails=")
+	builder.WriteString(fmt.Sprintf("%v", pi.Thumbnails))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pi.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -20,6 +21,20 @@ type ProductImageCreate struct {
 	hooks    []Hook
 }
 
+// SetProductID sets the "product_id" field.
+func (pic *ProductImageCreate) SetProductID(u uint64) *ProductImageCreate {
+	pic.mutation.SetProductID(u)
+	return pic
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (pic *ProductImageCreate) SetNillableProductID(u *uint64) *ProductImageCreate {
+	if u != nil {
+		pic.SetProductID(*u)
+	}
+	return pic
+}
+
 // SetImageURL sets the "image_url" field.
 func (pic *ProductImageCreate) SetImageURL(s string) *ProductImageCreate {
 	pic.mutation.SetImageURL(s)
@@ -40,26 +55,74 @@ func (pic *ProductImageCreate) SetNillableIsPrimary(b *bool) *ProductImageCreate
 	return pic
 }
 
-// SetID sets the "id" field.
-func (pic *ProductImageCreate) SetID(u uint64) *ProductImageCreate {
-	pic.mutation.SetID(u)
+// SetPosition sets the "position" field.
+func (pic *ProductImageCreate) SetPosition(i int) *ProductImageCreate {
+	pic.mutation.SetPosition(i)
+	return pic
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (pic *ProductImageCreate) SetNillablePosition(i *int) *ProductImageCreate {
+	if i != nil {
+		pic.SetPosition(*i)
+	}
+	return pic
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (pic *ProductImageCreate) SetStorageKey(s string) *ProductImageCreate {
+	pic.mutation.SetStorageKey(s)
+	return pic
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (pic *ProductImageCreate) SetNillableStorageKey(s *string) *ProductImageCreate {
+	if s != nil {
+		pic.SetStorageKey(*s)
+	}
+	return pic
+}
+
+// SetContentType sets the "content_type" field.
+func (pic *ProductImageCreate) SetContentType(s string) *ProductImageCreate {
+	pic.mutation.SetContentType(s)
+	return pic
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (pic *ProductImageCreate) SetNillableContentType(s *string) *ProductImageCreate {
+	if s != nil {
+		pic.SetContentType(*s)
+	}
+	return pic
+}
+
+// SetThumbnails sets the "thumbnails" field.
+func (pic *ProductImageCreate) SetThumbnails(m map[string]string) *ProductImageCreate {
+	pic.mutation.SetThumbnails(m)
 	return pic
 }
 
-// SetProductID sets the "product" edge to the Product entity by ID.
-func (pic *ProductImageCreate) SetProductID(id uint64) *ProductImageCreate {
-	pic.mutation.SetProductID(id)
+// SetCreatedAt sets the "created_at" field.
+func (pic *ProductImageCreate) SetCreatedAt(t time.Time) *ProductImageCreate {
+	pic.mutation.SetCreatedAt(t)
 	return pic
 }
 
-// SetNillableProductID sets the "product" edge to the Product entity by ID if the given value is not nil.
-func (pic *ProductImageCreate) SetNillableProductID(id *uint64) *ProductImageCreate {
-	if id != nil {
-		pic = pic.SetProductID(*id)
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pic *ProductImageCreate) SetNillableCreatedAt(t *time.Time) *ProductImageCreate {
+	if t != nil {
+		pic.SetCreatedAt(*t)
 	}
 	return pic
 }
 
+// SetID sets the "id" field.
+func (pic *ProductImageCreate) SetID(u uint64) *ProductImageCreate {
+	pic.mutation.SetID(u)
+	return pic
+}
+
 // SetProduct sets the "product" edge to the Product entity.
 func (pic *ProductImageCreate) SetProduct(p *Product) *ProductImageCreate {
 	return pic.SetProductID(p.ID)
@@ -104,6 +167,14 @@ func (pic *ProductImageCreate) defaults() {
 		v := productimage.DefaultIsPrimary
 		pic.mutation.SetIsPrimary(v)
 	}
+	if _, ok := pic.mutation.Position(); !ok {
+		v := productimage.DefaultPosition
+		pic.mutation.SetPosition(v)
+	}
+	if _, ok := pic.mutation.CreatedAt(); !ok {
+		v := productimage.DefaultCreatedAt()
+		pic.mutation.SetCreatedAt(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -114,6 +185,12 @@ func (pic *ProductImageCreate) check() error {
 	if _, ok := pic.mutation.IsPrimary(); !ok {
 		return &ValidationError{Name: "is_primary", err: errors.New(`gen: missing required field "ProductImage.is_primary"`)}
 	}
+	if _, ok := pic.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`gen: missing required field "ProductImage.position"`)}
+	}
+	if _, ok := pic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`gen: missing required field "ProductImage.created_at"`)}
+	}
 	return nil
 }
 
@@ -154,6 +231,26 @@ func (pic *ProductImageCreate) createSpec() (*ProductImage, *sqlgraph.CreateSpec
 		_spec.SetField(productimage.FieldIsPrimary, field.TypeBool, value)
 		_node.IsPrimary = value
 	}
+	if value, ok := pic.mutation.Position(); ok {
+		_spec.SetField(productimage.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := pic.mutation.StorageKey(); ok {
+		_spec.SetField(productimage.FieldStorageKey, field.TypeString, value)
+		_node.StorageKey = value
+	}
+	if value, ok := pic.mutation.ContentType(); ok {
+		_spec.SetField(productimage.FieldContentType, field.TypeString, value)
+		_node.ContentType = value
+	}
+	if value, ok := pic.mutation.Thumbnails(); ok {
+		_spec.SetField(productimage.FieldThumbnails, field.TypeJSON, value)
+		_node.Thumbnails = value
+	}
+	if value, ok := pic.mutation.CreatedAt(); ok {
+		_spec.SetField(productimage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
 	if nodes := pic.mutation.ProductIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -168,7 +265,7 @@ func (pic *ProductImageCreate) createSpec() (*ProductImage, *sqlgraph.CreateSpec
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.product_images = &nodes[0]
+		_node.ProductID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
@@ -8,6 +8,7 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -24,7 +25,7 @@ type ProductImageQuery struct {
 	inters      []Interceptor
 	predicates  []predicate.ProductImage
 	withProduct *ProductQuery
-	withFKs     bool
+	modifiers   []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -299,12 +300,12 @@ func (piq *ProductImageQuery) WithProduct(opts ...func(*ProductQuery)) *ProductI
 // Example:
 //
 //	var v []struct {
-//		ImageURL string `json:"image_url,omitempty"`
+//		ProductID uint64 `json:"product_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.ProductImage.Query().
-//		GroupBy(productimage.FieldImageURL).
+//		GroupBy(productimage.FieldProductID).
 //		Aggregate(gen.Count()).
 //		Scan(ctx, &v)
 func (piq *ProductImageQuery) GroupBy(field string, fields ...string) *ProductImageGroupBy {
@@ -322,11 +323,11 @@ func (piq *ProductImageQuery) GroupBy(field string, fields ...string) *ProductIm
 // Example:
 //
 //	var v []struct {
-//		ImageURL string `json:"image_url,omitempty"`
+//		ProductID uint64 `json:"product_id,omitempty"`
 //	}
 //
 //	client.ProductImage.Query().
-//		Select(productimage.FieldImageURL).
+//		Select(productimage.FieldProductID).
 //		Scan(ctx, &v)
 func (piq *ProductImageQuery) Select(fields ...string) *ProductImageSelect {
 	piq.ctx.Fields = append(piq.ctx.Fields, fields...)
@@ -370,18 +371,11 @@ func (piq *ProductImageQuery) prepareQuery(ctx context.Context) error {
 func (piq *ProductImageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProductImage, error) {
 	var (
 		nodes       = []*ProductImage{}
-		withFKs     = piq.withFKs
 		_spec       = piq.querySpec()
 		loadedTypes = [1]bool{
 			piq.withProduct != nil,
 		}
 	)
-	if piq.withProduct != nil {
-		withFKs = true
-	}
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, productimage.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*ProductImage).scanValues(nil, columns)
 	}
@@ -391,6 +385,9 @@ func (piq *ProductImageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(piq.modifiers) > 0 {
+		_spec.Modifiers = piq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -413,10 +410,7 @@ func (piq *ProductImageQuery) loadProduct(ctx context.Context, query *ProductQue
 	ids := make([]uint64, 0, len(nodes))
 	nodeids := make(map[uint64][]*ProductImage)
 	for i := range nodes {
-		if nodes[i].product_images == nil {
-			continue
-		}
-		fk := *nodes[i].product_images
+		fk := nodes[i].ProductID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -433,7 +427,7 @@ func (piq *ProductImageQuery) loadProduct(ctx context.Context, query *ProductQue
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "product_images" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "product_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -444,6 +438,9 @@ func (piq *ProductImageQuery) loadProduct(ctx context.Context, query *ProductQue
 
 func (piq *ProductImageQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := piq.querySpec()
+	if len(piq.modifiers) > 0 {
+		_spec.Modifiers = piq.modifiers
+	}
 	_spec.Node.Columns = piq.ctx.Fields
 	if len(piq.ctx.Fields) > 0 {
 		_spec.Unique = piq.ctx.Unique != nil && *piq.ctx.Unique
@@ -467,6 +464,9 @@ func (piq *ProductImageQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if piq.withProduct != nil {
+			_spec.Node.AddColumnOnce(productimage.FieldProductID)
+		}
 	}
 	if ps := piq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -506,6 +506,9 @@ func (piq *ProductImageQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if piq.ctx.Unique != nil && *piq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range piq.modifiers {
+		m(selector)
+	}
 	for _, p := range piq.predicates {
 		p(selector)
 	}
@@ -523,6 +526,32 @@ func (piq *ProductImageQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (piq *ProductImageQuery) ForUpdate(opts ...sql.LockOption) *ProductImageQuery {
+	if piq.driver.Dialect() == dialect.Postgres {
+		piq.Unique(false)
+	}
+	piq.modifiers = append(piq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return piq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (piq *ProductImageQuery) ForShare(opts ...sql.LockOption) *ProductImageQuery {
+	if piq.driver.Dialect() == dialect.Postgres {
+		piq.Unique(false)
+	}
+	piq.modifiers = append(piq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return piq
+}
+
 // ProductImageGroupBy is the group-by builder for ProductImage entities.
 type ProductImageGroupBy struct {
 	selector
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -28,6 +29,26 @@ func (piu *ProductImageUpdate) Where(ps ...predicate.ProductImage) *ProductImage
 	return piu
 }
 
+// SetProductID sets the "product_id" field.
+func (piu *ProductImageUpdate) SetProductID(u uint64) *ProductImageUpdate {
+	piu.mutation.SetProductID(u)
+	return piu
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (piu *ProductImageUpdate) SetNillableProductID(u *uint64) *ProductImageUpdate {
+	if u != nil {
+		piu.SetProductID(*u)
+	}
+	return piu
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (piu *ProductImageUpdate) ClearProductID() *ProductImageUpdate {
+	piu.mutation.ClearProductID()
+	return piu
+}
+
 // SetImageURL sets the "image_url" field.
 func (piu *ProductImageUpdate) SetImageURL(s string) *ProductImageUpdate {
 	piu.mutation.SetImageURL(s)
@@ -56,16 +77,89 @@ func (piu *ProductImageUpdate) SetNillableIsPrimary(b *bool) *ProductImageUpdate
 	return piu
 }
 
-// SetProductID sets the "product" edge to the Product entity by ID.
-func (piu *ProductImageUpdate) SetProductID(id uint64) *ProductImageUpdate {
-	piu.mutation.SetProductID(id)
+// SetPosition sets the "position" field.
+func (piu *ProductImageUpdate) SetPosition(i int) *ProductImageUpdate {
+	piu.mutation.ResetPosition()
+	piu.mutation.SetPosition(i)
 	return piu
 }
 
-// SetNillableProductID sets the "product" edge to the Product entity by ID if the given value is not nil.
-func (piu *ProductImageUpdate) SetNillableProductID(id *uint64) *ProductImageUpdate {
-	if id != nil {
-		piu = piu.SetProductID(*id)
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (piu *ProductImageUpdate) SetNillablePosition(i *int) *ProductImageUpdate {
+	if i != nil {
+		piu.SetPosition(*i)
+	}
+	return piu
+}
+
+// AddPosition adds i to the "position" field.
+func (piu *ProductImageUpdate) AddPosition(i int) *ProductImageUpdate {
+	piu.mutation.AddPosition(i)
+	return piu
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (piu *ProductImageUpdate) SetStorageKey(s string) *ProductImageUpdate {
+	piu.mutation.SetStorageKey(s)
+	return piu
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (piu *ProductImageUpdate) SetNillableStorageKey(s *string) *ProductImageUpdate {
+	if s != nil {
+		piu.SetStorageKey(*s)
+	}
+	return piu
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (piu *ProductImageUpdate) ClearStorageKey() *ProductImageUpdate {
+	piu.mutation.ClearStorageKey()
+	return piu
+}
+
+// SetContentType sets the "content_type" field.
+func (piu *ProductImageUpdate) SetContentType(s string) *ProductImageUpdate {
+	piu.mutation.SetContentType(s)
+	return piu
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (piu *ProductImageUpdate) SetNillableContentType(s *string) *ProductImageUpdate {
+	if s != nil {
+		piu.SetContentType(*s)
+	}
+	return piu
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (piu *ProductImageUpdate) ClearContentType() *ProductImageUpdate {
+	piu.mutation.ClearContentType()
+	return piu
+}
+
+// SetThumbnails sets the "thumbnails" field.
+func (piu *ProductImageUpdate) SetThumbnails(m map[string]string) *ProductImageUpdate {
+	piu.mutation.SetThumbnails(m)
+	return piu
+}
+
+// ClearThumbnails clears the value of the "thumbnails" field.
+func (piu *ProductImageUpdate) ClearThumbnails() *ProductImageUpdate {
+	piu.mutation.ClearThumbnails()
+	return piu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (piu *ProductImageUpdate) SetCreatedAt(t time.Time) *ProductImageUpdate {
+	piu.mutation.SetCreatedAt(t)
+	return piu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (piu *ProductImageUpdate) SetNillableCreatedAt(t *time.Time) *ProductImageUpdate {
+	if t != nil {
+		piu.SetCreatedAt(*t)
 	}
 	return piu
 }
@@ -128,6 +222,33 @@ func (piu *ProductImageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := piu.mutation.IsPrimary(); ok {
 		_spec.SetField(productimage.FieldIsPrimary, field.TypeBool, value)
 	}
+	if value, ok := piu.mutation.Position(); ok {
+		_spec.SetField(productimage.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := piu.mutation.AddedPosition(); ok {
+		_spec.AddField(productimage.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := piu.mutation.StorageKey(); ok {
+		_spec.SetField(productimage.FieldStorageKey, field.TypeString, value)
+	}
+	if piu.mutation.StorageKeyCleared() {
+		_spec.ClearField(productimage.FieldStorageKey, field.TypeString)
+	}
+	if value, ok := piu.mutation.ContentType(); ok {
+		_spec.SetField(productimage.FieldContentType, field.TypeString, value)
+	}
+	if piu.mutation.ContentTypeCleared() {
+		_spec.ClearField(productimage.FieldContentType, field.TypeString)
+	}
+	if value, ok := piu.mutation.Thumbnails(); ok {
+		_spec.SetField(productimage.FieldThumbnails, field.TypeJSON, value)
+	}
+	if piu.mutation.ThumbnailsCleared() {
+		_spec.ClearField(productimage.FieldThumbnails, field.TypeJSON)
+	}
+	if value, ok := piu.mutation.CreatedAt(); ok {
+		_spec.SetField(productimage.FieldCreatedAt, field.TypeTime, value)
+	}
 	if piu.mutation.ProductCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -177,6 +298,26 @@ type ProductImageUpdateOne struct {
 	mutation *ProductImageMutation
 }
 
+// SetProductID sets the "product_id" field.
+func (piuo *ProductImageUpdateOne) SetProductID(u uint64) *ProductImageUpdateOne {
+	piuo.mutation.SetProductID(u)
+	return piuo
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (piuo *ProductImageUpdateOne) SetNillableProductID(u *uint64) *ProductImageUpdateOne {
+	if u != nil {
+		piuo.SetProductID(*u)
+	}
+	return piuo
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (piuo *ProductImageUpdateOne) ClearProductID() *ProductImageUpdateOne {
+	piuo.mutation.ClearProductID()
+	return piuo
+}
+
 // SetImageURL sets the "image_url" field.
 func (piuo *ProductImageUpdateOne) SetImageURL(s string) *ProductImageUpdateOne {
 	piuo.mutation.SetImageURL(s)
@@ -205,16 +346,89 @@ func (piuo *ProductImageUpdateOne) SetNillableIsPrimary(b *bool) *ProductImageUp
 	return piuo
 }
 
-// SetProductID sets the "product" edge to the Product entity by ID.
-func (piuo *ProductImageUpdateOne) SetProductID(id uint64) *ProductImageUpdateOne {
-	piuo.mutation.SetProductID(id)
+// SetPosition sets the "position" field.
+func (piuo *ProductImageUpdateOne) SetPosition(i int) *ProductImageUpdateOne {
+	piuo.mutation.ResetPosition()
+	piuo.mutation.SetPosition(i)
 	return piuo
 }
 
-// SetNillableProductID sets the "product" edge to the Product entity by ID if the given value is not nil.
-func (piuo *ProductImageUpdateOne) SetNillableProductID(id *uint64) *ProductImageUpdateOne {
-	if id != nil {
-		piuo = piuo.SetProductID(*id)
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (piuo *ProductImageUpdateOne) SetNillablePosition(i *int) *ProductImageUpdateOne {
+	if i != nil {
+		piuo.SetPosition(*i)
+	}
+	return piuo
+}
+
+// AddPosition adds i to the "position" field.
+func (piuo *ProductImageUpdateOne) AddPosition(i int) *ProductImageUpdateOne {
+	piuo.mutation.AddPosition(i)
+	return piuo
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (piuo *ProductImageUpdateOne) SetStorageKey(s string) *ProductImageUpdateOne {
+	piuo.mutation.SetStorageKey(s)
+	return piuo
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (piuo *ProductImageUpdateOne) SetNillableStorageKey(s *string) *ProductImageUpdateOne {
+	if s != nil {
+		piuo.SetStorageKey(*s)
+	}
+	return piuo
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (piuo *ProductImageUpdateOne) ClearStorageKey() *ProductImageUpdateOne {
+	piuo.mutation.ClearStorageKey()
+	return piuo
+}
+
+// SetContentType sets the "content_type" field.
+func (piuo *ProductImageUpdateOne) SetContentType(s string) *ProductImageUpdateOne {
+	piuo.mutation.SetContentType(s)
+	return piuo
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (piuo *ProductImageUpdateOne) SetNillableContentType(s *string) *ProductImageUpdateOne {
+	if s != nil {
+		piuo.SetContentType(*s)
+	}
+	return piuo
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (piuo *ProductImageUpdateOne) ClearContentType() *ProductImageUpdateOne {
+	piuo.mutation.ClearContentType()
+	return piuo
+}
+
+// SetThumbnails sets the "thumbnails" field.
+func (piuo *ProductImageUpdateOne) SetThumbnails(m map[string]string) *ProductImageUpdateOne {
+	piuo.mutation.SetThumbnails(m)
+	return piuo
+}
+
+// ClearThumbnails clears the value of the "thumbnails" field.
+func (piuo *ProductImageUpdateOne) ClearThumbnails() *ProductImageUpdateOne {
+	piuo.mutation.ClearThumbnails()
+	return piuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (piuo *ProductImageUpdateOne) SetCreatedAt(t time.Time) *ProductImageUpdateOne {
+	piuo.mutation.SetCreatedAt(t)
+	return piuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (piuo *ProductImageUpdateOne) SetNillableCreatedAt(t *time.Time) *ProductImageUpdateOne {
+	if t != nil {
+		piuo.SetCreatedAt(*t)
 	}
 	return piuo
 }
@@ -307,6 +521,33 @@ func (piuo *ProductImageUpdateOne) sqlSave(ctx context.Context) (_node *ProductI
 	if value, ok := piuo.mutation.IsPrimary(); ok {
 		_spec.SetField(productimage.FieldIsPrimary, field.TypeBool, value)
 	}
+	if value, ok := piuo.mutation.Position(); ok {
+		_spec.SetField(productimage.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := piuo.mutation.AddedPosition(); ok {
+		_spec.AddField(productimage.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := piuo.mutation.StorageKey(); ok {
+		_spec.SetField(productimage.FieldStorageKey, field.TypeString, value)
+	}
+	if piuo.mutation.StorageKeyCleared() {
+		_spec.ClearField(productimage.FieldStorageKey, field.TypeString)
+	}
+	if value, ok := piuo.mutation.ContentType(); ok {
+		_spec.SetField(productimage.FieldContentType, field.TypeString, value)
+	}
+	if piuo.mutation.ContentTypeCleared() {
+		_spec.ClearField(productimage.FieldContentType, field.TypeString)
+	}
+	if value, ok := piuo.mutation.Thumbnails(); ok {
+		_spec.SetField(productimage.FieldThumbnails, field.TypeJSON, value)
+	}
+	if piuo.mutation.ThumbnailsCleared() {
+		_spec.ClearField(productimage.FieldThumbnails, field.TypeJSON)
+	}
+	if value, ok := piuo.mutation.CreatedAt(); ok {
+		_spec.SetField(productimage.FieldCreatedAt, field.TypeTime, value)
+	}
 	if piuo.mutation.ProductCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
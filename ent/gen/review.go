@@ -19,6 +19,10 @@ type Review struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uint64 `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uint64 `json:"user_id,omitempty"`
+	// ProductID holds the value of the "product_id" field.
+	ProductID uint64 `json:"product_id,omitempty"`
 	// Rating holds the value of the "rating" field.
 	Rating int `json:"rating,omitempty"`
 	// Comment holds the value of the "comment" field.
@@ -29,10 +33,8 @@ type Review struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ReviewQuery when eager-loading is set.
-	Edges           ReviewEdges `json:"edges"`
-	product_reviews *uint64
-	user_reviews    *uint64
-	selectValues    sql.SelectValues
+	Edges        ReviewEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // ReviewEdges holds the relations/edges for other nodes in the graph.
@@ -73,16 +75,12 @@ func (*Review) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case review.FieldID, review.FieldRating:
+		case review.FieldID, review.FieldUserID, review.FieldProductID, review.FieldRating:
 			values[i] = new(sql.NullInt64)
 		case review.FieldComment:
 			values[i] = new(sql.NullString)
 		case review.FieldCreatedAt, review.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
-		case review.ForeignKeys[0]: // product_reviews
-			values[i] = new(sql.NullInt64)
-		case review.ForeignKeys[1]: // user_reviews
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -104,6 +102,18 @@ func (r *Review) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			r.ID = uint64(value.Int64)
+		case review.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				r.UserID = uint64(value.Int64)
+			}
+		case review.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				r.ProductID = uint64(value.Int64)
+			}
 		case review.FieldRating:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field rating", values[i])
@@ -128,20 +138,6 @@ func (r *Review) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				r.UpdatedAt = value.Time
 			}
-		case review.ForeignKeys[0]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field product_reviews", value)
-			} else if value.Valid {
-				r.product_reviews = new(uint64)
-				*r.product_reviews = uint64(value.Int64)
-			}
-		case review.ForeignKeys[1]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_reviews", value)
-			} else if value.Valid {
-				r.user_reviews = new(uint64)
-				*r.user_reviews = uint64(value.Int64)
-			}
 		default:
 			r.selectValues.Set(columns[i], values[i])
 		}
@@ -188,6 +184,12 @@ func (r *Review) String() string {
 	var builder strings.Builder
 	builder.WriteString("Review(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.ProductID))
+	builder.WriteString(", ")
 	builder.WriteString("rating=")
 	builder.WriteString(fmt.Sprintf("%v", r.Rating))
 	builder.WriteString(", ")
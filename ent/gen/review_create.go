@@ -22,6 +22,34 @@ type ReviewCreate struct {
 	hooks    []Hook
 }
 
+// SetUserID sets the "user_id" field.
+func (rc *ReviewCreate) SetUserID(u uint64) *ReviewCreate {
+	rc.mutation.SetUserID(u)
+	return rc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rc *ReviewCreate) SetNillableUserID(u *uint64) *ReviewCreate {
+	if u != nil {
+		rc.SetUserID(*u)
+	}
+	return rc
+}
+
+// SetProductID sets the "product_id" field.
+func (rc *ReviewCreate) SetProductID(u uint64) *ReviewCreate {
+	rc.mutation.SetProductID(u)
+	return rc
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (rc *ReviewCreate) SetNillableProductID(u *uint64) *ReviewCreate {
+	if u != nil {
+		rc.SetProductID(*u)
+	}
+	return rc
+}
+
 // SetRating sets the "rating" field.
 func (rc *ReviewCreate) SetRating(i int) *ReviewCreate {
 	rc.mutation.SetRating(i)
@@ -76,39 +104,11 @@ func (rc *ReviewCreate) SetID(u uint64) *ReviewCreate {
 	return rc
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (rc *ReviewCreate) SetUserID(id uint64) *ReviewCreate {
-	rc.mutation.SetUserID(id)
-	return rc
-}
-
-// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
-func (rc *ReviewCreate) SetNillableUserID(id *uint64) *ReviewCreate {
-	if id != nil {
-		rc = rc.SetUserID(*id)
-	}
-	return rc
-}
-
 // SetUser sets the "user" edge to the User entity.
 func (rc *ReviewCreate) SetUser(u *User) *ReviewCreate {
 	return rc.SetUserID(u.ID)
 }
 
-// SetProductID sets the "product" edge to the Product entity by ID.
-func (rc *ReviewCreate) SetProductID(id uint64) *ReviewCreate {
-	rc.mutation.SetProductID(id)
-	return rc
-}
-
-// SetNillableProductID sets the "product" edge to the Product entity by ID if the given value is not nil.
-func (rc *ReviewCreate) SetNillableProductID(id *uint64) *ReviewCreate {
-	if id != nil {
-		rc = rc.SetProductID(*id)
-	}
-	return rc
-}
-
 // SetProduct sets the "product" edge to the Product entity.
 func (rc *ReviewCreate) SetProduct(p *Product) *ReviewCreate {
 	return rc.SetProductID(p.ID)
@@ -164,6 +164,11 @@ func (rc *ReviewCreate) check() error {
 	if _, ok := rc.mutation.Rating(); !ok {
 		return &ValidationError{Name: "rating", err: errors.New(`gen: missing required field "Review.rating"`)}
 	}
+	if v, ok := rc.mutation.Rating(); ok {
+		if err := review.RatingValidator(v); err != nil {
+			return &ValidationError{Name: "rating", err: fmt.Errorf(`gen: validator failed for field "Review.rating": %w`, err)}
+		}
+	}
 	if _, ok := rc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`gen: missing required field "Review.created_at"`)}
 	}
@@ -232,7 +237,7 @@ func (rc *ReviewCreate) createSpec() (*Review, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.user_reviews = &nodes[0]
+		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := rc.mutation.ProductIDs(); len(nodes) > 0 {
@@ -249,7 +254,7 @@ func (rc *ReviewCreate) createSpec() (*Review, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.product_reviews = &nodes[0]
+		_node.ProductID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
@@ -8,6 +8,7 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -26,7 +27,7 @@ type ReviewQuery struct {
 	predicates  []predicate.Review
 	withUser    *UserQuery
 	withProduct *ProductQuery
-	withFKs     bool
+	modifiers   []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -335,12 +336,12 @@ func (rq *ReviewQuery) WithProduct(opts ...func(*ProductQuery)) *ReviewQuery {
 // Example:
 //
 //	var v []struct {
-//		Rating int `json:"rating,omitempty"`
+//		UserID uint64 `json:"user_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Review.Query().
-//		GroupBy(review.FieldRating).
+//		GroupBy(review.FieldUserID).
 //		Aggregate(gen.Count()).
 //		Scan(ctx, &v)
 func (rq *ReviewQuery) GroupBy(field string, fields ...string) *ReviewGroupBy {
@@ -358,11 +359,11 @@ func (rq *ReviewQuery) GroupBy(field string, fields ...string) *ReviewGroupBy {
 // Example:
 //
 //	var v []struct {
-//		Rating int `json:"rating,omitempty"`
+//		UserID uint64 `json:"user_id,omitempty"`
 //	}
 //
 //	client.Review.Query().
-//		Select(review.FieldRating).
+//		Select(review.FieldUserID).
 //		Scan(ctx, &v)
 func (rq *ReviewQuery) Select(fields ...string) *ReviewSelect {
 	rq.ctx.Fields = append(rq.ctx.Fields, fields...)
@@ -406,19 +407,12 @@ func (rq *ReviewQuery) prepareQuery(ctx context.Context) error {
 func (rq *ReviewQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Review, error) {
 	var (
 		nodes       = []*Review{}
-		withFKs     = rq.withFKs
 		_spec       = rq.querySpec()
 		loadedTypes = [2]bool{
 			rq.withUser != nil,
 			rq.withProduct != nil,
 		}
 	)
-	if rq.withUser != nil || rq.withProduct != nil {
-		withFKs = true
-	}
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, review.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Review).scanValues(nil, columns)
 	}
@@ -428,6 +422,9 @@ func (rq *ReviewQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Revie
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(rq.modifiers) > 0 {
+		_spec.Modifiers = rq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -456,10 +453,7 @@ func (rq *ReviewQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*
 	ids := make([]uint64, 0, len(nodes))
 	nodeids := make(map[uint64][]*Review)
 	for i := range nodes {
-		if nodes[i].user_reviews == nil {
-			continue
-		}
-		fk := *nodes[i].user_reviews
+		fk := nodes[i].UserID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -476,7 +470,7 @@ func (rq *ReviewQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "user_reviews" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -488,10 +482,7 @@ func (rq *ReviewQuery) loadProduct(ctx context.Context, query *ProductQuery, nod
 	ids := make([]uint64, 0, len(nodes))
 	nodeids := make(map[uint64][]*Review)
 	for i := range nodes {
-		if nodes[i].product_reviews == nil {
-			continue
-		}
-		fk := *nodes[i].product_reviews
+		fk := nodes[i].ProductID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -508,7 +499,7 @@ func (rq *ReviewQuery) loadProduct(ctx context.Context, query *ProductQuery, nod
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "product_reviews" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "product_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -519,6 +510,9 @@ func (rq *ReviewQuery) loadProduct(ctx context.Context, query *ProductQuery, nod
 
 func (rq *ReviewQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := rq.querySpec()
+	if len(rq.modifiers) > 0 {
+		_spec.Modifiers = rq.modifiers
+	}
 	_spec.Node.Columns = rq.ctx.Fields
 	if len(rq.ctx.Fields) > 0 {
 		_spec.Unique = rq.ctx.Unique != nil && *rq.ctx.Unique
@@ -542,6 +536,12 @@ func (rq *ReviewQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if rq.withUser != nil {
+			_spec.Node.AddColumnOnce(review.FieldUserID)
+		}
+		if rq.withProduct != nil {
+			_spec.Node.AddColumnOnce(review.FieldProductID)
+		}
 	}
 	if ps := rq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -581,6 +581,9 @@ func (rq *ReviewQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if rq.ctx.Unique != nil && *rq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range rq.modifiers {
+		m(selector)
+	}
 	for _, p := range rq.predicates {
 		p(selector)
 	}
@@ -598,6 +601,32 @@ func (rq *ReviewQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (rq *ReviewQuery) ForUpdate(opts ...sql.LockOption) *ReviewQuery {
+	if rq.driver.Dialect() == dialect.Postgres {
+		rq.Unique(false)
+	}
+	rq.modifiers = append(rq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return rq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (rq *ReviewQuery) ForShare(opts ...sql.LockOption) *ReviewQuery {
+	if rq.driver.Dialect() == dialect.Postgres {
+		rq.Unique(false)
+	}
+	rq.modifiers = append(rq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return rq
+}
+
 // ReviewGroupBy is the group-by builder for Review entities.
 type ReviewGroupBy struct {
 	selector
@@ -30,6 +30,46 @@ func (ru *ReviewUpdate) Where(ps ...predicate.Review) *ReviewUpdate {
 	return ru
 }
 
+// SetUserID sets the "user_id" field.
+func (ru *ReviewUpdate) SetUserID(u uint64) *ReviewUpdate {
+	ru.mutation.SetUserID(u)
+	return ru
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ru *ReviewUpdate) SetNillableUserID(u *uint64) *ReviewUpdate {
+	if u != nil {
+		ru.SetUserID(*u)
+	}
+	return ru
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (ru *ReviewUpdate) ClearUserID() *ReviewUpdate {
+	ru.mutation.ClearUserID()
+	return ru
+}
+
+// SetProductID sets the "product_id" field.
+func (ru *ReviewUpdate) SetProductID(u uint64) *ReviewUpdate {
+	ru.mutation.SetProductID(u)
+	return ru
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (ru *ReviewUpdate) SetNillableProductID(u *uint64) *ReviewUpdate {
+	if u != nil {
+		ru.SetProductID(*u)
+	}
+	return ru
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (ru *ReviewUpdate) ClearProductID() *ReviewUpdate {
+	ru.mutation.ClearProductID()
+	return ru
+}
+
 // SetRating sets the "rating" field.
 func (ru *ReviewUpdate) SetRating(i int) *ReviewUpdate {
 	ru.mutation.ResetRating()
@@ -91,39 +131,11 @@ func (ru *ReviewUpdate) SetUpdatedAt(t time.Time) *ReviewUpdate {
 	return ru
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (ru *ReviewUpdate) SetUserID(id uint64) *ReviewUpdate {
-	ru.mutation.SetUserID(id)
-	return ru
-}
-
-// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
-func (ru *ReviewUpdate) SetNillableUserID(id *uint64) *ReviewUpdate {
-	if id != nil {
-		ru = ru.SetUserID(*id)
-	}
-	return ru
-}
-
 // SetUser sets the "user" edge to the User entity.
 func (ru *ReviewUpdate) SetUser(u *User) *ReviewUpdate {
 	return ru.SetUserID(u.ID)
 }
 
-// SetProductID sets the "product" edge to the Product entity by ID.
-func (ru *ReviewUpdate) SetProductID(id uint64) *ReviewUpdate {
-	ru.mutation.SetProductID(id)
-	return ru
-}
-
-// SetNillableProductID sets the "product" edge to the Product entity by ID if the given value is not nil.
-func (ru *ReviewUpdate) SetNillableProductID(id *uint64) *ReviewUpdate {
-	if id != nil {
-		ru = ru.SetProductID(*id)
-	}
-	return ru
-}
-
 // SetProduct sets the "product" edge to the Product entity.
 func (ru *ReviewUpdate) SetProduct(p *Product) *ReviewUpdate {
 	return ru.SetProductID(p.ID)
@@ -182,7 +194,20 @@ func (ru *ReviewUpdate) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (ru *ReviewUpdate) check() error {
+	if v, ok := ru.mutation.Rating(); ok {
+		if err := review.RatingValidator(v); err != nil {
+			return &ValidationError{Name: "rating", err: fmt.Errorf(`gen: validator failed for field "Review.rating": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (ru *ReviewUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(review.Table, review.Columns, sqlgraph.NewFieldSpec(review.FieldID, field.TypeUint64))
 	if ps := ru.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -287,6 +312,46 @@ type ReviewUpdateOne struct {
 	mutation *ReviewMutation
 }
 
+// SetUserID sets the "user_id" field.
+func (ruo *ReviewUpdateOne) SetUserID(u uint64) *ReviewUpdateOne {
+	ruo.mutation.SetUserID(u)
+	return ruo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ruo *ReviewUpdateOne) SetNillableUserID(u *uint64) *ReviewUpdateOne {
+	if u != nil {
+		ruo.SetUserID(*u)
+	}
+	return ruo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (ruo *ReviewUpdateOne) ClearUserID() *ReviewUpdateOne {
+	ruo.mutation.ClearUserID()
+	return ruo
+}
+
+// SetProductID sets the "product_id" field.
+func (ruo *ReviewUpdateOne) SetProductID(u uint64) *ReviewUpdateOne {
+	ruo.mutation.SetProductID(u)
+	return ruo
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (ruo *ReviewUpdateOne) SetNillableProductID(u *uint64) *ReviewUpdateOne {
+	if u != nil {
+		ruo.SetProductID(*u)
+	}
+	return ruo
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (ruo *ReviewUpdateOne) ClearProductID() *ReviewUpdateOne {
+	ruo.mutation.ClearProductID()
+	return ruo
+}
+
 // SetRating sets the "rating" field.
 func (ruo *ReviewUpdateOne) SetRating(i int) *ReviewUpdateOne {
 	ruo.mutation.ResetRating()
@@ -348,39 +413,11 @@ func (ruo *ReviewUpdateOne) SetUpdatedAt(t time.Time) *ReviewUpdateOne {
 	return ruo
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (ruo *ReviewUpdateOne) SetUserID(id uint64) *ReviewUpdateOne {
-	ruo.mutation.SetUserID(id)
-	return ruo
-}
-
-// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
-func (ruo *ReviewUpdateOne) SetNillableUserID(id *uint64) *ReviewUpdateOne {
-	if id != nil {
-		ruo = ruo.SetUserID(*id)
-	}
-	return ruo
-}
-
 // SetUser sets the "user" edge to the User entity.
 func (ruo *ReviewUpdateOne) SetUser(u *User) *ReviewUpdateOne {
 	return ruo.SetUserID(u.ID)
 }
 
-// SetProductID sets the "product" edge to the Product entity by ID.
-func (ruo *ReviewUpdateOne) SetProductID(id uint64) *ReviewUpdateOne {
-	ruo.mutation.SetProductID(id)
-	return ruo
-}
-
-// SetNillableProductID sets the "product" edge to the Product entity by ID if the given value is not nil.
-func (ruo *ReviewUpdateOne) SetNillableProductID(id *uint64) *ReviewUpdateOne {
-	if id != nil {
-		ruo = ruo.SetProductID(*id)
-	}
-	return ruo
-}
-
 // SetProduct sets the "product" edge to the Product entity.
 func (ruo *ReviewUpdateOne) SetProduct(p *Product) *ReviewUpdateOne {
 	return ruo.SetProductID(p.ID)
@@ -452,7 +489,20 @@ func (ruo *ReviewUpdateOne) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (ruo *ReviewUpdateOne) check() error {
+	if v, ok := ruo.mutation.Rating(); ok {
+		if err := review.RatingValidator(v); err != nil {
+			return &ValidationError{Name: "rating", err: fmt.Errorf(`gen: validator failed for field "Review.rating": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (ruo *ReviewUpdateOne) sqlSave(ctx context.Context) (_node *Review, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(review.Table, review.Columns, sqlgraph.NewFieldSpec(review.FieldID, field.TypeUint64))
 	id, ok := ruo.mutation.ID()
 	if !ok {
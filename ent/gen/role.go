@@ -37,8 +37,8 @@ type Role struct {
 type RoleEdges struct {
 	// UserRoles holds the value of the user_roles edge.
 	UserRoles []*UserRole `json:"user_roles,omitempty"`
-	// Permissions holds the value of the permissions edge.
-	Permissions []*RolePermission `json:"permissions,omitempty"`
+	// RolePermissions holds the value of the role_permissions edge.
+	RolePermissions []*RolePermission `json:"role_permissions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [2]bool
@@ -53,13 +53,13 @@ func (e RoleEdges) UserRolesOrErr() ([]*UserRole, error) {
 	return nil, &NotLoadedError{edge: "user_roles"}
 }
 
-// PermissionsOrErr returns the Permissions value or an error if the edge
+// RolePermissionsOrErr returns the RolePermissions value or an error if the edge
 // was not loaded in eager-loading.
-func (e RoleEdges) PermissionsOrErr() ([]*RolePermission, error) {
+func (e RoleEdges) RolePermissionsOrErr() ([]*RolePermission, error) {
 	if e.loadedTypes[1] {
-		return e.Permissions, nil
+		return e.RolePermissions, nil
 	}
-	return nil, &NotLoadedError{edge: "permissions"}
+	return nil, &NotLoadedError{edge: "role_permissions"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -144,9 +144,9 @@ func (r *Role) QueryUserRoles() *UserRoleQuery {
 	return NewRoleClient(r.config).QueryUserRoles(r)
 }
 
-// QueryPermissions queries the "permissions" edge of the Role entity.
-func (r *Role) QueryPermissions() *RolePermissionQuery {
-	return NewRoleClient(r.config).QueryPermissions(r)
+// QueryRolePermissions queries the "role_permissions" edge of the Role entity.
+func (r *Role) QueryRolePermissions() *RolePermissionQuery {
+	return NewRoleClient(r.config).QueryRolePermissions(r)
 }
 
 // Update returns a builder for updating this Role.
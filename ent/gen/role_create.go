@@ -105,19 +105,19 @@ func (rc *RoleCreate) AddUserRoles(u ...*UserRole) *RoleCreate {
 	return rc.AddUserRoleIDs(ids...)
 }
 
-// AddPermissionIDs adds the "permissions" edge to the RolePermission entity by IDs.
-func (rc *RoleCreate) AddPermissionIDs(ids ...uint64) *RoleCreate {
-	rc.mutation.AddPermissionIDs(ids...)
+// AddRolePermissionIDs adds the "role_permissions" edge to the RolePermission entity by IDs.
+func (rc *RoleCreate) AddRolePermissionIDs(ids ...uint64) *RoleCreate {
+	rc.mutation.AddRolePermissionIDs(ids...)
 	return rc
 }
 
-// AddPermissions adds the "permissions" edges to the RolePermission entity.
-func (rc *RoleCreate) AddPermissions(r ...*RolePermission) *RoleCreate {
+// AddRolePermissions adds the "role_permissions" edges to the RolePermission entity.
+func (rc *RoleCreate) AddRolePermissions(r ...*RolePermission) *RoleCreate {
 	ids := make([]uint64, len(r))
 	for i := range r {
 		ids[i] = r[i].ID
 	}
-	return rc.AddPermissionIDs(ids...)
+	return rc.AddRolePermissionIDs(ids...)
 }
 
 // Mutation returns the RoleMutation object of the builder.
@@ -251,12 +251,12 @@ func (rc *RoleCreate) createSpec() (*Role, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := rc.mutation.PermissionsIDs(); len(nodes) > 0 {
+	if nodes := rc.mutation.RolePermissionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   role.PermissionsTable,
-			Columns: []string{role.PermissionsColumn},
+			Table:   role.RolePermissionsTable,
+			Columns: []string{role.RolePermissionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(rolepermission.FieldID, field.TypeUint64),
@@ -113,19 +113,19 @@ func (ru *RoleUpdate) AddUserRoles(u ...*UserRole) *RoleUpdate {
 	return ru.AddUserRoleIDs(ids...)
 }
 
-// AddPermissionIDs adds the "permissions" edge to the RolePermission entity by IDs.
-func (ru *RoleUpdate) AddPermissionIDs(ids ...uint64) *RoleUpdate {
-	ru.mutation.AddPermissionIDs(ids...)
+// AddRolePermissionIDs adds the "role_permissions" edge to the RolePermission entity by IDs.
+func (ru *RoleUpdate) AddRolePermissionIDs(ids ...uint64) *RoleUpdate {
+	ru.mutation.AddRolePermissionIDs(ids...)
 	return ru
 }
 
-// AddPermissions adds the "permissions" edges to the RolePermission entity.
-func (ru *RoleUpdate) AddPermissions(r ...*RolePermission) *RoleUpdate {
+// AddRolePermissions adds the "role_permissions" edges to the RolePermission entity.
+func (ru *RoleUpdate) AddRolePermissions(r ...*RolePermission) *RoleUpdate {
 	ids := make([]uint64, len(r))
 	for i := range r {
 		ids[i] = r[i].ID
 	}
-	return ru.AddPermissionIDs(ids...)
+	return ru.AddRolePermissionIDs(ids...)
 }
 
 // Mutation returns the RoleMutation object of the builder.
@@ -154,25 +154,25 @@ func (ru *RoleUpdate) RemoveUserRoles(u ...*UserRole) *RoleUpdate {
 	return ru.RemoveUserRoleIDs(ids...)
 }
 
-// ClearPermissions clears all "permissions" edges to the RolePermission entity.
-func (ru *RoleUpdate) ClearPermissions() *RoleUpdate {
-	ru.mutation.ClearPermissions()
+// ClearRolePermissions clears all "role_permissions" edges to the RolePermission entity.
+func (ru *RoleUpdate) ClearRolePermissions() *RoleUpdate {
+	ru.mutation.ClearRolePermissions()
 	return ru
 }
 
-// RemovePermissionIDs removes the "permissions" edge to RolePermission entities by IDs.
-func (ru *RoleUpdate) RemovePermissionIDs(ids ...uint64) *RoleUpdate {
-	ru.mutation.RemovePermissionIDs(ids...)
+// RemoveRolePermissionIDs removes the "role_permissions" edge to RolePermission entities by IDs.
+func (ru *RoleUpdate) RemoveRolePermissionIDs(ids ...uint64) *RoleUpdate {
+	ru.mutation.RemoveRolePermissionIDs(ids...)
 	return ru
 }
 
-// RemovePermissions removes "permissions" edges to RolePermission entities.
-func (ru *RoleUpdate) RemovePermissions(r ...*RolePermission) *RoleUpdate {
+// RemoveRolePermissions removes "role_permissions" edges to RolePermission entities.
+func (ru *RoleUpdate) RemoveRolePermissions(r ...*RolePermission) *RoleUpdate {
 	ids := make([]uint64, len(r))
 	for i := range r {
 		ids[i] = r[i].ID
 	}
-	return ru.RemovePermissionIDs(ids...)
+	return ru.RemoveRolePermissionIDs(ids...)
 }
 
 // Save executes the query and returns the number of nodes affected by the update operation.
@@ -283,12 +283,12 @@ func (ru *RoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if ru.mutation.PermissionsCleared() {
+	if ru.mutation.RolePermissionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   role.PermissionsTable,
-			Columns: []string{role.PermissionsColumn},
+			Table:   role.RolePermissionsTable,
+			Columns: []string{role.RolePermissionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(rolepermission.FieldID, field.TypeUint64),
@@ -296,12 +296,12 @@ func (ru *RoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := ru.mutation.RemovedPermissionsIDs(); len(nodes) > 0 && !ru.mutation.PermissionsCleared() {
+	if nodes := ru.mutation.RemovedRolePermissionsIDs(); len(nodes) > 0 && !ru.mutation.RolePermissionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   role.PermissionsTable,
-			Columns: []string{role.PermissionsColumn},
+			Table:   role.RolePermissionsTable,
+			Columns: []string{role.RolePermissionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(rolepermission.FieldID, field.TypeUint64),
@@ -312,12 +312,12 @@ func (ru *RoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := ru.mutation.PermissionsIDs(); len(nodes) > 0 {
+	if nodes := ru.mutation.RolePermissionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   role.PermissionsTable,
-			Columns: []string{role.PermissionsColumn},
+			Table:   role.RolePermissionsTable,
+			Columns: []string{role.RolePermissionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(rolepermission.FieldID, field.TypeUint64),
@@ -431,19 +431,19 @@ func (ruo *RoleUpdateOne) AddUserRoles(u ...*UserRole) *RoleUpdateOne {
 	return ruo.AddUserRoleIDs(ids...)
 }
 
-// AddPermissionIDs adds the "permissions" edge to the RolePermission entity by IDs.
-func (ruo *RoleUpdateOne) AddPermissionIDs(ids ...uint64) *RoleUpdateOne {
-	ruo.mutation.AddPermissionIDs(ids...)
+// AddRolePermissionIDs adds the "role_permissions" edge to the RolePermission entity by IDs.
+func (ruo *RoleUpdateOne) AddRolePermissionIDs(ids ...uint64) *RoleUpdateOne {
+	ruo.mutation.AddRolePermissionIDs(ids...)
 	return ruo
 }
 
-// AddPermissions adds the "permissions" edges to the RolePermission entity.
-func (ruo *RoleUpdateOne) AddPermissions(r ...*RolePermission) *RoleUpdateOne {
+// AddRolePermissions adds the "role_permissions" edges to the RolePermission entity.
+func (ruo *RoleUpdateOne) AddRolePermissions(r ...*RolePermission) *RoleUpdateOne {
 	ids := make([]uint64, len(r))
 	for i := range r {
 		ids[i] = r[i].ID
 	}
-	return ruo.AddPermissionIDs(ids...)
+	return ruo.AddRolePermissionIDs(ids...)
 }
 
 // Mutation returns the RoleMutation object of the builder.
@@ -472,25 +472,25 @@ func (ruo *RoleUpdateOne) RemoveUserRoles(u ...*UserRole) *RoleUpdateOne {
 	return ruo.RemoveUserRoleIDs(ids...)
 }
 
-// ClearPermissions clears all "permissions" edges to the RolePermission entity.
-func (ruo *RoleUpdateOne) ClearPermissions() *RoleUpdateOne {
-	ruo.mutation.ClearPermissions()
+// ClearRolePermissions clears all "role_permissions" edges to the RolePermission entity.
+func (ruo *RoleUpdateOne) ClearRolePermissions() *RoleUpdateOne {
+	ruo.mutation.ClearRolePermissions()
 	return ruo
 }
 
-// RemovePermissionIDs removes the "permissions" edge to RolePermission entities by IDs.
-func (ruo *RoleUpdateOne) RemovePermissionIDs(ids ...uint64) *RoleUpdateOne {
-	ruo.mutation.RemovePermissionIDs(ids...)
+// RemoveRolePermissionIDs removes the "role_permissions" edge to RolePermission entities by IDs.
+func (ruo *RoleUpdateOne) RemoveRolePermissionIDs(ids ...uint64) *RoleUpdateOne {
+	ruo.mutation.RemoveRolePermissionIDs(ids...)
 	return ruo
 }
 
-// RemovePermissions removes "permissions" edges to RolePermission entities.
-func (ruo *RoleUpdateOne) RemovePermissions(r ...*RolePermission) *RoleUpdateOne {
+// RemoveRolePermissions removes "role_permissions" edges to RolePermission entities.
+func (ruo *RoleUpdateOne) RemoveRolePermissions(r ...*RolePermission) *RoleUpdateOne {
 	ids := make([]uint64, len(r))
 	for i := range r {
 		ids[i] = r[i].ID
 	}
-	return ruo.RemovePermissionIDs(ids...)
+	return ruo.RemoveRolePermissionIDs(ids...)
 }
 
 // Where appends a list predicates to the RoleUpdate builder.
@@ -631,12 +631,12 @@ func (ruo *RoleUpdateOne) sqlSave(ctx context.Context) (_node *Role, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if ruo.mutation.PermissionsCleared() {
+	if ruo.mutation.RolePermissionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   role.PermissionsTable,
-			Columns: []string{role.PermissionsColumn},
+			Table:   role.RolePermissionsTable,
+			Columns: []string{role.RolePermissionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(rolepermission.FieldID, field.TypeUint64),
@@ -644,12 +644,12 @@ func (ruo *RoleUpdateOne) sqlSave(ctx context.Context) (_node *Role, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := ruo.mutation.RemovedPermissionsIDs(); len(nodes) > 0 && !ruo.mutation.PermissionsCleared() {
+	if nodes := ruo.mutation.RemovedRolePermissionsIDs(); len(nodes) > 0 && !ruo.mutation.RolePermissionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   role.PermissionsTable,
-			Columns: []string{role.PermissionsColumn},
+			Table:   role.RolePermissionsTable,
+			Columns: []string{role.RolePermissionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(rolepermission.FieldID, field.TypeUint64),
@@ -660,12 +660,12 @@ func (ruo *RoleUpdateOne) sqlSave(ctx context.Context) (_node *Role, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := ruo.mutation.PermissionsIDs(); len(nodes) > 0 {
+	if nodes := ruo.mutation.RolePermissionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   role.PermissionsTable,
-			Columns: []string{role.PermissionsColumn},
+			Table:   role.RolePermissionsTable,
+			Columns: []string{role.RolePermissionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(rolepermission.FieldID, field.TypeUint64),
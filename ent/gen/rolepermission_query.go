@@ -8,6 +8,7 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -26,6 +27,7 @@ type RolePermissionQuery struct {
 	predicates     []predicate.RolePermission
 	withRole       *RoleQuery
 	withPermission *PermissionQuery
+	modifiers      []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -420,6 +422,9 @@ func (rpq *RolePermissionQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(rpq.modifiers) > 0 {
+		_spec.Modifiers = rpq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -505,6 +510,9 @@ func (rpq *RolePermissionQuery) loadPermission(ctx context.Context, query *Permi
 
 func (rpq *RolePermissionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := rpq.querySpec()
+	if len(rpq.modifiers) > 0 {
+		_spec.Modifiers = rpq.modifiers
+	}
 	_spec.Node.Columns = rpq.ctx.Fields
 	if len(rpq.ctx.Fields) > 0 {
 		_spec.Unique = rpq.ctx.Unique != nil && *rpq.ctx.Unique
@@ -573,6 +581,9 @@ func (rpq *RolePermissionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if rpq.ctx.Unique != nil && *rpq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range rpq.modifiers {
+		m(selector)
+	}
 	for _, p := range rpq.predicates {
 		p(selector)
 	}
@@ -590,6 +601,32 @@ func (rpq *RolePermissionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (rpq *RolePermissionQuery) ForUpdate(opts ...sql.LockOption) *RolePermissionQuery {
+	if rpq.driver.Dialect() == dialect.Postgres {
+		rpq.Unique(false)
+	}
+	rpq.modifiers = append(rpq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return rpq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (rpq *RolePermissionQuery) ForShare(opts ...sql.LockOption) *RolePermissionQuery {
+	if rpq.driver.Dialect() == dialect.Postgres {
+		rpq.Unique(false)
+	}
+	rpq.modifiers = append(rpq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return rpq
+}
+
 // RolePermissionGroupBy is the group-by builder for RolePermission entities.
 type RolePermissionGroupBy struct {
 	selector
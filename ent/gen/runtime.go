@@ -5,21 +5,30 @@ package gen
 import (
 	"time"
 
+	"github.com/thang1834/go-goss/ent/gen/apikey"
+	"github.com/thang1834/go-goss/ent/gen/auditlog"
 	"github.com/thang1834/go-goss/ent/gen/cart"
 	"github.com/thang1834/go-goss/ent/gen/category"
 	"github.com/thang1834/go-goss/ent/gen/discount"
+	"github.com/thang1834/go-goss/ent/gen/idempotencykey"
 	"github.com/thang1834/go-goss/ent/gen/order"
+	"github.com/thang1834/go-goss/ent/gen/orderstatushistory"
 	"github.com/thang1834/go-goss/ent/gen/payment"
 	"github.com/thang1834/go-goss/ent/gen/permission"
 	"github.com/thang1834/go-goss/ent/gen/product"
 	"github.com/thang1834/go-goss/ent/gen/productimage"
+	"github.com/thang1834/go-goss/ent/gen/recoverycode"
 	"github.com/thang1834/go-goss/ent/gen/review"
 	"github.com/thang1834/go-goss/ent/gen/role"
 	"github.com/thang1834/go-goss/ent/gen/rolepermission"
+	"github.com/thang1834/go-goss/ent/gen/session"
 	"github.com/thang1834/go-goss/ent/gen/user"
+	"github.com/thang1834/go-goss/ent/gen/useridentity"
 	"github.com/thang1834/go-goss/ent/gen/userpermission"
 	"github.com/thang1834/go-goss/ent/gen/userrole"
+	"github.com/thang1834/go-goss/ent/gen/usertoken"
 	"github.com/thang1834/go-goss/ent/gen/uservoucher"
+	"github.com/thang1834/go-goss/ent/gen/webhookevent"
 	"github.com/thang1834/go-goss/ent/gen/wishlist"
 	"github.com/thang1834/go-goss/ent/gen/wishlistitem"
 	"github.com/thang1834/go-goss/ent/schema"
@@ -29,14 +38,50 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[2].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = apikeyDescName.Validators[0].(func(string) error)
+	// apikeyDescPrefix is the schema descriptor for prefix field.
+	apikeyDescPrefix := apikeyFields[3].Descriptor()
+	// apikey.PrefixValidator is a validator for the "prefix" field. It is called by the builders before save.
+	apikey.PrefixValidator = apikeyDescPrefix.Validators[0].(func(string) error)
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[9].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescTableName is the schema descriptor for table_name field.
+	auditlogDescTableName := auditlogFields[2].Descriptor()
+	// auditlog.TableNameValidator is a validator for the "table_name" field. It is called by the builders before save.
+	auditlog.TableNameValidator = auditlogDescTableName.Validators[0].(func(string) error)
+	// auditlogDescAction is the schema descriptor for action field.
+	auditlogDescAction := auditlogFields[4].Descriptor()
+	// auditlog.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditlog.ActionValidator = auditlogDescAction.Validators[0].(func(string) error)
+	// auditlogDescHTTPMethod is the schema descriptor for http_method field.
+	auditlogDescHTTPMethod := auditlogFields[8].Descriptor()
+	// auditlog.HTTPMethodValidator is a validator for the "http_method" field. It is called by the builders before save.
+	auditlog.HTTPMethodValidator = auditlogDescHTTPMethod.Validators[0].(func(string) error)
+	// auditlogDescIPAddress is the schema descriptor for ip_address field.
+	auditlogDescIPAddress := auditlogFields[10].Descriptor()
+	// auditlog.IPAddressValidator is a validator for the "ip_address" field. It is called by the builders before save.
+	auditlog.IPAddressValidator = auditlogDescIPAddress.Validators[0].(func(string) error)
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[12].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
 	cartFields := schema.Cart{}.Fields()
 	_ = cartFields
 	// cartDescCreatedAt is the schema descriptor for created_at field.
-	cartDescCreatedAt := cartFields[1].Descriptor()
+	cartDescCreatedAt := cartFields[2].Descriptor()
 	// cart.DefaultCreatedAt holds the default value on creation for the created_at field.
 	cart.DefaultCreatedAt = cartDescCreatedAt.Default.(func() time.Time)
 	// cartDescUpdatedAt is the schema descriptor for updated_at field.
-	cartDescUpdatedAt := cartFields[2].Descriptor()
+	cartDescUpdatedAt := cartFields[3].Descriptor()
 	// cart.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	cart.DefaultUpdatedAt = cartDescUpdatedAt.Default.(func() time.Time)
 	// cart.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -77,34 +122,50 @@ func init() {
 	discount.DefaultUpdatedAt = discountDescUpdatedAt.Default.(func() time.Time)
 	// discount.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	discount.UpdateDefaultUpdatedAt = discountDescUpdatedAt.UpdateDefault.(func() time.Time)
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescFingerprint is the schema descriptor for fingerprint field.
+	idempotencykeyDescFingerprint := idempotencykeyFields[1].Descriptor()
+	// idempotencykey.FingerprintValidator is a validator for the "fingerprint" field. It is called by the builders before save.
+	idempotencykey.FingerprintValidator = idempotencykeyDescFingerprint.Validators[0].(func(string) error)
 	orderFields := schema.Order{}.Fields()
 	_ = orderFields
 	// orderDescStatus is the schema descriptor for status field.
-	orderDescStatus := orderFields[1].Descriptor()
+	orderDescStatus := orderFields[2].Descriptor()
 	// order.DefaultStatus holds the default value on creation for the status field.
 	order.DefaultStatus = orderDescStatus.Default.(string)
 	// orderDescTotalPrice is the schema descriptor for total_price field.
-	orderDescTotalPrice := orderFields[2].Descriptor()
+	orderDescTotalPrice := orderFields[3].Descriptor()
 	// order.DefaultTotalPrice holds the default value on creation for the total_price field.
 	order.DefaultTotalPrice = orderDescTotalPrice.Default.(float64)
+	// orderDescDiscountAmount is the schema descriptor for discount_amount field.
+	orderDescDiscountAmount := orderFields[7].Descriptor()
+	// order.DefaultDiscountAmount holds the default value on creation for the discount_amount field.
+	order.DefaultDiscountAmount = orderDescDiscountAmount.Default.(float64)
 	// orderDescCreatedAt is the schema descriptor for created_at field.
-	orderDescCreatedAt := orderFields[5].Descriptor()
+	orderDescCreatedAt := orderFields[8].Descriptor()
 	// order.DefaultCreatedAt holds the default value on creation for the created_at field.
 	order.DefaultCreatedAt = orderDescCreatedAt.Default.(func() time.Time)
 	// orderDescUpdatedAt is the schema descriptor for updated_at field.
-	orderDescUpdatedAt := orderFields[6].Descriptor()
+	orderDescUpdatedAt := orderFields[9].Descriptor()
 	// order.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	order.DefaultUpdatedAt = orderDescUpdatedAt.Default.(func() time.Time)
 	// order.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	order.UpdateDefaultUpdatedAt = orderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	orderstatushistoryFields := schema.OrderStatusHistory{}.Fields()
+	_ = orderstatushistoryFields
+	// orderstatushistoryDescCreatedAt is the schema descriptor for created_at field.
+	orderstatushistoryDescCreatedAt := orderstatushistoryFields[6].Descriptor()
+	// orderstatushistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	orderstatushistory.DefaultCreatedAt = orderstatushistoryDescCreatedAt.Default.(func() time.Time)
 	paymentFields := schema.Payment{}.Fields()
 	_ = paymentFields
 	// paymentDescStatus is the schema descriptor for status field.
-	paymentDescStatus := paymentFields[3].Descriptor()
+	paymentDescStatus := paymentFields[4].Descriptor()
 	// payment.DefaultStatus holds the default value on creation for the status field.
 	payment.DefaultStatus = paymentDescStatus.Default.(string)
 	// paymentDescCreatedAt is the schema descriptor for created_at field.
-	paymentDescCreatedAt := paymentFields[5].Descriptor()
+	paymentDescCreatedAt := paymentFields[6].Descriptor()
 	// payment.DefaultCreatedAt holds the default value on creation for the created_at field.
 	payment.DefaultCreatedAt = paymentDescCreatedAt.Default.(func() time.Time)
 	permissionFields := schema.Permission{}.Fields()
@@ -154,11 +215,11 @@ func init() {
 	// product.DefaultReviewCount holds the default value on creation for the review_count field.
 	product.DefaultReviewCount = productDescReviewCount.Default.(int)
 	// productDescCreatedAt is the schema descriptor for created_at field.
-	productDescCreatedAt := productFields[8].Descriptor()
+	productDescCreatedAt := productFields[9].Descriptor()
 	// product.DefaultCreatedAt holds the default value on creation for the created_at field.
 	product.DefaultCreatedAt = productDescCreatedAt.Default.(func() time.Time)
 	// productDescUpdatedAt is the schema descriptor for updated_at field.
-	productDescUpdatedAt := productFields[9].Descriptor()
+	productDescUpdatedAt := productFields[10].Descriptor()
 	// product.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	product.DefaultUpdatedAt = productDescUpdatedAt.Default.(func() time.Time)
 	// product.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -166,17 +227,35 @@ func init() {
 	productimageFields := schema.ProductImage{}.Fields()
 	_ = productimageFields
 	// productimageDescIsPrimary is the schema descriptor for is_primary field.
-	productimageDescIsPrimary := productimageFields[2].Descriptor()
+	productimageDescIsPrimary := productimageFields[3].Descriptor()
 	// productimage.DefaultIsPrimary holds the default value on creation for the is_primary field.
 	productimage.DefaultIsPrimary = productimageDescIsPrimary.Default.(bool)
+	// productimageDescPosition is the schema descriptor for position field.
+	productimageDescPosition := productimageFields[4].Descriptor()
+	// productimage.DefaultPosition holds the default value on creation for the position field.
+	productimage.DefaultPosition = productimageDescPosition.Default.(int)
+	// productimageDescCreatedAt is the schema descriptor for created_at field.
+	productimageDescCreatedAt := productimageFields[8].Descriptor()
+	// productimage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	productimage.DefaultCreatedAt = productimageDescCreatedAt.Default.(func() time.Time)
+	recoverycodeFields := schema.RecoveryCode{}.Fields()
+	_ = recoverycodeFields
+	// recoverycodeDescCreatedAt is the schema descriptor for created_at field.
+	recoverycodeDescCreatedAt := recoverycodeFields[4].Descriptor()
+	// recoverycode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recoverycode.DefaultCreatedAt = recoverycodeDescCreatedAt.Default.(func() time.Time)
 	reviewFields := schema.Review{}.Fields()
 	_ = reviewFields
+	// reviewDescRating is the schema descriptor for rating field.
+	reviewDescRating := reviewFields[3].Descriptor()
+	// review.RatingValidator is a validator for the "rating" field. It is called by the builders before save.
+	review.RatingValidator = reviewDescRating.Validators[0].(func(int) error)
 	// reviewDescCreatedAt is the schema descriptor for created_at field.
-	reviewDescCreatedAt := reviewFields[3].Descriptor()
+	reviewDescCreatedAt := reviewFields[5].Descriptor()
 	// review.DefaultCreatedAt holds the default value on creation for the created_at field.
 	review.DefaultCreatedAt = reviewDescCreatedAt.Default.(func() time.Time)
 	// reviewDescUpdatedAt is the schema descriptor for updated_at field.
-	reviewDescUpdatedAt := reviewFields[4].Descriptor()
+	reviewDescUpdatedAt := reviewFields[6].Descriptor()
 	// review.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	review.DefaultUpdatedAt = reviewDescUpdatedAt.Default.(func() time.Time)
 	// review.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -203,6 +282,16 @@ func init() {
 	rolepermissionDescCreatedAt := rolepermissionFields[3].Descriptor()
 	// rolepermission.DefaultCreatedAt holds the default value on creation for the created_at field.
 	rolepermission.DefaultCreatedAt = rolepermissionDescCreatedAt.Default.(func() time.Time)
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescCreatedAt is the schema descriptor for created_at field.
+	sessionDescCreatedAt := sessionFields[4].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
+	// sessionDescLastSeenAt is the schema descriptor for last_seen_at field.
+	sessionDescLastSeenAt := sessionFields[5].Descriptor()
+	// session.DefaultLastSeenAt holds the default value on creation for the last_seen_at field.
+	session.DefaultLastSeenAt = sessionDescLastSeenAt.Default.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescFirstName is the schema descriptor for first_name field.
@@ -245,6 +334,24 @@ func init() {
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	useridentityFields := schema.UserIdentity{}.Fields()
+	_ = useridentityFields
+	// useridentityDescProvider is the schema descriptor for provider field.
+	useridentityDescProvider := useridentityFields[2].Descriptor()
+	// useridentity.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	useridentity.ProviderValidator = useridentityDescProvider.Validators[0].(func(string) error)
+	// useridentityDescSubject is the schema descriptor for subject field.
+	useridentityDescSubject := useridentityFields[3].Descriptor()
+	// useridentity.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	useridentity.SubjectValidator = useridentityDescSubject.Validators[0].(func(string) error)
+	// useridentityDescEmail is the schema descriptor for email field.
+	useridentityDescEmail := useridentityFields[4].Descriptor()
+	// useridentity.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	useridentity.EmailValidator = useridentityDescEmail.Validators[0].(func(string) error)
+	// useridentityDescCreatedAt is the schema descriptor for created_at field.
+	useridentityDescCreatedAt := useridentityFields[6].Descriptor()
+	// useridentity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	useridentity.DefaultCreatedAt = useridentityDescCreatedAt.Default.(func() time.Time)
 	userpermissionFields := schema.UserPermission{}.Fields()
 	_ = userpermissionFields
 	// userpermissionDescGrantedAt is the schema descriptor for granted_at field.
@@ -265,22 +372,58 @@ func init() {
 	userroleDescIsActive := userroleFields[6].Descriptor()
 	// userrole.DefaultIsActive holds the default value on creation for the is_active field.
 	userrole.DefaultIsActive = userroleDescIsActive.Default.(bool)
+	usertokenFields := schema.UserToken{}.Fields()
+	_ = usertokenFields
+	// usertokenDescPurpose is the schema descriptor for purpose field.
+	usertokenDescPurpose := usertokenFields[2].Descriptor()
+	// usertoken.PurposeValidator is a validator for the "purpose" field. It is called by the builders before save.
+	usertoken.PurposeValidator = usertokenDescPurpose.Validators[0].(func(string) error)
+	// usertokenDescCreatedAt is the schema descriptor for created_at field.
+	usertokenDescCreatedAt := usertokenFields[6].Descriptor()
+	// usertoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usertoken.DefaultCreatedAt = usertokenDescCreatedAt.Default.(func() time.Time)
 	uservoucherFields := schema.UserVoucher{}.Fields()
 	_ = uservoucherFields
 	// uservoucherDescIsUsed is the schema descriptor for is_used field.
-	uservoucherDescIsUsed := uservoucherFields[1].Descriptor()
+	uservoucherDescIsUsed := uservoucherFields[3].Descriptor()
 	// uservoucher.DefaultIsUsed holds the default value on creation for the is_used field.
 	uservoucher.DefaultIsUsed = uservoucherDescIsUsed.Default.(bool)
+	webhookeventFields := schema.WebhookEvent{}.Fields()
+	_ = webhookeventFields
+	// webhookeventDescProvider is the schema descriptor for provider field.
+	webhookeventDescProvider := webhookeventFields[1].Descriptor()
+	// webhookevent.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	webhookevent.ProviderValidator = webhookeventDescProvider.Validators[0].(func(string) error)
+	// webhookeventDescEventID is the schema descriptor for event_id field.
+	webhookeventDescEventID := webhookeventFields[2].Descriptor()
+	// webhookevent.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	webhookevent.EventIDValidator = webhookeventDescEventID.Validators[0].(func(string) error)
+	// webhookeventDescTransactionID is the schema descriptor for transaction_id field.
+	webhookeventDescTransactionID := webhookeventFields[3].Descriptor()
+	// webhookevent.TransactionIDValidator is a validator for the "transaction_id" field. It is called by the builders before save.
+	webhookevent.TransactionIDValidator = webhookeventDescTransactionID.Validators[0].(func(string) error)
+	// webhookeventDescStatus is the schema descriptor for status field.
+	webhookeventDescStatus := webhookeventFields[4].Descriptor()
+	// webhookevent.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	webhookevent.StatusValidator = webhookeventDescStatus.Validators[0].(func(string) error)
+	// webhookeventDescAttempts is the schema descriptor for attempts field.
+	webhookeventDescAttempts := webhookeventFields[7].Descriptor()
+	// webhookevent.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookevent.DefaultAttempts = webhookeventDescAttempts.Default.(int)
+	// webhookeventDescReceivedAt is the schema descriptor for received_at field.
+	webhookeventDescReceivedAt := webhookeventFields[9].Descriptor()
+	// webhookevent.DefaultReceivedAt holds the default value on creation for the received_at field.
+	webhookevent.DefaultReceivedAt = webhookeventDescReceivedAt.Default.(func() time.Time)
 	wishlistFields := schema.Wishlist{}.Fields()
 	_ = wishlistFields
 	// wishlistDescCreatedAt is the schema descriptor for created_at field.
-	wishlistDescCreatedAt := wishlistFields[1].Descriptor()
+	wishlistDescCreatedAt := wishlistFields[2].Descriptor()
 	// wishlist.DefaultCreatedAt holds the default value on creation for the created_at field.
 	wishlist.DefaultCreatedAt = wishlistDescCreatedAt.Default.(func() time.Time)
 	wishlistitemFields := schema.WishlistItem{}.Fields()
 	_ = wishlistitemFields
 	// wishlistitemDescAddedAt is the schema descriptor for added_at field.
-	wishlistitemDescAddedAt := wishlistitemFields[0].Descriptor()
+	wishlistitemDescAddedAt := wishlistitemFields[3].Descriptor()
 	// wishlistitem.DefaultAddedAt holds the default value on creation for the added_at field.
 	wishlistitem.DefaultAddedAt = wishlistitemDescAddedAt.Default.(func() time.Time)
 }
@@ -26,7 +26,7 @@ type User struct {
 	// Email holds the value of the "email" field.
 	Email string `json:"email,omitempty"`
 	// PasswordHash holds the value of the "password_hash" field.
-	PasswordHash string `json:"password_hash,omitempty"`
+	PasswordHash string `json:"-"`
 	// Phone holds the value of the "phone" field.
 	Phone string `json:"phone,omitempty"`
 	// Status holds the value of the "status" field.
@@ -37,6 +37,12 @@ type User struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// VerifiedAt holds the value of the "verified_at" field.
 	VerifiedAt *time.Time `json:"-"`
+	// TotpSecret holds the value of the "totp_secret" field.
+	TotpSecret string `json:"-"`
+	// TotpEnabledAt holds the value of the "totp_enabled_at" field.
+	TotpEnabledAt *time.Time `json:"-"`
+	// TotpLastStep holds the value of the "totp_last_step" field.
+	TotpLastStep *int64 `json:"-"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -45,10 +51,10 @@ type User struct {
 
 // UserEdges holds the relations/edges for other nodes in the graph.
 type UserEdges struct {
-	// Roles holds the value of the roles edge.
-	Roles []*UserRole `json:"roles,omitempty"`
-	// Permissions holds the value of the permissions edge.
-	Permissions []*UserPermission `json:"permissions,omitempty"`
+	// UserRoles holds the value of the user_roles edge.
+	UserRoles []*UserRole `json:"user_roles,omitempty"`
+	// UserPermissions holds the value of the user_permissions edge.
+	UserPermissions []*UserPermission `json:"user_permissions,omitempty"`
 	// Carts holds the value of the carts edge.
 	Carts []*Cart `json:"carts,omitempty"`
 	// Orders holds the value of the orders edge.
@@ -59,27 +65,37 @@ type UserEdges struct {
 	Reviews []*Review `json:"reviews,omitempty"`
 	// Vouchers holds the value of the vouchers edge.
 	Vouchers []*UserVoucher `json:"vouchers,omitempty"`
+	// OrderStatusChanges holds the value of the order_status_changes edge.
+	OrderStatusChanges []*OrderStatusHistory `json:"order_status_changes,omitempty"`
+	// Tokens holds the value of the tokens edge.
+	Tokens []*UserToken `json:"tokens,omitempty"`
+	// RecoveryCodes holds the value of the recovery_codes edge.
+	RecoveryCodes []*RecoveryCode `json:"recovery_codes,omitempty"`
+	// APIKeys holds the value of the api_keys edge.
+	APIKeys []*APIKey `json:"api_keys,omitempty"`
+	// Identities holds the value of the identities edge.
+	Identities []*UserIdentity `json:"identities,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [12]bool
 }
 
-// RolesOrErr returns the Roles value or an error if the edge
+// UserRolesOrErr returns the UserRoles value or an error if the edge
 // was not loaded in eager-loading.
-func (e UserEdges) RolesOrErr() ([]*UserRole, error) {
+func (e UserEdges) UserRolesOrErr() ([]*UserRole, error) {
 	if e.loadedTypes[0] {
-		return e.Roles, nil
+		return e.UserRoles, nil
 	}
-	return nil, &NotLoadedError{edge: "roles"}
+	return nil, &NotLoadedError{edge: "user_roles"}
 }
 
-// PermissionsOrErr returns the Permissions value or an error if the edge
+// UserPermissionsOrErr returns the UserPermissions value or an error if the edge
 // was not loaded in eager-loading.
-func (e UserEdges) PermissionsOrErr() ([]*UserPermission, error) {
+func (e UserEdges) UserPermissionsOrErr() ([]*UserPermission, error) {
 	if e.loadedTypes[1] {
-		return e.Permissions, nil
+		return e.UserPermissions, nil
 	}
-	return nil, &NotLoadedError{edge: "permissions"}
+	return nil, &NotLoadedError{edge: "user_permissions"}
 }
 
 // CartsOrErr returns the Carts value or an error if the edge
@@ -127,16 +143,61 @@ func (e UserEdges) VouchersOrErr() ([]*UserVoucher, error) {
 	return nil, &NotLoadedError{edge: "vouchers"}
 }
 
+// OrderStatusChangesOrErr returns the OrderStatusChanges value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) OrderStatusChangesOrErr() ([]*OrderStatusHistory, error) {
+	if e.loadedTypes[7] {
+		return e.OrderStatusChanges, nil
+	}
+	return nil, &NotLoadedError{edge: "order_status_changes"}
+}
+
+// TokensOrErr returns the Tokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) TokensOrErr() ([]*UserToken, error) {
+	if e.loadedTypes[8] {
+		return e.Tokens, nil
+	}
+	return nil, &NotLoadedError{edge: "tokens"}
+}
+
+// RecoveryCodesOrErr returns the RecoveryCodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RecoveryCodesOrErr() ([]*RecoveryCode, error) {
+	if e.loadedTypes[9] {
+		return e.RecoveryCodes, nil
+	}
+	return nil, &NotLoadedError{edge: "recovery_codes"}
+}
+
+// APIKeysOrErr returns the APIKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) APIKeysOrErr() ([]*APIKey, error) {
+	if e.loadedTypes[10] {
+		return e.APIKeys, nil
+	}
+	return nil, &NotLoadedError{edge: "api_keys"}
+}
+
+// IdentitiesOrErr returns the Identities value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) IdentitiesOrErr() ([]*UserIdentity, error) {
+	if e.loadedTypes[11] {
+		return e.Identities, nil
+	}
+	return nil, &NotLoadedError{edge: "identities"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldID:
+		case user.FieldID, user.FieldTotpLastStep:
 			values[i] = new(sql.NullInt64)
-		case user.FieldFirstName, user.FieldMiddleName, user.FieldLastName, user.FieldEmail, user.FieldPasswordHash, user.FieldPhone, user.FieldStatus:
+		case user.FieldFirstName, user.FieldMiddleName, user.FieldLastName, user.FieldEmail, user.FieldPasswordHash, user.FieldPhone, user.FieldStatus, user.FieldTotpSecret:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldVerifiedAt:
+		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldVerifiedAt, user.FieldTotpEnabledAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -220,6 +281,26 @@ func (u *User) assignValues(columns []string, values []any) error {
 				u.VerifiedAt = new(time.Time)
 				*u.VerifiedAt = value.Time
 			}
+		case user.FieldTotpSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_secret", values[i])
+			} else if value.Valid {
+				u.TotpSecret = value.String
+			}
+		case user.FieldTotpEnabledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_enabled_at", values[i])
+			} else if value.Valid {
+				u.TotpEnabledAt = new(time.Time)
+				*u.TotpEnabledAt = value.Time
+			}
+		case user.FieldTotpLastStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_last_step", values[i])
+			} else if value.Valid {
+				u.TotpLastStep = new(int64)
+				*u.TotpLastStep = value.Int64
+			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
 		}
@@ -233,14 +314,14 @@ func (u *User) Value(name string) (ent.Value, error) {
 	return u.selectValues.Get(name)
 }
 
-// QueryRoles queries the "roles" edge of the User entity.
-func (u *User) QueryRoles() *UserRoleQuery {
-	return NewUserClient(u.config).QueryRoles(u)
+// QueryUserRoles queries the "user_roles" edge of the User entity.
+func (u *User) QueryUserRoles() *UserRoleQuery {
+	return NewUserClient(u.config).QueryUserRoles(u)
 }
 
-// QueryPermissions queries the "permissions" edge of the User entity.
-func (u *User) QueryPermissions() *UserPermissionQuery {
-	return NewUserClient(u.config).QueryPermissions(u)
+// QueryUserPermissions queries the "user_permissions" edge of the User entity.
+func (u *User) QueryUserPermissions() *UserPermissionQuery {
+	return NewUserClient(u.config).QueryUserPermissions(u)
 }
 
 // QueryCarts queries the "carts" edge of the User entity.
@@ -268,6 +349,31 @@ func (u *User) QueryVouchers() *UserVoucherQuery {
 	return NewUserClient(u.config).QueryVouchers(u)
 }
 
+// QueryOrderStatusChanges queries the "order_status_changes" edge of the User entity.
+func (u *User) QueryOrderStatusChanges() *OrderStatusHistoryQuery {
+	return NewUserClient(u.config).QueryOrderStatusChanges(u)
+}
+
+// QueryTokens queries the "tokens" edge of the User entity.
+func (u *User) QueryTokens() *UserTokenQuery {
+	return NewUserClient(u.config).QueryTokens(u)
+}
+
+// QueryRecoveryCodes queries the "recovery_codes" edge of the User entity.
+func (u *User) QueryRecoveryCodes() *RecoveryCodeQuery {
+	return NewUserClient(u.config).QueryRecoveryCodes(u)
+}
+
+// QueryAPIKeys queries the "api_keys" edge of the User entity.
+func (u *User) QueryAPIKeys() *APIKeyQuery {
+	return NewUserClient(u.config).QueryAPIKeys(u)
+}
+
+// QueryIdentities queries the "identities" edge of the User entity.
+func (u *User) QueryIdentities() *UserIdentityQuery {
+	return NewUserClient(u.config).QueryIdentities(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -303,8 +409,7 @@ func (u *User) String() string {
 	builder.WriteString("email=")
 	builder.WriteString(u.Email)
 	builder.WriteString(", ")
-	builder.WriteString("password_hash=")
-	builder.WriteString(u.PasswordHash)
+	builder.WriteString("password_hash=<sensitive>")
 	builder.WriteString(", ")
 	builder.WriteString("phone=")
 	builder.WriteString(u.Phone)
@@ -322,6 +427,18 @@ func (u *User) String() string {
 		builder.WriteString("verified_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("totp_secret=<sensitive>")
+	builder.WriteString(", ")
+	if v := u.TotpEnabledAt; v != nil {
+		builder.WriteString("totp_enabled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := u.TotpLastStep; v != nil {
+		builder.WriteString("totp_last_step=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -9,16 +9,22 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/thang1834/go-goss/ent/gen/apikey"
 	"github.com/thang1834/go-goss/ent/gen/cart"
 	"github.com/thang1834/go-goss/ent/gen/order"
+	"github.com/thang1834/go-goss/ent/gen/orderstatushistory"
 	"github.com/thang1834/go-goss/ent/gen/predicate"
+	"github.com/thang1834/go-goss/ent/gen/recoverycode"
 	"github.com/thang1834/go-goss/ent/gen/review"
 	"github.com/thang1834/go-goss/ent/gen/user"
+	"github.com/thang1834/go-goss/ent/gen/useridentity"
 	"github.com/thang1834/go-goss/ent/gen/userpermission"
 	"github.com/thang1834/go-goss/ent/gen/userrole"
+	"github.com/thang1834/go-goss/ent/gen/usertoken"
 	"github.com/thang1834/go-goss/ent/gen/uservoucher"
 	"github.com/thang1834/go-goss/ent/gen/wishlist"
 )
@@ -26,17 +32,23 @@ import (
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	ctx             *QueryContext
-	order           []user.OrderOption
-	inters          []Interceptor
-	predicates      []predicate.User
-	withRoles       *UserRoleQuery
-	withPermissions *UserPermissionQuery
-	withCarts       *CartQuery
-	withOrders      *OrderQuery
-	withWishlists   *WishlistQuery
-	withReviews     *ReviewQuery
-	withVouchers    *UserVoucherQuery
+	ctx                    *QueryContext
+	order                  []user.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.User
+	withUserRoles          *UserRoleQuery
+	withUserPermissions    *UserPermissionQuery
+	withCarts              *CartQuery
+	withOrders             *OrderQuery
+	withWishlists          *WishlistQuery
+	withReviews            *ReviewQuery
+	withVouchers           *UserVoucherQuery
+	withOrderStatusChanges *OrderStatusHistoryQuery
+	withTokens             *UserTokenQuery
+	withRecoveryCodes      *RecoveryCodeQuery
+	withAPIKeys            *APIKeyQuery
+	withIdentities         *UserIdentityQuery
+	modifiers              []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -73,8 +85,8 @@ func (uq *UserQuery) Order(o ...user.OrderOption) *UserQuery {
 	return uq
 }
 
-// QueryRoles chains the current query on the "roles" edge.
-func (uq *UserQuery) QueryRoles() *UserRoleQuery {
+// QueryUserRoles chains the current query on the "user_roles" edge.
+func (uq *UserQuery) QueryUserRoles() *UserRoleQuery {
 	query := (&UserRoleClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
@@ -87,7 +99,7 @@ func (uq *UserQuery) QueryRoles() *UserRoleQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, selector),
 			sqlgraph.To(userrole.Table, userrole.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.RolesTable, user.RolesColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UserRolesTable, user.UserRolesColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
 		return fromU, nil
@@ -95,8 +107,8 @@ func (uq *UserQuery) QueryRoles() *UserRoleQuery {
 	return query
 }
 
-// QueryPermissions chains the current query on the "permissions" edge.
-func (uq *UserQuery) QueryPermissions() *UserPermissionQuery {
+// QueryUserPermissions chains the current query on the "user_permissions" edge.
+func (uq *UserQuery) QueryUserPermissions() *UserPermissionQuery {
 	query := (&UserPermissionClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
@@ -109,7 +121,7 @@ func (uq *UserQuery) QueryPermissions() *UserPermissionQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, selector),
 			sqlgraph.To(userpermission.Table, userpermission.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.PermissionsTable, user.PermissionsColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UserPermissionsTable, user.UserPermissionsColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
 		return fromU, nil
@@ -227,6 +239,116 @@ func (uq *UserQuery) QueryVouchers() *UserVoucherQuery {
 	return query
 }
 
+// QueryOrderStatusChanges chains the current query on the "order_status_changes" edge.
+func (uq *UserQuery) QueryOrderStatusChanges() *OrderStatusHistoryQuery {
+	query := (&OrderStatusHistoryClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(orderstatushistory.Table, orderstatushistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.OrderStatusChangesTable, user.OrderStatusChangesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTokens chains the current query on the "tokens" edge.
+func (uq *UserQuery) QueryTokens() *UserTokenQuery {
+	query := (&UserTokenClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(usertoken.Table, usertoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TokensTable, user.TokensColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRecoveryCodes chains the current query on the "recovery_codes" edge.
+func (uq *UserQuery) QueryRecoveryCodes() *RecoveryCodeQuery {
+	query := (&RecoveryCodeClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(recoverycode.Table, recoverycode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RecoveryCodesTable, user.RecoveryCodesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAPIKeys chains the current query on the "api_keys" edge.
+func (uq *UserQuery) QueryAPIKeys() *APIKeyQuery {
+	query := (&APIKeyClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(apikey.Table, apikey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.APIKeysTable, user.APIKeysColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryIdentities chains the current query on the "identities" edge.
+func (uq *UserQuery) QueryIdentities() *UserIdentityQuery {
+	query := (&UserIdentityClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(useridentity.Table, useridentity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.IdentitiesTable, user.IdentitiesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (uq *UserQuery) First(ctx context.Context) (*User, error) {
@@ -414,43 +536,48 @@ func (uq *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:          uq.config,
-		ctx:             uq.ctx.Clone(),
-		order:           append([]user.OrderOption{}, uq.order...),
-		inters:          append([]Interceptor{}, uq.inters...),
-		predicates:      append([]predicate.User{}, uq.predicates...),
-		withRoles:       uq.withRoles.Clone(),
-		withPermissions: uq.withPermissions.Clone(),
-		withCarts:       uq.withCarts.Clone(),
-		withOrders:      uq.withOrders.Clone(),
-		withWishlists:   uq.withWishlists.Clone(),
-		withReviews:     uq.withReviews.Clone(),
-		withVouchers:    uq.withVouchers.Clone(),
+		config:                 uq.config,
+		ctx:                    uq.ctx.Clone(),
+		order:                  append([]user.OrderOption{}, uq.order...),
+		inters:                 append([]Interceptor{}, uq.inters...),
+		predicates:             append([]predicate.User{}, uq.predicates...),
+		withUserRoles:          uq.withUserRoles.Clone(),
+		withUserPermissions:    uq.withUserPermissions.Clone(),
+		withCarts:              uq.withCarts.Clone(),
+		withOrders:             uq.withOrders.Clone(),
+		withWishlists:          uq.withWishlists.Clone(),
+		withReviews:            uq.withReviews.Clone(),
+		withVouchers:           uq.withVouchers.Clone(),
+		withOrderStatusChanges: uq.withOrderStatusChanges.Clone(),
+		withTokens:             uq.withTokens.Clone(),
+		withRecoveryCodes:      uq.withRecoveryCodes.Clone(),
+		withAPIKeys:            uq.withAPIKeys.Clone(),
+		withIdentities:         uq.withIdentities.Clone(),
 		// clone intermediate query.
 		sql:  uq.sql.Clone(),
 		path: uq.path,
 	}
 }
 
-// WithRoles tells the query-builder to eager-load the nodes that are connected to
-// the "roles" edge. The optional arguments are used to configure the query builder of the edge.
-func (uq *UserQuery) WithRoles(opts ...func(*UserRoleQuery)) *UserQuery {
+// WithUserRoles tells the query-builder to eager-load the nodes that are connected to
+// the "user_roles" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithUserRoles(opts ...func(*UserRoleQuery)) *UserQuery {
 	query := (&UserRoleClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	uq.withRoles = query
+	uq.withUserRoles = query
 	return uq
 }
 
-// WithPermissions tells the query-builder to eager-load the nodes that are connected to
-// the "permissions" edge. The optional arguments are used to configure the query builder of the edge.
-func (uq *UserQuery) WithPermissions(opts ...func(*UserPermissionQuery)) *UserQuery {
+// WithUserPermissions tells the query-builder to eager-load the nodes that are connected to
+// the "user_permissions" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithUserPermissions(opts ...func(*UserPermissionQuery)) *UserQuery {
 	query := (&UserPermissionClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	uq.withPermissions = query
+	uq.withUserPermissions = query
 	return uq
 }
 
@@ -509,6 +636,61 @@ func (uq *UserQuery) WithVouchers(opts ...func(*UserVoucherQuery)) *UserQuery {
 	return uq
 }
 
+// WithOrderStatusChanges tells the query-builder to eager-load the nodes that are connected to
+// the "order_status_changes" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithOrderStatusChanges(opts ...func(*OrderStatusHistoryQuery)) *UserQuery {
+	query := (&OrderStatusHistoryClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withOrderStatusChanges = query
+	return uq
+}
+
+// WithTokens tells the query-builder to eager-load the nodes that are connected to
+// the "tokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithTokens(opts ...func(*UserTokenQuery)) *UserQuery {
+	query := (&UserTokenClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withTokens = query
+	return uq
+}
+
+// WithRecoveryCodes tells the query-builder to eager-load the nodes that are connected to
+// the "recovery_codes" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithRecoveryCodes(opts ...func(*RecoveryCodeQuery)) *UserQuery {
+	query := (&RecoveryCodeClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withRecoveryCodes = query
+	return uq
+}
+
+// WithAPIKeys tells the query-builder to eager-load the nodes that are connected to
+// the "api_keys" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithAPIKeys(opts ...func(*APIKeyQuery)) *UserQuery {
+	query := (&APIKeyClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withAPIKeys = query
+	return uq
+}
+
+// WithIdentities tells the query-builder to eager-load the nodes that are connected to
+// the "identities" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithIdentities(opts ...func(*UserIdentityQuery)) *UserQuery {
+	query := (&UserIdentityClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withIdentities = query
+	return uq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -587,14 +769,19 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = uq.querySpec()
-		loadedTypes = [7]bool{
-			uq.withRoles != nil,
-			uq.withPermissions != nil,
+		loadedTypes = [12]bool{
+			uq.withUserRoles != nil,
+			uq.withUserPermissions != nil,
 			uq.withCarts != nil,
 			uq.withOrders != nil,
 			uq.withWishlists != nil,
 			uq.withReviews != nil,
 			uq.withVouchers != nil,
+			uq.withOrderStatusChanges != nil,
+			uq.withTokens != nil,
+			uq.withRecoveryCodes != nil,
+			uq.withAPIKeys != nil,
+			uq.withIdentities != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -606,6 +793,9 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(uq.modifiers) > 0 {
+		_spec.Modifiers = uq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -615,17 +805,17 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
-	if query := uq.withRoles; query != nil {
-		if err := uq.loadRoles(ctx, query, nodes,
-			func(n *User) { n.Edges.Roles = []*UserRole{} },
-			func(n *User, e *UserRole) { n.Edges.Roles = append(n.Edges.Roles, e) }); err != nil {
+	if query := uq.withUserRoles; query != nil {
+		if err := uq.loadUserRoles(ctx, query, nodes,
+			func(n *User) { n.Edges.UserRoles = []*UserRole{} },
+			func(n *User, e *UserRole) { n.Edges.UserRoles = append(n.Edges.UserRoles, e) }); err != nil {
 			return nil, err
 		}
 	}
-	if query := uq.withPermissions; query != nil {
-		if err := uq.loadPermissions(ctx, query, nodes,
-			func(n *User) { n.Edges.Permissions = []*UserPermission{} },
-			func(n *User, e *UserPermission) { n.Edges.Permissions = append(n.Edges.Permissions, e) }); err != nil {
+	if query := uq.withUserPermissions; query != nil {
+		if err := uq.loadUserPermissions(ctx, query, nodes,
+			func(n *User) { n.Edges.UserPermissions = []*UserPermission{} },
+			func(n *User, e *UserPermission) { n.Edges.UserPermissions = append(n.Edges.UserPermissions, e) }); err != nil {
 			return nil, err
 		}
 	}
@@ -664,10 +854,47 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := uq.withOrderStatusChanges; query != nil {
+		if err := uq.loadOrderStatusChanges(ctx, query, nodes,
+			func(n *User) { n.Edges.OrderStatusChanges = []*OrderStatusHistory{} },
+			func(n *User, e *OrderStatusHistory) {
+				n.Edges.OrderStatusChanges = append(n.Edges.OrderStatusChanges, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withTokens; query != nil {
+		if err := uq.loadTokens(ctx, query, nodes,
+			func(n *User) { n.Edges.Tokens = []*UserToken{} },
+			func(n *User, e *UserToken) { n.Edges.Tokens = append(n.Edges.Tokens, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withRecoveryCodes; query != nil {
+		if err := uq.loadRecoveryCodes(ctx, query, nodes,
+			func(n *User) { n.Edges.RecoveryCodes = []*RecoveryCode{} },
+			func(n *User, e *RecoveryCode) { n.Edges.RecoveryCodes = append(n.Edges.RecoveryCodes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withAPIKeys; query != nil {
+		if err := uq.loadAPIKeys(ctx, query, nodes,
+			func(n *User) { n.Edges.APIKeys = []*APIKey{} },
+			func(n *User, e *APIKey) { n.Edges.APIKeys = append(n.Edges.APIKeys, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withIdentities; query != nil {
+		if err := uq.loadIdentities(ctx, query, nodes,
+			func(n *User) { n.Edges.Identities = []*UserIdentity{} },
+			func(n *User, e *UserIdentity) { n.Edges.Identities = append(n.Edges.Identities, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
-func (uq *UserQuery) loadRoles(ctx context.Context, query *UserRoleQuery, nodes []*User, init func(*User), assign func(*User, *UserRole)) error {
+func (uq *UserQuery) loadUserRoles(ctx context.Context, query *UserRoleQuery, nodes []*User, init func(*User), assign func(*User, *UserRole)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uint64]*User)
 	for i := range nodes {
@@ -681,7 +908,7 @@ func (uq *UserQuery) loadRoles(ctx context.Context, query *UserRoleQuery, nodes
 		query.ctx.AppendFieldOnce(userrole.FieldUserID)
 	}
 	query.Where(predicate.UserRole(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.RolesColumn), fks...))
+		s.Where(sql.InValues(s.C(user.UserRolesColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -697,7 +924,7 @@ func (uq *UserQuery) loadRoles(ctx context.Context, query *UserRoleQuery, nodes
 	}
 	return nil
 }
-func (uq *UserQuery) loadPermissions(ctx context.Context, query *UserPermissionQuery, nodes []*User, init func(*User), assign func(*User, *UserPermission)) error {
+func (uq *UserQuery) loadUserPermissions(ctx context.Context, query *UserPermissionQuery, nodes []*User, init func(*User), assign func(*User, *UserPermission)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uint64]*User)
 	for i := range nodes {
@@ -711,7 +938,7 @@ func (uq *UserQuery) loadPermissions(ctx context.Context, query *UserPermissionQ
 		query.ctx.AppendFieldOnce(userpermission.FieldUserID)
 	}
 	query.Where(predicate.UserPermission(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.PermissionsColumn), fks...))
+		s.Where(sql.InValues(s.C(user.UserPermissionsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -737,7 +964,9 @@ func (uq *UserQuery) loadCarts(ctx context.Context, query *CartQuery, nodes []*U
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(cart.FieldUserID)
+	}
 	query.Where(predicate.Cart(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(user.CartsColumn), fks...))
 	}))
@@ -746,13 +975,13 @@ func (uq *UserQuery) loadCarts(ctx context.Context, query *CartQuery, nodes []*U
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_carts
+		fk := n.UserID
 		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_carts" is nil for node %v`, n.ID)
+			return fmt.Errorf(`foreign-key "user_id" is nil for node %v`, n.ID)
 		}
 		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_carts" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -768,7 +997,9 @@ func (uq *UserQuery) loadOrders(ctx context.Context, query *OrderQuery, nodes []
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(order.FieldUserID)
+	}
 	query.Where(predicate.Order(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(user.OrdersColumn), fks...))
 	}))
@@ -777,13 +1008,10 @@ func (uq *UserQuery) loadOrders(ctx context.Context, query *OrderQuery, nodes []
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_orders
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_orders" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.UserID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_orders" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -799,7 +1027,9 @@ func (uq *UserQuery) loadWishlists(ctx context.Context, query *WishlistQuery, no
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(wishlist.FieldUserID)
+	}
 	query.Where(predicate.Wishlist(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(user.WishlistsColumn), fks...))
 	}))
@@ -808,13 +1038,10 @@ func (uq *UserQuery) loadWishlists(ctx context.Context, query *WishlistQuery, no
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_wishlists
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_wishlists" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.UserID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_wishlists" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -830,7 +1057,9 @@ func (uq *UserQuery) loadReviews(ctx context.Context, query *ReviewQuery, nodes
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(review.FieldUserID)
+	}
 	query.Where(predicate.Review(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(user.ReviewsColumn), fks...))
 	}))
@@ -839,13 +1068,10 @@ func (uq *UserQuery) loadReviews(ctx context.Context, query *ReviewQuery, nodes
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_reviews
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_reviews" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.UserID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_reviews" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -861,7 +1087,9 @@ func (uq *UserQuery) loadVouchers(ctx context.Context, query *UserVoucherQuery,
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(uservoucher.FieldUserID)
+	}
 	query.Where(predicate.UserVoucher(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(user.VouchersColumn), fks...))
 	}))
@@ -870,13 +1098,163 @@ func (uq *UserQuery) loadVouchers(ctx context.Context, query *UserVoucherQuery,
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_vouchers
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadOrderStatusChanges(ctx context.Context, query *OrderStatusHistoryQuery, nodes []*User, init func(*User), assign func(*User, *OrderStatusHistory)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(orderstatushistory.FieldChangedBy)
+	}
+	query.Where(predicate.OrderStatusHistory(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.OrderStatusChangesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ChangedBy
 		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_vouchers" is nil for node %v`, n.ID)
+			return fmt.Errorf(`foreign-key "changed_by" is nil for node %v`, n.ID)
 		}
 		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_vouchers" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "changed_by" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadTokens(ctx context.Context, query *UserTokenQuery, nodes []*User, init func(*User), assign func(*User, *UserToken)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(usertoken.FieldUserID)
+	}
+	query.Where(predicate.UserToken(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.TokensColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadRecoveryCodes(ctx context.Context, query *RecoveryCodeQuery, nodes []*User, init func(*User), assign func(*User, *RecoveryCode)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(recoverycode.FieldUserID)
+	}
+	query.Where(predicate.RecoveryCode(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.RecoveryCodesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadAPIKeys(ctx context.Context, query *APIKeyQuery, nodes []*User, init func(*User), assign func(*User, *APIKey)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(apikey.FieldUserID)
+	}
+	query.Where(predicate.APIKey(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.APIKeysColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadIdentities(ctx context.Context, query *UserIdentityQuery, nodes []*User, init func(*User), assign func(*User, *UserIdentity)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint64]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(useridentity.FieldUserID)
+	}
+	query.Where(predicate.UserIdentity(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.IdentitiesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -885,6 +1263,9 @@ func (uq *UserQuery) loadVouchers(ctx context.Context, query *UserVoucherQuery,
 
 func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := uq.querySpec()
+	if len(uq.modifiers) > 0 {
+		_spec.Modifiers = uq.modifiers
+	}
 	_spec.Node.Columns = uq.ctx.Fields
 	if len(uq.ctx.Fields) > 0 {
 		_spec.Unique = uq.ctx.Unique != nil && *uq.ctx.Unique
@@ -947,6 +1328,9 @@ func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if uq.ctx.Unique != nil && *uq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range uq.modifiers {
+		m(selector)
+	}
 	for _, p := range uq.predicates {
 		p(selector)
 	}
@@ -964,6 +1348,32 @@ func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (uq *UserQuery) ForUpdate(opts ...sql.LockOption) *UserQuery {
+	if uq.driver.Dialect() == dialect.Postgres {
+		uq.Unique(false)
+	}
+	uq.modifiers = append(uq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return uq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (uq *UserQuery) ForShare(opts ...sql.LockOption) *UserQuery {
+	if uq.driver.Dialect() == dialect.Postgres {
+		uq.Unique(false)
+	}
+	uq.modifiers = append(uq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return uq
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	selector
@@ -8,6 +8,7 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -26,6 +27,7 @@ type UserPermissionQuery struct {
 	predicates     []predicate.UserPermission
 	withUser       *UserQuery
 	withPermission *PermissionQuery
+	modifiers      []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -420,6 +422,9 @@ func (upq *UserPermissionQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(upq.modifiers) > 0 {
+		_spec.Modifiers = upq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -505,6 +510,9 @@ func (upq *UserPermissionQuery) loadPermission(ctx context.Context, query *Permi
 
 func (upq *UserPermissionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := upq.querySpec()
+	if len(upq.modifiers) > 0 {
+		_spec.Modifiers = upq.modifiers
+	}
 	_spec.Node.Columns = upq.ctx.Fields
 	if len(upq.ctx.Fields) > 0 {
 		_spec.Unique = upq.ctx.Unique != nil && *upq.ctx.Unique
@@ -573,6 +581,9 @@ func (upq *UserPermissionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if upq.ctx.Unique != nil && *upq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range upq.modifiers {
+		m(selector)
+	}
 	for _, p := range upq.predicates {
 		p(selector)
 	}
@@ -590,6 +601,32 @@ func (upq *UserPermissionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (upq *UserPermissionQuery) ForUpdate(opts ...sql.LockOption) *UserPermissionQuery {
+	if upq.driver.Dialect() == dialect.Postgres {
+		upq.Unique(false)
+	}
+	upq.modifiers = append(upq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return upq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (upq *UserPermissionQuery) ForShare(opts ...sql.LockOption) *UserPermissionQuery {
+	if upq.driver.Dialect() == dialect.Postgres {
+		upq.Unique(false)
+	}
+	upq.modifiers = append(upq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return upq
+}
+
 // UserPermissionGroupBy is the group-by builder for UserPermission entities.
 type UserPermissionGroupBy struct {
 	selector
@@ -19,16 +19,18 @@ type UserVoucher struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uint64 `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uint64 `json:"user_id,omitempty"`
+	// DiscountID holds the value of the "discount_id" field.
+	DiscountID uint64 `json:"discount_id,omitempty"`
 	// IsUsed holds the value of the "is_used" field.
 	IsUsed bool `json:"is_used,omitempty"`
 	// UsedAt holds the value of the "used_at" field.
-	UsedAt time.Time `json:"used_at,omitempty"`
+	UsedAt *time.Time `json:"used_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserVoucherQuery when eager-loading is set.
-	Edges                  UserVoucherEdges `json:"edges"`
-	discount_user_vouchers *uint64
-	user_vouchers          *uint64
-	selectValues           sql.SelectValues
+	Edges        UserVoucherEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // UserVoucherEdges holds the relations/edges for other nodes in the graph.
@@ -71,14 +73,10 @@ func (*UserVoucher) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case uservoucher.FieldIsUsed:
 			values[i] = new(sql.NullBool)
-		case uservoucher.FieldID:
+		case uservoucher.FieldID, uservoucher.FieldUserID, uservoucher.FieldDiscountID:
 			values[i] = new(sql.NullInt64)
 		case uservoucher.FieldUsedAt:
 			values[i] = new(sql.NullTime)
-		case uservoucher.ForeignKeys[0]: // discount_user_vouchers
-			values[i] = new(sql.NullInt64)
-		case uservoucher.ForeignKeys[1]: // user_vouchers
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -100,6 +98,18 @@ func (uv *UserVoucher) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			uv.ID = uint64(value.Int64)
+		case uservoucher.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				uv.UserID = uint64(value.Int64)
+			}
+		case uservoucher.FieldDiscountID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_id", values[i])
+			} else if value.Valid {
+				uv.DiscountID = uint64(value.Int64)
+			}
 		case uservoucher.FieldIsUsed:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_used", values[i])
@@ -110,21 +120,8 @@ func (uv *UserVoucher) assignValues(columns []string, values []any) error {
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field used_at", values[i])
 			} else if value.Valid {
-				uv.UsedAt = value.Time
-			}
-		case uservoucher.ForeignKeys[0]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field discount_user_vouchers", value)
-			} else if value.Valid {
-				uv.discount_user_vouchers = new(uint64)
-				*uv.discount_user_vouchers = uint64(value.Int64)
-			}
-		case uservoucher.ForeignKeys[1]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_vouchers", value)
-			} else if value.Valid {
-				uv.user_vouchers = new(uint64)
-				*uv.user_vouchers = uint64(value.Int64)
+				uv.UsedAt = new(time.Time)
+				*uv.UsedAt = value.Time
 			}
 		default:
 			uv.selectValues.Set(columns[i], values[i])
@@ -172,11 +169,19 @@ func (uv *UserVoucher) String() string {
 	var builder strings.Builder
 	builder.WriteString("UserVoucher(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", uv.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", uv.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("discount_id=")
+	builder.WriteString(fmt.Sprintf("%v", uv.DiscountID))
+	builder.WriteString(", ")
 	builder.WriteString("is_used=")
 	builder.WriteString(fmt.Sprintf("%v", uv.IsUsed))
 	builder.WriteString(", ")
-	builder.WriteString("used_at=")
-	builder.WriteString(uv.UsedAt.Format(time.ANSIC))
+	if v := uv.UsedAt; v != nil {
+		builder.WriteString("used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
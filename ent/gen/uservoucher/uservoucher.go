@@ -12,6 +12,10 @@ const (
 	Label = "user_voucher"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldDiscountID holds the string denoting the discount_id field in the database.
+	FieldDiscountID = "discount_id"
 	// FieldIsUsed holds the string denoting the is_used field in the database.
 	FieldIsUsed = "is_used"
 	// FieldUsedAt holds the string denoting the used_at field in the database.
@@ -28,30 +32,25 @@ const (
 	// It exists in this package in order to avoid circular dependency with the "user" package.
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
-	UserColumn = "user_vouchers"
+	UserColumn = "user_id"
 	// DiscountTable is the table that holds the discount relation/edge.
 	DiscountTable = "user_vouchers"
 	// DiscountInverseTable is the table name for the Discount entity.
 	// It exists in this package in order to avoid circular dependency with the "discount" package.
 	DiscountInverseTable = "discounts"
 	// DiscountColumn is the table column denoting the discount relation/edge.
-	DiscountColumn = "discount_user_vouchers"
+	DiscountColumn = "discount_id"
 )
 
 // Columns holds all SQL columns for uservoucher fields.
 var Columns = []string{
 	FieldID,
+	FieldUserID,
+	FieldDiscountID,
 	FieldIsUsed,
 	FieldUsedAt,
 }
 
-// ForeignKeys holds the SQL foreign-keys that are owned by the "user_vouchers"
-// table and are not defined as standalone fields in the schema.
-var ForeignKeys = []string{
-	"discount_user_vouchers",
-	"user_vouchers",
-}
-
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -59,11 +58,6 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
-	for i := range ForeignKeys {
-		if column == ForeignKeys[i] {
-			return true
-		}
-	}
 	return false
 }
 
@@ -80,6 +74,16 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByDiscountID orders the results by the discount_id field.
+func ByDiscountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscountID, opts...).ToFunc()
+}
+
 // ByIsUsed orders the results by the is_used field.
 func ByIsUsed(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsUsed, opts...).ToFunc()
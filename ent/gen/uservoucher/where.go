@@ -55,6 +55,16 @@ func IDLTE(id uint64) predicate.UserVoucher {
 	return predicate.UserVoucher(sql.FieldLTE(FieldID, id))
 }
 
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uint64) predicate.UserVoucher {
+	return predicate.UserVoucher(sql.FieldEQ(FieldUserID, v))
+}
+
+// DiscountID applies equality check predicate on the "discount_id" field. It's identical to DiscountIDEQ.
+func DiscountID(v uint64) predicate.UserVoucher {
+	return predicate.UserVoucher(sql.FieldEQ(FieldDiscountID, v))
+}
+
 // IsUsed applies equality check predicate on the "is_used" field. It's identical to IsUsedEQ.
 func IsUsed(v bool) predicate.UserVoucher {
 	return predicate.UserVoucher(sql.FieldEQ(FieldIsUsed, v))
@@ -65,6 +75,66 @@ func UsedAt(v time.Time) predicate.UserVoucher {
 	return predicate.UserVoucher(sql.FieldEQ(FieldUsedAt, v))
 }
 
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uint64) predicate.UserVoucher {
+	return predicate.UserVoucher(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uint64) predicate.UserVoucher {
+	return predicate.UserVoucher(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uint64) predicate.UserVoucher {
+	return predicate.UserVoucher(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uint64) predicate.UserVoucher {
+	return predicate.UserVoucher(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.UserVoucher {
+	return predicate.UserVoucher(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.UserVoucher {
+	return predicate.UserVoucher(sql.FieldNotNull(FieldUserID))
+}
+
+// DiscountIDEQ applies the EQ predicate on the "discount_id" field.
+func DiscountIDEQ(v uint64) predicate.UserVoucher {
+	return predicate.UserVoucher(sql.FieldEQ(FieldDiscountID, v))
+}
+
+// DiscountIDNEQ applies the NEQ predicate on the "discount_id" field.
+func DiscountIDNEQ(v uint64) predicate.UserVoucher {
+	return predicate.UserVoucher(sql.FieldNEQ(FieldDiscountID, v))
+}
+
+// DiscountIDIn applies the In predicate on the "discount_id" field.
+func DiscountIDIn(vs ...uint64) predicate.UserVoucher {
+	return predicate.UserVoucher(sql.FieldIn(FieldDiscountID, vs...))
+}
+
+// DiscountIDNotIn applies the NotIn predicate on the "discount_id" field.
+func DiscountIDNotIn(vs ...uint64) predicate.UserVoucher {
+	return predicate.UserVoucher(sql.FieldNotIn(FieldDiscountID, vs...))
+}
+
+// DiscountIDIsNil applies the IsNil predicate on the "discount_id" field.
+func DiscountIDIsNil() predicate.UserVoucher {
+	return predicate.UserVoucher(sql.FieldIsNull(FieldDiscountID))
+}
+
+// DiscountIDNotNil applies the NotNil predicate on the "discount_id" field.
+func DiscountIDNotNil() predicate.UserVoucher {
+	return predicate.UserVoucher(sql.FieldNotNull(FieldDiscountID))
+}
+
 // IsUsedEQ applies the EQ predicate on the "is_used" field.
 func IsUsedEQ(v bool) predicate.UserVoucher {
 	return predicate.UserVoucher(sql.FieldEQ(FieldIsUsed, v))
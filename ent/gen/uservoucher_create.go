@@ -22,6 +22,34 @@ type UserVoucherCreate struct {
 	hooks    []Hook
 }
 
+// SetUserID sets the "user_id" field.
+func (uvc *UserVoucherCreate) SetUserID(u uint64) *UserVoucherCreate {
+	uvc.mutation.SetUserID(u)
+	return uvc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (uvc *UserVoucherCreate) SetNillableUserID(u *uint64) *UserVoucherCreate {
+	if u != nil {
+		uvc.SetUserID(*u)
+	}
+	return uvc
+}
+
+// SetDiscountID sets the "discount_id" field.
+func (uvc *UserVoucherCreate) SetDiscountID(u uint64) *UserVoucherCreate {
+	uvc.mutation.SetDiscountID(u)
+	return uvc
+}
+
+// SetNillableDiscountID sets the "discount_id" field if the given value is not nil.
+func (uvc *UserVoucherCreate) SetNillableDiscountID(u *uint64) *UserVoucherCreate {
+	if u != nil {
+		uvc.SetDiscountID(*u)
+	}
+	return uvc
+}
+
 // SetIsUsed sets the "is_used" field.
 func (uvc *UserVoucherCreate) SetIsUsed(b bool) *UserVoucherCreate {
 	uvc.mutation.SetIsUsed(b)
@@ -56,39 +84,11 @@ func (uvc *UserVoucherCreate) SetID(u uint64) *UserVoucherCreate {
 	return uvc
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (uvc *UserVoucherCreate) SetUserID(id uint64) *UserVoucherCreate {
-	uvc.mutation.SetUserID(id)
-	return uvc
-}
-
-// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
-func (uvc *UserVoucherCreate) SetNillableUserID(id *uint64) *UserVoucherCreate {
-	if id != nil {
-		uvc = uvc.SetUserID(*id)
-	}
-	return uvc
-}
-
 // SetUser sets the "user" edge to the User entity.
 func (uvc *UserVoucherCreate) SetUser(u *User) *UserVoucherCreate {
 	return uvc.SetUserID(u.ID)
 }
 
-// SetDiscountID sets the "discount" edge to the Discount entity by ID.
-func (uvc *UserVoucherCreate) SetDiscountID(id uint64) *UserVoucherCreate {
-	uvc.mutation.SetDiscountID(id)
-	return uvc
-}
-
-// SetNillableDiscountID sets the "discount" edge to the Discount entity by ID if the given value is not nil.
-func (uvc *UserVoucherCreate) SetNillableDiscountID(id *uint64) *UserVoucherCreate {
-	if id != nil {
-		uvc = uvc.SetDiscountID(*id)
-	}
-	return uvc
-}
-
 // SetDiscount sets the "discount" edge to the Discount entity.
 func (uvc *UserVoucherCreate) SetDiscount(d *Discount) *UserVoucherCreate {
 	return uvc.SetDiscountID(d.ID)
@@ -178,7 +178,7 @@ func (uvc *UserVoucherCreate) createSpec() (*UserVoucher, *sqlgraph.CreateSpec)
 	}
 	if value, ok := uvc.mutation.UsedAt(); ok {
 		_spec.SetField(uservoucher.FieldUsedAt, field.TypeTime, value)
-		_node.UsedAt = value
+		_node.UsedAt = &value
 	}
 	if nodes := uvc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
@@ -194,7 +194,7 @@ func (uvc *UserVoucherCreate) createSpec() (*UserVoucher, *sqlgraph.CreateSpec)
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.user_vouchers = &nodes[0]
+		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := uvc.mutation.DiscountIDs(); len(nodes) > 0 {
@@ -211,7 +211,7 @@ func (uvc *UserVoucherCreate) createSpec() (*UserVoucher, *sqlgraph.CreateSpec)
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.discount_user_vouchers = &nodes[0]
+		_node.DiscountID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
@@ -8,6 +8,7 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -26,7 +27,7 @@ type UserVoucherQuery struct {
 	predicates   []predicate.UserVoucher
 	withUser     *UserQuery
 	withDiscount *DiscountQuery
-	withFKs      bool
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -335,12 +336,12 @@ func (uvq *UserVoucherQuery) WithDiscount(opts ...func(*DiscountQuery)) *UserVou
 // Example:
 //
 //	var v []struct {
-//		IsUsed bool `json:"is_used,omitempty"`
+//		UserID uint64 `json:"user_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.UserVoucher.Query().
-//		GroupBy(uservoucher.FieldIsUsed).
+//		GroupBy(uservoucher.FieldUserID).
 //		Aggregate(gen.Count()).
 //		Scan(ctx, &v)
 func (uvq *UserVoucherQuery) GroupBy(field string, fields ...string) *UserVoucherGroupBy {
@@ -358,11 +359,11 @@ func (uvq *UserVoucherQuery) GroupBy(field string, fields ...string) *UserVouche
 // Example:
 //
 //	var v []struct {
-//		IsUsed bool `json:"is_used,omitempty"`
+//		UserID uint64 `json:"user_id,omitempty"`
 //	}
 //
 //	client.UserVoucher.Query().
-//		Select(uservoucher.FieldIsUsed).
+//		Select(uservoucher.FieldUserID).
 //		Scan(ctx, &v)
 func (uvq *UserVoucherQuery) Select(fields ...string) *UserVoucherSelect {
 	uvq.ctx.Fields = append(uvq.ctx.Fields, fields...)
@@ -406,19 +407,12 @@ func (uvq *UserVoucherQuery) prepareQuery(ctx context.Context) error {
 func (uvq *UserVoucherQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UserVoucher, error) {
 	var (
 		nodes       = []*UserVoucher{}
-		withFKs     = uvq.withFKs
 		_spec       = uvq.querySpec()
 		loadedTypes = [2]bool{
 			uvq.withUser != nil,
 			uvq.withDiscount != nil,
 		}
 	)
-	if uvq.withUser != nil || uvq.withDiscount != nil {
-		withFKs = true
-	}
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, uservoucher.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*UserVoucher).scanValues(nil, columns)
 	}
@@ -428,6 +422,9 @@ func (uvq *UserVoucherQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(uvq.modifiers) > 0 {
+		_spec.Modifiers = uvq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -456,10 +453,7 @@ func (uvq *UserVoucherQuery) loadUser(ctx context.Context, query *UserQuery, nod
 	ids := make([]uint64, 0, len(nodes))
 	nodeids := make(map[uint64][]*UserVoucher)
 	for i := range nodes {
-		if nodes[i].user_vouchers == nil {
-			continue
-		}
-		fk := *nodes[i].user_vouchers
+		fk := nodes[i].UserID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -476,7 +470,7 @@ func (uvq *UserVoucherQuery) loadUser(ctx context.Context, query *UserQuery, nod
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "user_vouchers" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -488,10 +482,7 @@ func (uvq *UserVoucherQuery) loadDiscount(ctx context.Context, query *DiscountQu
 	ids := make([]uint64, 0, len(nodes))
 	nodeids := make(map[uint64][]*UserVoucher)
 	for i := range nodes {
-		if nodes[i].discount_user_vouchers == nil {
-			continue
-		}
-		fk := *nodes[i].discount_user_vouchers
+		fk := nodes[i].DiscountID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -508,7 +499,7 @@ func (uvq *UserVoucherQuery) loadDiscount(ctx context.Context, query *DiscountQu
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "discount_user_vouchers" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "discount_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -519,6 +510,9 @@ func (uvq *UserVoucherQuery) loadDiscount(ctx context.Context, query *DiscountQu
 
 func (uvq *UserVoucherQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := uvq.querySpec()
+	if len(uvq.modifiers) > 0 {
+		_spec.Modifiers = uvq.modifiers
+	}
 	_spec.Node.Columns = uvq.ctx.Fields
 	if len(uvq.ctx.Fields) > 0 {
 		_spec.Unique = uvq.ctx.Unique != nil && *uvq.ctx.Unique
@@ -542,6 +536,12 @@ func (uvq *UserVoucherQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if uvq.withUser != nil {
+			_spec.Node.AddColumnOnce(uservoucher.FieldUserID)
+		}
+		if uvq.withDiscount != nil {
+			_spec.Node.AddColumnOnce(uservoucher.FieldDiscountID)
+		}
 	}
 	if ps := uvq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -581,6 +581,9 @@ func (uvq *UserVoucherQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if uvq.ctx.Unique != nil && *uvq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range uvq.modifiers {
+		m(selector)
+	}
 	for _, p := range uvq.predicates {
 		p(selector)
 	}
@@ -598,6 +601,32 @@ func (uvq *UserVoucherQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (uvq *UserVoucherQuery) ForUpdate(opts ...sql.LockOption) *UserVoucherQuery {
+	if uvq.driver.Dialect() == dialect.Postgres {
+		uvq.Unique(false)
+	}
+	uvq.modifiers = append(uvq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return uvq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (uvq *UserVoucherQuery) ForShare(opts ...sql.LockOption) *UserVoucherQuery {
+	if uvq.driver.Dialect() == dialect.Postgres {
+		uvq.Unique(false)
+	}
+	uvq.modifiers = append(uvq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return uvq
+}
+
 // UserVoucherGroupBy is the group-by builder for UserVoucher entities.
 type UserVoucherGroupBy struct {
 	selector
@@ -30,6 +30,46 @@ func (uvu *UserVoucherUpdate) Where(ps ...predicate.UserVoucher) *UserVoucherUpd
 	return uvu
 }
 
+// SetUserID sets the "user_id" field.
+func (uvu *UserVoucherUpdate) SetUserID(u uint64) *UserVoucherUpdate {
+	uvu.mutation.SetUserID(u)
+	return uvu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (uvu *UserVoucherUpdate) SetNillableUserID(u *uint64) *UserVoucherUpdate {
+	if u != nil {
+		uvu.SetUserID(*u)
+	}
+	return uvu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (uvu *UserVoucherUpdate) ClearUserID() *UserVoucherUpdate {
+	uvu.mutation.ClearUserID()
+	return uvu
+}
+
+// SetDiscountID sets the "discount_id" field.
+func (uvu *UserVoucherUpdate) SetDiscountID(u uint64) *UserVoucherUpdate {
+	uvu.mutation.SetDiscountID(u)
+	return uvu
+}
+
+// SetNillableDiscountID sets the "discount_id" field if the given value is not nil.
+func (uvu *UserVoucherUpdate) SetNillableDiscountID(u *uint64) *UserVoucherUpdate {
+	if u != nil {
+		uvu.SetDiscountID(*u)
+	}
+	return uvu
+}
+
+// ClearDiscountID clears the value of the "discount_id" field.
+func (uvu *UserVoucherUpdate) ClearDiscountID() *UserVoucherUpdate {
+	uvu.mutation.ClearDiscountID()
+	return uvu
+}
+
 // SetIsUsed sets the "is_used" field.
 func (uvu *UserVoucherUpdate) SetIsUsed(b bool) *UserVoucherUpdate {
 	uvu.mutation.SetIsUsed(b)
@@ -64,39 +104,11 @@ func (uvu *UserVoucherUpdate) ClearUsedAt() *UserVoucherUpdate {
 	return uvu
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (uvu *UserVoucherUpdate) SetUserID(id uint64) *UserVoucherUpdate {
-	uvu.mutation.SetUserID(id)
-	return uvu
-}
-
-// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
-func (uvu *UserVoucherUpdate) SetNillableUserID(id *uint64) *UserVoucherUpdate {
-	if id != nil {
-		uvu = uvu.SetUserID(*id)
-	}
-	return uvu
-}
-
 // SetUser sets the "user" edge to the User entity.
 func (uvu *UserVoucherUpdate) SetUser(u *User) *UserVoucherUpdate {
 	return uvu.SetUserID(u.ID)
 }
 
-// SetDiscountID sets the "discount" edge to the Discount entity by ID.
-func (uvu *UserVoucherUpdate) SetDiscountID(id uint64) *UserVoucherUpdate {
-	uvu.mutation.SetDiscountID(id)
-	return uvu
-}
-
-// SetNillableDiscountID sets the "discount" edge to the Discount entity by ID if the given value is not nil.
-func (uvu *UserVoucherUpdate) SetNillableDiscountID(id *uint64) *UserVoucherUpdate {
-	if id != nil {
-		uvu = uvu.SetDiscountID(*id)
-	}
-	return uvu
-}
-
 // SetDiscount sets the "discount" edge to the Discount entity.
 func (uvu *UserVoucherUpdate) SetDiscount(d *Discount) *UserVoucherUpdate {
 	return uvu.SetDiscountID(d.ID)
@@ -242,6 +254,46 @@ type UserVoucherUpdateOne struct {
 	mutation *UserVoucherMutation
 }
 
+// SetUserID sets the "user_id" field.
+func (uvuo *UserVoucherUpdateOne) SetUserID(u uint64) *UserVoucherUpdateOne {
+	uvuo.mutation.SetUserID(u)
+	return uvuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (uvuo *UserVoucherUpdateOne) SetNillableUserID(u *uint64) *UserVoucherUpdateOne {
+	if u != nil {
+		uvuo.SetUserID(*u)
+	}
+	return uvuo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (uvuo *UserVoucherUpdateOne) ClearUserID() *UserVoucherUpdateOne {
+	uvuo.mutation.ClearUserID()
+	return uvuo
+}
+
+// SetDiscountID sets the "discount_id" field.
+func (uvuo *UserVoucherUpdateOne) SetDiscountID(u uint64) *UserVoucherUpdateOne {
+	uvuo.mutation.SetDiscountID(u)
+	return uvuo
+}
+
+// SetNillableDiscountID sets the "discount_id" field if the given value is not nil.
+func (uvuo *UserVoucherUpdateOne) SetNillableDiscountID(u *uint64) *UserVoucherUpdateOne {
+	if u != nil {
+		uvuo.SetDiscountID(*u)
+	}
+	return uvuo
+}
+
+// ClearDiscountID clears the value of the "discount_id" field.
+func (uvuo *UserVoucherUpdateOne) ClearDiscountID() *UserVoucherUpdateOne {
+	uvuo.mutation.ClearDiscountID()
+	return uvuo
+}
+
 // SetIsUsed sets the "is_used" field.
 func (uvuo *UserVoucherUpdateOne) SetIsUsed(b bool) *UserVoucherUpdateOne {
 	uvuo.mutation.SetIsUsed(b)
@@ -276,39 +328,11 @@ func (uvuo *UserVoucherUpdateOne) ClearUsedAt() *UserVoucherUpdateOne {
 	return uvuo
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (uvuo *UserVoucherUpdateOne) SetUserID(id uint64) *UserVoucherUpdateOne {
-	uvuo.mutation.SetUserID(id)
-	return uvuo
-}
-
-// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
-func (uvuo *UserVoucherUpdateOne) SetNillableUserID(id *uint64) *UserVoucherUpdateOne {
-	if id != nil {
-		uvuo = uvuo.SetUserID(*id)
-	}
-	return uvuo
-}
-
 // SetUser sets the "user" edge to the User entity.
 func (uvuo *UserVoucherUpdateOne) SetUser(u *User) *UserVoucherUpdateOne {
 	return uvuo.SetUserID(u.ID)
 }
 
-// SetDiscountID sets the "discount" edge to the Discount entity by ID.
-func (uvuo *UserVoucherUpdateOne) SetDiscountID(id uint64) *UserVoucherUpdateOne {
-	uvuo.mutation.SetDiscountID(id)
-	return uvuo
-}
-
-// SetNillableDiscountID sets the "discount" edge to the Discount entity by ID if the given value is not nil.
-func (uvuo *UserVoucherUpdateOne) SetNillableDiscountID(id *uint64) *UserVoucherUpdateOne {
-	if id != nil {
-		uvuo = uvuo.SetDiscountID(*id)
-	}
-	return uvuo
-}
-
 // SetDiscount sets the "discount" edge to the Discount entity.
 func (uvuo *UserVoucherUpdateOne) SetDiscount(d *Discount) *UserVoucherUpdateOne {
 	return uvuo.SetDiscountID(d.ID)
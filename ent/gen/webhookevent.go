@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/thang1834/go-goss/ent/gen/webhookevent"
+)
+
+// WebhookEvent is the model entity for the WebhookEvent schema.
+type WebhookEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider string `json:"provider,omitempty"`
+	// EventID holds the value of the "event_id" field.
+	EventID string `json:"event_id,omitempty"`
+	// TransactionID holds the value of the "transaction_id" field.
+	TransactionID string `json:"transaction_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount float64 `json:"amount,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload string `json:"payload,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// ReceivedAt holds the value of the "received_at" field.
+	ReceivedAt time.Time `json:"received_at,omitempty"`
+	// ProcessedAt holds the value of the "processed_at" field.
+	ProcessedAt  *time.Time `json:"processed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebhookEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webhookevent.FieldAmount:
+			values[i] = new(sql.NullFloat64)
+		case webhookevent.FieldID, webhookevent.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case webhookevent.FieldProvider, webhookevent.FieldEventID, webhookevent.FieldTransactionID, webhookevent.FieldStatus, webhookevent.FieldPayload, webhookevent.FieldLastError:
+			values[i] = new(sql.NullString)
+		case webhookevent.FieldReceivedAt, webhookevent.FieldProcessedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebhookEvent fields.
+func (we *WebhookEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webhookevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			we.ID = uint64(value.Int64)
+		case webhookevent.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				we.Provider = value.String
+			}
+		case webhookevent.FieldEventID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value.Valid {
+				we.EventID = value.String
+			}
+		case webhookevent.FieldTransactionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_id", values[i])
+			} else if value.Valid {
+				we.TransactionID = value.String
+			}
+		case webhookevent.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				we.Status = value.String
+			}
+		case webhookevent.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				we.Amount = value.Float64
+			}
+		case webhookevent.FieldPayload:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value.Valid {
+				we.Payload = value.String
+			}
+		case webhookevent.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				we.Attempts = int(value.Int64)
+			}
+		case webhookevent.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				we.LastError = value.String
+			}
+		case webhookevent.FieldReceivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field received_at", values[i])
+			} else if value.Valid {
+				we.ReceivedAt = value.Time
+			}
+		case webhookevent.FieldProcessedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field processed_at", values[i])
+			} else if value.Valid {
+				we.ProcessedAt = new(time.Time)
+				*we.ProcessedAt = value.Time
+			}
+		default:
+			we.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WebhookEvent.
+// This includes values selected through modifiers, order, etc.
+func (we *WebhookEvent) Value(name string) (ent.Value, error) {
+	return we.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WebhookEvent.
+// Note that you need to call WebhookEvent.Unwrap() before calling this method if this WebhookEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (we *WebhookEvent) Update() *WebhookEventUpdateOne {
+	return NewWebhookEventClient(we.config).UpdateOne(we)
+}
+
+// Unwrap unwraps the WebhookEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (we *WebhookEvent) Unwrap() *WebhookEvent {
+	_tx, ok := we.config.driver.(*txDriver)
+	if !ok {
+		panic("gen: WebhookEvent is not a transactional entity")
+	}
+	we.config.driver = _tx.drv
+	return we
+}
+
+// String implements the fmt.Stringer.
+func (we *WebhookEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebhookEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", we.ID))
+	builder.WriteString("provider=")
+	builder.WriteString(we.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("event_id=")
+	builder.WriteString(we.EventID)
+	builder.WriteString(", ")
+	builder.WriteString("transaction_id=")
+	builder.WriteString(we.TransactionID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(we.Status)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", we.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(we.Payload)
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", we.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(we.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("received_at=")
+	builder.WriteString(we.ReceivedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := we.ProcessedAt; v != nil {
+		builder.WriteString("processed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebhookEvents is a parsable slice of WebhookEvent.
+type WebhookEvents []*WebhookEvent
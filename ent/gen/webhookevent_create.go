@@ -0,0 +1,388 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/thang1834/go-goss/ent/gen/webhookevent"
+)
+
+// WebhookEventCreate is the builder for creating a WebhookEvent entity.
+type WebhookEventCreate struct {
+	config
+	mutation *WebhookEventMutation
+	hooks    []Hook
+}
+
+// SetProvider sets the "provider" field.
+func (wec *WebhookEventCreate) SetProvider(s string) *WebhookEventCreate {
+	wec.mutation.SetProvider(s)
+	return wec
+}
+
+// SetEventID sets the "event_id" field.
+func (wec *WebhookEventCreate) SetEventID(s string) *WebhookEventCreate {
+	wec.mutation.SetEventID(s)
+	return wec
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (wec *WebhookEventCreate) SetTransactionID(s string) *WebhookEventCreate {
+	wec.mutation.SetTransactionID(s)
+	return wec
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (wec *WebhookEventCreate) SetNillableTransactionID(s *string) *WebhookEventCreate {
+	if s != nil {
+		wec.SetTransactionID(*s)
+	}
+	return wec
+}
+
+// SetStatus sets the "status" field.
+func (wec *WebhookEventCreate) SetStatus(s string) *WebhookEventCreate {
+	wec.mutation.SetStatus(s)
+	return wec
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wec *WebhookEventCreate) SetNillableStatus(s *string) *WebhookEventCreate {
+	if s != nil {
+		wec.SetStatus(*s)
+	}
+	return wec
+}
+
+// SetAmount sets the "amount" field.
+func (wec *WebhookEventCreate) SetAmount(f float64) *WebhookEventCreate {
+	wec.mutation.SetAmount(f)
+	return wec
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (wec *WebhookEventCreate) SetNillableAmount(f *float64) *WebhookEventCreate {
+	if f != nil {
+		wec.SetAmount(*f)
+	}
+	return wec
+}
+
+// SetPayload sets the "payload" field.
+func (wec *WebhookEventCreate) SetPayload(s string) *WebhookEventCreate {
+	wec.mutation.SetPayload(s)
+	return wec
+}
+
+// SetAttempts sets the "attempts" field.
+func (wec *WebhookEventCreate) SetAttempts(i int) *WebhookEventCreate {
+	wec.mutation.SetAttempts(i)
+	return wec
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wec *WebhookEventCreate) SetNillableAttempts(i *int) *WebhookEventCreate {
+	if i != nil {
+		wec.SetAttempts(*i)
+	}
+	return wec
+}
+
+// SetLastError sets the "last_error" field.
+func (wec *WebhookEventCreate) SetLastError(s string) *WebhookEventCreate {
+	wec.mutation.SetLastError(s)
+	return wec
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (wec *WebhookEventCreate) SetNillableLastError(s *string) *WebhookEventCreate {
+	if s != nil {
+		wec.SetLastError(*s)
+	}
+	return wec
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (wec *WebhookEventCreate) SetReceivedAt(t time.Time) *WebhookEventCreate {
+	wec.mutation.SetReceivedAt(t)
+	return wec
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (wec *WebhookEventCreate) SetNillableReceivedAt(t *time.Time) *WebhookEventCreate {
+	if t != nil {
+		wec.SetReceivedAt(*t)
+	}
+	return wec
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (wec *WebhookEventCreate) SetProcessedAt(t time.Time) *WebhookEventCreate {
+	wec.mutation.SetProcessedAt(t)
+	return wec
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (wec *WebhookEventCreate) SetNillableProcessedAt(t *time.Time) *WebhookEventCreate {
+	if t != nil {
+		wec.SetProcessedAt(*t)
+	}
+	return wec
+}
+
+// SetID sets the "id" field.
+func (wec *WebhookEventCreate) SetID(u uint64) *WebhookEventCreate {
+	wec.mutation.SetID(u)
+	return wec
+}
+
+// Mutation returns the WebhookEventMutation object of the builder.
+func (wec *WebhookEventCreate) Mutation() *WebhookEventMutation {
+	return wec.mutation
+}
+
+// Save creates the WebhookEvent in the database.
+func (wec *WebhookEventCreate) Save(ctx context.Context) (*WebhookEvent, error) {
+	wec.defaults()
+	return withHooks(ctx, wec.sqlSave, wec.mutation, wec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wec *WebhookEventCreate) SaveX(ctx context.Context) *WebhookEvent {
+	v, err := wec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wec *WebhookEventCreate) Exec(ctx context.Context) error {
+	_, err := wec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wec *WebhookEventCreate) ExecX(ctx context.Context) {
+	if err := wec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wec *WebhookEventCreate) defaults() {
+	if _, ok := wec.mutation.Attempts(); !ok {
+		v := webhookevent.DefaultAttempts
+		wec.mutation.SetAttempts(v)
+	}
+	if _, ok := wec.mutation.ReceivedAt(); !ok {
+		v := webhookevent.DefaultReceivedAt()
+		wec.mutation.SetReceivedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wec *WebhookEventCreate) check() error {
+	if _, ok := wec.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`gen: missing required field "WebhookEvent.provider"`)}
+	}
+	if v, ok := wec.mutation.Provider(); ok {
+		if err := webhookevent.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`gen: validator failed for field "WebhookEvent.provider": %w`, err)}
+		}
+	}
+	if _, ok := wec.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`gen: missing required field "WebhookEvent.event_id"`)}
+	}
+	if v, ok := wec.mutation.EventID(); ok {
+		if err := webhookevent.EventIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_id", err: fmt.Errorf(`gen: validator failed for field "WebhookEvent.event_id": %w`, err)}
+		}
+	}
+	if v, ok := wec.mutation.TransactionID(); ok {
+		if err := webhookevent.TransactionIDValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_id", err: fmt.Errorf(`gen: validator failed for field "WebhookEvent.transaction_id": %w`, err)}
+		}
+	}
+	if v, ok := wec.mutation.Status(); ok {
+		if err := webhookevent.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`gen: validator failed for field "WebhookEvent.status": %w`, err)}
+		}
+	}
+	if _, ok := wec.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`gen: missing required field "WebhookEvent.payload"`)}
+	}
+	if _, ok := wec.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`gen: missing required field "WebhookEvent.attempts"`)}
+	}
+	if _, ok := wec.mutation.ReceivedAt(); !ok {
+		return &ValidationError{Name: "received_at", err: errors.New(`gen: missing required field "WebhookEvent.received_at"`)}
+	}
+	return nil
+}
+
+func (wec *WebhookEventCreate) sqlSave(ctx context.Context) (*WebhookEvent, error) {
+	if err := wec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	wec.mutation.id = &_node.ID
+	wec.mutation.done = true
+	return _node, nil
+}
+
+func (wec *WebhookEventCreate) createSpec() (*WebhookEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookEvent{config: wec.config}
+		_spec = sqlgraph.NewCreateSpec(webhookevent.Table, sqlgraph.NewFieldSpec(webhookevent.FieldID, field.TypeUint64))
+	)
+	if id, ok := wec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wec.mutation.Provider(); ok {
+		_spec.SetField(webhookevent.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := wec.mutation.EventID(); ok {
+		_spec.SetField(webhookevent.FieldEventID, field.TypeString, value)
+		_node.EventID = value
+	}
+	if value, ok := wec.mutation.TransactionID(); ok {
+		_spec.SetField(webhookevent.FieldTransactionID, field.TypeString, value)
+		_node.TransactionID = value
+	}
+	if value, ok := wec.mutation.Status(); ok {
+		_spec.SetField(webhookevent.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := wec.mutation.Amount(); ok {
+		_spec.SetField(webhookevent.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := wec.mutation.Payload(); ok {
+		_spec.SetField(webhookevent.FieldPayload, field.TypeString, value)
+		_node.Payload = value
+	}
+	if value, ok := wec.mutation.Attempts(); ok {
+		_spec.SetField(webhookevent.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := wec.mutation.LastError(); ok {
+		_spec.SetField(webhookevent.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := wec.mutation.ReceivedAt(); ok {
+		_spec.SetField(webhookevent.FieldReceivedAt, field.TypeTime, value)
+		_node.ReceivedAt = value
+	}
+	if value, ok := wec.mutation.ProcessedAt(); ok {
+		_spec.SetField(webhookevent.FieldProcessedAt, field.TypeTime, value)
+		_node.ProcessedAt = &value
+	}
+	return _node, _spec
+}
+
+// WebhookEventCreateBulk is the builder for creating many WebhookEvent entities in bulk.
+type WebhookEventCreateBulk struct {
+	config
+	err      error
+	builders []*WebhookEventCreate
+}
+
+// Save creates the WebhookEvent entities in the database.
+func (wecb *WebhookEventCreateBulk) Save(ctx context.Context) ([]*WebhookEvent, error) {
+	if wecb.err != nil {
+		return nil, wecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wecb.builders))
+	nodes := make([]*WebhookEvent, len(wecb.builders))
+	mutators := make([]Mutator, len(wecb.builders))
+	for i := range wecb.builders {
+		func(i int, root context.Context) {
+			builder := wecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wecb *WebhookEventCreateBulk) SaveX(ctx context.Context) []*WebhookEvent {
+	v, err := wecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wecb *WebhookEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := wecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wecb *WebhookEventCreateBulk) ExecX(ctx context.Context) {
+	if err := wecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,702 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/thang1834/go-goss/ent/gen/predicate"
+	"github.com/thang1834/go-goss/ent/gen/webhookevent"
+)
+
+// WebhookEventUpdate is the builder for updating WebhookEvent entities.
+type WebhookEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookEventMutation
+}
+
+// Where appends a list predicates to the WebhookEventUpdate builder.
+func (weu *WebhookEventUpdate) Where(ps ...predicate.WebhookEvent) *WebhookEventUpdate {
+	weu.mutation.Where(ps...)
+	return weu
+}
+
+// SetProvider sets the "provider" field.
+func (weu *WebhookEventUpdate) SetProvider(s string) *WebhookEventUpdate {
+	weu.mutation.SetProvider(s)
+	return weu
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (weu *WebhookEventUpdate) SetNillableProvider(s *string) *WebhookEventUpdate {
+	if s != nil {
+		weu.SetProvider(*s)
+	}
+	return weu
+}
+
+// SetEventID sets the "event_id" field.
+func (weu *WebhookEventUpdate) SetEventID(s string) *WebhookEventUpdate {
+	weu.mutation.SetEventID(s)
+	return weu
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (weu *WebhookEventUpdate) SetNillableEventID(s *string) *WebhookEventUpdate {
+	if s != nil {
+		weu.SetEventID(*s)
+	}
+	return weu
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (weu *WebhookEventUpdate) SetTransactionID(s string) *WebhookEventUpdate {
+	weu.mutation.SetTransactionID(s)
+	return weu
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (weu *WebhookEventUpdate) SetNillableTransactionID(s *string) *WebhookEventUpdate {
+	if s != nil {
+		weu.SetTransactionID(*s)
+	}
+	return weu
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (weu *WebhookEventUpdate) ClearTransactionID() *WebhookEventUpdate {
+	weu.mutation.ClearTransactionID()
+	return weu
+}
+
+// SetStatus sets the "status" field.
+func (weu *WebhookEventUpdate) SetStatus(s string) *WebhookEventUpdate {
+	weu.mutation.SetStatus(s)
+	return weu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (weu *WebhookEventUpdate) SetNillableStatus(s *string) *WebhookEventUpdate {
+	if s != nil {
+		weu.SetStatus(*s)
+	}
+	return weu
+}
+
+// ClearStatus clears the value of the "status" field.
+func (weu *WebhookEventUpdate) ClearStatus() *WebhookEventUpdate {
+	weu.mutation.ClearStatus()
+	return weu
+}
+
+// SetAmount sets the "amount" field.
+func (weu *WebhookEventUpdate) SetAmount(f float64) *WebhookEventUpdate {
+	weu.mutation.ResetAmount()
+	weu.mutation.SetAmount(f)
+	return weu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (weu *WebhookEventUpdate) SetNillableAmount(f *float64) *WebhookEventUpdate {
+	if f != nil {
+		weu.SetAmount(*f)
+	}
+	return weu
+}
+
+// AddAmount adds f to the "amount" field.
+func (weu *WebhookEventUpdate) AddAmount(f float64) *WebhookEventUpdate {
+	weu.mutation.AddAmount(f)
+	return weu
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (weu *WebhookEventUpdate) ClearAmount() *WebhookEventUpdate {
+	weu.mutation.ClearAmount()
+	return weu
+}
+
+// SetPayload sets the "payload" field.
+func (weu *WebhookEventUpdate) SetPayload(s string) *WebhookEventUpdate {
+	weu.mutation.SetPayload(s)
+	return weu
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (weu *WebhookEventUpdate) SetNillablePayload(s *string) *WebhookEventUpdate {
+	if s != nil {
+		weu.SetPayload(*s)
+	}
+	return weu
+}
+
+// SetAttempts sets the "attempts" field.
+func (weu *WebhookEventUpdate) SetAttempts(i int) *WebhookEventUpdate {
+	weu.mutation.ResetAttempts()
+	weu.mutation.SetAttempts(i)
+	return weu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (weu *WebhookEventUpdate) SetNillableAttempts(i *int) *WebhookEventUpdate {
+	if i != nil {
+		weu.SetAttempts(*i)
+	}
+	return weu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (weu *WebhookEventUpdate) AddAttempts(i int) *WebhookEventUpdate {
+	weu.mutation.AddAttempts(i)
+	return weu
+}
+
+// SetLastError sets the "last_error" field.
+func (weu *WebhookEventUpdate) SetLastError(s string) *WebhookEventUpdate {
+	weu.mutation.SetLastError(s)
+	return weu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (weu *WebhookEventUpdate) SetNillableLastError(s *string) *WebhookEventUpdate {
+	if s != nil {
+		weu.SetLastError(*s)
+	}
+	return weu
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (weu *WebhookEventUpdate) ClearLastError() *WebhookEventUpdate {
+	weu.mutation.ClearLastError()
+	return weu
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (weu *WebhookEventUpdate) SetReceivedAt(t time.Time) *WebhookEventUpdate {
+	weu.mutation.SetReceivedAt(t)
+	return weu
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (weu *WebhookEventUpdate) SetNillableReceivedAt(t *time.Time) *WebhookEventUpdate {
+	if t != nil {
+		weu.SetReceivedAt(*t)
+	}
+	return weu
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (weu *WebhookEventUpdate) SetProcessedAt(t time.Time) *WebhookEventUpdate {
+	weu.mutation.SetProcessedAt(t)
+	return weu
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (weu *WebhookEventUpdate) SetNillableProcessedAt(t *time.Time) *WebhookEventUpdate {
+	if t != nil {
+		weu.SetProcessedAt(*t)
+	}
+	return weu
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (weu *WebhookEventUpdate) ClearProcessedAt() *WebhookEventUpdate {
+	weu.mutation.ClearProcessedAt()
+	return weu
+}
+
+// Mutation returns the WebhookEventMutation object of the builder.
+func (weu *WebhookEventUpdate) Mutation() *WebhookEventMutation {
+	return weu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (weu *WebhookEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, weu.sqlSave, weu.mutation, weu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (weu *WebhookEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := weu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (weu *WebhookEventUpdate) Exec(ctx context.Context) error {
+	_, err := weu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (weu *WebhookEventUpdate) ExecX(ctx context.Context) {
+	if err := weu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (weu *WebhookEventUpdate) check() error {
+	if v, ok := weu.mutation.Provider(); ok {
+		if err := webhookevent.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`gen: validator failed for field "WebhookEvent.provider": %w`, err)}
+		}
+	}
+	if v, ok := weu.mutation.EventID(); ok {
+		if err := webhookevent.EventIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_id", err: fmt.Errorf(`gen: validator failed for field "WebhookEvent.event_id": %w`, err)}
+		}
+	}
+	if v, ok := weu.mutation.TransactionID(); ok {
+		if err := webhookevent.TransactionIDValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_id", err: fmt.Errorf(`gen: validator failed for field "WebhookEvent.transaction_id": %w`, err)}
+		}
+	}
+	if v, ok := weu.mutation.Status(); ok {
+		if err := webhookevent.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`gen: validator failed for field "WebhookEvent.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (weu *WebhookEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := weu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookevent.Table, webhookevent.Columns, sqlgraph.NewFieldSpec(webhookevent.FieldID, field.TypeUint64))
+	if ps := weu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := weu.mutation.Provider(); ok {
+		_spec.SetField(webhookevent.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := weu.mutation.EventID(); ok {
+		_spec.SetField(webhookevent.FieldEventID, field.TypeString, value)
+	}
+	if value, ok := weu.mutation.TransactionID(); ok {
+		_spec.SetField(webhookevent.FieldTransactionID, field.TypeString, value)
+	}
+	if weu.mutation.TransactionIDCleared() {
+		_spec.ClearField(webhookevent.FieldTransactionID, field.TypeString)
+	}
+	if value, ok := weu.mutation.Status(); ok {
+		_spec.SetField(webhookevent.FieldStatus, field.TypeString, value)
+	}
+	if weu.mutation.StatusCleared() {
+		_spec.ClearField(webhookevent.FieldStatus, field.TypeString)
+	}
+	if value, ok := weu.mutation.Amount(); ok {
+		_spec.SetField(webhookevent.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := weu.mutation.AddedAmount(); ok {
+		_spec.AddField(webhookevent.FieldAmount, field.TypeFloat64, value)
+	}
+	if weu.mutation.AmountCleared() {
+		_spec.ClearField(webhookevent.FieldAmount, field.TypeFloat64)
+	}
+	if value, ok := weu.mutation.Payload(); ok {
+		_spec.SetField(webhookevent.FieldPayload, field.TypeString, value)
+	}
+	if value, ok := weu.mutation.Attempts(); ok {
+		_spec.SetField(webhookevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := weu.mutation.AddedAttempts(); ok {
+		_spec.AddField(webhookevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := weu.mutation.LastError(); ok {
+		_spec.SetField(webhookevent.FieldLastError, field.TypeString, value)
+	}
+	if weu.mutation.LastErrorCleared() {
+		_spec.ClearField(webhookevent.FieldLastError, field.TypeString)
+	}
+	if value, ok := weu.mutation.ReceivedAt(); ok {
+		_spec.SetField(webhookevent.FieldReceivedAt, field.TypeTime, value)
+	}
+	if value, ok := weu.mutation.ProcessedAt(); ok {
+		_spec.SetField(webhookevent.FieldProcessedAt, field.TypeTime, value)
+	}
+	if weu.mutation.ProcessedAtCleared() {
+		_spec.ClearField(webhookevent.FieldProcessedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, weu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	weu.mutation.done = true
+	return n, nil
+}
+
+// WebhookEventUpdateOne is the builder for updating a single WebhookEvent entity.
+type WebhookEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebhookEventMutation
+}
+
+// SetProvider sets the "provider" field.
+func (weuo *WebhookEventUpdateOne) SetProvider(s string) *WebhookEventUpdateOne {
+	weuo.mutation.SetProvider(s)
+	return weuo
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (weuo *WebhookEventUpdateOne) SetNillableProvider(s *string) *WebhookEventUpdateOne {
+	if s != nil {
+		weuo.SetProvider(*s)
+	}
+	return weuo
+}
+
+// SetEventID sets the "event_id" field.
+func (weuo *WebhookEventUpdateOne) SetEventID(s string) *WebhookEventUpdateOne {
+	weuo.mutation.SetEventID(s)
+	return weuo
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (weuo *WebhookEventUpdateOne) SetNillableEventID(s *string) *WebhookEventUpdateOne {
+	if s != nil {
+		weuo.SetEventID(*s)
+	}
+	return weuo
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (weuo *WebhookEventUpdateOne) SetTransactionID(s string) *WebhookEventUpdateOne {
+	weuo.mutation.SetTransactionID(s)
+	return weuo
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (weuo *WebhookEventUpdateOne) SetNillableTransactionID(s *string) *WebhookEventUpdateOne {
+	if s != nil {
+		weuo.SetTransactionID(*s)
+	}
+	return weuo
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (weuo *WebhookEventUpdateOne) ClearTransactionID() *WebhookEventUpdateOne {
+	weuo.mutation.ClearTransactionID()
+	return weuo
+}
+
+// SetStatus sets the "status" field.
+func (weuo *WebhookEventUpdateOne) SetStatus(s string) *WebhookEventUpdateOne {
+	weuo.mutation.SetStatus(s)
+	return weuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (weuo *WebhookEventUpdateOne) SetNillableStatus(s *string) *WebhookEventUpdateOne {
+	if s != nil {
+		weuo.SetStatus(*s)
+	}
+	return weuo
+}
+
+// ClearStatus clears the value of the "status" field.
+func (weuo *WebhookEventUpdateOne) ClearStatus() *WebhookEventUpdateOne {
+	weuo.mutation.ClearStatus()
+	return weuo
+}
+
+// SetAmount sets the "amount" field.
+func (weuo *WebhookEventUpdateOne) SetAmount(f float64) *WebhookEventUpdateOne {
+	weuo.mutation.ResetAmount()
+	weuo.mutation.SetAmount(f)
+	return weuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (weuo *WebhookEventUpdateOne) SetNillableAmount(f *float64) *WebhookEventUpdateOne {
+	if f != nil {
+		weuo.SetAmount(*f)
+	}
+	return weuo
+}
+
+// AddAmount adds f to the "amount" field.
+func (weuo *WebhookEventUpdateOne) AddAmount(f float64) *WebhookEventUpdateOne {
+	weuo.mutation.AddAmount(f)
+	return weuo
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (weuo *WebhookEventUpdateOne) ClearAmount() *WebhookEventUpdateOne {
+	weuo.mutation.ClearAmount()
+	return weuo
+}
+
+// SetPayload sets the "payload" field.
+func (weuo *WebhookEventUpdateOne) SetPayload(s string) *WebhookEventUpdateOne {
+	weuo.mutation.SetPayload(s)
+	return weuo
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (weuo *WebhookEventUpdateOne) SetNillablePayload(s *string) *WebhookEventUpdateOne {
+	if s != nil {
+		weuo.SetPayload(*s)
+	}
+	return weuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (weuo *WebhookEventUpdateOne) SetAttempts(i int) *WebhookEventUpdateOne {
+	weuo.mutation.ResetAttempts()
+	weuo.mutation.SetAttempts(i)
+	return weuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (weuo *WebhookEventUpdateOne) SetNillableAttempts(i *int) *WebhookEventUpdateOne {
+	if i != nil {
+		weuo.SetAttempts(*i)
+	}
+	return weuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (weuo *WebhookEventUpdateOne) AddAttempts(i int) *WebhookEventUpdateOne {
+	weuo.mutation.AddAttempts(i)
+	return weuo
+}
+
+// SetLastError sets the "last_error" field.
+func (weuo *WebhookEventUpdateOne) SetLastError(s string) *WebhookEventUpdateOne {
+	weuo.mutation.SetLastError(s)
+	return weuo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (weuo *WebhookEventUpdateOne) SetNillableLastError(s *string) *WebhookEventUpdateOne {
+	if s != nil {
+		weuo.SetLastError(*s)
+	}
+	return weuo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (weuo *WebhookEventUpdateOne) ClearLastError() *WebhookEventUpdateOne {
+	weuo.mutation.ClearLastError()
+	return weuo
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (weuo *WebhookEventUpdateOne) SetReceivedAt(t time.Time) *WebhookEventUpdateOne {
+	weuo.mutation.SetReceivedAt(t)
+	return weuo
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (weuo *WebhookEventUpdateOne) SetNillableReceivedAt(t *time.Time) *WebhookEventUpdateOne {
+	if t != nil {
+		weuo.SetReceivedAt(*t)
+	}
+	return weuo
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (weuo *WebhookEventUpdateOne) SetProcessedAt(t time.Time) *WebhookEventUpdateOne {
+	weuo.mutation.SetProcessedAt(t)
+	return weuo
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (weuo *WebhookEventUpdateOne) SetNillableProcessedAt(t *time.Time) *WebhookEventUpdateOne {
+	if t != nil {
+		weuo.SetProcessedAt(*t)
+	}
+	return weuo
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (weuo *WebhookEventUpdateOne) ClearProcessedAt() *WebhookEventUpdateOne {
+	weuo.mutation.ClearProcessedAt()
+	return weuo
+}
+
+// Mutation returns the WebhookEventMutation object of the builder.
+func (weuo *WebhookEventUpdateOne) Mutation() *WebhookEventMutation {
+	return weuo.mutation
+}
+
+// Where appends a list predicates to the WebhookEventUpdate builder.
+func (weuo *WebhookEventUpdateOne) Where(ps ...predicate.WebhookEvent) *WebhookEventUpdateOne {
+	weuo.mutation.Where(ps...)
+	return weuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (weuo *WebhookEventUpdateOne) Select(field string, fields ...string) *WebhookEventUpdateOne {
+	weuo.fields = append([]string{field}, fields...)
+	return weuo
+}
+
+// Save executes the query and returns the updated WebhookEvent entity.
+func (weuo *WebhookEventUpdateOne) Save(ctx context.Context) (*WebhookEvent, error) {
+	return withHooks(ctx, weuo.sqlSave, weuo.mutation, weuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (weuo *WebhookEventUpdateOne) SaveX(ctx context.Context) *WebhookEvent {
+	node, err := weuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (weuo *WebhookEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := weuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (weuo *WebhookEventUpdateOne) ExecX(ctx context.Context) {
+	if err := weuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (weuo *WebhookEventUpdateOne) check() error {
+	if v, ok := weuo.mutation.Provider(); ok {
+		if err := webhookevent.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`gen: validator failed for field "WebhookEvent.provider": %w`, err)}
+		}
+	}
+	if v, ok := weuo.mutation.EventID(); ok {
+		if err := webhookevent.EventIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_id", err: fmt.Errorf(`gen: validator failed for field "WebhookEvent.event_id": %w`, err)}
+		}
+	}
+	if v, ok := weuo.mutation.TransactionID(); ok {
+		if err := webhookevent.TransactionIDValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_id", err: fmt.Errorf(`gen: validator failed for field "WebhookEvent.transaction_id": %w`, err)}
+		}
+	}
+	if v, ok := weuo.mutation.Status(); ok {
+		if err := webhookevent.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`gen: validator failed for field "WebhookEvent.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (weuo *WebhookEventUpdateOne) sqlSave(ctx context.Context) (_node *WebhookEvent, err error) {
+	if err := weuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookevent.Table, webhookevent.Columns, sqlgraph.NewFieldSpec(webhookevent.FieldID, field.TypeUint64))
+	id, ok := weuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`gen: missing "WebhookEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := weuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookevent.FieldID)
+		for _, f := range fields {
+			if !webhookevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("gen: invalid field %q for query", f)}
+			}
+			if f != webhookevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := weuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := weuo.mutation.Provider(); ok {
+		_spec.SetField(webhookevent.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := weuo.mutation.EventID(); ok {
+		_spec.SetField(webhookevent.FieldEventID, field.TypeString, value)
+	}
+	if value, ok := weuo.mutation.TransactionID(); ok {
+		_spec.SetField(webhookevent.FieldTransactionID, field.TypeString, value)
+	}
+	if weuo.mutation.TransactionIDCleared() {
+		_spec.ClearField(webhookevent.FieldTransactionID, field.TypeString)
+	}
+	if value, ok := weuo.mutation.Status(); ok {
+		_spec.SetField(webhookevent.FieldStatus, field.TypeString, value)
+	}
+	if weuo.mutation.StatusCleared() {
+		_spec.ClearField(webhookevent.FieldStatus, field.TypeString)
+	}
+	if value, ok := weuo.mutation.Amount(); ok {
+		_spec.SetField(webhookevent.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := weuo.mutation.AddedAmount(); ok {
+		_spec.AddField(webhookevent.FieldAmount, field.TypeFloat64, value)
+	}
+	if weuo.mutation.AmountCleared() {
+		_spec.ClearField(webhookevent.FieldAmount, field.TypeFloat64)
+	}
+	if value, ok := weuo.mutation.Payload(); ok {
+		_spec.SetField(webhookevent.FieldPayload, field.TypeString, value)
+	}
+	if value, ok := weuo.mutation.Attempts(); ok {
+		_spec.SetField(webhookevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := weuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(webhookevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := weuo.mutation.LastError(); ok {
+		_spec.SetField(webhookevent.FieldLastError, field.TypeString, value)
+	}
+	if weuo.mutation.LastErrorCleared() {
+		_spec.ClearField(webhookevent.FieldLastError, field.TypeString)
+	}
+	if value, ok := weuo.mutation.ReceivedAt(); ok {
+		_spec.SetField(webhookevent.FieldReceivedAt, field.TypeTime, value)
+	}
+	if value, ok := weuo.mutation.ProcessedAt(); ok {
+		_spec.SetField(webhookevent.FieldProcessedAt, field.TypeTime, value)
+	}
+	if weuo.mutation.ProcessedAtCleared() {
+		_spec.ClearField(webhookevent.FieldProcessedAt, field.TypeTime)
+	}
+	_node = &WebhookEvent{config: weuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, weuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	weuo.mutation.done = true
+	return _node, nil
+}
@@ -18,24 +18,27 @@ type Wishlist struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uint64 `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uint64 `json:"user_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the WishlistQuery when eager-loading is set.
-	Edges          WishlistEdges `json:"edges"`
-	user_wishlists *uint64
-	selectValues   sql.SelectValues
+	Edges        WishlistEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // WishlistEdges holds the relations/edges for other nodes in the graph.
 type WishlistEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// Products holds the value of the products edge.
+	Products []*Product `json:"products,omitempty"`
 	// Items holds the value of the items edge.
 	Items []*WishlistItem `json:"items,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -49,10 +52,19 @@ func (e WishlistEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// ProductsOrErr returns the Products value or an error if the edge
+// was not loaded in eager-loading.
+func (e WishlistEdges) ProductsOrErr() ([]*Product, error) {
+	if e.loadedTypes[1] {
+		return e.Products, nil
+	}
+	return nil, &NotLoadedError{edge: "products"}
+}
+
 // ItemsOrErr returns the Items value or an error if the edge
 // was not loaded in eager-loading.
 func (e WishlistEdges) ItemsOrErr() ([]*WishlistItem, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.Items, nil
 	}
 	return nil, &NotLoadedError{edge: "items"}
@@ -63,12 +75,10 @@ func (*Wishlist) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case wishlist.FieldID:
+		case wishlist.FieldID, wishlist.FieldUserID:
 			values[i] = new(sql.NullInt64)
 		case wishlist.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
-		case wishlist.ForeignKeys[0]: // user_wishlists
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -90,19 +100,18 @@ func (w *Wishlist) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			w.ID = uint64(value.Int64)
+		case wishlist.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				w.UserID = uint64(value.Int64)
+			}
 		case wishlist.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
 			} else if value.Valid {
 				w.CreatedAt = value.Time
 			}
-		case wishlist.ForeignKeys[0]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_wishlists", value)
-			} else if value.Valid {
-				w.user_wishlists = new(uint64)
-				*w.user_wishlists = uint64(value.Int64)
-			}
 		default:
 			w.selectValues.Set(columns[i], values[i])
 		}
@@ -121,6 +130,11 @@ func (w *Wishlist) QueryUser() *UserQuery {
 	return NewWishlistClient(w.config).QueryUser(w)
 }
 
+// QueryProducts queries the "products" edge of the Wishlist entity.
+func (w *Wishlist) QueryProducts() *ProductQuery {
+	return NewWishlistClient(w.config).QueryProducts(w)
+}
+
 // QueryItems queries the "items" edge of the Wishlist entity.
 func (w *Wishlist) QueryItems() *WishlistItemQuery {
 	return NewWishlistClient(w.config).QueryItems(w)
@@ -149,6 +163,9 @@ func (w *Wishlist) String() string {
 	var builder strings.Builder
 	builder.WriteString("Wishlist(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", w.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", w.UserID))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(w.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -10,9 +10,9 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/thang1834/go-goss/ent/gen/product"
 	"github.com/thang1834/go-goss/ent/gen/user"
 	"github.com/thang1834/go-goss/ent/gen/wishlist"
-	"github.com/thang1834/go-goss/ent/gen/wishlistitem"
 )
 
 // WishlistCreate is the builder for creating a Wishlist entity.
@@ -22,6 +22,20 @@ type WishlistCreate struct {
 	hooks    []Hook
 }
 
+// SetUserID sets the "user_id" field.
+func (wc *WishlistCreate) SetUserID(u uint64) *WishlistCreate {
+	wc.mutation.SetUserID(u)
+	return wc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (wc *WishlistCreate) SetNillableUserID(u *uint64) *WishlistCreate {
+	if u != nil {
+		wc.SetUserID(*u)
+	}
+	return wc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (wc *WishlistCreate) SetCreatedAt(t time.Time) *WishlistCreate {
 	wc.mutation.SetCreatedAt(t)
@@ -42,38 +56,24 @@ func (wc *WishlistCreate) SetID(u uint64) *WishlistCreate {
 	return wc
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (wc *WishlistCreate) SetUserID(id uint64) *WishlistCreate {
-	wc.mutation.SetUserID(id)
-	return wc
-}
-
-// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
-func (wc *WishlistCreate) SetNillableUserID(id *uint64) *WishlistCreate {
-	if id != nil {
-		wc = wc.SetUserID(*id)
-	}
-	return wc
-}
-
 // SetUser sets the "user" edge to the User entity.
 func (wc *WishlistCreate) SetUser(u *User) *WishlistCreate {
 	return wc.SetUserID(u.ID)
 }
 
-// AddItemIDs adds the "items" edge to the WishlistItem entity by IDs.
-func (wc *WishlistCreate) AddItemIDs(ids ...int) *WishlistCreate {
-	wc.mutation.AddItemIDs(ids...)
+// AddProductIDs adds the "products" edge to the Product entity by IDs.
+func (wc *WishlistCreate) AddProductIDs(ids ...uint64) *WishlistCreate {
+	wc.mutation.AddProductIDs(ids...)
 	return wc
 }
 
-// AddItems adds the "items" edges to the WishlistItem entity.
-func (wc *WishlistCreate) AddItems(w ...*WishlistItem) *WishlistCreate {
-	ids := make([]int, len(w))
-	for i := range w {
-		ids[i] = w[i].ID
+// AddProducts adds the "products" edges to the Product entity.
+func (wc *WishlistCreate) AddProducts(p ...*Product) *WishlistCreate {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	return wc.AddItemIDs(ids...)
+	return wc.AddProductIDs(ids...)
 }
 
 // Mutation returns the WishlistMutation object of the builder.
@@ -172,23 +172,27 @@ func (wc *WishlistCreate) createSpec() (*Wishlist, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.user_wishlists = &nodes[0]
+		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := wc.mutation.ItemsIDs(); len(nodes) > 0 {
+	if nodes := wc.mutation.ProductsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   wishlist.ItemsTable,
-			Columns: []string{wishlist.ItemsColumn},
+			Table:   wishlist.ProductsTable,
+			Columns: wishlist.ProductsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &WishlistItemCreate{config: wc.config, mutation: newWishlistItemMutation(wc.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
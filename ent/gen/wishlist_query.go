@@ -9,10 +9,12 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/thang1834/go-goss/ent/gen/predicate"
+	"github.com/thang1834/go-goss/ent/gen/product"
 	"github.com/thang1834/go-goss/ent/gen/user"
 	"github.com/thang1834/go-goss/ent/gen/wishlist"
 	"github.com/thang1834/go-goss/ent/gen/wishlistitem"
@@ -21,13 +23,14 @@ import (
 // WishlistQuery is the builder for querying Wishlist entities.
 type WishlistQuery struct {
 	config
-	ctx        *QueryContext
-	order      []wishlist.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Wishlist
-	withUser   *UserQuery
-	withItems  *WishlistItemQuery
-	withFKs    bool
+	ctx          *QueryContext
+	order        []wishlist.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.Wishlist
+	withUser     *UserQuery
+	withProducts *ProductQuery
+	withItems    *WishlistItemQuery
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -86,6 +89,28 @@ func (wq *WishlistQuery) QueryUser() *UserQuery {
 	return query
 }
 
+// QueryProducts chains the current query on the "products" edge.
+func (wq *WishlistQuery) QueryProducts() *ProductQuery {
+	query := (&ProductClient{config: wq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wishlist.Table, wishlist.FieldID, selector),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, wishlist.ProductsTable, wishlist.ProductsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(wq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryItems chains the current query on the "items" edge.
 func (wq *WishlistQuery) QueryItems() *WishlistItemQuery {
 	query := (&WishlistItemClient{config: wq.config}).Query()
@@ -99,8 +124,8 @@ func (wq *WishlistQuery) QueryItems() *WishlistItemQuery {
 		}
 		step := sqlgraph.NewStep(
 			sqlgraph.From(wishlist.Table, wishlist.FieldID, selector),
-			sqlgraph.To(wishlistitem.Table, wishlistitem.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, wishlist.ItemsTable, wishlist.ItemsColumn),
+			sqlgraph.To(wishlistitem.Table, wishlistitem.WishlistColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, wishlist.ItemsTable, wishlist.ItemsColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(wq.driver.Dialect(), step)
 		return fromU, nil
@@ -295,13 +320,14 @@ func (wq *WishlistQuery) Clone() *WishlistQuery {
 		return nil
 	}
 	return &WishlistQuery{
-		config:     wq.config,
-		ctx:        wq.ctx.Clone(),
-		order:      append([]wishlist.OrderOption{}, wq.order...),
-		inters:     append([]Interceptor{}, wq.inters...),
-		predicates: append([]predicate.Wishlist{}, wq.predicates...),
-		withUser:   wq.withUser.Clone(),
-		withItems:  wq.withItems.Clone(),
+		config:       wq.config,
+		ctx:          wq.ctx.Clone(),
+		order:        append([]wishlist.OrderOption{}, wq.order...),
+		inters:       append([]Interceptor{}, wq.inters...),
+		predicates:   append([]predicate.Wishlist{}, wq.predicates...),
+		withUser:     wq.withUser.Clone(),
+		withProducts: wq.withProducts.Clone(),
+		withItems:    wq.withItems.Clone(),
 		// clone intermediate query.
 		sql:  wq.sql.Clone(),
 		path: wq.path,
@@ -319,6 +345,17 @@ func (wq *WishlistQuery) WithUser(opts ...func(*UserQuery)) *WishlistQuery {
 	return wq
 }
 
+// WithProducts tells the query-builder to eager-load the nodes that are connected to
+// the "products" edge. The optional arguments are used to configure the query builder of the edge.
+func (wq *WishlistQuery) WithProducts(opts ...func(*ProductQuery)) *WishlistQuery {
+	query := (&ProductClient{config: wq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	wq.withProducts = query
+	return wq
+}
+
 // WithItems tells the query-builder to eager-load the nodes that are connected to
 // the "items" edge. The optional arguments are used to configure the query builder of the edge.
 func (wq *WishlistQuery) WithItems(opts ...func(*WishlistItemQuery)) *WishlistQuery {
@@ -336,12 +373,12 @@ func (wq *WishlistQuery) WithItems(opts ...func(*WishlistItemQuery)) *WishlistQu
 // Example:
 //
 //	var v []struct {
-//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		UserID uint64 `json:"user_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Wishlist.Query().
-//		GroupBy(wishlist.FieldCreatedAt).
+//		GroupBy(wishlist.FieldUserID).
 //		Aggregate(gen.Count()).
 //		Scan(ctx, &v)
 func (wq *WishlistQuery) GroupBy(field string, fields ...string) *WishlistGroupBy {
@@ -359,11 +396,11 @@ func (wq *WishlistQuery) GroupBy(field string, fields ...string) *WishlistGroupB
 // Example:
 //
 //	var v []struct {
-//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		UserID uint64 `json:"user_id,omitempty"`
 //	}
 //
 //	client.Wishlist.Query().
-//		Select(wishlist.FieldCreatedAt).
+//		Select(wishlist.FieldUserID).
 //		Scan(ctx, &v)
 func (wq *WishlistQuery) Select(fields ...string) *WishlistSelect {
 	wq.ctx.Fields = append(wq.ctx.Fields, fields...)
@@ -407,19 +444,13 @@ func (wq *WishlistQuery) prepareQuery(ctx context.Context) error {
 func (wq *WishlistQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Wishlist, error) {
 	var (
 		nodes       = []*Wishlist{}
-		withFKs     = wq.withFKs
 		_spec       = wq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			wq.withUser != nil,
+			wq.withProducts != nil,
 			wq.withItems != nil,
 		}
 	)
-	if wq.withUser != nil {
-		withFKs = true
-	}
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, wishlist.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Wishlist).scanValues(nil, columns)
 	}
@@ -429,6 +460,9 @@ func (wq *WishlistQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Wis
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(wq.modifiers) > 0 {
+		_spec.Modifiers = wq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -444,6 +478,13 @@ func (wq *WishlistQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Wis
 			return nil, err
 		}
 	}
+	if query := wq.withProducts; query != nil {
+		if err := wq.loadProducts(ctx, query, nodes,
+			func(n *Wishlist) { n.Edges.Products = []*Product{} },
+			func(n *Wishlist, e *Product) { n.Edges.Products = append(n.Edges.Products, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := wq.withItems; query != nil {
 		if err := wq.loadItems(ctx, query, nodes,
 			func(n *Wishlist) { n.Edges.Items = []*WishlistItem{} },
@@ -458,10 +499,7 @@ func (wq *WishlistQuery) loadUser(ctx context.Context, query *UserQuery, nodes [
 	ids := make([]uint64, 0, len(nodes))
 	nodeids := make(map[uint64][]*Wishlist)
 	for i := range nodes {
-		if nodes[i].user_wishlists == nil {
-			continue
-		}
-		fk := *nodes[i].user_wishlists
+		fk := nodes[i].UserID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -478,7 +516,7 @@ func (wq *WishlistQuery) loadUser(ctx context.Context, query *UserQuery, nodes [
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "user_wishlists" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -486,6 +524,67 @@ func (wq *WishlistQuery) loadUser(ctx context.Context, query *UserQuery, nodes [
 	}
 	return nil
 }
+func (wq *WishlistQuery) loadProducts(ctx context.Context, query *ProductQuery, nodes []*Wishlist, init func(*Wishlist), assign func(*Wishlist, *Product)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uint64]*Wishlist)
+	nids := make(map[uint64]map[*Wishlist]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(wishlist.ProductsTable)
+		s.Join(joinT).On(s.C(product.FieldID), joinT.C(wishlist.ProductsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(wishlist.ProductsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(wishlist.ProductsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := uint64(values[0].(*sql.NullInt64).Int64)
+				inValue := uint64(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Wishlist]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Product](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "products" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 func (wq *WishlistQuery) loadItems(ctx context.Context, query *WishlistItemQuery, nodes []*Wishlist, init func(*Wishlist), assign func(*Wishlist, *WishlistItem)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uint64]*Wishlist)
@@ -496,7 +595,9 @@ func (wq *WishlistQuery) loadItems(ctx context.Context, query *WishlistItemQuery
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(wishlistitem.FieldWishlistID)
+	}
 	query.Where(predicate.WishlistItem(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(wishlist.ItemsColumn), fks...))
 	}))
@@ -505,13 +606,10 @@ func (wq *WishlistQuery) loadItems(ctx context.Context, query *WishlistItemQuery
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.wishlist_items
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "wishlist_items" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.WishlistID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "wishlist_items" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "wishlist_id" returned %v for node %v`, fk, n)
 		}
 		assign(node, n)
 	}
@@ -520,6 +618,9 @@ func (wq *WishlistQuery) loadItems(ctx context.Context, query *WishlistItemQuery
 
 func (wq *WishlistQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := wq.querySpec()
+	if len(wq.modifiers) > 0 {
+		_spec.Modifiers = wq.modifiers
+	}
 	_spec.Node.Columns = wq.ctx.Fields
 	if len(wq.ctx.Fields) > 0 {
 		_spec.Unique = wq.ctx.Unique != nil && *wq.ctx.Unique
@@ -543,6 +644,9 @@ func (wq *WishlistQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if wq.withUser != nil {
+			_spec.Node.AddColumnOnce(wishlist.FieldUserID)
+		}
 	}
 	if ps := wq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -582,6 +686,9 @@ func (wq *WishlistQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if wq.ctx.Unique != nil && *wq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range wq.modifiers {
+		m(selector)
+	}
 	for _, p := range wq.predicates {
 		p(selector)
 	}
@@ -599,6 +706,32 @@ func (wq *WishlistQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (wq *WishlistQuery) ForUpdate(opts ...sql.LockOption) *WishlistQuery {
+	if wq.driver.Dialect() == dialect.Postgres {
+		wq.Unique(false)
+	}
+	wq.modifiers = append(wq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return wq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (wq *WishlistQuery) ForShare(opts ...sql.LockOption) *WishlistQuery {
+	if wq.driver.Dialect() == dialect.Postgres {
+		wq.Unique(false)
+	}
+	wq.modifiers = append(wq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return wq
+}
+
 // WishlistGroupBy is the group-by builder for Wishlist entities.
 type WishlistGroupBy struct {
 	selector
@@ -12,9 +12,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/thang1834/go-goss/ent/gen/predicate"
+	"github.com/thang1834/go-goss/ent/gen/product"
 	"github.com/thang1834/go-goss/ent/gen/user"
 	"github.com/thang1834/go-goss/ent/gen/wishlist"
-	"github.com/thang1834/go-goss/ent/gen/wishlistitem"
 )
 
 // WishlistUpdate is the builder for updating Wishlist entities.
@@ -30,30 +30,36 @@ func (wu *WishlistUpdate) Where(ps ...predicate.Wishlist) *WishlistUpdate {
 	return wu
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (wu *WishlistUpdate) SetCreatedAt(t time.Time) *WishlistUpdate {
-	wu.mutation.SetCreatedAt(t)
+// SetUserID sets the "user_id" field.
+func (wu *WishlistUpdate) SetUserID(u uint64) *WishlistUpdate {
+	wu.mutation.SetUserID(u)
 	return wu
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (wu *WishlistUpdate) SetNillableCreatedAt(t *time.Time) *WishlistUpdate {
-	if t != nil {
-		wu.SetCreatedAt(*t)
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (wu *WishlistUpdate) SetNillableUserID(u *uint64) *WishlistUpdate {
+	if u != nil {
+		wu.SetUserID(*u)
 	}
 	return wu
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (wu *WishlistUpdate) SetUserID(id uint64) *WishlistUpdate {
-	wu.mutation.SetUserID(id)
+// ClearUserID clears the value of the "user_id" field.
+func (wu *WishlistUpdate) ClearUserID() *WishlistUpdate {
+	wu.mutation.ClearUserID()
 	return wu
 }
 
-// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
-func (wu *WishlistUpdate) SetNillableUserID(id *uint64) *WishlistUpdate {
-	if id != nil {
-		wu = wu.SetUserID(*id)
+// SetCreatedAt sets the "created_at" field.
+func (wu *WishlistUpdate) SetCreatedAt(t time.Time) *WishlistUpdate {
+	wu.mutation.SetCreatedAt(t)
+	return wu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wu *WishlistUpdate) SetNillableCreatedAt(t *time.Time) *WishlistUpdate {
+	if t != nil {
+		wu.SetCreatedAt(*t)
 	}
 	return wu
 }
@@ -63,19 +69,19 @@ func (wu *WishlistUpdate) SetUser(u *User) *WishlistUpdate {
 	return wu.SetUserID(u.ID)
 }
 
-// AddItemIDs adds the "items" edge to the WishlistItem entity by IDs.
-func (wu *WishlistUpdate) AddItemIDs(ids ...int) *WishlistUpdate {
-	wu.mutation.AddItemIDs(ids...)
+// AddProductIDs adds the "products" edge to the Product entity by IDs.
+func (wu *WishlistUpdate) AddProductIDs(ids ...uint64) *WishlistUpdate {
+	wu.mutation.AddProductIDs(ids...)
 	return wu
 }
 
-// AddItems adds the "items" edges to the WishlistItem entity.
-func (wu *WishlistUpdate) AddItems(w ...*WishlistItem) *WishlistUpdate {
-	ids := make([]int, len(w))
-	for i := range w {
-		ids[i] = w[i].ID
+// AddProducts adds the "products" edges to the Product entity.
+func (wu *WishlistUpdate) AddProducts(p ...*Product) *WishlistUpdate {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	return wu.AddItemIDs(ids...)
+	return wu.AddProductIDs(ids...)
 }
 
 // Mutation returns the WishlistMutation object of the builder.
@@ -89,25 +95,25 @@ func (wu *WishlistUpdate) ClearUser() *WishlistUpdate {
 	return wu
 }
 
-// ClearItems clears all "items" edges to the WishlistItem entity.
-func (wu *WishlistUpdate) ClearItems() *WishlistUpdate {
-	wu.mutation.ClearItems()
+// ClearProducts clears all "products" edges to the Product entity.
+func (wu *WishlistUpdate) ClearProducts() *WishlistUpdate {
+	wu.mutation.ClearProducts()
 	return wu
 }
 
-// RemoveItemIDs removes the "items" edge to WishlistItem entities by IDs.
-func (wu *WishlistUpdate) RemoveItemIDs(ids ...int) *WishlistUpdate {
-	wu.mutation.RemoveItemIDs(ids...)
+// RemoveProductIDs removes the "products" edge to Product entities by IDs.
+func (wu *WishlistUpdate) RemoveProductIDs(ids ...uint64) *WishlistUpdate {
+	wu.mutation.RemoveProductIDs(ids...)
 	return wu
 }
 
-// RemoveItems removes "items" edges to WishlistItem entities.
-func (wu *WishlistUpdate) RemoveItems(w ...*WishlistItem) *WishlistUpdate {
-	ids := make([]int, len(w))
-	for i := range w {
-		ids[i] = w[i].ID
+// RemoveProducts removes "products" edges to Product entities.
+func (wu *WishlistUpdate) RemoveProducts(p ...*Product) *WishlistUpdate {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	return wu.RemoveItemIDs(ids...)
+	return wu.RemoveProductIDs(ids...)
 }
 
 // Save executes the query and returns the number of nodes affected by the update operation.
@@ -178,49 +184,61 @@ func (wu *WishlistUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if wu.mutation.ItemsCleared() {
+	if wu.mutation.ProductsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   wishlist.ItemsTable,
-			Columns: []string{wishlist.ItemsColumn},
+			Table:   wishlist.ProductsTable,
+			Columns: wishlist.ProductsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUint64),
 			},
 		}
+		createE := &WishlistItemCreate{config: wu.config, mutation: newWishlistItemMutation(wu.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := wu.mutation.RemovedItemsIDs(); len(nodes) > 0 && !wu.mutation.ItemsCleared() {
+	if nodes := wu.mutation.RemovedProductsIDs(); len(nodes) > 0 && !wu.mutation.ProductsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   wishlist.ItemsTable,
-			Columns: []string{wishlist.ItemsColumn},
+			Table:   wishlist.ProductsTable,
+			Columns: wishlist.ProductsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &WishlistItemCreate{config: wu.config, mutation: newWishlistItemMutation(wu.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := wu.mutation.ItemsIDs(); len(nodes) > 0 {
+	if nodes := wu.mutation.ProductsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   wishlist.ItemsTable,
-			Columns: []string{wishlist.ItemsColumn},
+			Table:   wishlist.ProductsTable,
+			Columns: wishlist.ProductsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &WishlistItemCreate{config: wu.config, mutation: newWishlistItemMutation(wu.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, wu.driver, _spec); err != nil {
@@ -243,30 +261,36 @@ type WishlistUpdateOne struct {
 	mutation *WishlistMutation
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (wuo *WishlistUpdateOne) SetCreatedAt(t time.Time) *WishlistUpdateOne {
-	wuo.mutation.SetCreatedAt(t)
+// SetUserID sets the "user_id" field.
+func (wuo *WishlistUpdateOne) SetUserID(u uint64) *WishlistUpdateOne {
+	wuo.mutation.SetUserID(u)
 	return wuo
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (wuo *WishlistUpdateOne) SetNillableCreatedAt(t *time.Time) *WishlistUpdateOne {
-	if t != nil {
-		wuo.SetCreatedAt(*t)
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (wuo *WishlistUpdateOne) SetNillableUserID(u *uint64) *WishlistUpdateOne {
+	if u != nil {
+		wuo.SetUserID(*u)
 	}
 	return wuo
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (wuo *WishlistUpdateOne) SetUserID(id uint64) *WishlistUpdateOne {
-	wuo.mutation.SetUserID(id)
+// ClearUserID clears the value of the "user_id" field.
+func (wuo *WishlistUpdateOne) ClearUserID() *WishlistUpdateOne {
+	wuo.mutation.ClearUserID()
 	return wuo
 }
 
-// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
-func (wuo *WishlistUpdateOne) SetNillableUserID(id *uint64) *WishlistUpdateOne {
-	if id != nil {
-		wuo = wuo.SetUserID(*id)
+// SetCreatedAt sets the "created_at" field.
+func (wuo *WishlistUpdateOne) SetCreatedAt(t time.Time) *WishlistUpdateOne {
+	wuo.mutation.SetCreatedAt(t)
+	return wuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wuo *WishlistUpdateOne) SetNillableCreatedAt(t *time.Time) *WishlistUpdateOne {
+	if t != nil {
+		wuo.SetCreatedAt(*t)
 	}
 	return wuo
 }
@@ -276,19 +300,19 @@ func (wuo *WishlistUpdateOne) SetUser(u *User) *WishlistUpdateOne {
 	return wuo.SetUserID(u.ID)
 }
 
-// AddItemIDs adds the "items" edge to the WishlistItem entity by IDs.
-func (wuo *WishlistUpdateOne) AddItemIDs(ids ...int) *WishlistUpdateOne {
-	wuo.mutation.AddItemIDs(ids...)
+// AddProductIDs adds the "products" edge to the Product entity by IDs.
+func (wuo *WishlistUpdateOne) AddProductIDs(ids ...uint64) *WishlistUpdateOne {
+	wuo.mutation.AddProductIDs(ids...)
 	return wuo
 }
 
-// AddItems adds the "items" edges to the WishlistItem entity.
-func (wuo *WishlistUpdateOne) AddItems(w ...*WishlistItem) *WishlistUpdateOne {
-	ids := make([]int, len(w))
-	for i := range w {
-		ids[i] = w[i].ID
+// AddProducts adds the "products" edges to the Product entity.
+func (wuo *WishlistUpdateOne) AddProducts(p ...*Product) *WishlistUpdateOne {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	return wuo.AddItemIDs(ids...)
+	return wuo.AddProductIDs(ids...)
 }
 
 // Mutation returns the WishlistMutation object of the builder.
@@ -302,25 +326,25 @@ func (wuo *WishlistUpdateOne) ClearUser() *WishlistUpdateOne {
 	return wuo
 }
 
-// ClearItems clears all "items" edges to the WishlistItem entity.
-func (wuo *WishlistUpdateOne) ClearItems() *WishlistUpdateOne {
-	wuo.mutation.ClearItems()
+// ClearProducts clears all "products" edges to the Product entity.
+func (wuo *WishlistUpdateOne) ClearProducts() *WishlistUpdateOne {
+	wuo.mutation.ClearProducts()
 	return wuo
 }
 
-// RemoveItemIDs removes the "items" edge to WishlistItem entities by IDs.
-func (wuo *WishlistUpdateOne) RemoveItemIDs(ids ...int) *WishlistUpdateOne {
-	wuo.mutation.RemoveItemIDs(ids...)
+// RemoveProductIDs removes the "products" edge to Product entities by IDs.
+func (wuo *WishlistUpdateOne) RemoveProductIDs(ids ...uint64) *WishlistUpdateOne {
+	wuo.mutation.RemoveProductIDs(ids...)
 	return wuo
 }
 
-// RemoveItems removes "items" edges to WishlistItem entities.
-func (wuo *WishlistUpdateOne) RemoveItems(w ...*WishlistItem) *WishlistUpdateOne {
-	ids := make([]int, len(w))
-	for i := range w {
-		ids[i] = w[i].ID
+// RemoveProducts removes "products" edges to Product entities.
+func (wuo *WishlistUpdateOne) RemoveProducts(p ...*Product) *WishlistUpdateOne {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	return wuo.RemoveItemIDs(ids...)
+	return wuo.RemoveProductIDs(ids...)
 }
 
 // Where appends a list predicates to the WishlistUpdate builder.
@@ -421,49 +445,61 @@ func (wuo *WishlistUpdateOne) sqlSave(ctx context.Context) (_node *Wishlist, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if wuo.mutation.ItemsCleared() {
+	if wuo.mutation.ProductsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   wishlist.ItemsTable,
-			Columns: []string{wishlist.ItemsColumn},
+			Table:   wishlist.ProductsTable,
+			Columns: wishlist.ProductsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUint64),
 			},
 		}
+		createE := &WishlistItemCreate{config: wuo.config, mutation: newWishlistItemMutation(wuo.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := wuo.mutation.RemovedItemsIDs(); len(nodes) > 0 && !wuo.mutation.ItemsCleared() {
+	if nodes := wuo.mutation.RemovedProductsIDs(); len(nodes) > 0 && !wuo.mutation.ProductsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   wishlist.ItemsTable,
-			Columns: []string{wishlist.ItemsColumn},
+			Table:   wishlist.ProductsTable,
+			Columns: wishlist.ProductsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &WishlistItemCreate{config: wuo.config, mutation: newWishlistItemMutation(wuo.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := wuo.mutation.ItemsIDs(); len(nodes) > 0 {
+	if nodes := wuo.mutation.ProductsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   wishlist.ItemsTable,
-			Columns: []string{wishlist.ItemsColumn},
+			Table:   wishlist.ProductsTable,
+			Columns: wishlist.ProductsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeUint64),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &WishlistItemCreate{config: wuo.config, mutation: newWishlistItemMutation(wuo.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	_node = &Wishlist{config: wuo.config}
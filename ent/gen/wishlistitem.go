@@ -17,16 +17,18 @@ import (
 // WishlistItem is the model entity for the WishlistItem schema.
 type WishlistItem struct {
 	config `json:"-"`
-	// ID of the ent.
-	ID int `json:"id,omitempty"`
+	// WishlistID holds the value of the "wishlist_id" field.
+	WishlistID uint64 `json:"wishlist_id,omitempty"`
+	// ProductID holds the value of the "product_id" field.
+	ProductID uint64 `json:"product_id,omitempty"`
+	// PriceAtAdd holds the value of the "price_at_add" field.
+	PriceAtAdd float64 `json:"price_at_add,omitempty"`
 	// AddedAt holds the value of the "added_at" field.
 	AddedAt time.Time `json:"added_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the WishlistItemQuery when eager-loading is set.
-	Edges                  WishlistItemEdges `json:"edges"`
-	product_wishlist_items *uint64
-	wishlist_items         *uint64
-	selectValues           sql.SelectValues
+	Edges        WishlistItemEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // WishlistItemEdges holds the relations/edges for other nodes in the graph.
@@ -67,14 +69,12 @@ func (*WishlistItem) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case wishlistitem.FieldID:
+		case wishlistitem.FieldPriceAtAdd:
+			values[i] = new(sql.NullFloat64)
+		case wishlistitem.FieldWishlistID, wishlistitem.FieldProductID:
 			values[i] = new(sql.NullInt64)
 		case wishlistitem.FieldAddedAt:
 			values[i] = new(sql.NullTime)
-		case wishlistitem.ForeignKeys[0]: // product_wishlist_items
-			values[i] = new(sql.NullInt64)
-		case wishlistitem.ForeignKeys[1]: // wishlist_items
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -90,31 +90,29 @@ func (wi *WishlistItem) assignValues(columns []string, values []any) error {
 	}
 	for i := range columns {
 		switch columns[i] {
-		case wishlistitem.FieldID:
-			value, ok := values[i].(*sql.NullInt64)
-			if !ok {
-				return fmt.Errorf("unexpected type %T for field id", value)
-			}
-			wi.ID = int(value.Int64)
-		case wishlistitem.FieldAddedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field added_at", values[i])
+		case wishlistitem.FieldWishlistID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field wishlist_id", values[i])
 			} else if value.Valid {
-				wi.AddedAt = value.Time
+				wi.WishlistID = uint64(value.Int64)
 			}
-		case wishlistitem.ForeignKeys[0]:
+		case wishlistitem.FieldProductID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field product_wishlist_items", value)
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
 			} else if value.Valid {
-				wi.product_wishlist_items = new(uint64)
-				*wi.product_wishlist_items = uint64(value.Int64)
+				wi.ProductID = uint64(value.Int64)
 			}
-		case wishlistitem.ForeignKeys[1]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field wishlist_items", value)
+		case wishlistitem.FieldPriceAtAdd:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field price_at_add", values[i])
 			} else if value.Valid {
-				wi.wishlist_items = new(uint64)
-				*wi.wishlist_items = uint64(value.Int64)
+				wi.PriceAtAdd = value.Float64
+			}
+		case wishlistitem.FieldAddedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field added_at", values[i])
+			} else if value.Valid {
+				wi.AddedAt = value.Time
 			}
 		default:
 			wi.selectValues.Set(columns[i], values[i])
@@ -161,7 +159,15 @@ func (wi *WishlistItem) Unwrap() *WishlistItem {
 func (wi *WishlistItem) String() string {
 	var builder strings.Builder
 	builder.WriteString("WishlistItem(")
-	builder.WriteString(fmt.Sprintf("id=%v, ", wi.ID))
+	builder.WriteString("wishlist_id=")
+	builder.WriteString(fmt.Sprintf("%v", wi.WishlistID))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", wi.ProductID))
+	builder.WriteString(", ")
+	builder.WriteString("price_at_add=")
+	builder.WriteString(fmt.Sprintf("%v", wi.PriceAtAdd))
+	builder.WriteString(", ")
 	builder.WriteString("added_at=")
 	builder.WriteString(wi.AddedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -10,54 +10,114 @@ import (
 	"github.com/thang1834/go-goss/ent/gen/predicate"
 )
 
-// ID filters vertices based on their ID field.
-func ID(id int) predicate.WishlistItem {
-	return predicate.WishlistItem(sql.FieldEQ(FieldID, id))
+// WishlistID applies equality check predicate on the "wishlist_id" field. It's identical to WishlistIDEQ.
+func WishlistID(v uint64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldWishlistID, v))
 }
 
-// IDEQ applies the EQ predicate on the ID field.
-func IDEQ(id int) predicate.WishlistItem {
-	return predicate.WishlistItem(sql.FieldEQ(FieldID, id))
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v uint64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldProductID, v))
 }
 
-// IDNEQ applies the NEQ predicate on the ID field.
-func IDNEQ(id int) predicate.WishlistItem {
-	return predicate.WishlistItem(sql.FieldNEQ(FieldID, id))
+// PriceAtAdd applies equality check predicate on the "price_at_add" field. It's identical to PriceAtAddEQ.
+func PriceAtAdd(v float64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldPriceAtAdd, v))
 }
 
-// IDIn applies the In predicate on the ID field.
-func IDIn(ids ...int) predicate.WishlistItem {
-	return predicate.WishlistItem(sql.FieldIn(FieldID, ids...))
+// AddedAt applies equality check predicate on the "added_at" field. It's identical to AddedAtEQ.
+func AddedAt(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldAddedAt, v))
 }
 
-// IDNotIn applies the NotIn predicate on the ID field.
-func IDNotIn(ids ...int) predicate.WishlistItem {
-	return predicate.WishlistItem(sql.FieldNotIn(FieldID, ids...))
+// WishlistIDEQ applies the EQ predicate on the "wishlist_id" field.
+func WishlistIDEQ(v uint64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldWishlistID, v))
 }
 
-// IDGT applies the GT predicate on the ID field.
-func IDGT(id int) predicate.WishlistItem {
-	return predicate.WishlistItem(sql.FieldGT(FieldID, id))
+// WishlistIDNEQ applies the NEQ predicate on the "wishlist_id" field.
+func WishlistIDNEQ(v uint64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNEQ(FieldWishlistID, v))
 }
 
-// IDGTE applies the GTE predicate on the ID field.
-func IDGTE(id int) predicate.WishlistItem {
-	return predicate.WishlistItem(sql.FieldGTE(FieldID, id))
+// WishlistIDIn applies the In predicate on the "wishlist_id" field.
+func WishlistIDIn(vs ...uint64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIn(FieldWishlistID, vs...))
 }
 
-// IDLT applies the LT predicate on the ID field.
-func IDLT(id int) predicate.WishlistItem {
-	return predicate.WishlistItem(sql.FieldLT(FieldID, id))
+// WishlistIDNotIn applies the NotIn predicate on the "wishlist_id" field.
+func WishlistIDNotIn(vs ...uint64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotIn(FieldWishlistID, vs...))
 }
 
-// IDLTE applies the LTE predicate on the ID field.
-func IDLTE(id int) predicate.WishlistItem {
-	return predicate.WishlistItem(sql.FieldLTE(FieldID, id))
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v uint64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldProductID, v))
 }
 
-// AddedAt applies equality check predicate on the "added_at" field. It's identical to AddedAtEQ.
-func AddedAt(v time.Time) predicate.WishlistItem {
-	return predicate.WishlistItem(sql.FieldEQ(FieldAddedAt, v))
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v uint64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNEQ(FieldProductID, v))
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...uint64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIn(FieldProductID, vs...))
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...uint64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotIn(FieldProductID, vs...))
+}
+
+// PriceAtAddEQ applies the EQ predicate on the "price_at_add" field.
+func PriceAtAddEQ(v float64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldPriceAtAdd, v))
+}
+
+// PriceAtAddNEQ applies the NEQ predicate on the "price_at_add" field.
+func PriceAtAddNEQ(v float64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNEQ(FieldPriceAtAdd, v))
+}
+
+// PriceAtAddIn applies the In predicate on the "price_at_add" field.
+func PriceAtAddIn(vs ...float64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIn(FieldPriceAtAdd, vs...))
+}
+
+// PriceAtAddNotIn applies the NotIn predicate on the "price_at_add" field.
+func PriceAtAddNotIn(vs ...float64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotIn(FieldPriceAtAdd, vs...))
+}
+
+// PriceAtAddGT applies the GT predicate on the "price_at_add" field.
+func PriceAtAddGT(v float64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGT(FieldPriceAtAdd, v))
+}
+
+// PriceAtAddGTE applies the GTE predicate on the "price_at_add" field.
+func PriceAtAddGTE(v float64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGTE(FieldPriceAtAdd, v))
+}
+
+// PriceAtAddLT applies the LT predicate on the "price_at_add" field.
+func PriceAtAddLT(v float64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLT(FieldPriceAtAdd, v))
+}
+
+// PriceAtAddLTE applies the LTE predicate on the "price_at_add" field.
+func PriceAtAddLTE(v float64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLTE(FieldPriceAtAdd, v))
+}
+
+// PriceAtAddIsNil applies the IsNil predicate on the "price_at_add" field.
+func PriceAtAddIsNil() predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIsNull(FieldPriceAtAdd))
+}
+
+// PriceAtAddNotNil applies the NotNil predicate on the "price_at_add" field.
+func PriceAtAddNotNil() predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotNull(FieldPriceAtAdd))
 }
 
 // AddedAtEQ applies the EQ predicate on the "added_at" field.
@@ -104,8 +164,8 @@ func AddedAtLTE(v time.Time) predicate.WishlistItem {
 func HasWishlist() predicate.WishlistItem {
 	return predicate.WishlistItem(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, WishlistTable, WishlistColumn),
+			sqlgraph.From(Table, WishlistColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, WishlistTable, WishlistColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -127,8 +187,8 @@ func HasWishlistWith(preds ...predicate.Wishlist) predicate.WishlistItem {
 func HasProduct() predicate.WishlistItem {
 	return predicate.WishlistItem(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, ProductTable, ProductColumn),
+			sqlgraph.From(Table, ProductColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, ProductTable, ProductColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -12,14 +12,22 @@ import (
 const (
 	// Label holds the string label denoting the wishlistitem type in the database.
 	Label = "wishlist_item"
-	// FieldID holds the string denoting the id field in the database.
-	FieldID = "id"
+	// FieldWishlistID holds the string denoting the wishlist_id field in the database.
+	FieldWishlistID = "wishlist_id"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
+	// FieldPriceAtAdd holds the string denoting the price_at_add field in the database.
+	FieldPriceAtAdd = "price_at_add"
 	// FieldAddedAt holds the string denoting the added_at field in the database.
 	FieldAddedAt = "added_at"
 	// EdgeWishlist holds the string denoting the wishlist edge name in mutations.
 	EdgeWishlist = "wishlist"
 	// EdgeProduct holds the string denoting the product edge name in mutations.
 	EdgeProduct = "product"
+	// WishlistFieldID holds the string denoting the ID field of the Wishlist.
+	WishlistFieldID = "id"
+	// ProductFieldID holds the string denoting the ID field of the Product.
+	ProductFieldID = "id"
 	// Table holds the table name of the wishlistitem in the database.
 	Table = "wishlist_items"
 	// WishlistTable is the table that holds the wishlist relation/edge.
@@ -28,29 +36,24 @@ const (
 	// It exists in this package in order to avoid circular dependency with the "wishlist" package.
 	WishlistInverseTable = "wishlists"
 	// WishlistColumn is the table column denoting the wishlist relation/edge.
-	WishlistColumn = "wishlist_items"
+	WishlistColumn = "wishlist_id"
 	// ProductTable is the table that holds the product relation/edge.
 	ProductTable = "wishlist_items"
 	// ProductInverseTable is the table name for the Product entity.
 	// It exists in this package in order to avoid circular dependency with the "product" package.
 	ProductInverseTable = "products"
 	// ProductColumn is the table column denoting the product relation/edge.
-	ProductColumn = "product_wishlist_items"
+	ProductColumn = "product_id"
 )
 
 // Columns holds all SQL columns for wishlistitem fields.
 var Columns = []string{
-	FieldID,
+	FieldWishlistID,
+	FieldProductID,
+	FieldPriceAtAdd,
 	FieldAddedAt,
 }
 
-// ForeignKeys holds the SQL foreign-keys that are owned by the "wishlist_items"
-// table and are not defined as standalone fields in the schema.
-var ForeignKeys = []string{
-	"product_wishlist_items",
-	"wishlist_items",
-}
-
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -58,11 +61,6 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
-	for i := range ForeignKeys {
-		if column == ForeignKeys[i] {
-			return true
-		}
-	}
 	return false
 }
 
@@ -74,9 +72,19 @@ var (
 // OrderOption defines the ordering options for the WishlistItem queries.
 type OrderOption func(*sql.Selector)
 
-// ByID orders the results by the id field.
-func ByID(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldID, opts...).ToFunc()
+// ByWishlistID orders the results by the wishlist_id field.
+func ByWishlistID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWishlistID, opts...).ToFunc()
+}
+
+// ByProductID orders the results by the product_id field.
+func ByProductID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProductID, opts...).ToFunc()
+}
+
+// ByPriceAtAdd orders the results by the price_at_add field.
+func ByPriceAtAdd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriceAtAdd, opts...).ToFunc()
 }
 
 // ByAddedAt orders the results by the added_at field.
@@ -99,15 +107,15 @@ func ByProductField(field string, opts ...sql.OrderTermOption) OrderOption {
 }
 func newWishlistStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
-		sqlgraph.From(Table, FieldID),
-		sqlgraph.To(WishlistInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.M2O, true, WishlistTable, WishlistColumn),
+		sqlgraph.From(Table, WishlistColumn),
+		sqlgraph.To(WishlistInverseTable, WishlistFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, WishlistTable, WishlistColumn),
 	)
 }
 func newProductStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
-		sqlgraph.From(Table, FieldID),
-		sqlgraph.To(ProductInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.M2O, true, ProductTable, ProductColumn),
+		sqlgraph.From(Table, ProductColumn),
+		sqlgraph.To(ProductInverseTable, ProductFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ProductTable, ProductColumn),
 	)
 }
@@ -22,53 +22,51 @@ type WishlistItemCreate struct {
 	hooks    []Hook
 }
 
-// SetAddedAt sets the "added_at" field.
-func (wic *WishlistItemCreate) SetAddedAt(t time.Time) *WishlistItemCreate {
-	wic.mutation.SetAddedAt(t)
+// SetWishlistID sets the "wishlist_id" field.
+func (wic *WishlistItemCreate) SetWishlistID(u uint64) *WishlistItemCreate {
+	wic.mutation.SetWishlistID(u)
 	return wic
 }
 
-// SetNillableAddedAt sets the "added_at" field if the given value is not nil.
-func (wic *WishlistItemCreate) SetNillableAddedAt(t *time.Time) *WishlistItemCreate {
-	if t != nil {
-		wic.SetAddedAt(*t)
-	}
+// SetProductID sets the "product_id" field.
+func (wic *WishlistItemCreate) SetProductID(u uint64) *WishlistItemCreate {
+	wic.mutation.SetProductID(u)
 	return wic
 }
 
-// SetWishlistID sets the "wishlist" edge to the Wishlist entity by ID.
-func (wic *WishlistItemCreate) SetWishlistID(id uint64) *WishlistItemCreate {
-	wic.mutation.SetWishlistID(id)
+// SetPriceAtAdd sets the "price_at_add" field.
+func (wic *WishlistItemCreate) SetPriceAtAdd(f float64) *WishlistItemCreate {
+	wic.mutation.SetPriceAtAdd(f)
 	return wic
 }
 
-// SetNillableWishlistID sets the "wishlist" edge to the Wishlist entity by ID if the given value is not nil.
-func (wic *WishlistItemCreate) SetNillableWishlistID(id *uint64) *WishlistItemCreate {
-	if id != nil {
-		wic = wic.SetWishlistID(*id)
+// SetNillablePriceAtAdd sets the "price_at_add" field if the given value is not nil.
+func (wic *WishlistItemCreate) SetNillablePriceAtAdd(f *float64) *WishlistItemCreate {
+	if f != nil {
+		wic.SetPriceAtAdd(*f)
 	}
 	return wic
 }
 
-// SetWishlist sets the "wishlist" edge to the Wishlist entity.
-func (wic *WishlistItemCreate) SetWishlist(w *Wishlist) *WishlistItemCreate {
-	return wic.SetWishlistID(w.ID)
-}
-
-// SetProductID sets the "product" edge to the Product entity by ID.
-func (wic *WishlistItemCreate) SetProductID(id uint64) *WishlistItemCreate {
-	wic.mutation.SetProductID(id)
+// SetAddedAt sets the "added_at" field.
+func (wic *WishlistItemCreate) SetAddedAt(t time.Time) *WishlistItemCreate {
+	wic.mutation.SetAddedAt(t)
 	return wic
 }
 
-// SetNillableProductID sets the "product" edge to the Product entity by ID if the given value is not nil.
-func (wic *WishlistItemCreate) SetNillableProductID(id *uint64) *WishlistItemCreate {
-	if id != nil {
-		wic = wic.SetProductID(*id)
+// SetNillableAddedAt sets the "added_at" field if the given value is not nil.
+func (wic *WishlistItemCreate) SetNillableAddedAt(t *time.Time) *WishlistItemCreate {
+	if t != nil {
+		wic.SetAddedAt(*t)
 	}
 	return wic
 }
 
+// SetWishlist sets the "wishlist" edge to the Wishlist entity.
+func (wic *WishlistItemCreate) SetWishlist(w *Wishlist) *WishlistItemCreate {
+	return wic.SetWishlistID(w.ID)
+}
+
 // SetProduct sets the "product" edge to the Product entity.
 func (wic *WishlistItemCreate) SetProduct(p *Product) *WishlistItemCreate {
 	return wic.SetProductID(p.ID)
@@ -117,9 +115,21 @@ func (wic *WishlistItemCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (wic *WishlistItemCreate) check() error {
+	if _, ok := wic.mutation.WishlistID(); !ok {
+		return &ValidationError{Name: "wishlist_id", err: errors.New(`gen: missing required field "WishlistItem.wishlist_id"`)}
+	}
+	if _, ok := wic.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`gen: missing required field "WishlistItem.product_id"`)}
+	}
 	if _, ok := wic.mutation.AddedAt(); !ok {
 		return &ValidationError{Name: "added_at", err: errors.New(`gen: missing required field "WishlistItem.added_at"`)}
 	}
+	if len(wic.mutation.WishlistIDs()) == 0 {
+		return &ValidationError{Name: "wishlist", err: errors.New(`gen: missing required edge "WishlistItem.wishlist"`)}
+	}
+	if len(wic.mutation.ProductIDs()) == 0 {
+		return &ValidationError{Name: "product", err: errors.New(`gen: missing required edge "WishlistItem.product"`)}
+	}
 	return nil
 }
 
@@ -134,18 +144,18 @@ func (wic *WishlistItemCreate) sqlSave(ctx context.Context) (*WishlistItem, erro
 		}
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	_node.ID = int(id)
-	wic.mutation.id = &_node.ID
-	wic.mutation.done = true
 	return _node, nil
 }
 
 func (wic *WishlistItemCreate) createSpec() (*WishlistItem, *sqlgraph.CreateSpec) {
 	var (
 		_node = &WishlistItem{config: wic.config}
-		_spec = sqlgraph.NewCreateSpec(wishlistitem.Table, sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeInt))
+		_spec = sqlgraph.NewCreateSpec(wishlistitem.Table, nil)
 	)
+	if value, ok := wic.mutation.PriceAtAdd(); ok {
+		_spec.SetField(wishlistitem.FieldPriceAtAdd, field.TypeFloat64, value)
+		_node.PriceAtAdd = value
+	}
 	if value, ok := wic.mutation.AddedAt(); ok {
 		_spec.SetField(wishlistitem.FieldAddedAt, field.TypeTime, value)
 		_node.AddedAt = value
@@ -153,7 +163,7 @@ func (wic *WishlistItemCreate) createSpec() (*WishlistItem, *sqlgraph.CreateSpec
 	if nodes := wic.mutation.WishlistIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   wishlistitem.WishlistTable,
 			Columns: []string{wishlistitem.WishlistColumn},
 			Bidi:    false,
@@ -164,13 +174,13 @@ func (wic *WishlistItemCreate) createSpec() (*WishlistItem, *sqlgraph.CreateSpec
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.wishlist_items = &nodes[0]
+		_node.WishlistID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := wic.mutation.ProductIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   wishlistitem.ProductTable,
 			Columns: []string{wishlistitem.ProductColumn},
 			Bidi:    false,
@@ -181,7 +191,7 @@ func (wic *WishlistItemCreate) createSpec() (*WishlistItem, *sqlgraph.CreateSpec
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.product_wishlist_items = &nodes[0]
+		_node.ProductID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
@@ -231,11 +241,6 @@ func (wicb *WishlistItemCreateBulk) Save(ctx context.Context) ([]*WishlistItem,
 				if err != nil {
 					return nil, err
 				}
-				mutation.id = &nodes[i].ID
-				if specs[i].ID.Value != nil {
-					id := specs[i].ID.Value.(int64)
-					nodes[i].ID = int(id)
-				}
 				mutation.done = true
 				return nodes[i], nil
 			})
@@ -8,9 +8,9 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
-	"entgo.io/ent/schema/field"
 	"github.com/thang1834/go-goss/ent/gen/predicate"
 	"github.com/thang1834/go-goss/ent/gen/product"
 	"github.com/thang1834/go-goss/ent/gen/wishlist"
@@ -26,7 +26,7 @@ type WishlistItemQuery struct {
 	predicates   []predicate.WishlistItem
 	withWishlist *WishlistQuery
 	withProduct  *ProductQuery
-	withFKs      bool
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -75,9 +75,9 @@ func (wiq *WishlistItemQuery) QueryWishlist() *WishlistQuery {
 			return nil, err
 		}
 		step := sqlgraph.NewStep(
-			sqlgraph.From(wishlistitem.Table, wishlistitem.FieldID, selector),
+			sqlgraph.From(wishlistitem.Table, wishlistitem.WishlistColumn, selector),
 			sqlgraph.To(wishlist.Table, wishlist.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, wishlistitem.WishlistTable, wishlistitem.WishlistColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, wishlistitem.WishlistTable, wishlistitem.WishlistColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(wiq.driver.Dialect(), step)
 		return fromU, nil
@@ -97,9 +97,9 @@ func (wiq *WishlistItemQuery) QueryProduct() *ProductQuery {
 			return nil, err
 		}
 		step := sqlgraph.NewStep(
-			sqlgraph.From(wishlistitem.Table, wishlistitem.FieldID, selector),
+			sqlgraph.From(wishlistitem.Table, wishlistitem.ProductColumn, selector),
 			sqlgraph.To(product.Table, product.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, wishlistitem.ProductTable, wishlistitem.ProductColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, wishlistitem.ProductTable, wishlistitem.ProductColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(wiq.driver.Dialect(), step)
 		return fromU, nil
@@ -129,29 +129,6 @@ func (wiq *WishlistItemQuery) FirstX(ctx context.Context) *WishlistItem {
 	return node
 }
 
-// FirstID returns the first WishlistItem ID from the query.
-// Returns a *NotFoundError when no WishlistItem ID was found.
-func (wiq *WishlistItemQuery) FirstID(ctx context.Context) (id int, err error) {
-	var ids []int
-	if ids, err = wiq.Limit(1).IDs(setContextOp(ctx, wiq.ctx, ent.OpQueryFirstID)); err != nil {
-		return
-	}
-	if len(ids) == 0 {
-		err = &NotFoundError{wishlistitem.Label}
-		return
-	}
-	return ids[0], nil
-}
-
-// FirstIDX is like FirstID, but panics if an error occurs.
-func (wiq *WishlistItemQuery) FirstIDX(ctx context.Context) int {
-	id, err := wiq.FirstID(ctx)
-	if err != nil && !IsNotFound(err) {
-		panic(err)
-	}
-	return id
-}
-
 // Only returns a single WishlistItem entity found by the query, ensuring it only returns one.
 // Returns a *NotSingularError when more than one WishlistItem entity is found.
 // Returns a *NotFoundError when no WishlistItem entities are found.
@@ -179,34 +156,6 @@ func (wiq *WishlistItemQuery) OnlyX(ctx context.Context) *WishlistItem {
 	return node
 }
 
-// OnlyID is like Only, but returns the only WishlistItem ID in the query.
-// Returns a *NotSingularError when more than one WishlistItem ID is found.
-// Returns a *NotFoundError when no entities are found.
-func (wiq *WishlistItemQuery) OnlyID(ctx context.Context) (id int, err error) {
-	var ids []int
-	if ids, err = wiq.Limit(2).IDs(setContextOp(ctx, wiq.ctx, ent.OpQueryOnlyID)); err != nil {
-		return
-	}
-	switch len(ids) {
-	case 1:
-		id = ids[0]
-	case 0:
-		err = &NotFoundError{wishlistitem.Label}
-	default:
-		err = &NotSingularError{wishlistitem.Label}
-	}
-	return
-}
-
-// OnlyIDX is like OnlyID, but panics if an error occurs.
-func (wiq *WishlistItemQuery) OnlyIDX(ctx context.Context) int {
-	id, err := wiq.OnlyID(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return id
-}
-
 // All executes the query and returns a list of WishlistItems.
 func (wiq *WishlistItemQuery) All(ctx context.Context) ([]*WishlistItem, error) {
 	ctx = setContextOp(ctx, wiq.ctx, ent.OpQueryAll)
@@ -226,27 +175,6 @@ func (wiq *WishlistItemQuery) AllX(ctx context.Context) []*WishlistItem {
 	return nodes
 }
 
-// IDs executes the query and returns a list of WishlistItem IDs.
-func (wiq *WishlistItemQuery) IDs(ctx context.Context) (ids []int, err error) {
-	if wiq.ctx.Unique == nil && wiq.path != nil {
-		wiq.Unique(true)
-	}
-	ctx = setContextOp(ctx, wiq.ctx, ent.OpQueryIDs)
-	if err = wiq.Select(wishlistitem.FieldID).Scan(ctx, &ids); err != nil {
-		return nil, err
-	}
-	return ids, nil
-}
-
-// IDsX is like IDs, but panics if an error occurs.
-func (wiq *WishlistItemQuery) IDsX(ctx context.Context) []int {
-	ids, err := wiq.IDs(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return ids
-}
-
 // Count returns the count of the given query.
 func (wiq *WishlistItemQuery) Count(ctx context.Context) (int, error) {
 	ctx = setContextOp(ctx, wiq.ctx, ent.OpQueryCount)
@@ -268,7 +196,7 @@ func (wiq *WishlistItemQuery) CountX(ctx context.Context) int {
 // Exist returns true if the query has elements in the graph.
 func (wiq *WishlistItemQuery) Exist(ctx context.Context) (bool, error) {
 	ctx = setContextOp(ctx, wiq.ctx, ent.OpQueryExist)
-	switch _, err := wiq.FirstID(ctx); {
+	switch _, err := wiq.First(ctx); {
 	case IsNotFound(err):
 		return false, nil
 	case err != nil:
@@ -335,12 +263,12 @@ func (wiq *WishlistItemQuery) WithProduct(opts ...func(*ProductQuery)) *Wishlist
 // Example:
 //
 //	var v []struct {
-//		AddedAt time.Time `json:"added_at,omitempty"`
+//		WishlistID uint64 `json:"wishlist_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.WishlistItem.Query().
-//		GroupBy(wishlistitem.FieldAddedAt).
+//		GroupBy(wishlistitem.FieldWishlistID).
 //		Aggregate(gen.Count()).
 //		Scan(ctx, &v)
 func (wiq *WishlistItemQuery) GroupBy(field string, fields ...string) *WishlistItemGroupBy {
@@ -358,11 +286,11 @@ func (wiq *WishlistItemQuery) GroupBy(field string, fields ...string) *WishlistI
 // Example:
 //
 //	var v []struct {
-//		AddedAt time.Time `json:"added_at,omitempty"`
+//		WishlistID uint64 `json:"wishlist_id,omitempty"`
 //	}
 //
 //	client.WishlistItem.Query().
-//		Select(wishlistitem.FieldAddedAt).
+//		Select(wishlistitem.FieldWishlistID).
 //		Scan(ctx, &v)
 func (wiq *WishlistItemQuery) Select(fields ...string) *WishlistItemSelect {
 	wiq.ctx.Fields = append(wiq.ctx.Fields, fields...)
@@ -406,19 +334,12 @@ func (wiq *WishlistItemQuery) prepareQuery(ctx context.Context) error {
 func (wiq *WishlistItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WishlistItem, error) {
 	var (
 		nodes       = []*WishlistItem{}
-		withFKs     = wiq.withFKs
 		_spec       = wiq.querySpec()
 		loadedTypes = [2]bool{
 			wiq.withWishlist != nil,
 			wiq.withProduct != nil,
 		}
 	)
-	if wiq.withWishlist != nil || wiq.withProduct != nil {
-		withFKs = true
-	}
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, wishlistitem.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*WishlistItem).scanValues(nil, columns)
 	}
@@ -428,6 +349,9 @@ func (wiq *WishlistItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(wiq.modifiers) > 0 {
+		_spec.Modifiers = wiq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -456,10 +380,7 @@ func (wiq *WishlistItemQuery) loadWishlist(ctx context.Context, query *WishlistQ
 	ids := make([]uint64, 0, len(nodes))
 	nodeids := make(map[uint64][]*WishlistItem)
 	for i := range nodes {
-		if nodes[i].wishlist_items == nil {
-			continue
-		}
-		fk := *nodes[i].wishlist_items
+		fk := nodes[i].WishlistID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -476,7 +397,7 @@ func (wiq *WishlistItemQuery) loadWishlist(ctx context.Context, query *WishlistQ
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "wishlist_items" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "wishlist_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -488,10 +409,7 @@ func (wiq *WishlistItemQuery) loadProduct(ctx context.Context, query *ProductQue
 	ids := make([]uint64, 0, len(nodes))
 	nodeids := make(map[uint64][]*WishlistItem)
 	for i := range nodes {
-		if nodes[i].product_wishlist_items == nil {
-			continue
-		}
-		fk := *nodes[i].product_wishlist_items
+		fk := nodes[i].ProductID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -508,7 +426,7 @@ func (wiq *WishlistItemQuery) loadProduct(ctx context.Context, query *ProductQue
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "product_wishlist_items" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "product_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -519,15 +437,16 @@ func (wiq *WishlistItemQuery) loadProduct(ctx context.Context, query *ProductQue
 
 func (wiq *WishlistItemQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := wiq.querySpec()
-	_spec.Node.Columns = wiq.ctx.Fields
-	if len(wiq.ctx.Fields) > 0 {
-		_spec.Unique = wiq.ctx.Unique != nil && *wiq.ctx.Unique
+	if len(wiq.modifiers) > 0 {
+		_spec.Modifiers = wiq.modifiers
 	}
+	_spec.Unique = false
+	_spec.Node.Columns = nil
 	return sqlgraph.CountNodes(ctx, wiq.driver, _spec)
 }
 
 func (wiq *WishlistItemQuery) querySpec() *sqlgraph.QuerySpec {
-	_spec := sqlgraph.NewQuerySpec(wishlistitem.Table, wishlistitem.Columns, sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeInt))
+	_spec := sqlgraph.NewQuerySpec(wishlistitem.Table, wishlistitem.Columns, nil)
 	_spec.From = wiq.sql
 	if unique := wiq.ctx.Unique; unique != nil {
 		_spec.Unique = *unique
@@ -536,11 +455,14 @@ func (wiq *WishlistItemQuery) querySpec() *sqlgraph.QuerySpec {
 	}
 	if fields := wiq.ctx.Fields; len(fields) > 0 {
 		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, wishlistitem.FieldID)
 		for i := range fields {
-			if fields[i] != wishlistitem.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
-			}
+			_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+		}
+		if wiq.withWishlist != nil {
+			_spec.Node.AddColumnOnce(wishlistitem.FieldWishlistID)
+		}
+		if wiq.withProduct != nil {
+			_spec.Node.AddColumnOnce(wishlistitem.FieldProductID)
 		}
 	}
 	if ps := wiq.predicates; len(ps) > 0 {
@@ -581,6 +503,9 @@ func (wiq *WishlistItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if wiq.ctx.Unique != nil && *wiq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range wiq.modifiers {
+		m(selector)
+	}
 	for _, p := range wiq.predicates {
 		p(selector)
 	}
@@ -598,6 +523,32 @@ func (wiq *WishlistItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (wiq *WishlistItemQuery) ForUpdate(opts ...sql.LockOption) *WishlistItemQuery {
+	if wiq.driver.Dialect() == dialect.Postgres {
+		wiq.Unique(false)
+	}
+	wiq.modifiers = append(wiq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return wiq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (wiq *WishlistItemQuery) ForShare(opts ...sql.LockOption) *WishlistItemQuery {
+	if wiq.driver.Dialect() == dialect.Postgres {
+		wiq.Unique(false)
+	}
+	wiq.modifiers = append(wiq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return wiq
+}
+
 // WishlistItemGroupBy is the group-by builder for WishlistItem entities.
 type WishlistItemGroupBy struct {
 	selector
@@ -30,53 +30,80 @@ func (wiu *WishlistItemUpdate) Where(ps ...predicate.WishlistItem) *WishlistItem
 	return wiu
 }
 
-// SetAddedAt sets the "added_at" field.
-func (wiu *WishlistItemUpdate) SetAddedAt(t time.Time) *WishlistItemUpdate {
-	wiu.mutation.SetAddedAt(t)
+// SetWishlistID sets the "wishlist_id" field.
+func (wiu *WishlistItemUpdate) SetWishlistID(u uint64) *WishlistItemUpdate {
+	wiu.mutation.SetWishlistID(u)
 	return wiu
 }
 
-// SetNillableAddedAt sets the "added_at" field if the given value is not nil.
-func (wiu *WishlistItemUpdate) SetNillableAddedAt(t *time.Time) *WishlistItemUpdate {
-	if t != nil {
-		wiu.SetAddedAt(*t)
+// SetNillableWishlistID sets the "wishlist_id" field if the given value is not nil.
+func (wiu *WishlistItemUpdate) SetNillableWishlistID(u *uint64) *WishlistItemUpdate {
+	if u != nil {
+		wiu.SetWishlistID(*u)
 	}
 	return wiu
 }
 
-// SetWishlistID sets the "wishlist" edge to the Wishlist entity by ID.
-func (wiu *WishlistItemUpdate) SetWishlistID(id uint64) *WishlistItemUpdate {
-	wiu.mutation.SetWishlistID(id)
+// SetProductID sets the "product_id" field.
+func (wiu *WishlistItemUpdate) SetProductID(u uint64) *WishlistItemUpdate {
+	wiu.mutation.SetProductID(u)
 	return wiu
 }
 
-// SetNillableWishlistID sets the "wishlist" edge to the Wishlist entity by ID if the given value is not nil.
-func (wiu *WishlistItemUpdate) SetNillableWishlistID(id *uint64) *WishlistItemUpdate {
-	if id != nil {
-		wiu = wiu.SetWishlistID(*id)
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (wiu *WishlistItemUpdate) SetNillableProductID(u *uint64) *WishlistItemUpdate {
+	if u != nil {
+		wiu.SetProductID(*u)
 	}
 	return wiu
 }
 
-// SetWishlist sets the "wishlist" edge to the Wishlist entity.
-func (wiu *WishlistItemUpdate) SetWishlist(w *Wishlist) *WishlistItemUpdate {
-	return wiu.SetWishlistID(w.ID)
+// SetPriceAtAdd sets the "price_at_add" field.
+func (wiu *WishlistItemUpdate) SetPriceAtAdd(f float64) *WishlistItemUpdate {
+	wiu.mutation.ResetPriceAtAdd()
+	wiu.mutation.SetPriceAtAdd(f)
+	return wiu
+}
+
+// SetNillablePriceAtAdd sets the "price_at_add" field if the given value is not nil.
+func (wiu *WishlistItemUpdate) SetNillablePriceAtAdd(f *float64) *WishlistItemUpdate {
+	if f != nil {
+		wiu.SetPriceAtAdd(*f)
+	}
+	return wiu
+}
+
+// AddPriceAtAdd adds f to the "price_at_add" field.
+func (wiu *WishlistItemUpdate) AddPriceAtAdd(f float64) *WishlistItemUpdate {
+	wiu.mutation.AddPriceAtAdd(f)
+	return wiu
+}
+
+// ClearPriceAtAdd clears the value of the "price_at_add" field.
+func (wiu *WishlistItemUpdate) ClearPriceAtAdd() *WishlistItemUpdate {
+	wiu.mutation.ClearPriceAtAdd()
+	return wiu
 }
 
-// SetProductID sets the "product" edge to the Product entity by ID.
-func (wiu *WishlistItemUpdate) SetProductID(id uint64) *WishlistItemUpdate {
-	wiu.mutation.SetProductID(id)
+// SetAddedAt sets the "added_at" field.
+func (wiu *WishlistItemUpdate) SetAddedAt(t time.Time) *WishlistItemUpdate {
+	wiu.mutation.SetAddedAt(t)
 	return wiu
 }
 
-// SetNillableProductID sets the "product" edge to the Product entity by ID if the given value is not nil.
-func (wiu *WishlistItemUpdate) SetNillableProductID(id *uint64) *WishlistItemUpdate {
-	if id != nil {
-		wiu = wiu.SetProductID(*id)
+// SetNillableAddedAt sets the "added_at" field if the given value is not nil.
+func (wiu *WishlistItemUpdate) SetNillableAddedAt(t *time.Time) *WishlistItemUpdate {
+	if t != nil {
+		wiu.SetAddedAt(*t)
 	}
 	return wiu
 }
 
+// SetWishlist sets the "wishlist" edge to the Wishlist entity.
+func (wiu *WishlistItemUpdate) SetWishlist(w *Wishlist) *WishlistItemUpdate {
+	return wiu.SetWishlistID(w.ID)
+}
+
 // SetProduct sets the "product" edge to the Product entity.
 func (wiu *WishlistItemUpdate) SetProduct(p *Product) *WishlistItemUpdate {
 	return wiu.SetProductID(p.ID)
@@ -126,8 +153,22 @@ func (wiu *WishlistItemUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (wiu *WishlistItemUpdate) check() error {
+	if wiu.mutation.WishlistCleared() && len(wiu.mutation.WishlistIDs()) > 0 {
+		return errors.New(`gen: clearing a required unique edge "WishlistItem.wishlist"`)
+	}
+	if wiu.mutation.ProductCleared() && len(wiu.mutation.ProductIDs()) > 0 {
+		return errors.New(`gen: clearing a required unique edge "WishlistItem.product"`)
+	}
+	return nil
+}
+
 func (wiu *WishlistItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	_spec := sqlgraph.NewUpdateSpec(wishlistitem.Table, wishlistitem.Columns, sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeInt))
+	if err := wiu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wishlistitem.Table, wishlistitem.Columns, sqlgraph.NewFieldSpec(wishlistitem.FieldWishlistID, field.TypeUint64), sqlgraph.NewFieldSpec(wishlistitem.FieldProductID, field.TypeUint64))
 	if ps := wiu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -135,13 +176,22 @@ func (wiu *WishlistItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := wiu.mutation.PriceAtAdd(); ok {
+		_spec.SetField(wishlistitem.FieldPriceAtAdd, field.TypeFloat64, value)
+	}
+	if value, ok := wiu.mutation.AddedPriceAtAdd(); ok {
+		_spec.AddField(wishlistitem.FieldPriceAtAdd, field.TypeFloat64, value)
+	}
+	if wiu.mutation.PriceAtAddCleared() {
+		_spec.ClearField(wishlistitem.FieldPriceAtAdd, field.TypeFloat64)
+	}
 	if value, ok := wiu.mutation.AddedAt(); ok {
 		_spec.SetField(wishlistitem.FieldAddedAt, field.TypeTime, value)
 	}
 	if wiu.mutation.WishlistCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   wishlistitem.WishlistTable,
 			Columns: []string{wishlistitem.WishlistColumn},
 			Bidi:    false,
@@ -154,7 +204,7 @@ func (wiu *WishlistItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if nodes := wiu.mutation.WishlistIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   wishlistitem.WishlistTable,
 			Columns: []string{wishlistitem.WishlistColumn},
 			Bidi:    false,
@@ -170,7 +220,7 @@ func (wiu *WishlistItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if wiu.mutation.ProductCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   wishlistitem.ProductTable,
 			Columns: []string{wishlistitem.ProductColumn},
 			Bidi:    false,
@@ -183,7 +233,7 @@ func (wiu *WishlistItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if nodes := wiu.mutation.ProductIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   wishlistitem.ProductTable,
 			Columns: []string{wishlistitem.ProductColumn},
 			Bidi:    false,
@@ -216,53 +266,80 @@ type WishlistItemUpdateOne struct {
 	mutation *WishlistItemMutation
 }
 
-// SetAddedAt sets the "added_at" field.
-func (wiuo *WishlistItemUpdateOne) SetAddedAt(t time.Time) *WishlistItemUpdateOne {
-	wiuo.mutation.SetAddedAt(t)
+// SetWishlistID sets the "wishlist_id" field.
+func (wiuo *WishlistItemUpdateOne) SetWishlistID(u uint64) *WishlistItemUpdateOne {
+	wiuo.mutation.SetWishlistID(u)
 	return wiuo
 }
 
-// SetNillableAddedAt sets the "added_at" field if the given value is not nil.
-func (wiuo *WishlistItemUpdateOne) SetNillableAddedAt(t *time.Time) *WishlistItemUpdateOne {
-	if t != nil {
-		wiuo.SetAddedAt(*t)
+// SetNillableWishlistID sets the "wishlist_id" field if the given value is not nil.
+func (wiuo *WishlistItemUpdateOne) SetNillableWishlistID(u *uint64) *WishlistItemUpdateOne {
+	if u != nil {
+		wiuo.SetWishlistID(*u)
 	}
 	return wiuo
 }
 
-// SetWishlistID sets the "wishlist" edge to the Wishlist entity by ID.
-func (wiuo *WishlistItemUpdateOne) SetWishlistID(id uint64) *WishlistItemUpdateOne {
-	wiuo.mutation.SetWishlistID(id)
+// SetProductID sets the "product_id" field.
+func (wiuo *WishlistItemUpdateOne) SetProductID(u uint64) *WishlistItemUpdateOne {
+	wiuo.mutation.SetProductID(u)
 	return wiuo
 }
 
-// SetNillableWishlistID sets the "wishlist" edge to the Wishlist entity by ID if the given value is not nil.
-func (wiuo *WishlistItemUpdateOne) SetNillableWishlistID(id *uint64) *WishlistItemUpdateOne {
-	if id != nil {
-		wiuo = wiuo.SetWishlistID(*id)
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (wiuo *WishlistItemUpdateOne) SetNillableProductID(u *uint64) *WishlistItemUpdateOne {
+	if u != nil {
+		wiuo.SetProductID(*u)
 	}
 	return wiuo
 }
 
-// SetWishlist sets the "wishlist" edge to the Wishlist entity.
-func (wiuo *WishlistItemUpdateOne) SetWishlist(w *Wishlist) *WishlistItemUpdateOne {
-	return wiuo.SetWishlistID(w.ID)
+// SetPriceAtAdd sets the "price_at_add" field.
+func (wiuo *WishlistItemUpdateOne) SetPriceAtAdd(f float64) *WishlistItemUpdateOne {
+	wiuo.mutation.ResetPriceAtAdd()
+	wiuo.mutation.SetPriceAtAdd(f)
+	return wiuo
 }
 
-// SetProductID sets the "product" edge to the Product entity by ID.
-func (wiuo *WishlistItemUpdateOne) SetProductID(id uint64) *WishlistItemUpdateOne {
-	wiuo.mutation.SetProductID(id)
+// SetNillablePriceAtAdd sets the "price_at_add" field if the given value is not nil.
+func (wiuo *WishlistItemUpdateOne) SetNillablePriceAtAdd(f *float64) *WishlistItemUpdateOne {
+	if f != nil {
+		wiuo.SetPriceAtAdd(*f)
+	}
+	return wiuo
+}
+
+// AddPriceAtAdd adds f to the "price_at_add" field.
+func (wiuo *WishlistItemUpdateOne) AddPriceAtAdd(f float64) *WishlistItemUpdateOne {
+	wiuo.mutation.AddPriceAtAdd(f)
+	return wiuo
+}
+
+// ClearPriceAtAdd clears the value of the "price_at_add" field.
+func (wiuo *WishlistItemUpdateOne) ClearPriceAtAdd() *WishlistItemUpdateOne {
+	wiuo.mutation.ClearPriceAtAdd()
+	return wiuo
+}
+
+// SetAddedAt sets the "added_at" field.
+func (wiuo *WishlistItemUpdateOne) SetAddedAt(t time.Time) *WishlistItemUpdateOne {
+	wiuo.mutation.SetAddedAt(t)
 	return wiuo
 }
 
-// SetNillableProductID sets the "product" edge to the Product entity by ID if the given value is not nil.
-func (wiuo *WishlistItemUpdateOne) SetNillableProductID(id *uint64) *WishlistItemUpdateOne {
-	if id != nil {
-		wiuo = wiuo.SetProductID(*id)
+// SetNillableAddedAt sets the "added_at" field if the given value is not nil.
+func (wiuo *WishlistItemUpdateOne) SetNillableAddedAt(t *time.Time) *WishlistItemUpdateOne {
+	if t != nil {
+		wiuo.SetAddedAt(*t)
 	}
 	return wiuo
 }
 
+// SetWishlist sets the "wishlist" edge to the Wishlist entity.
+func (wiuo *WishlistItemUpdateOne) SetWishlist(w *Wishlist) *WishlistItemUpdateOne {
+	return wiuo.SetWishlistID(w.ID)
+}
+
 // SetProduct sets the "product" edge to the Product entity.
 func (wiuo *WishlistItemUpdateOne) SetProduct(p *Product) *WishlistItemUpdateOne {
 	return wiuo.SetProductID(p.ID)
@@ -325,23 +402,39 @@ func (wiuo *WishlistItemUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (wiuo *WishlistItemUpdateOne) check() error {
+	if wiuo.mutation.WishlistCleared() && len(wiuo.mutation.WishlistIDs()) > 0 {
+		return errors.New(`gen: clearing a required unique edge "WishlistItem.wishlist"`)
+	}
+	if wiuo.mutation.ProductCleared() && len(wiuo.mutation.ProductIDs()) > 0 {
+		return errors.New(`gen: clearing a required unique edge "WishlistItem.product"`)
+	}
+	return nil
+}
+
 func (wiuo *WishlistItemUpdateOne) sqlSave(ctx context.Context) (_node *WishlistItem, err error) {
-	_spec := sqlgraph.NewUpdateSpec(wishlistitem.Table, wishlistitem.Columns, sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeInt))
-	id, ok := wiuo.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`gen: missing "WishlistItem.id" for update`)}
+	if err := wiuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wishlistitem.Table, wishlistitem.Columns, sqlgraph.NewFieldSpec(wishlistitem.FieldWishlistID, field.TypeUint64), sqlgraph.NewFieldSpec(wishlistitem.FieldProductID, field.TypeUint64))
+	if id, ok := wiuo.mutation.WishlistID(); !ok {
+		return nil, &ValidationError{Name: "wishlist_id", err: errors.New(`gen: missing "WishlistItem.wishlist_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[0].Value = id
+	}
+	if id, ok := wiuo.mutation.ProductID(); !ok {
+		return nil, &ValidationError{Name: "product_id", err: errors.New(`gen: missing "WishlistItem.product_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[1].Value = id
 	}
-	_spec.Node.ID.Value = id
 	if fields := wiuo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, wishlistitem.FieldID)
-		for _, f := range fields {
+		_spec.Node.Columns = make([]string, len(fields))
+		for i, f := range fields {
 			if !wishlistitem.ValidColumn(f) {
 				return nil, &ValidationError{Name: f, err: fmt.Errorf("gen: invalid field %q for query", f)}
 			}
-			if f != wishlistitem.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+			_spec.Node.Columns[i] = f
 		}
 	}
 	if ps := wiuo.mutation.predicates; len(ps) > 0 {
@@ -351,13 +444,22 @@ func (wiuo *WishlistItemUpdateOne) sqlSave(ctx context.Context) (_node *Wishlist
 			}
 		}
 	}
+	if value, ok := wiuo.mutation.PriceAtAdd(); ok {
+		_spec.SetField(wishlistitem.FieldPriceAtAdd, field.TypeFloat64, value)
+	}
+	if value, ok := wiuo.mutation.AddedPriceAtAdd(); ok {
+		_spec.AddField(wishlistitem.FieldPriceAtAdd, field.TypeFloat64, value)
+	}
+	if wiuo.mutation.PriceAtAddCleared() {
+		_spec.ClearField(wishlistitem.FieldPriceAtAdd, field.TypeFloat64)
+	}
 	if value, ok := wiuo.mutation.AddedAt(); ok {
 		_spec.SetField(wishlistitem.FieldAddedAt, field.TypeTime, value)
 	}
 	if wiuo.mutation.WishlistCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   wishlistitem.WishlistTable,
 			Columns: []string{wishlistitem.WishlistColumn},
 			Bidi:    false,
@@ -370,7 +472,7 @@ func (wiuo *WishlistItemUpdateOne) sqlSave(ctx context.Context) (_node *Wishlist
 	if nodes := wiuo.mutation.WishlistIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   wishlistitem.WishlistTable,
 			Columns: []string{wishlistitem.WishlistColumn},
 			Bidi:    false,
@@ -386,7 +488,7 @@ func (wiuo *WishlistItemUpdateOne) sqlSave(ctx context.Context) (_node *Wishlist
 	if wiuo.mutation.ProductCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   wishlistitem.ProductTable,
 			Columns: []string{wishlistitem.ProductColumn},
 			Bidi:    false,
@@ -399,7 +501,7 @@ func (wiuo *WishlistItemUpdateOne) sqlSave(ctx context.Context) (_node *Wishlist
 	if nodes := wiuo.mutation.ProductIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
-			Inverse: true,
+			Inverse: false,
 			Table:   wishlistitem.ProductTable,
 			Columns: []string{wishlistitem.ProductColumn},
 			Bidi:    false,
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditLog is one row changed by a request, with its values before and after.
+type AuditLog struct {
+	ent.Schema
+}
+
+func (AuditLog) Fields() []ent.Field {
+	jsonb := map[string]string{dialect.Postgres: "jsonb"}
+
+	return []ent.Field{
+		field.Uint64("id"),
+		field.Uint64("actor_id").Optional().Nillable(),
+		field.String("table_name").MaxLen(100),
+		field.Uint64("table_row_id").Optional().Nillable(),
+		field.String("action").MaxLen(20),
+		field.Text("old_values").Optional().Nillable().SchemaType(jsonb),
+		field.Text("new_values").Optional().Nillable().SchemaType(jsonb),
+		field.Text("diff").Optional().Nillable().SchemaType(jsonb),
+		field.String("http_method").MaxLen(10),
+		field.Text("url"),
+		field.String("ip_address").MaxLen(45),
+		field.Text("user_agent"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("table_name", "table_row_id"),
+		index.Fields("actor_id"),
+		index.Fields("created_at"),
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 type Cart struct {
@@ -15,6 +16,7 @@ type Cart struct {
 func (Cart) Fields() []ent.Field {
 	return []ent.Field{
 		field.Uint64("id"),
+		field.Uint64("user_id").Optional().Nillable(),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -22,7 +24,13 @@ func (Cart) Fields() []ent.Field {
 
 func (Cart) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.From("user", User.Type).Ref("carts").Unique(),
-		edge.To("items", CartItem.Type),
+		edge.From("user", User.Type).Ref("carts").Field("user_id").Unique(),
+		edge.To("products", Product.Type).Through("items", CartItem.Type),
+	}
+}
+
+func (Cart) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id").Unique(),
 	}
 }
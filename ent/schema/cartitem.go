@@ -2,29 +2,34 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
 
+// CartItem is the edge schema between Cart and Product. Its primary key is
+// the composite (cart_id, product_id).
 type CartItem struct {
 	ent.Schema
 }
 
+func (CartItem) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		field.ID("cart_id", "product_id"),
+	}
+}
+
 func (CartItem) Fields() []ent.Field {
 	return []ent.Field{
+		field.Uint64("cart_id"),
+		field.Uint64("product_id"),
 		field.Int("quantity"),
 	}
 }
 
 func (CartItem) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.From("cart", Cart.Type).Ref("items").Unique(),
-		edge.From("product", Product.Type).Ref("cart_items").Unique(),
+		edge.To("cart", Cart.Type).Required().Unique().Field("cart_id"),
+		edge.To("product", Product.Type).Required().Unique().Field("product_id"),
 	}
 }
-
-//func (CartItem) Indexes() []ent.Index {
-//	return []ent.Index{
-//		index.Fields("cart_id", "product_id").Unique(),
-//	}
-//}
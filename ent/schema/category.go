@@ -17,7 +17,7 @@ func (Category) Fields() []ent.Field {
 		field.Uint64("id"),
 		field.String("name").MaxLen(100),
 		field.String("slug").MaxLen(150).Unique(),
-		field.Int("parent_id").Optional(),
+		field.Uint64("parent_id").Optional(),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -25,7 +25,11 @@ func (Category) Fields() []ent.Field {
 
 func (Category) Edges() []ent.Edge {
 	return []ent.Edge{
+		edge.To("children", Category.Type).
+			From("parent").
+			Field("parent_id").
+			Unique(),
 		edge.To("products", Product.Type),
-		edge.To("discounts", DiscountCategory.Type),
+		edge.From("discounts", Discount.Type).Ref("categories").Through("discount_categories", DiscountCategory.Type),
 	}
 }
@@ -23,7 +23,7 @@ func (Discount) Fields() []ent.Field {
 		field.Time("end_date"),
 		field.Int("usage_limit").Optional(),
 		field.Int("usage_count").Default(0),
-		field.Int("min_order_value").Optional(),
+		field.Float("min_order_value").Optional(),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -31,8 +31,9 @@ func (Discount) Fields() []ent.Field {
 
 func (Discount) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("products", DiscountProduct.Type),
-		edge.To("categories", DiscountCategory.Type),
+		edge.To("products", Product.Type).Through("discount_products", DiscountProduct.Type),
+		edge.To("categories", Category.Type).Through("discount_categories", DiscountCategory.Type),
 		edge.To("user_vouchers", UserVoucher.Type),
+		edge.To("orders", Order.Type),
 	}
 }
@@ -2,24 +2,33 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
 )
 
+// DiscountCategory is the edge schema between Discount and Category. Its
+// primary key is the composite (discount_id, category_id).
 type DiscountCategory struct {
 	ent.Schema
 }
 
-func (DiscountCategory) Fields() []ent.Field { return nil }
+func (DiscountCategory) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		field.ID("discount_id", "category_id"),
+	}
+}
+
+func (DiscountCategory) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("discount_id"),
+		field.Uint64("category_id"),
+	}
+}
 
 func (DiscountCategory) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.From("discount", Discount.Type).Ref("categories").Unique(),
-		edge.From("category", Category.Type).Ref("discounts").Unique(),
+		edge.To("discount", Discount.Type).Required().Unique().Field("discount_id"),
+		edge.To("category", Category.Type).Required().Unique().Field("category_id"),
 	}
 }
-
-//func (DiscountCategory) Indexes() []ent.Index {
-//	return []ent.Index{
-//		index.Fields("discount_id", "category_id").Unique(),
-//	}
-//}
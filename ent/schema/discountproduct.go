@@ -2,24 +2,33 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
 )
 
+// DiscountProduct is the edge schema between Discount and Product. Its
+// primary key is the composite (discount_id, product_id).
 type DiscountProduct struct {
 	ent.Schema
 }
 
-func (DiscountProduct) Fields() []ent.Field { return nil }
+func (DiscountProduct) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		field.ID("discount_id", "product_id"),
+	}
+}
+
+func (DiscountProduct) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("discount_id"),
+		field.Uint64("product_id"),
+	}
+}
 
 func (DiscountProduct) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.From("discount", Discount.Type).Ref("products").Unique(),
-		edge.From("product", Product.Type).Ref("discounts").Unique(),
+		edge.To("discount", Discount.Type).Required().Unique().Field("discount_id"),
+		edge.To("product", Product.Type).Required().Unique().Field("product_id"),
 	}
 }
-
-//func (DiscountProduct) Indexes() []ent.Index {
-//	return []ent.Index{
-//		index.Fields("discount_id", "product_id").Unique(),
-//	}
-//}
@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// IdempotencyKey holds the response to a request sent with an
+// Idempotency-Key header when Redis is not enabled. StatusCode is null while
+// the request is being handled.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").StorageKey("key"),
+		field.String("fingerprint").MaxLen(64),
+		field.Int("status_code").Optional().Nillable(),
+		field.JSON("header", map[string][]string{}).Optional(),
+		field.Bytes("body").Optional(),
+		field.Time("expires_at"),
+	}
+}
+
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
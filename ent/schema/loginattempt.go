@@ -0,0 +1,20 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginAttempt counts failed logins per email or IP when Redis is not
+// enabled.
+type LoginAttempt struct {
+	ent.Schema
+}
+
+func (LoginAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").StorageKey("key"),
+		field.Int("failures"),
+		field.Time("last_failed_at"),
+	}
+}
@@ -15,10 +15,13 @@ type Order struct {
 func (Order) Fields() []ent.Field {
 	return []ent.Field{
 		field.Uint64("id"),
+		field.Uint64("user_id").Optional(),
 		field.String("status").Default("pending"),
 		field.Float("total_price").Default(0),
 		field.String("payment_method").Optional(),
 		field.Text("shipping_address").Optional(),
+		field.Uint64("discount_id").Optional().Nillable(),
+		field.Float("discount_amount").Default(0),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -26,8 +29,10 @@ func (Order) Fields() []ent.Field {
 
 func (Order) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.From("user", User.Type).Ref("orders").Unique(),
+		edge.From("user", User.Type).Ref("orders").Field("user_id").Unique(),
+		edge.From("discount", Discount.Type).Ref("orders").Field("discount_id").Unique(),
 		edge.To("items", OrderItem.Type),
 		edge.To("payments", Payment.Type),
+		edge.To("status_history", OrderStatusHistory.Type),
 	}
 }
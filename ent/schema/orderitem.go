@@ -13,6 +13,8 @@ type OrderItem struct {
 func (OrderItem) Fields() []ent.Field {
 	return []ent.Field{
 		field.Uint64("id"),
+		field.Uint64("order_id").Optional(),
+		field.Uint64("product_id").Optional(),
 		field.Int("quantity"),
 		field.Float("unit_price"),
 	}
@@ -20,7 +22,7 @@ func (OrderItem) Fields() []ent.Field {
 
 func (OrderItem) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.From("order", Order.Type).Ref("items").Unique(),
-		edge.From("product", Product.Type).Ref("order_items").Unique(),
+		edge.From("order", Order.Type).Ref("items").Field("order_id").Unique(),
+		edge.From("product", Product.Type).Ref("order_items").Field("product_id").Unique(),
 	}
 }
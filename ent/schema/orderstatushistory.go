@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OrderStatusHistory records every status change of an order.
+type OrderStatusHistory struct {
+	ent.Schema
+}
+
+func (OrderStatusHistory) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "order_status_history"},
+	}
+}
+
+func (OrderStatusHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id"),
+		field.Uint64("order_id"),
+		field.String("from_status").Optional(),
+		field.String("to_status"),
+		field.Uint64("changed_by").Optional().Nillable(),
+		field.Text("note").Optional(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (OrderStatusHistory) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("order", Order.Type).Ref("status_history").Field("order_id").Required().Unique(),
+		edge.From("user", User.Type).Ref("order_status_changes").Field("changed_by").Unique(),
+	}
+}
+
+func (OrderStatusHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("order_id", "created_at"),
+	}
+}
@@ -15,16 +15,17 @@ type Payment struct {
 func (Payment) Fields() []ent.Field {
 	return []ent.Field{
 		field.Uint64("id"),
+		field.Uint64("order_id").Optional(),
 		field.Float("amount"),
 		field.String("method").Optional(),
 		field.String("status").Default("pending"),
-		field.String("transaction_id").Optional(),
+		field.String("transaction_id").Optional().Nillable().Unique(),
 		field.Time("created_at").Default(time.Now),
 	}
 }
 
 func (Payment) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.From("order", Order.Type).Ref("payments").Unique(),
+		edge.From("order", Order.Type).Ref("payments").Field("order_id").Unique(),
 	}
 }
@@ -22,6 +22,7 @@ func (Product) Fields() []ent.Field {
 		field.Int("stock_quantity").Default(0),
 		field.Float("avg_rating").Default(0),
 		field.Int("review_count").Default(0),
+		field.Uint64("category_id").Optional(),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -29,12 +30,12 @@ func (Product) Fields() []ent.Field {
 
 func (Product) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.From("category", Category.Type).Ref("products").Unique(),
+		edge.From("category", Category.Type).Ref("products").Field("category_id").Unique(),
 		edge.To("images", ProductImage.Type),
 		edge.To("reviews", Review.Type),
-		edge.To("cart_items", CartItem.Type),
+		edge.From("carts", Cart.Type).Ref("products").Through("cart_items", CartItem.Type),
 		edge.To("order_items", OrderItem.Type),
-		edge.To("discounts", DiscountProduct.Type),
-		edge.To("wishlist_items", WishlistItem.Type),
+		edge.From("discounts", Discount.Type).Ref("products").Through("discount_products", DiscountProduct.Type),
+		edge.From("wishlists", Wishlist.Type).Ref("products").Through("wishlist_items", WishlistItem.Type),
 	}
 }
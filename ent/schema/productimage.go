@@ -1,9 +1,13 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 type ProductImage struct {
@@ -13,13 +17,31 @@ type ProductImage struct {
 func (ProductImage) Fields() []ent.Field {
 	return []ent.Field{
 		field.Uint64("id"),
+		field.Uint64("product_id").Optional(),
 		field.String("image_url"),
 		field.Bool("is_primary").Default(false),
+		field.Int("position").Default(0),
+		// storage_key and thumbnails locate the blobs behind image_url so
+		// they can be removed together with the row.
+		field.String("storage_key").Optional(),
+		field.String("content_type").Optional(),
+		field.JSON("thumbnails", map[string]string{}).Optional(),
+		field.Time("created_at").Default(time.Now),
 	}
 }
 
 func (ProductImage) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.From("product", Product.Type).Ref("images").Unique(),
+		edge.From("product", Product.Type).Ref("images").Field("product_id").Unique(),
+	}
+}
+
+func (ProductImage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("product_id", "position"),
+		// At most one primary image per product.
+		index.Fields("product_id").Unique().
+			Annotations(entsql.IndexWhere("is_primary")).
+			StorageKey("product_images_primary_key"),
 	}
 }
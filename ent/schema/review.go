@@ -15,7 +15,9 @@ type Review struct {
 func (Review) Fields() []ent.Field {
 	return []ent.Field{
 		field.Uint64("id"),
-		field.Int("rating"),
+		field.Uint64("user_id").Optional(),
+		field.Uint64("product_id").Optional(),
+		field.Int("rating").Range(1, 5),
 		field.Text("comment").Optional(),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
@@ -24,8 +26,8 @@ func (Review) Fields() []ent.Field {
 
 func (Review) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.From("user", User.Type).Ref("reviews").Unique(),
-		edge.From("product", Product.Type).Ref("reviews").Unique(),
+		edge.From("user", User.Type).Ref("reviews").Field("user_id").Unique(),
+		edge.From("product", Product.Type).Ref("reviews").Field("product_id").Unique(),
 	}
 }
 
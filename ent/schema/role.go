@@ -26,6 +26,6 @@ func (Role) Fields() []ent.Field {
 func (Role) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("user_roles", UserRole.Type),
-		edge.To("permissions", RolePermission.Type),
+		edge.To("role_permissions", RolePermission.Type),
 	}
 }
@@ -25,7 +25,7 @@ func (RolePermission) Fields() []ent.Field {
 func (RolePermission) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("role", Role.Type).
-			Ref("permissions").
+			Ref("role_permissions").
 			Field("role_id").
 			Required().
 			Unique(),
@@ -1,8 +1,11 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Session holds the schema definition for the Session entity.
@@ -17,5 +20,15 @@ func (Session) Fields() []ent.Field {
 		field.Uint64("user_id").Nillable().Optional(),
 		field.Bytes("data"),
 		field.Time("expiry"),
+		field.Time("created_at").Default(time.Now),
+		field.Time("last_seen_at").Default(time.Now),
+		field.String("ip_address").Optional(),
+		field.String("user_agent").Optional(),
+	}
+}
+
+func (Session) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
 	}
 }
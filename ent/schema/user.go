@@ -21,23 +21,32 @@ func (User) Fields() []ent.Field {
 		field.String("middle_name").Optional().MaxLen(255),
 		field.String("last_name").Optional().MaxLen(255),
 		field.String("email").MaxLen(255).Unique(),
-		field.String("password_hash").MaxLen(255),
+		field.String("password_hash").MaxLen(255).Sensitive(),
 		field.String("phone").Optional().MaxLen(20),
 		field.String("status").MaxLen(50).Default("active"),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.Time("verified_at").Optional().Nillable().StructTag(`json:"-"`),
+		// totp_secret is set on enrollment, totp_enabled_at once confirmed.
+		field.String("totp_secret").Optional().Sensitive(),
+		field.Time("totp_enabled_at").Optional().Nillable().StructTag(`json:"-"`),
+		field.Int64("totp_last_step").Optional().Nillable().StructTag(`json:"-"`),
 	}
 }
 
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("roles", UserRole.Type),
-		edge.To("permissions", UserPermission.Type),
+		edge.To("user_roles", UserRole.Type),
+		edge.To("user_permissions", UserPermission.Type),
 		edge.To("carts", Cart.Type),
 		edge.To("orders", Order.Type),
 		edge.To("wishlists", Wishlist.Type),
 		edge.To("reviews", Review.Type),
 		edge.To("vouchers", UserVoucher.Type),
+		edge.To("order_status_changes", OrderStatusHistory.Type),
+		edge.To("tokens", UserToken.Type),
+		edge.To("recovery_codes", RecoveryCode.Type),
+		edge.To("api_keys", APIKey.Type),
+		edge.To("identities", UserIdentity.Type),
 	}
 }
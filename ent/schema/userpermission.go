@@ -28,7 +28,7 @@ func (UserPermission) Fields() []ent.Field {
 func (UserPermission) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("user", User.Type).
-			Ref("permissions").
+			Ref("user_permissions").
 			Field("user_id").
 			Required().
 			Unique(),
@@ -28,7 +28,7 @@ func (UserRole) Fields() []ent.Field {
 func (UserRole) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("user", User.Type).
-			Ref("roles").
+			Ref("user_roles").
 			Field("user_id").
 			Required().
 			Unique(),
@@ -13,15 +13,17 @@ type UserVoucher struct {
 func (UserVoucher) Fields() []ent.Field {
 	return []ent.Field{
 		field.Uint64("id"),
+		field.Uint64("user_id").Optional(),
+		field.Uint64("discount_id").Optional(),
 		field.Bool("is_used").Default(false),
-		field.Time("used_at").Optional(),
+		field.Time("used_at").Optional().Nillable(),
 	}
 }
 
 func (UserVoucher) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.From("user", User.Type).Ref("vouchers").Unique(),
-		edge.From("discount", Discount.Type).Ref("user_vouchers").Unique(),
+		edge.From("user", User.Type).Ref("vouchers").Field("user_id").Unique(),
+		edge.From("discount", Discount.Type).Ref("user_vouchers").Field("discount_id").Unique(),
 	}
 }
 
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookEvent is the inbox of verified payment provider callbacks.
+type WebhookEvent struct {
+	ent.Schema
+}
+
+func (WebhookEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "payment_webhook_events"},
+	}
+}
+
+func (WebhookEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id"),
+		field.String("provider").MaxLen(50),
+		field.String("event_id").MaxLen(100),
+		field.String("transaction_id").Optional().MaxLen(100),
+		// The outcome as decoded by the provider, so that processing does
+		// not depend on the provider's wire format.
+		field.String("status").Optional().MaxLen(20),
+		field.Float("amount").Optional(),
+		field.Text("payload"),
+		field.Int("attempts").Default(0),
+		field.Text("last_error").Optional(),
+		field.Time("received_at").Default(time.Now),
+		field.Time("processed_at").Optional().Nillable(),
+	}
+}
+
+func (WebhookEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "event_id").Unique(),
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 type Wishlist struct {
@@ -15,13 +16,20 @@ type Wishlist struct {
 func (Wishlist) Fields() []ent.Field {
 	return []ent.Field{
 		field.Uint64("id"),
+		field.Uint64("user_id").Optional(),
 		field.Time("created_at").Default(time.Now),
 	}
 }
 
 func (Wishlist) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.From("user", User.Type).Ref("wishlists").Unique(),
-		edge.To("items", WishlistItem.Type),
+		edge.From("user", User.Type).Ref("wishlists").Field("user_id").Unique(),
+		edge.To("products", Product.Type).Through("items", WishlistItem.Type),
+	}
+}
+
+func (Wishlist) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id").Unique(),
 	}
 }
@@ -4,29 +4,37 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
 
+// WishlistItem is the edge schema between Wishlist and Product. Its primary
+// key is the composite (wishlist_id, product_id).
 type WishlistItem struct {
 	ent.Schema
 }
 
+func (WishlistItem) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		field.ID("wishlist_id", "product_id"),
+	}
+}
+
 func (WishlistItem) Fields() []ent.Field {
 	return []ent.Field{
+		field.Uint64("wishlist_id"),
+		field.Uint64("product_id"),
+		// PriceAtAdd is the product price when it was added, used to
+		// detect price drops.
+		field.Float("price_at_add").Optional(),
 		field.Time("added_at").Default(time.Now),
 	}
 }
 
 func (WishlistItem) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.From("wishlist", Wishlist.Type).Ref("items").Unique(),
-		edge.From("product", Product.Type).Ref("wishlist_items").Unique(),
+		edge.To("wishlist", Wishlist.Type).Required().Unique().Field("wishlist_id"),
+		edge.To("product", Product.Type).Required().Unique().Field("product_id"),
 	}
 }
-
-//func (WishlistItem) Indexes() []ent.Index {
-//	return []ent.Index{
-//		index.Fields("wishlist_id", "product_id").Unique(),
-//	}
-//}
@@ -0,0 +1,51 @@
+package audit
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// Change is the before and after value of one field. Old is nil for created
+// rows and New is nil for deleted ones.
+type Change struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+// Diff compares two JSON encoded rows field by field and returns the fields
+// that differ. Either row may be empty. Eager-loaded edges are ignored.
+func Diff(oldValues, newValues []byte) (map[string]Change, error) {
+	before, err := fields(oldValues)
+	if err != nil {
+		return nil, err
+	}
+	after, err := fields(newValues)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(map[string]Change)
+	for name, old := range before {
+		if val, ok := after[name]; !ok || !reflect.DeepEqual(old, val) {
+			changes[name] = Change{Old: old, New: after[name]}
+		}
+	}
+	for name, val := range after {
+		if _, ok := before[name]; !ok {
+			changes[name] = Change{New: val}
+		}
+	}
+	return changes, nil
+}
+
+func fields(row []byte) (map[string]any, error) {
+	values := make(map[string]any)
+	if len(row) == 0 {
+		return values, nil
+	}
+	if err := json.Unmarshal(row, &values); err != nil {
+		return nil, err
+	}
+	delete(values, "edges")
+	return values, nil
+}
@@ -0,0 +1,68 @@
+package audit
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new string
+		want     map[string]Change
+	}{
+		{
+			name: "create",
+			new:  `{"id":1,"name":"Mug"}`,
+			want: map[string]Change{
+				"id":   {New: float64(1)},
+				"name": {New: "Mug"},
+			},
+		},
+		{
+			name: "update",
+			old:  `{"id":1,"name":"Mug","price":10,"edges":{}}`,
+			new:  `{"id":1,"name":"Cup","price":10,"edges":{"category":{"id":2}}}`,
+			want: map[string]Change{
+				"name": {Old: "Mug", New: "Cup"},
+			},
+		},
+		{
+			name: "field cleared",
+			old:  `{"id":1,"description":"large"}`,
+			new:  `{"id":1}`,
+			want: map[string]Change{
+				"description": {Old: "large"},
+			},
+		},
+		{
+			name: "delete",
+			old:  `{"id":1}`,
+			want: map[string]Change{
+				"id": {Old: float64(1)},
+			},
+		},
+		{
+			name: "unchanged",
+			old:  `{"id":1,"tags":["a","b"]}`,
+			new:  `{"id":1,"tags":["a","b"]}`,
+			want: map[string]Change{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Diff([]byte(tt.old), []byte(tt.new))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Diff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := Diff([]byte(`{`), nil); err == nil {
+		t.Error("expected an error for invalid JSON")
+	}
+}
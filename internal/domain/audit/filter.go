@@ -0,0 +1,40 @@
+package audit
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/thang1834/go-goss/internal/utility/filter"
+)
+
+type Filter struct {
+	Base filter.Filter
+
+	ActorID uint64
+	// Table is the ent type name, such as Product or OrderItem.
+	Table  string
+	RowID  uint64
+	Action string
+	// From and To bound created_at. To is exclusive; a date without a time
+	// includes the whole day.
+	From time.Time
+	To   time.Time
+}
+
+func Filters(queries url.Values) *Filter {
+	f := filter.New(queries)
+
+	actorID, _ := strconv.ParseUint(queries.Get("actor_id"), 10, 64)
+	rowID, _ := strconv.ParseUint(queries.Get("row_id"), 10, 64)
+
+	return &Filter{
+		Base:    *f,
+		ActorID: actorID,
+		Table:   queries.Get("table"),
+		RowID:   rowID,
+		Action:  queries.Get("action"),
+		From:    filter.ParseTime(queries.Get("from"), false),
+		To:      filter.ParseTime(queries.Get("to"), true),
+	}
+}
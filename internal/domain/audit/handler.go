@@ -0,0 +1,47 @@
+package audit
+
+import (
+	"net/http"
+
+	"github.com/thang1834/go-goss/internal/utility/message"
+	"github.com/thang1834/go-goss/internal/utility/respond"
+)
+
+type Handler struct {
+	useCase UseCase
+}
+
+func NewHandler(useCase UseCase) *Handler {
+	return &Handler{
+		useCase: useCase,
+	}
+}
+
+// List searches the audit trail, newest first
+// @Summary List audit logs
+// @Param actor_id query int false "user who made the change"
+// @Param table query string false "entity type, e.g. Product"
+// @Param row_id query int false "ID of the changed row"
+// @Param action query string false "create, update or delete"
+// @Param from query string false "RFC 3339 timestamp or date, inclusive"
+// @Param to query string false "RFC 3339 timestamp or date, exclusive"
+// @Param page query int false "page number"
+// @Param limit query int false "items per page"
+// @Success 200 {object} respond.Standard
+// @router /api/v1/manage/audit-logs [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	logs, total, err := h.useCase.List(r.Context(), Filters(r.URL.Query()))
+	if err != nil {
+		respond.Error(w, http.StatusInternalServerError, message.ErrInternalError)
+		return
+	}
+
+	list := Resources(logs)
+	respond.Json(w, http.StatusOK, respond.Standard{
+		Data: list,
+		Meta: respond.Meta{
+			Size:  len(list),
+			Total: total,
+		},
+	})
+}
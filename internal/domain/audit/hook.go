@@ -0,0 +1,203 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"entgo.io/ent"
+
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+// maxRows bounds how many rows of a bulk update or delete are read to keep
+// their values. Larger changes are recorded as one event without values.
+const maxRows = 100
+
+// Hook records the rows changed by every mutation made while serving a
+// request that went through middleware.Audit. Updated and deleted rows are
+// read before the mutation so that their old values and a diff are kept.
+//
+// Events are recorded once the mutation succeeds. Within a transaction they
+// wait for the commit, and are dropped if the transaction rolls back.
+func Hook(rec *Recorder) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			meta, ok := ctx.Value(middleware.KeyAuditID).(middleware.Event)
+			if !ok || m.Type() == gen.TypeAuditLog {
+				return next.Mutate(ctx, m)
+			}
+
+			var (
+				ids    []uint64
+				before map[uint64][]byte
+			)
+			if !m.Op().Is(ent.OpCreate) {
+				ids, before = rowsBefore(ctx, m)
+			}
+
+			val, err := next.Mutate(ctx, m)
+			if err != nil {
+				return val, err
+			}
+
+			after := make(map[uint64][]byte)
+			switch {
+			case m.Op().Is(ent.OpCreate | ent.OpUpdateOne):
+				row, err := json.Marshal(val)
+				if err != nil {
+					log.Printf("audit: encoding %s: %v", m.Type(), err)
+					break
+				}
+				id := rowID(row)
+				if len(ids) == 0 {
+					ids = []uint64{id}
+				}
+				after[id] = row
+			case m.Op().Is(ent.OpUpdate):
+				after, err = loadRows(ctx, m, ids)
+				if err != nil {
+					log.Printf("audit: reading updated %s: %v", m.Type(), err)
+				}
+			}
+
+			if len(ids) == 0 {
+				// Rows with composite keys, or too many of them.
+				ids = []uint64{0}
+			}
+			events := make([]middleware.Event, 0, len(ids))
+			for _, id := range ids {
+				events = append(events, event(meta, m, id, before[id], after[id]))
+			}
+			record := func() {
+				for _, e := range events {
+					rec.Record(e)
+				}
+			}
+
+			txer, ok := m.(interface{ Tx() (*gen.Tx, error) })
+			if !ok {
+				record()
+				return val, nil
+			}
+			tx, err := txer.Tx()
+			if err != nil {
+				// Not within a transaction.
+				record()
+				return val, nil
+			}
+			tx.OnCommit(func(next gen.Committer) gen.Committer {
+				return gen.CommitFunc(func(ctx context.Context, tx *gen.Tx) error {
+					if err := next.Commit(ctx, tx); err != nil {
+						return err
+					}
+					record()
+					return nil
+				})
+			})
+
+			return val, nil
+		})
+	}
+}
+
+// rowsBefore returns the IDs of the rows an update or delete is about to
+// change together with their current values.
+func rowsBefore(ctx context.Context, m ent.Mutation) ([]uint64, map[uint64][]byte) {
+	withIDs, ok := m.(interface {
+		IDs(ctx context.Context) ([]uint64, error)
+	})
+	if !ok {
+		return nil, nil
+	}
+
+	ids, err := withIDs.IDs(ctx)
+	if err != nil {
+		log.Printf("audit: finding %s rows: %v", m.Type(), err)
+		return nil, nil
+	}
+	if len(ids) > maxRows {
+		return nil, nil
+	}
+
+	rows, err := loadRows(ctx, m, ids)
+	if err != nil {
+		log.Printf("audit: reading %s rows: %v", m.Type(), err)
+	}
+	return ids, rows
+}
+
+// loadRows reads rows by ID with the client of the mutation, so that a
+// mutation in a transaction reads from the same transaction. Every type has
+// a client named after it, such as Client.Product, with a Get method.
+func loadRows(ctx context.Context, m ent.Mutation, ids []uint64) (map[uint64][]byte, error) {
+	rows := make(map[uint64][]byte, len(ids))
+	if len(ids) == 0 {
+		return rows, nil
+	}
+
+	withClient, ok := m.(interface{ Client() *gen.Client })
+	if !ok {
+		return rows, nil
+	}
+	get := reflect.ValueOf(withClient.Client()).Elem().FieldByName(m.Type()).MethodByName("Get")
+	if !get.IsValid() {
+		return rows, fmt.Errorf("no client for %s", m.Type())
+	}
+
+	for _, id := range ids {
+		out := get.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(id)})
+		if err, _ := out[1].Interface().(error); err != nil {
+			if gen.IsNotFound(err) {
+				continue
+			}
+			return rows, err
+		}
+
+		row, err := json.Marshal(out[0].Interface())
+		if err != nil {
+			return rows, err
+		}
+		rows[id] = row
+	}
+
+	return rows, nil
+}
+
+func rowID(row []byte) uint64 {
+	var v struct {
+		ID uint64 `json:"id"`
+	}
+	_ = json.Unmarshal(row, &v)
+	return v.ID
+}
+
+func event(meta middleware.Event, m ent.Mutation, id uint64, before, after []byte) middleware.Event {
+	meta.Table = m.Type()
+	meta.TableRowID = id
+	meta.CreatedAt = time.Now()
+
+	switch {
+	case m.Op().Is(ent.OpCreate):
+		meta.Action = middleware.ActionCreate
+	case m.Op().Is(ent.OpUpdate | ent.OpUpdateOne):
+		meta.Action = middleware.ActionUpdate
+	default:
+		meta.Action = middleware.ActionDelete
+	}
+
+	meta.OldValues = string(before)
+	meta.NewValues = string(after)
+	if len(before) > 0 || len(after) > 0 {
+		if changes, err := Diff(before, after); err == nil {
+			diff, _ := json.Marshal(changes)
+			meta.Diff = string(diff)
+		}
+	}
+
+	return meta
+}
@@ -0,0 +1,135 @@
+package audit
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+const (
+	queueSize     = 1024
+	batchSize     = 100
+	flushInterval = time.Second
+	writeTimeout  = 10 * time.Second
+)
+
+// Recorder writes audit events to the database in the background so that
+// requests do not wait on the audit trail.
+type Recorder struct {
+	repo   Repo
+	events chan middleware.Event
+	stop   chan struct{}
+	done   chan struct{}
+
+	// mu guards closed. Record holds it for reading while queueing, so no
+	// event is queued once Close has taken it.
+	mu     sync.RWMutex
+	closed bool
+}
+
+func NewRecorder(repo Repo) *Recorder {
+	r := &Recorder{
+		repo:   repo,
+		events: make(chan middleware.Event, queueSize),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go r.run()
+
+	return r
+}
+
+// Record queues an event for writing. If the database falls too far behind
+// the event is logged and dropped rather than blocking the request. Events
+// recorded after Close, by work that outlived the server, are dropped too.
+func (r *Recorder) Record(ev middleware.Event) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.closed {
+		log.Printf("audit: recorder closed, dropping %s of %s %d", ev.Action, ev.Table, ev.TableRowID)
+		return
+	}
+
+	select {
+	case r.events <- ev:
+	default:
+		log.Printf("audit: queue full, dropping %s of %s %d", ev.Action, ev.Table, ev.TableRowID)
+	}
+}
+
+// Close writes the queued events and stops the recorder.
+func (r *Recorder) Close(ctx context.Context) error {
+	r.mu.Lock()
+	if !r.closed {
+		r.closed = true
+		close(r.stop)
+	}
+	r.mu.Unlock()
+
+	select {
+	case <-r.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (r *Recorder) run() {
+	defer close(r.done)
+
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]middleware.Event, 0, batchSize)
+	for {
+		select {
+		case ev := <-r.events:
+			batch = append(batch, ev)
+			if len(batch) < batchSize {
+				continue
+			}
+		case <-ticker.C:
+		case <-r.stop:
+			r.drain(batch)
+			return
+		}
+
+		r.write(batch)
+		batch = batch[:0]
+	}
+}
+
+// drain writes batch and whatever is still queued. Nothing is queued once
+// stop is closed.
+func (r *Recorder) drain(batch []middleware.Event) {
+	for {
+		select {
+		case ev := <-r.events:
+			batch = append(batch, ev)
+			if len(batch) == batchSize {
+				r.write(batch)
+				batch = batch[:0]
+			}
+		default:
+			r.write(batch)
+			return
+		}
+	}
+}
+
+func (r *Recorder) write(batch []middleware.Event) {
+	if len(batch) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+	defer cancel()
+
+	if err := r.repo.Create(ctx, batch); err != nil {
+		log.Printf("audit: writing %d events: %v", len(batch), err)
+	}
+}
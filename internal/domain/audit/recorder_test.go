@@ -0,0 +1,75 @@
+package audit
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+type memoryRepo struct {
+	mu      sync.Mutex
+	batches [][]middleware.Event
+}
+
+func (m *memoryRepo) Create(_ context.Context, events []middleware.Event) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.batches = append(m.batches, append([]middleware.Event(nil), events...))
+	return nil
+}
+
+func (m *memoryRepo) List(context.Context, *Filter) ([]*gen.AuditLog, int, error) {
+	return nil, 0, nil
+}
+
+func TestRecorder(t *testing.T) {
+	repo := &memoryRepo{}
+	rec := NewRecorder(repo)
+
+	const n = batchSize + 10
+	for i := 1; i <= n; i++ {
+		rec.Record(middleware.Event{Table: "Product", TableRowID: uint64(i)})
+	}
+
+	if err := rec.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	var written []middleware.Event
+	for _, b := range repo.batches {
+		if len(b) > batchSize {
+			t.Errorf("batch of %d events, want at most %d", len(b), batchSize)
+		}
+		written = append(written, b...)
+	}
+	if len(written) != n {
+		t.Fatalf("wrote %d events, want %d", len(written), n)
+	}
+	for i, ev := range written {
+		if ev.TableRowID != uint64(i+1) {
+			t.Fatalf("event %d is row %d, want events in order", i, ev.TableRowID)
+		}
+	}
+}
+
+func TestRecordAfterClose(t *testing.T) {
+	repo := &memoryRepo{}
+	rec := NewRecorder(repo)
+
+	if err := rec.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	// Work that outlives the server must not panic.
+	rec.Record(middleware.Event{Table: "User", TableRowID: 1})
+
+	if err := rec.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(repo.batches) != 0 {
+		t.Errorf("wrote %d batches after Close", len(repo.batches))
+	}
+}
@@ -0,0 +1,22 @@
+package audit
+
+import (
+	"github.com/gmhafiz/scs/v2"
+	"github.com/go-chi/chi/v5"
+
+	"github.com/thang1834/go-goss/internal/domain/authentication"
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+func RegisterHTTPEndPoints(router *chi.Mux, uc UseCase, session *scs.SessionManager, auth *authentication.Handler) *Handler {
+	h := NewHandler(uc)
+
+	router.Route("/api/v1/manage/audit-logs", func(router chi.Router) {
+		router.Use(middleware.Authenticate(session))
+		router.Use(auth.RequirePermission("audit:read"))
+
+		router.Get("/", h.List)
+	})
+
+	return h
+}
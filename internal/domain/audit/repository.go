@@ -0,0 +1,96 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/ent/gen/auditlog"
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+type Repo interface {
+	Create(ctx context.Context, events []middleware.Event) error
+	List(ctx context.Context, f *Filter) ([]*gen.AuditLog, int, error)
+}
+
+type repo struct {
+	ent *gen.Client
+}
+
+func NewRepo(ent *gen.Client) *repo {
+	return &repo{
+		ent: ent,
+	}
+}
+
+func (r *repo) Create(ctx context.Context, events []middleware.Event) error {
+	builders := make([]*gen.AuditLogCreate, 0, len(events))
+	for _, ev := range events {
+		b := r.ent.AuditLog.Create().
+			SetTableName(ev.Table).
+			SetAction(string(ev.Action)).
+			SetHTTPMethod(ev.HTTPMethod).
+			SetURL(ev.URL).
+			SetIPAddress(ev.IPAddress).
+			SetUserAgent(ev.UserAgent).
+			SetCreatedAt(ev.CreatedAt)
+		if ev.ActorID != 0 {
+			b.SetActorID(ev.ActorID)
+		}
+		if ev.TableRowID != 0 {
+			b.SetTableRowID(ev.TableRowID)
+		}
+		if ev.OldValues != "" {
+			b.SetOldValues(ev.OldValues)
+		}
+		if ev.NewValues != "" {
+			b.SetNewValues(ev.NewValues)
+		}
+		if ev.Diff != "" {
+			b.SetDiff(ev.Diff)
+		}
+		builders = append(builders, b)
+	}
+
+	return r.ent.AuditLog.CreateBulk(builders...).Exec(ctx)
+}
+
+func (r *repo) List(ctx context.Context, f *Filter) ([]*gen.AuditLog, int, error) {
+	query := r.ent.AuditLog.Query()
+
+	if f.ActorID != 0 {
+		query = query.Where(auditlog.ActorIDEQ(f.ActorID))
+	}
+	if f.Table != "" {
+		query = query.Where(auditlog.TableNameEQ(f.Table))
+	}
+	if f.RowID != 0 {
+		query = query.Where(auditlog.TableRowIDEQ(f.RowID))
+	}
+	if f.Action != "" {
+		query = query.Where(auditlog.ActionEQ(f.Action))
+	}
+	if !f.From.IsZero() {
+		query = query.Where(auditlog.CreatedAtGTE(f.From))
+	}
+	if !f.To.IsZero() {
+		query = query.Where(auditlog.CreatedAtLT(f.To))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Order(gen.Desc(auditlog.FieldID))
+	if !f.Base.DisablePaging {
+		query = query.Limit(f.Base.Limit).Offset(f.Base.Offset)
+	}
+
+	logs, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return logs, total, nil
+}
@@ -0,0 +1,57 @@
+package audit
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/thang1834/go-goss/ent/gen"
+)
+
+type Res struct {
+	ID         uint64          `json:"id"`
+	ActorID    *uint64         `json:"actor_id"`
+	Table      string          `json:"table"`
+	TableRowID *uint64         `json:"table_row_id"`
+	Action     string          `json:"action"`
+	OldValues  json.RawMessage `json:"old_values,omitempty"`
+	NewValues  json.RawMessage `json:"new_values,omitempty"`
+	Diff       json.RawMessage `json:"diff,omitempty"`
+	HTTPMethod string          `json:"http_method"`
+	URL        string          `json:"url"`
+	IPAddress  string          `json:"ip_address"`
+	UserAgent  string          `json:"user_agent"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+func Resource(l *gen.AuditLog) *Res {
+	return &Res{
+		ID:         l.ID,
+		ActorID:    l.ActorID,
+		Table:      l.TableName,
+		TableRowID: l.TableRowID,
+		Action:     l.Action,
+		OldValues:  raw(l.OldValues),
+		NewValues:  raw(l.NewValues),
+		Diff:       raw(l.Diff),
+		HTTPMethod: l.HTTPMethod,
+		URL:        l.URL,
+		IPAddress:  l.IPAddress,
+		UserAgent:  l.UserAgent,
+		CreatedAt:  l.CreatedAt,
+	}
+}
+
+func Resources(logs []*gen.AuditLog) []*Res {
+	res := make([]*Res, 0, len(logs))
+	for _, l := range logs {
+		res = append(res, Resource(l))
+	}
+	return res
+}
+
+func raw(s *string) json.RawMessage {
+	if s == nil {
+		return nil
+	}
+	return json.RawMessage(*s)
+}
@@ -0,0 +1,25 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/thang1834/go-goss/ent/gen"
+)
+
+type UseCase interface {
+	List(ctx context.Context, f *Filter) ([]*gen.AuditLog, int, error)
+}
+
+type Audit struct {
+	repo Repo
+}
+
+func New(repo Repo) *Audit {
+	return &Audit{
+		repo: repo,
+	}
+}
+
+func (u *Audit) List(ctx context.Context, f *Filter) ([]*gen.AuditLog, int, error) {
+	return u.repo.List(ctx, f)
+}
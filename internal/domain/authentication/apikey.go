@@ -0,0 +1,131 @@
+package authentication
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/thang1834/go-goss/internal/middleware"
+	"github.com/thang1834/go-goss/internal/utility/param"
+	"github.com/thang1834/go-goss/internal/utility/request"
+	"github.com/thang1834/go-goss/internal/utility/respond"
+)
+
+var (
+	ErrAPIKeyNameRequired = errors.New("name is required")
+	ErrScopesRequired     = errors.New("at least one scope is required")
+	ErrExpiryInPast       = errors.New("expires_at must be in the future")
+)
+
+// CreateAPIKey issues a personal access token. The key is only shown in
+// this response.
+// @Summary Create API key
+// @Param key body CreateAPIKeyRequest true "name, scopes and optional expiry"
+// @Success 201 {object} APIKeyResponse
+// @Failure 400
+// @Failure 403
+// @router /api/v1/restricted/api-keys [post]
+func (h *Handler) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	var req CreateAPIKeyRequest
+	err := request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	userID, ok := h.session.Get(r.Context(), string(middleware.KeyID)).(uint64)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	req.Name = strings.TrimSpace(req.Name)
+	switch {
+	case req.Name == "":
+		respond.Error(w, http.StatusBadRequest, ErrAPIKeyNameRequired)
+		return
+	case len(req.Scopes) == 0:
+		respond.Error(w, http.StatusBadRequest, ErrScopesRequired)
+		return
+	case req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()):
+		respond.Error(w, http.StatusBadRequest, ErrExpiryInPast)
+		return
+	}
+
+	for _, scope := range req.Scopes {
+		if !h.hasPermission(r.Context(), userID, scope) {
+			respond.Error(w, http.StatusBadRequest, fmt.Errorf("you do not have the %q permission", scope))
+			return
+		}
+	}
+
+	key, token, err := h.repo.CreateAPIKey(r.Context(), userID, req)
+	if err != nil {
+		respond.Status(w, http.StatusInternalServerError)
+		return
+	}
+
+	res := APIKeyResource(key)
+	res.Key = token
+
+	respond.Json(w, http.StatusCreated, res)
+}
+
+// ListAPIKeys lists the current user's API keys that have not been revoked
+// @Summary List API keys
+// @Success 200 {array} APIKeyResponse
+// @router /api/v1/restricted/api-keys [get]
+func (h *Handler) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.session.Get(r.Context(), string(middleware.KeyID)).(uint64)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	keys, err := h.repo.ListAPIKeys(r.Context(), userID)
+	if err != nil {
+		respond.Status(w, http.StatusInternalServerError)
+		return
+	}
+
+	res := make([]*APIKeyResponse, 0, len(keys))
+	for _, k := range keys {
+		res = append(res, APIKeyResource(k))
+	}
+
+	respond.Json(w, http.StatusOK, res)
+}
+
+// RevokeAPIKey revokes one of the current user's API keys
+// @Summary Revoke API key
+// @Param keyID path int true "API key ID"
+// @Success 204
+// @Failure 404
+// @router /api/v1/restricted/api-keys/{keyID} [delete]
+func (h *Handler) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	keyID, err := param.UInt64(r, "keyID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	userID, ok := h.session.Get(r.Context(), string(middleware.KeyID)).(uint64)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	err = h.repo.RevokeAPIKey(r.Context(), userID, keyID)
+	if err != nil {
+		if errors.Is(err, ErrAPIKeyNotFound) {
+			respond.Error(w, http.StatusNotFound, err)
+			return
+		}
+		respond.Status(w, http.StatusInternalServerError)
+		return
+	}
+
+	respond.Status(w, http.StatusNoContent)
+}
@@ -0,0 +1,107 @@
+package authentication
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/thang1834/go-goss/config"
+)
+
+// Attempts is the failed login count of one key within the attempt window.
+type Attempts struct {
+	Failures     int
+	LastFailedAt time.Time
+}
+
+// AttemptStore counts failed logins per key. Counts older than the window
+// are forgotten.
+type AttemptStore interface {
+	Get(ctx context.Context, key string, window time.Duration) (Attempts, error)
+	Fail(ctx context.Context, key string, window time.Duration) (Attempts, error)
+	Reset(ctx context.Context, key string) error
+}
+
+// LoginLimiter applies exponential back-off to logins per email and per IP.
+type LoginLimiter struct {
+	store AttemptStore
+	cfg   config.Auth
+	now   func() time.Time
+}
+
+func NewLoginLimiter(store AttemptStore, cfg config.Auth) *LoginLimiter {
+	return &LoginLimiter{
+		store: store,
+		cfg:   cfg,
+		now:   time.Now,
+	}
+}
+
+// Wait returns how long the caller must wait before the next login attempt
+// for email from ip is considered.
+func (l *LoginLimiter) Wait(ctx context.Context, email, ip string) (time.Duration, error) {
+	var wait time.Duration
+	for _, k := range l.keys(email, ip) {
+		a, err := l.store.Get(ctx, k.key, l.cfg.LoginAttemptWindow)
+		if err != nil {
+			return 0, err
+		}
+
+		delay := backoff(a.Failures, k.free, l.cfg.LoginBackoffBase, l.cfg.LoginBackoffMax)
+		wait = max(wait, a.LastFailedAt.Add(delay).Sub(l.now()))
+	}
+	return wait, nil
+}
+
+// Fail records a failed login and returns the failure count of the email.
+func (l *LoginLimiter) Fail(ctx context.Context, email, ip string) (int, error) {
+	var failures int
+	for _, k := range l.keys(email, ip) {
+		a, err := l.store.Fail(ctx, k.key, l.cfg.LoginAttemptWindow)
+		if err != nil {
+			return 0, err
+		}
+		if k.email {
+			failures = a.Failures
+		}
+	}
+	return failures, nil
+}
+
+// Reset forgets the failures of an email after a successful login. The IP
+// count is kept, one correct password says nothing about other accounts
+// tried from the same address.
+func (l *LoginLimiter) Reset(ctx context.Context, email string) error {
+	return l.store.Reset(ctx, emailKey(email))
+}
+
+type limitKey struct {
+	key   string
+	free  int
+	email bool
+}
+
+func (l *LoginLimiter) keys(email, ip string) []limitKey {
+	return []limitKey{
+		{key: emailKey(email), free: l.cfg.LoginFreeAttempts, email: true},
+		{key: "ip:" + ip, free: l.cfg.LoginIPFreeAttempts},
+	}
+}
+
+func emailKey(email string) string {
+	return "email:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+// backoff returns the delay required after failures, doubling from base for
+// every failure past the free ones and capped at limit.
+func backoff(failures, free int, base, limit time.Duration) time.Duration {
+	if failures < free {
+		return 0
+	}
+
+	n := failures - free
+	if n >= 32 {
+		return limit
+	}
+	return min(base<<n, limit)
+}
@@ -0,0 +1,55 @@
+package authentication
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// PostgresAttempts keeps failed login counts in the login_attempts table. It
+// is used when Redis is not enabled.
+type PostgresAttempts struct {
+	db *sql.DB
+}
+
+func NewPostgresAttempts(db *sql.DB) *PostgresAttempts {
+	return &PostgresAttempts{db: db}
+}
+
+func (s *PostgresAttempts) Get(ctx context.Context, key string, window time.Duration) (Attempts, error) {
+	var a Attempts
+	err := s.db.QueryRowContext(ctx, `
+		SELECT failures, last_failed_at
+		FROM login_attempts
+		WHERE key = $1
+		  AND last_failed_at > $2`, key, time.Now().Add(-window)).
+		Scan(&a.Failures, &a.LastFailedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Attempts{}, nil
+	}
+	return a, err
+}
+
+// Fail increments the count in one statement, starting over when the last
+// failure fell outside the window.
+func (s *PostgresAttempts) Fail(ctx context.Context, key string, window time.Duration) (Attempts, error) {
+	var a Attempts
+	err := s.db.QueryRowContext(ctx, `
+		INSERT INTO login_attempts (key, failures, last_failed_at)
+		VALUES ($1, 1, $2)
+		ON CONFLICT (key) DO UPDATE
+			SET failures       = CASE
+									 WHEN login_attempts.last_failed_at > $3 THEN login_attempts.failures + 1
+									 ELSE 1
+				END,
+				last_failed_at = excluded.last_failed_at
+		RETURNING failures, last_failed_at`, key, time.Now(), time.Now().Add(-window)).
+		Scan(&a.Failures, &a.LastFailedAt)
+	return a, err
+}
+
+func (s *PostgresAttempts) Reset(ctx context.Context, key string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM login_attempts WHERE key = $1`, key)
+	return err
+}
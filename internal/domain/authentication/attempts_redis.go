@@ -0,0 +1,71 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const attemptsPrefix = "login_attempts:"
+
+// RedisAttempts keeps failed login counts in Redis hashes that expire with
+// the attempt window. It works with a single node and a cluster.
+type RedisAttempts struct {
+	client redis.Cmdable
+}
+
+func NewRedisAttempts(client redis.Cmdable) *RedisAttempts {
+	return &RedisAttempts{client: client}
+}
+
+func (s *RedisAttempts) Get(ctx context.Context, key string, _ time.Duration) (Attempts, error) {
+	values, err := s.client.HMGet(ctx, attemptsPrefix+key, "failures", "last").Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return Attempts{}, nil
+		}
+		return Attempts{}, err
+	}
+	return parseAttempts(values[0], values[1]), nil
+}
+
+func (s *RedisAttempts) Fail(ctx context.Context, key string, window time.Duration) (Attempts, error) {
+	now := time.Now()
+	key = attemptsPrefix + key
+
+	var failures *redis.IntCmd
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		failures = pipe.HIncrBy(ctx, key, "failures", 1)
+		pipe.HSet(ctx, key, "last", now.UnixNano())
+		pipe.Expire(ctx, key, window)
+		return nil
+	})
+	if err != nil {
+		return Attempts{}, err
+	}
+
+	return Attempts{
+		Failures:     int(failures.Val()),
+		LastFailedAt: now,
+	}, nil
+}
+
+func (s *RedisAttempts) Reset(ctx context.Context, key string) error {
+	return s.client.Del(ctx, attemptsPrefix+key).Err()
+}
+
+func parseAttempts(failures, last any) Attempts {
+	var a Attempts
+	if s, ok := failures.(string); ok {
+		a.Failures, _ = strconv.Atoi(s)
+	}
+	if s, ok := last.(string); ok {
+		if nanos, err := strconv.ParseInt(s, 10, 64); err == nil {
+			a.LastFailedAt = time.Unix(0, nanos)
+		}
+	}
+	return a
+}
@@ -0,0 +1,112 @@
+package authentication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/thang1834/go-goss/config"
+)
+
+func TestBackoff(t *testing.T) {
+	base, limit := time.Second, time.Minute
+	tests := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{0, 0},
+		{2, 0},
+		{3, time.Second},
+		{4, 2 * time.Second},
+		{6, 8 * time.Second},
+		{9, time.Minute},
+		{100, time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := backoff(tt.failures, 3, base, limit); got != tt.want {
+			t.Errorf("backoff(%d) = %s, want %s", tt.failures, got, tt.want)
+		}
+	}
+}
+
+// memoryAttempts is an AttemptStore for tests that ignores the window.
+type memoryAttempts struct {
+	now      func() time.Time
+	attempts map[string]Attempts
+}
+
+func (m *memoryAttempts) Get(_ context.Context, key string, _ time.Duration) (Attempts, error) {
+	return m.attempts[key], nil
+}
+
+func (m *memoryAttempts) Fail(_ context.Context, key string, _ time.Duration) (Attempts, error) {
+	a := m.attempts[key]
+	a.Failures++
+	a.LastFailedAt = m.now()
+	m.attempts[key] = a
+	return a, nil
+}
+
+func (m *memoryAttempts) Reset(_ context.Context, key string) error {
+	delete(m.attempts, key)
+	return nil
+}
+
+func TestLoginLimiter(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	store := &memoryAttempts{now: clock, attempts: map[string]Attempts{}}
+	l := NewLoginLimiter(store, config.Auth{
+		LoginFreeAttempts:   2,
+		LoginIPFreeAttempts: 5,
+		LoginBackoffBase:    time.Second,
+		LoginBackoffMax:     time.Minute,
+	})
+	l.now = clock
+	ctx := context.Background()
+
+	wait := func(email, ip string) time.Duration {
+		t.Helper()
+		d, err := l.Wait(ctx, email, ip)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+
+	for i := 1; i <= 3; i++ {
+		failures, err := l.Fail(ctx, "Jane@Example.com", "10.0.0.1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if failures != i {
+			t.Errorf("failures = %d, want %d", failures, i)
+		}
+	}
+
+	// Three failures with two free: 2s back-off, keyed case-insensitively.
+	if got := wait("jane@example.com", "10.0.0.2"); got != 2*time.Second {
+		t.Errorf("email wait = %s, want 2s", got)
+	}
+	// Another email from the same IP is still under the IP allowance.
+	if got := wait("john@example.com", "10.0.0.1"); got != 0 {
+		t.Errorf("ip wait = %s, want 0", got)
+	}
+
+	now = now.Add(3 * time.Second)
+	if got := wait("jane@example.com", "10.0.0.1"); got != 0 {
+		t.Errorf("wait after back-off = %s, want 0", got)
+	}
+
+	if err := l.Reset(ctx, "JANE@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := store.attempts["email:jane@example.com"]; ok {
+		t.Error("email failures were not reset")
+	}
+	if _, ok := store.attempts["ip:10.0.0.1"]; !ok {
+		t.Error("ip failures must survive a successful login")
+	}
+}
@@ -5,16 +5,23 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log"
+	"math"
 	"net/http"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gmhafiz/scs/v2"
 	"github.com/redis/go-redis/v9"
+	"github.com/thang1834/go-goss/config"
+	"github.com/thang1834/go-goss/ent/gen"
 	"github.com/thang1834/go-goss/internal/middleware"
 	"github.com/thang1834/go-goss/internal/utility/param"
 	"github.com/thang1834/go-goss/internal/utility/request"
 	"github.com/thang1834/go-goss/internal/utility/respond"
+	"github.com/thang1834/go-goss/third_party/mailer"
 )
 
 const (
@@ -22,20 +29,29 @@ const (
 )
 
 var (
+	ErrTooManyAttempts   = errors.New("too many failed login attempts, try again later")
 	ErrEmailRequired     = errors.New("email is required")
 	ErrPasswordLength    = fmt.Errorf("password must be at least %d characters", minPasswordLength)
 	ErrFirstNameRequired = errors.New("first name is required")
 	ErrLastNameRequired  = errors.New("last name is required")
 )
 
+// LoginHook runs after a user has been logged into the current session.
+type LoginHook func(ctx context.Context, userID uint64) error
+
 type Handler struct {
 	repo        Repo
 	session     *scs.SessionManager
 	redisClient *redis.Client
+	mailer      mailer.Mailer
+	limiter     *LoginLimiter
+	cfg         config.Auth
+	loginHooks  []LoginHook
+	providers   map[string]oauthProvider
 }
 
 // NewHandler creates new handler with Redis caching
-func NewHandler(session *scs.SessionManager, repo Repo, redisAddr string) (*Handler, error) {
+func NewHandler(session *scs.SessionManager, repo Repo, redisAddr string, mail mailer.Mailer, attempts AttemptStore, cfg config.Auth) (*Handler, error) {
 	// Setup Redis client for permission caching (different DB from sessions)
 	redisClient := redis.NewClient(&redis.Options{
 		Addr: redisAddr,
@@ -51,6 +67,9 @@ func NewHandler(session *scs.SessionManager, repo Repo, redisAddr string) (*Hand
 		repo:        repo,
 		session:     session,
 		redisClient: redisClient,
+		mailer:      mail,
+		limiter:     NewLoginLimiter(attempts, cfg),
+		cfg:         cfg,
 	}, nil
 }
 
@@ -93,6 +112,17 @@ func (h *Handler) Register(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err = h.sendVerification(r.Context(), user); err != nil {
+		log.Printf("sending verification email to user %d: %v", user.ID, err)
+	}
+
+	// Unverified accounts cannot log in, so neither can they be logged in
+	// automatically.
+	if h.cfg.RequireVerifiedEmail {
+		respond.Status(w, http.StatusCreated)
+		return
+	}
+
 	// Auto-login after registration
 	if err := h.session.RenewToken(r.Context()); err != nil {
 		respond.Error(w, http.StatusInternalServerError, err)
@@ -100,6 +130,7 @@ func (h *Handler) Register(w http.ResponseWriter, r *http.Request) {
 	}
 
 	h.session.Put(r.Context(), string(middleware.KeyID), user.ID)
+	h.runLoginHooks(r.Context(), user.ID)
 
 	respond.Status(w, http.StatusCreated)
 }
@@ -114,21 +145,114 @@ func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx := r.Context()
+	ip := middleware.ClientIP(r)
+
+	if h.throttled(ctx, w, req.Email, ip) {
+		return
+	}
 
 	user, match, err := h.repo.Login(ctx, req)
-	if err != nil || !match {
+	switch {
+	case errors.Is(err, ErrUserLocked):
+		respond.Error(w, http.StatusLocked, err)
+		return
+	case errors.Is(err, ErrUserInactive), errors.Is(err, ErrEmailNotVerified):
+		respond.Error(w, http.StatusForbidden, err)
+		return
+	case err != nil || !match:
+		h.loginFailed(ctx, req.Email, ip)
 		respond.Status(w, http.StatusUnauthorized)
 		return
 	}
 
-	if err := h.session.RenewToken(ctx); err != nil {
+	twoFactorRequired, err := h.logIn(ctx, user)
+	if err != nil {
 		respond.Error(w, http.StatusInternalServerError, err)
 		return
 	}
+	if twoFactorRequired {
+		respond.Json(w, http.StatusOK, LoginResponse{TwoFactorRequired: true})
+		return
+	}
+
+	respond.Status(w, http.StatusOK)
+}
+
+// logIn renews the session and logs user into it. With two-factor
+// authentication enabled the session stays pending instead, until
+// LoginTwoFactor accepts a code, and failed logins are only forgotten then.
+func (h *Handler) logIn(ctx context.Context, user *gen.User) (twoFactorRequired bool, err error) {
+	if err = h.session.RenewToken(ctx); err != nil {
+		return false, err
+	}
+
+	if user.TotpEnabledAt != nil {
+		h.session.Put(ctx, keyPendingUserID, user.ID)
+		h.session.Put(ctx, keyPendingSince, time.Now().Unix())
+		return true, nil
+	}
 
 	h.session.Put(ctx, string(middleware.KeyID), user.ID)
+	h.loginSucceeded(ctx, user)
 
-	respond.Status(w, http.StatusOK)
+	return false, nil
+}
+
+// throttled answers 429 Too Many Requests and reports true while logins for
+// email from ip are backing off.
+func (h *Handler) throttled(ctx context.Context, w http.ResponseWriter, email, ip string) bool {
+	wait, err := h.limiter.Wait(ctx, email, ip)
+	if err != nil {
+		log.Printf("login limiter: %v", err)
+	}
+	if wait <= 0 {
+		return false
+	}
+
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+	respond.Error(w, http.StatusTooManyRequests, ErrTooManyAttempts)
+	return true
+}
+
+// loginSucceeded forgets the failed logins of user and runs the login hooks
+// once every factor has been accepted.
+func (h *Handler) loginSucceeded(ctx context.Context, user *gen.User) {
+	if err := h.limiter.Reset(ctx, user.Email); err != nil {
+		log.Printf("login limiter: %v", err)
+	}
+	h.runLoginHooks(ctx, user.ID)
+}
+
+// loginFailed counts a failed login and locks the account once the email
+// reaches LoginLockThreshold failures.
+func (h *Handler) loginFailed(ctx context.Context, email, ip string) {
+	failures, err := h.limiter.Fail(ctx, email, ip)
+	if err != nil {
+		log.Printf("login limiter: %v", err)
+		return
+	}
+
+	if h.cfg.LoginLockThreshold > 0 && failures >= h.cfg.LoginLockThreshold {
+		if err = h.repo.LockUser(ctx, email); err != nil {
+			log.Printf("locking account after failed logins: %v", err)
+		}
+	}
+}
+
+// OnLogin registers a hook that runs after every successful login, including
+// the automatic login following registration.
+func (h *Handler) OnLogin(hook LoginHook) {
+	h.loginHooks = append(h.loginHooks, hook)
+}
+
+// runLoginHooks runs the registered login hooks. A failing hook is logged but
+// never fails the login itself.
+func (h *Handler) runLoginHooks(ctx context.Context, userID uint64) {
+	for _, hook := range h.loginHooks {
+		if err := hook(ctx, userID); err != nil {
+			log.Printf("login hook for user %d: %v", userID, err)
+		}
+	}
 }
 
 // Protected endpoint following go8 pattern
@@ -322,6 +446,46 @@ func (h *Handler) AssignRole(w http.ResponseWriter, r *http.Request) {
 	respond.Status(w, http.StatusOK)
 }
 
+// UnlockUser reactivates an account locked by failed logins (admin only)
+func (h *Handler) UnlockUser(w http.ResponseWriter, r *http.Request) {
+	userID, err := param.UInt64(r, "userID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	adminUserID, ok := h.session.Get(r.Context(), string(middleware.KeyID)).(uint64)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	if !h.hasPermission(r.Context(), adminUserID, "user:unlock") {
+		respond.Status(w, http.StatusForbidden)
+		return
+	}
+
+	user, err := h.repo.UnlockUser(r.Context(), userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrUserNotFound):
+			respond.Error(w, http.StatusNotFound, err)
+		case errors.Is(err, ErrUserNotLocked):
+			respond.Error(w, http.StatusConflict, err)
+		default:
+			respond.Status(w, http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// Start the unlocked user with a clean slate.
+	if err = h.limiter.Reset(r.Context(), user.Email); err != nil {
+		log.Printf("login limiter: %v", err)
+	}
+
+	respond.Status(w, http.StatusOK)
+}
+
 // GetUserRoles gets user roles (admin only)
 func (h *Handler) GetUserRoles(w http.ResponseWriter, r *http.Request) {
 	userIDParam, err := param.UInt64(r, "userID")
@@ -352,8 +516,13 @@ func (h *Handler) GetUserRoles(w http.ResponseWriter, r *http.Request) {
 
 // RBAC Helper methods
 
-// hasPermission checks if user has specific permission (with caching)
+// hasPermission checks if user has specific permission (with caching).
+// Requests made with an API key are further limited to the key's scopes.
 func (h *Handler) hasPermission(ctx context.Context, userID uint64, permission string) bool {
+	if key, ok := middleware.APIKeyFrom(ctx); ok && !key.Allows(permission) {
+		return false
+	}
+
 	permissions, err := h.loadUserPermissions(ctx, userID)
 	if err != nil {
 		return false
@@ -416,22 +585,70 @@ func (h *Handler) loadUserPermissions(ctx context.Context, userID uint64) (*User
 		Email:       user.Email,
 		Roles:       roles,
 		Permissions: permissions,
+		TwoFactor:   user.TotpEnabledAt != nil,
 		CachedAt:    time.Now(),
 	}
 
-	// Cache for 10 minutes
-	permsJSON, _ := json.Marshal(userPerms)
-	h.redisClient.Set(ctx, cacheKey, permsJSON, 10*time.Minute)
+	// Cache for 10 minutes, or until the first grant expires so that it
+	// stops counting on time.
+	ttl := 10 * time.Minute
+	next, err := h.repo.NextGrantExpiry(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !next.IsZero() {
+		ttl = min(ttl, time.Until(next))
+	}
+	if ttl > 0 {
+		permsJSON, _ := json.Marshal(userPerms)
+		h.redisClient.Set(ctx, cacheKey, permsJSON, ttl)
+	}
 
 	return userPerms, nil
 }
 
+// missingTwoFactor reports whether the user holds one of the roles listed in
+// TwoFactorRoles without having two-factor authentication enabled.
+func (h *Handler) missingTwoFactor(ctx context.Context, userID uint64) bool {
+	if len(h.cfg.TwoFactorRoles) == 0 {
+		return false
+	}
+
+	permissions, err := h.loadUserPermissions(ctx, userID)
+	if err != nil {
+		return true
+	}
+	if permissions.TwoFactor {
+		return false
+	}
+
+	for _, role := range permissions.Roles {
+		if slices.Contains(h.cfg.TwoFactorRoles, role) {
+			return true
+		}
+	}
+	return false
+}
+
 // invalidateUserCache clears user permissions cache
 func (h *Handler) invalidateUserCache(ctx context.Context, userID uint64) {
 	cacheKey := fmt.Sprintf("user_perms:%d", userID)
 	h.redisClient.Del(ctx, cacheKey)
 }
 
+// invalidateUsersCache clears the permissions cache of several users at once
+func (h *Handler) invalidateUsersCache(ctx context.Context, userIDs []uint64) {
+	if len(userIDs) == 0 {
+		return
+	}
+
+	keys := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		keys = append(keys, fmt.Sprintf("user_perms:%d", id))
+	}
+	h.redisClient.Del(ctx, keys...)
+}
+
 // Middleware functions following go8 pattern
 
 // RequirePermission middleware for permission-based access control
@@ -469,6 +686,11 @@ func (h *Handler) RequireRole(role string) func(http.Handler) http.Handler {
 				return
 			}
 
+			if h.missingTwoFactor(r.Context(), userID) {
+				respond.Error(w, http.StatusForbidden, ErrTwoFactorRequired)
+				return
+			}
+
 			next.ServeHTTP(w, r)
 		})
 	}
@@ -497,6 +719,11 @@ func (h *Handler) RequireAnyRole(roles ...string) func(http.Handler) http.Handle
 				return
 			}
 
+			if h.missingTwoFactor(r.Context(), userID) {
+				respond.Error(w, http.StatusForbidden, ErrTwoFactorRequired)
+				return
+			}
+
 			next.ServeHTTP(w, r)
 		})
 	}
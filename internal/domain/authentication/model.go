@@ -61,5 +61,6 @@ type UserPermissionsCache struct {
 	Email       string    `json:"email"`
 	Roles       []string  `json:"roles"`
 	Permissions []string  `json:"permissions"`
+	TwoFactor   bool      `json:"two_factor"`
 	CachedAt    time.Time `json:"cached_at"`
 }
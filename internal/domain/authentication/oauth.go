@@ -0,0 +1,244 @@
+package authentication
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/thang1834/go-goss/internal/middleware"
+	"github.com/thang1834/go-goss/internal/utility/param"
+	"github.com/thang1834/go-goss/internal/utility/respond"
+	"github.com/thang1834/go-goss/third_party/oidc"
+)
+
+const (
+	// Session keys of a login waiting for the provider's callback.
+	keyOAuthProvider = "oauth_provider"
+	keyOAuthState    = "oauth_state"
+	keyOAuthNonce    = "oauth_nonce"
+	keyOAuthVerifier = "oauth_verifier"
+	keyOAuthSince    = "oauth_since"
+
+	oauthLoginTTL = 10 * time.Minute
+)
+
+var (
+	ErrOAuthState  = errors.New("login request is invalid or has expired, start again")
+	ErrOAuthFailed = errors.New("the provider did not confirm the login")
+)
+
+type oauthProvider struct {
+	*oidc.Provider
+	// loginRedirect is where the browser goes after the callback, if set.
+	loginRedirect string
+}
+
+// AddOIDCProvider enables logging in with p at /api/v1/oauth/{name}/login.
+// After the callback the browser is redirected to loginRedirect, or receives
+// JSON if it is empty.
+func (h *Handler) AddOIDCProvider(p *oidc.Provider, loginRedirect string) {
+	if h.providers == nil {
+		h.providers = make(map[string]oauthProvider)
+	}
+	h.providers[p.Name()] = oauthProvider{Provider: p, loginRedirect: loginRedirect}
+}
+
+// OAuthLogin redirects to the provider's login page
+// @Summary Log in with an OpenID Connect provider
+// @Param provider path string true "Provider name"
+// @Success 302
+// @Failure 404
+// @router /api/v1/oauth/{provider}/login [get]
+func (h *Handler) OAuthLogin(w http.ResponseWriter, r *http.Request) {
+	p, ok := h.providers[param.String(r, "provider")]
+	if !ok {
+		respond.Status(w, http.StatusNotFound)
+		return
+	}
+
+	var values [3]string
+	for i := range values {
+		v, err := oidc.RandomString()
+		if err != nil {
+			respond.Status(w, http.StatusInternalServerError)
+			return
+		}
+		values[i] = v
+	}
+	state, nonce, verifier := values[0], values[1], values[2]
+
+	ctx := r.Context()
+	h.session.Put(ctx, keyOAuthProvider, p.Name())
+	h.session.Put(ctx, keyOAuthState, state)
+	h.session.Put(ctx, keyOAuthNonce, nonce)
+	h.session.Put(ctx, keyOAuthVerifier, verifier)
+	h.session.Put(ctx, keyOAuthSince, time.Now().Unix())
+
+	http.Redirect(w, r, p.AuthCodeURL(state, nonce, verifier), http.StatusFound)
+}
+
+// OAuthCallback completes a provider login. The identity is linked to the
+// account with the same verified email, or a new account is created.
+// @Summary OpenID Connect callback
+// @Param provider path string true "Provider name"
+// @Param code query string true "Authorization code"
+// @Param state query string true "State from the login request"
+// @Success 200 {object} LoginResponse
+// @Success 302
+// @Failure 400
+// @Failure 401
+// @Failure 403
+// @Failure 409
+// @Failure 423
+// @router /api/v1/oauth/{provider}/callback [get]
+func (h *Handler) OAuthCallback(w http.ResponseWriter, r *http.Request) {
+	name := param.String(r, "provider")
+	p, ok := h.providers[name]
+	if !ok {
+		respond.Status(w, http.StatusNotFound)
+		return
+	}
+
+	ctx := r.Context()
+
+	provider := h.session.GetString(ctx, keyOAuthProvider)
+	state := h.session.GetString(ctx, keyOAuthState)
+	nonce := h.session.GetString(ctx, keyOAuthNonce)
+	verifier := h.session.GetString(ctx, keyOAuthVerifier)
+	since := h.session.GetInt64(ctx, keyOAuthSince)
+	// A login request can be completed only once.
+	h.clearOAuthLogin(ctx)
+
+	q := r.URL.Query()
+	if state == "" || provider != name ||
+		time.Since(time.Unix(since, 0)) > oauthLoginTTL ||
+		subtle.ConstantTimeCompare([]byte(q.Get("state")), []byte(state)) != 1 {
+		respond.Error(w, http.StatusBadRequest, ErrOAuthState)
+		return
+	}
+	if q.Get("error") != "" {
+		respond.Error(w, http.StatusUnauthorized, ErrOAuthFailed)
+		return
+	}
+
+	claims, err := p.Exchange(ctx, q.Get("code"), verifier, nonce)
+	if err != nil {
+		log.Printf("oauth %s callback: %v", name, err)
+		respond.Error(w, http.StatusUnauthorized, ErrOAuthFailed)
+		return
+	}
+
+	user, err := h.repo.LoginWithIdentity(ctx, name, claims)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrIdentityEmailUnverified):
+			respond.Error(w, http.StatusForbidden, err)
+		case errors.Is(err, ErrIdentityNotLinkable):
+			respond.Error(w, http.StatusConflict, err)
+		default:
+			respond.Status(w, http.StatusInternalServerError)
+		}
+		return
+	}
+
+	switch {
+	case user.Status == statusLocked:
+		respond.Error(w, http.StatusLocked, ErrUserLocked)
+		return
+	case user.Status != statusActive:
+		respond.Error(w, http.StatusForbidden, ErrUserInactive)
+		return
+	}
+
+	twoFactorRequired, err := h.logIn(ctx, user)
+	if err != nil {
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if p.loginRedirect == "" {
+		respond.Json(w, http.StatusOK, LoginResponse{TwoFactorRequired: twoFactorRequired})
+		return
+	}
+
+	target, err := url.Parse(p.loginRedirect)
+	if err != nil {
+		respond.Status(w, http.StatusInternalServerError)
+		return
+	}
+	if twoFactorRequired {
+		query := target.Query()
+		query.Set("two_factor_required", "true")
+		target.RawQuery = query.Encode()
+	}
+	http.Redirect(w, r, target.String(), http.StatusFound)
+}
+
+func (h *Handler) clearOAuthLogin(ctx context.Context) {
+	h.session.Remove(ctx, keyOAuthProvider)
+	h.session.Remove(ctx, keyOAuthState)
+	h.session.Remove(ctx, keyOAuthNonce)
+	h.session.Remove(ctx, keyOAuthVerifier)
+	h.session.Remove(ctx, keyOAuthSince)
+}
+
+// ListIdentities lists the external identities linked to the current user
+// @Summary List linked identities
+// @Success 200 {array} IdentityResponse
+// @router /api/v1/restricted/identities [get]
+func (h *Handler) ListIdentities(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.session.Get(r.Context(), string(middleware.KeyID)).(uint64)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	identities, err := h.repo.ListIdentities(r.Context(), userID)
+	if err != nil {
+		respond.Status(w, http.StatusInternalServerError)
+		return
+	}
+
+	res := make([]*IdentityResponse, 0, len(identities))
+	for _, i := range identities {
+		res = append(res, IdentityResource(i))
+	}
+
+	respond.Json(w, http.StatusOK, res)
+}
+
+// UnlinkIdentity removes one of the current user's linked identities
+// @Summary Unlink identity
+// @Param identityID path int true "Identity ID"
+// @Success 204
+// @Failure 404
+// @router /api/v1/restricted/identities/{identityID} [delete]
+func (h *Handler) UnlinkIdentity(w http.ResponseWriter, r *http.Request) {
+	identityID, err := param.UInt64(r, "identityID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	userID, ok := h.session.Get(r.Context(), string(middleware.KeyID)).(uint64)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	err = h.repo.UnlinkIdentity(r.Context(), userID, identityID)
+	if err != nil {
+		if errors.Is(err, ErrIdentityNotFound) {
+			respond.Error(w, http.StatusNotFound, err)
+			return
+		}
+		respond.Status(w, http.StatusInternalServerError)
+		return
+	}
+
+	respond.Status(w, http.StatusNoContent)
+}
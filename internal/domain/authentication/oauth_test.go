@@ -0,0 +1,185 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gmhafiz/scs/v2"
+	"github.com/gmhafiz/scs/v2/memstore"
+	"github.com/go-chi/chi/v5"
+
+	"github.com/thang1834/go-goss/config"
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/internal/middleware"
+	"github.com/thang1834/go-goss/third_party/oidc"
+	"github.com/thang1834/go-goss/third_party/oidc/oidctest"
+)
+
+// identityRepo stubs the part of Repo used by the OAuth handlers.
+type identityRepo struct {
+	Repo
+	user   *gen.User
+	claims *oidc.Claims
+}
+
+func (r *identityRepo) LoginWithIdentity(_ context.Context, _ string, claims *oidc.Claims) (*gen.User, error) {
+	r.claims = claims
+	return r.user, nil
+}
+
+// newOAuthApp serves the OAuth routes with a provider backed by a fake
+// issuer. The returned client keeps the session cookie.
+func newOAuthApp(t *testing.T, repo Repo) (*Handler, *oidctest.Issuer, *httptest.Server, *http.Client) {
+	t.Helper()
+
+	session := scs.New()
+	session.Store = memstore.New()
+	limiter := NewLoginLimiter(&memoryAttempts{now: time.Now, attempts: map[string]Attempts{}}, config.Auth{})
+	h := &Handler{repo: repo, session: session, limiter: limiter}
+
+	router := chi.NewRouter()
+	router.Use(middleware.LoadAndSave(session))
+	router.Get("/api/v1/oauth/{provider}/login", h.OAuthLogin)
+	router.Get("/api/v1/oauth/{provider}/callback", h.OAuthCallback)
+	app := httptest.NewServer(router)
+	t.Cleanup(app.Close)
+
+	iss := oidctest.NewIssuer("client", "secret")
+	t.Cleanup(iss.Close)
+
+	p, err := oidc.New(context.Background(), iss.Config("test", app.URL+"/api/v1/oauth/test/callback"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.AddOIDCProvider(p, "")
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h, iss, app, &http.Client{Jar: jar}
+}
+
+func TestOAuthLogin(t *testing.T) {
+	repo := &identityRepo{user: &gen.User{ID: 7, Status: statusActive}}
+	h, iss, app, client := newOAuthApp(t, repo)
+	iss.SetUser(oidctest.User{Subject: "abc", Email: "jane@example.com", EmailVerified: true})
+
+	var loggedIn uint64
+	h.OnLogin(func(_ context.Context, userID uint64) error {
+		loggedIn = userID
+		return nil
+	})
+
+	res, err := client.Get(app.URL + "/api/v1/oauth/test/login")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("callback returned %s", res.Status)
+	}
+	if repo.claims == nil || repo.claims.Subject != "abc" || repo.claims.Email != "jane@example.com" {
+		t.Errorf("unexpected claims %+v", repo.claims)
+	}
+	if loggedIn != 7 {
+		t.Errorf("logged in user %d, want 7", loggedIn)
+	}
+
+	// The login request is single use.
+	res, err = client.Get(app.URL + "/api/v1/oauth/test/callback?code=x&state=y")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusBadRequest {
+		t.Errorf("replayed callback returned %s, want 400", res.Status)
+	}
+}
+
+func TestOAuthLoginTwoFactor(t *testing.T) {
+	now := time.Now()
+	repo := &identityRepo{user: &gen.User{ID: 7, Status: statusActive, TotpEnabledAt: &now}}
+	h, iss, app, client := newOAuthApp(t, repo)
+	iss.SetUser(oidctest.User{Subject: "abc", Email: "jane@example.com", EmailVerified: true})
+
+	h.OnLogin(func(context.Context, uint64) error {
+		t.Error("login hook ran before the second factor")
+		return nil
+	})
+
+	res, err := client.Get(app.URL + "/api/v1/oauth/test/login")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	var body LoginResponse
+	if err = json.NewDecoder(res.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusOK || !body.TwoFactorRequired {
+		t.Errorf("got %s %+v, want 200 with two_factor_required", res.Status, body)
+	}
+}
+
+func TestOAuthCallbackRejects(t *testing.T) {
+	repo := &identityRepo{user: &gen.User{ID: 7, Status: statusLocked}}
+	_, iss, app, client := newOAuthApp(t, repo)
+	iss.SetUser(oidctest.User{Subject: "abc", Email: "jane@example.com", EmailVerified: true})
+
+	noRedirect := *client
+	noRedirect.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	// Without a login request in the session there is no state to match.
+	res, err := client.Get(app.URL + "/api/v1/oauth/test/callback?code=x&state=y")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusBadRequest {
+		t.Errorf("callback without login returned %s, want 400", res.Status)
+	}
+
+	// A state other than the one issued is rejected.
+	res, err = noRedirect.Get(app.URL + "/api/v1/oauth/test/login")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	res, err = client.Get(app.URL + "/api/v1/oauth/test/callback?code=x&state=forged")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusBadRequest {
+		t.Errorf("forged state returned %s, want 400", res.Status)
+	}
+
+	// Locked accounts cannot log in through a provider either.
+	res, err = client.Get(app.URL + "/api/v1/oauth/test/login")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusLocked {
+		t.Errorf("locked account returned %s, want 423", res.Status)
+	}
+
+	res, err = client.Get(app.URL + "/api/v1/oauth/unknown/login")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusNotFound {
+		t.Errorf("unknown provider returned %s, want 404", res.Status)
+	}
+}
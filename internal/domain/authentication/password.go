@@ -0,0 +1,128 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/internal/utility/request"
+	"github.com/thang1834/go-goss/internal/utility/respond"
+	"github.com/thang1834/go-goss/third_party/mailer"
+)
+
+// ForgotPassword mails a password reset link. The lookup and mailing run in
+// the background so neither the response nor its timing reveals whether the
+// address is registered.
+// @Summary Request a password reset
+// @Param email body ForgotPasswordRequest true "account email"
+// @Success 202
+// @Failure 400
+// @router /api/v1/password/forgot [post]
+func (h *Handler) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	var req ForgotPasswordRequest
+	err := request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	req.Email = strings.TrimSpace(req.Email)
+	if req.Email == "" {
+		respond.Error(w, http.StatusBadRequest, ErrEmailRequired)
+		return
+	}
+
+	go h.sendPasswordReset(context.WithoutCancel(r.Context()), req.Email)
+
+	respond.Status(w, http.StatusAccepted)
+}
+
+// ResetPassword sets a new password using a token from ForgotPassword and
+// logs the user out everywhere
+// @Summary Reset password
+// @Param reset body ResetPasswordRequest true "token and new password"
+// @Success 200
+// @Failure 400
+// @router /api/v1/password/reset [post]
+func (h *Handler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var req ResetPasswordRequest
+	err := request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	if req.Token == "" {
+		respond.Error(w, http.StatusBadRequest, ErrInvalidToken)
+		return
+	}
+	if len(req.NewPassword) < minPasswordLength {
+		respond.Error(w, http.StatusBadRequest, ErrPasswordLength)
+		return
+	}
+
+	userID, err := h.repo.ResetPassword(r.Context(), req.Token, req.NewPassword)
+	if err != nil {
+		if errors.Is(err, ErrInvalidToken) {
+			respond.Error(w, http.StatusBadRequest, err)
+			return
+		}
+		respond.Status(w, http.StatusInternalServerError)
+		return
+	}
+
+	h.invalidateUserCache(r.Context(), userID)
+
+	_, err = h.repo.Logout(r.Context(), userID)
+	if err != nil && !errors.Is(err, ErrNotLoggedIn) {
+		log.Printf("revoking sessions of user %d after password reset: %v", userID, err)
+	}
+
+	respond.Status(w, http.StatusOK)
+}
+
+func (h *Handler) sendPasswordReset(ctx context.Context, email string) {
+	user, err := h.repo.GetUserByEmail(ctx, email)
+	if err != nil {
+		if !gen.IsNotFound(err) {
+			log.Printf("password reset: %v", err)
+		}
+		return
+	}
+
+	last, err := h.repo.LastTokenAt(ctx, user.ID, tokenPasswordReset)
+	if err != nil {
+		log.Printf("password reset for user %d: %v", user.ID, err)
+		return
+	}
+	if time.Since(last) < h.cfg.PasswordResetResendInterval {
+		return
+	}
+
+	token, err := h.repo.CreateToken(ctx, user.ID, tokenPasswordReset, h.cfg.PasswordResetTTL)
+	if err != nil {
+		log.Printf("password reset for user %d: %v", user.ID, err)
+		return
+	}
+
+	link, err := tokenLink(h.cfg.PasswordResetURL, token)
+	if err != nil {
+		log.Printf("password reset for user %d: %v", user.ID, err)
+		return
+	}
+
+	err = h.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hi %s,\n\nSomeone asked to reset the password of your account. If it was you, open the link below to choose a new one. It expires in %s.\n\n%s\n\nIf you did not ask for this, you can ignore this email.\n",
+			user.FirstName, h.cfg.PasswordResetTTL, link),
+	})
+	if err != nil {
+		log.Printf("password reset for user %d: %v", user.ID, err)
+	}
+}
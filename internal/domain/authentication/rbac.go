@@ -0,0 +1,506 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/thang1834/go-goss/internal/middleware"
+	"github.com/thang1834/go-goss/internal/utility/param"
+	"github.com/thang1834/go-goss/internal/utility/request"
+	"github.com/thang1834/go-goss/internal/utility/respond"
+)
+
+var (
+	ErrRoleNameRequired         = errors.New("name is required")
+	ErrPermissionFieldsRequired = errors.New("resource and action are required")
+)
+
+// ListRoles lists every role, including deactivated ones
+// @Summary List roles
+// @Success 200 {array} RoleResponse
+// @Failure 403
+// @router /api/v1/admin/roles [get]
+func (h *Handler) ListRoles(w http.ResponseWriter, r *http.Request) {
+	roles, err := h.repo.ListRoles(r.Context())
+	if err != nil {
+		respond.Status(w, http.StatusInternalServerError)
+		return
+	}
+
+	res := make([]*RoleResponse, 0, len(roles))
+	for _, role := range roles {
+		res = append(res, RoleResource(role))
+	}
+
+	respond.Json(w, http.StatusOK, res)
+}
+
+// GetRole gets a role with its permissions
+// @Summary Get role
+// @Param roleID path int true "Role ID"
+// @Success 200 {object} RoleResponse
+// @Failure 404
+// @router /api/v1/admin/roles/{roleID} [get]
+func (h *Handler) GetRole(w http.ResponseWriter, r *http.Request) {
+	roleID, err := param.UInt64(r, "roleID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	role, err := h.repo.GetRole(r.Context(), roleID)
+	if err != nil {
+		rbacError(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, RoleResource(role))
+}
+
+// CreateRole creates a role without any permissions
+// @Summary Create role
+// @Param role body RoleRequest true "name and description"
+// @Success 201 {object} RoleResponse
+// @Failure 400
+// @Failure 409
+// @router /api/v1/admin/roles [post]
+func (h *Handler) CreateRole(w http.ResponseWriter, r *http.Request) {
+	var req RoleRequest
+	err := request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		respond.Error(w, http.StatusBadRequest, ErrRoleNameRequired)
+		return
+	}
+
+	role, err := h.repo.CreateRole(r.Context(), req)
+	if err != nil {
+		rbacError(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusCreated, RoleResource(role))
+}
+
+// UpdateRole renames a role or changes its description
+// @Summary Update role
+// @Param roleID path int true "Role ID"
+// @Param role body RoleRequest true "name and description"
+// @Success 200 {object} RoleResponse
+// @Failure 404
+// @Failure 409
+// @router /api/v1/admin/roles/{roleID} [put]
+func (h *Handler) UpdateRole(w http.ResponseWriter, r *http.Request) {
+	roleID, err := param.UInt64(r, "roleID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	var req RoleRequest
+	err = request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		respond.Error(w, http.StatusBadRequest, ErrRoleNameRequired)
+		return
+	}
+
+	role, err := h.repo.UpdateRole(r.Context(), roleID, req)
+	if err != nil {
+		rbacError(w, err)
+		return
+	}
+
+	// Members see the role by name.
+	h.invalidateRoleMembersCache(r, roleID)
+
+	respond.Json(w, http.StatusOK, RoleResource(role))
+}
+
+// ActivateRole restores the permissions of a deactivated role to its members
+// @Summary Activate role
+// @Param roleID path int true "Role ID"
+// @Success 204
+// @Failure 404
+// @router /api/v1/admin/roles/{roleID}/activate [post]
+func (h *Handler) ActivateRole(w http.ResponseWriter, r *http.Request) {
+	h.setRoleActive(w, r, true)
+}
+
+// DeactivateRole withdraws a role from its members without unassigning it
+// @Summary Deactivate role
+// @Param roleID path int true "Role ID"
+// @Success 204
+// @Failure 404
+// @router /api/v1/admin/roles/{roleID}/deactivate [post]
+func (h *Handler) DeactivateRole(w http.ResponseWriter, r *http.Request) {
+	h.setRoleActive(w, r, false)
+}
+
+func (h *Handler) setRoleActive(w http.ResponseWriter, r *http.Request, active bool) {
+	roleID, err := param.UInt64(r, "roleID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	err = h.repo.SetRoleActive(r.Context(), roleID, active)
+	if err != nil {
+		rbacError(w, err)
+		return
+	}
+
+	h.invalidateRoleMembersCache(r, roleID)
+
+	respond.Status(w, http.StatusNoContent)
+}
+
+// AttachPermission adds a permission to a role
+// @Summary Attach permission to role
+// @Param roleID path int true "Role ID"
+// @Param permissionID path int true "Permission ID"
+// @Success 204
+// @Failure 404
+// @router /api/v1/admin/roles/{roleID}/permissions/{permissionID} [put]
+func (h *Handler) AttachPermission(w http.ResponseWriter, r *http.Request) {
+	h.changeRolePermission(w, r, h.repo.AttachPermissionToRole)
+}
+
+// DetachPermission removes a permission from a role
+// @Summary Detach permission from role
+// @Param roleID path int true "Role ID"
+// @Param permissionID path int true "Permission ID"
+// @Success 204
+// @Failure 404
+// @router /api/v1/admin/roles/{roleID}/permissions/{permissionID} [delete]
+func (h *Handler) DetachPermission(w http.ResponseWriter, r *http.Request) {
+	h.changeRolePermission(w, r, h.repo.DetachPermissionFromRole)
+}
+
+func (h *Handler) changeRolePermission(w http.ResponseWriter, r *http.Request, change func(ctx context.Context, roleID, permissionID uint64) error) {
+	roleID, err := param.UInt64(r, "roleID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+	permissionID, err := param.UInt64(r, "permissionID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	err = change(r.Context(), roleID, permissionID)
+	if err != nil {
+		rbacError(w, err)
+		return
+	}
+
+	h.invalidateRoleMembersCache(r, roleID)
+
+	respond.Status(w, http.StatusNoContent)
+}
+
+// ListPermissions lists every permission
+// @Summary List permissions
+// @Success 200 {array} PermissionResponse
+// @Failure 403
+// @router /api/v1/admin/permissions [get]
+func (h *Handler) ListPermissions(w http.ResponseWriter, r *http.Request) {
+	permissions, err := h.repo.GetAllPermissions(r.Context())
+	if err != nil {
+		respond.Status(w, http.StatusInternalServerError)
+		return
+	}
+
+	res := make([]*PermissionResponse, 0, len(permissions))
+	for _, p := range permissions {
+		res = append(res, PermissionResource(p))
+	}
+
+	respond.Json(w, http.StatusOK, res)
+}
+
+// CreatePermission creates a permission. Its name defaults to
+// "resource:action".
+// @Summary Create permission
+// @Param permission body PermissionRequest true "resource, action and optional name"
+// @Success 201 {object} PermissionResponse
+// @Failure 400
+// @Failure 409
+// @router /api/v1/admin/permissions [post]
+func (h *Handler) CreatePermission(w http.ResponseWriter, r *http.Request) {
+	var req PermissionRequest
+	err := request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	if err = req.normalize(); err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	permission, err := h.repo.CreatePermission(r.Context(), req)
+	if err != nil {
+		rbacError(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusCreated, PermissionResource(permission))
+}
+
+// UpdatePermission changes a permission. Renaming it changes what every
+// holder is allowed to do.
+// @Summary Update permission
+// @Param permissionID path int true "Permission ID"
+// @Param permission body PermissionRequest true "resource, action and optional name"
+// @Success 200 {object} PermissionResponse
+// @Failure 404
+// @Failure 409
+// @router /api/v1/admin/permissions/{permissionID} [put]
+func (h *Handler) UpdatePermission(w http.ResponseWriter, r *http.Request) {
+	permissionID, err := param.UInt64(r, "permissionID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	var req PermissionRequest
+	err = request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	if err = req.normalize(); err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	permission, err := h.repo.UpdatePermission(r.Context(), permissionID, req)
+	if err != nil {
+		rbacError(w, err)
+		return
+	}
+
+	h.invalidatePermissionHoldersCache(r, permissionID)
+
+	respond.Json(w, http.StatusOK, PermissionResource(permission))
+}
+
+// DeletePermission deletes a permission and takes it away from every role
+// and user holding it
+// @Summary Delete permission
+// @Param permissionID path int true "Permission ID"
+// @Success 204
+// @Failure 404
+// @router /api/v1/admin/permissions/{permissionID} [delete]
+func (h *Handler) DeletePermission(w http.ResponseWriter, r *http.Request) {
+	permissionID, err := param.UInt64(r, "permissionID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	// Holders are no longer reachable once the permission is gone.
+	holders, err := h.repo.PermissionHolderIDs(r.Context(), permissionID)
+	if err != nil {
+		respond.Status(w, http.StatusInternalServerError)
+		return
+	}
+
+	err = h.repo.DeletePermission(r.Context(), permissionID)
+	if err != nil {
+		rbacError(w, err)
+		return
+	}
+
+	h.invalidateUsersCache(r.Context(), holders)
+
+	respond.Status(w, http.StatusNoContent)
+}
+
+// RemoveRole unassigns a role from a user
+// @Summary Remove role from user
+// @Param userID path int true "User ID"
+// @Param roleID path int true "Role ID"
+// @Success 204
+// @router /api/v1/admin/users/{userID}/roles/{roleID} [delete]
+func (h *Handler) RemoveRole(w http.ResponseWriter, r *http.Request) {
+	userID, err := param.UInt64(r, "userID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+	roleID, err := param.UInt64(r, "roleID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	err = h.repo.RemoveRoleFromUser(r.Context(), userID, roleID)
+	if err != nil {
+		respond.Status(w, http.StatusInternalServerError)
+		return
+	}
+
+	h.invalidateUserCache(r.Context(), userID)
+
+	respond.Status(w, http.StatusNoContent)
+}
+
+// GetUserPermissions lists the permissions granted to a user directly,
+// including revoked and expired grants
+// @Summary List direct user permissions
+// @Param userID path int true "User ID"
+// @Success 200 {array} UserPermissionResponse
+// @router /api/v1/admin/users/{userID}/permissions [get]
+func (h *Handler) GetUserPermissions(w http.ResponseWriter, r *http.Request) {
+	userID, err := param.UInt64(r, "userID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	grants, err := h.repo.GetUserDirectPermissions(r.Context(), userID)
+	if err != nil {
+		respond.Status(w, http.StatusInternalServerError)
+		return
+	}
+
+	res := make([]*UserPermissionResponse, 0, len(grants))
+	for _, up := range grants {
+		res = append(res, UserPermissionResource(up))
+	}
+
+	respond.Json(w, http.StatusOK, res)
+}
+
+// AssignPermission grants a permission to a user directly, optionally until
+// expires_at. Granting it again replaces the expiry.
+// @Summary Grant permission to user
+// @Param grant body AssignPermissionRequest true "user, permission and optional expiry"
+// @Success 204
+// @Failure 400
+// @Failure 404
+// @router /api/v1/admin/users/assign-permission [post]
+func (h *Handler) AssignPermission(w http.ResponseWriter, r *http.Request) {
+	var req AssignPermissionRequest
+	err := request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	adminUserID, ok := h.session.Get(r.Context(), string(middleware.KeyID)).(uint64)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		respond.Error(w, http.StatusBadRequest, ErrExpiryInPast)
+		return
+	}
+
+	err = h.repo.AssignPermissionToUser(r.Context(), req.UserID, req.PermissionID, adminUserID, req.ExpiresAt)
+	if err != nil {
+		rbacError(w, err)
+		return
+	}
+
+	h.invalidateUserCache(r.Context(), req.UserID)
+
+	respond.Status(w, http.StatusNoContent)
+}
+
+// RevokePermission revokes a permission granted to a user directly
+// @Summary Revoke permission from user
+// @Param userID path int true "User ID"
+// @Param permissionID path int true "Permission ID"
+// @Success 204
+// @router /api/v1/admin/users/{userID}/permissions/{permissionID} [delete]
+func (h *Handler) RevokePermission(w http.ResponseWriter, r *http.Request) {
+	userID, err := param.UInt64(r, "userID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+	permissionID, err := param.UInt64(r, "permissionID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	err = h.repo.RemovePermissionFromUser(r.Context(), userID, permissionID)
+	if err != nil {
+		respond.Status(w, http.StatusInternalServerError)
+		return
+	}
+
+	h.invalidateUserCache(r.Context(), userID)
+
+	respond.Status(w, http.StatusNoContent)
+}
+
+// invalidateRoleMembersCache clears the permissions cache of everyone
+// assigned the role.
+func (h *Handler) invalidateRoleMembersCache(r *http.Request, roleID uint64) {
+	members, err := h.repo.RoleMemberIDs(r.Context(), roleID)
+	if err != nil {
+		log.Printf("role %d members: %v", roleID, err)
+		return
+	}
+	h.invalidateUsersCache(r.Context(), members)
+}
+
+// invalidatePermissionHoldersCache clears the permissions cache of everyone
+// granted the permission, directly or through a role.
+func (h *Handler) invalidatePermissionHoldersCache(r *http.Request, permissionID uint64) {
+	holders, err := h.repo.PermissionHolderIDs(r.Context(), permissionID)
+	if err != nil {
+		log.Printf("permission %d holders: %v", permissionID, err)
+		return
+	}
+	h.invalidateUsersCache(r.Context(), holders)
+}
+
+func (req *PermissionRequest) normalize() error {
+	req.Resource = strings.TrimSpace(req.Resource)
+	req.Action = strings.TrimSpace(req.Action)
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Resource == "" || req.Action == "" {
+		return ErrPermissionFieldsRequired
+	}
+	if req.Name == "" {
+		req.Name = fmt.Sprintf("%s:%s", req.Resource, req.Action)
+	}
+	return nil
+}
+
+func rbacError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrRoleNotFound), errors.Is(err, ErrPermissionNotFound):
+		respond.Error(w, http.StatusNotFound, err)
+	case errors.Is(err, ErrRoleNameTaken), errors.Is(err, ErrPermissionNameTaken):
+		respond.Error(w, http.StatusConflict, err)
+	default:
+		respond.Status(w, http.StatusInternalServerError)
+	}
+}
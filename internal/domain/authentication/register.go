@@ -1,79 +1,121 @@
 package authentication
 
 import (
-	"net/http"
-
 	"github.com/gmhafiz/scs/v2"
 	"github.com/go-chi/chi/v5"
+	"github.com/thang1834/go-goss/config"
 	"github.com/thang1834/go-goss/internal/middleware"
+	"github.com/thang1834/go-goss/third_party/mailer"
 )
 
-// RegisterHTTPEndPoints registers authentication routes following go8 pattern
-func RegisterHTTPEndPoints(router *chi.Mux, session *scs.SessionManager, repo Repo, redisAddr string) error {
-	h, err := NewHandler(session, repo, redisAddr)
+// RegisterHTTPEndPoints registers authentication routes following go8 pattern.
+// The returned handler exposes RequirePermission and friends to other domains.
+func RegisterHTTPEndPoints(router *chi.Mux, session *scs.SessionManager, repo Repo, redisAddr string, mail mailer.Mailer, attempts AttemptStore, cfg config.Auth) (*Handler, error) {
+	h, err := NewHandler(session, repo, redisAddr, mail, attempts, cfg)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	h.routes(router)
+
+	return h, nil
+}
+
+// routes registers the authentication routes on router.
+func (h *Handler) routes(router chi.Router) {
 	// Public authentication routes
 	router.Post("/api/v1/login", h.Login)
+	router.Post("/api/v1/login/2fa", h.LoginTwoFactor)
 	router.Post("/api/v1/register", h.Register)
+	router.Get("/api/v1/verify-email", h.VerifyEmail)
+	router.Post("/api/v1/verify-email/resend", h.ResendVerification)
+	router.Post("/api/v1/password/forgot", h.ForgotPassword)
+	router.Post("/api/v1/password/reset", h.ResetPassword)
+	router.Get("/api/v1/oauth/{provider}/login", h.OAuthLogin)
+	router.Get("/api/v1/oauth/{provider}/callback", h.OAuthCallback)
 
 	// Logout route
 	router.Route("/api/v1/logout", func(router chi.Router) {
+		router.Use(middleware.RequireSession)
 		router.Post("/", h.Logout)
 	})
 
 	// Protected routes
 	router.Route("/api/v1/restricted", func(router chi.Router) {
-		router.Use(middleware.Authenticate(session))
+		router.Use(middleware.Authenticate(h.session))
 
 		// Basic protected routes
 		router.Get("/", h.Protected)
 		router.Get("/me", h.Me)
-		router.Post("/change-password", h.ChangePassword)
 		router.Get("/csrf", h.Csrf)
 
+		// Account management needs a logged in session, API keys are
+		// turned away.
+		router.Group(func(router chi.Router) {
+			router.Use(middleware.RequireSession)
+
+			router.Post("/change-password", h.ChangePassword)
+
+			// Two-factor authentication
+			router.Post("/2fa/enroll", h.EnrollTwoFactor)
+			router.Post("/2fa/confirm", h.ConfirmTwoFactor)
+			router.Post("/2fa/disable", h.DisableTwoFactor)
+			router.Post("/2fa/recovery-codes", h.RegenerateRecoveryCodes)
+
+			// API keys
+			router.Get("/api-keys", h.ListAPIKeys)
+			router.Post("/api-keys", h.CreateAPIKey)
+			router.Delete("/api-keys/{keyID}", h.RevokeAPIKey)
+
+			// Sessions
+			router.Get("/sessions", h.ListSessions)
+			router.Delete("/sessions", h.RevokeOtherSessions)
+			router.Delete("/sessions/{sessionID}", h.RevokeSession)
+
+			// Linked identities
+			router.Get("/identities", h.ListIdentities)
+			router.Delete("/identities/{identityID}", h.UnlinkIdentity)
+		})
+
 		// Admin routes - force logout other users
 		router.Post("/logout/{userID}", h.ForceLogout)
 	})
 
 	// Admin management routes
 	router.Route("/api/v1/admin", func(router chi.Router) {
-		router.Use(middleware.Authenticate(session))
+		router.Use(middleware.Authenticate(h.session))
 		router.Use(h.RequireAnyRole("admin", "super_admin"))
 
 		// User management
 		router.Get("/users", h.ListUsers)
 		router.Get("/users/{userID}/roles", h.GetUserRoles)
 		router.Post("/users/assign-role", h.AssignRole)
-	})
-
-	// Manager routes (example for e-commerce)
-	router.Route("/api/v1/manage", func(router chi.Router) {
-		router.Use(middleware.Authenticate(session))
-		router.Use(h.RequireAnyRole("admin", "super_admin", "manager"))
-
-		// Product management routes would go here
-		router.Get("/products", func(w http.ResponseWriter, r *http.Request) {
-			// Product management endpoint
+		router.Post("/users/{userID}/unlock", h.UnlockUser)
+		router.With(h.RequirePermission("role:assign")).Delete("/users/{userID}/roles/{roleID}", h.RemoveRole)
+		router.With(h.RequirePermission("user:read")).Get("/users/{userID}/permissions", h.GetUserPermissions)
+		router.With(h.RequirePermission("permission:assign")).Post("/users/assign-permission", h.AssignPermission)
+		router.With(h.RequirePermission("permission:assign")).Delete("/users/{userID}/permissions/{permissionID}", h.RevokePermission)
+
+		// Roles
+		router.With(h.RequirePermission("role:read")).Get("/roles", h.ListRoles)
+		router.With(h.RequirePermission("role:read")).Get("/roles/{roleID}", h.GetRole)
+		router.Group(func(router chi.Router) {
+			router.Use(h.RequirePermission("role:write"))
+			router.Post("/roles", h.CreateRole)
+			router.Put("/roles/{roleID}", h.UpdateRole)
+			router.Post("/roles/{roleID}/activate", h.ActivateRole)
+			router.Post("/roles/{roleID}/deactivate", h.DeactivateRole)
+			router.Put("/roles/{roleID}/permissions/{permissionID}", h.AttachPermission)
+			router.Delete("/roles/{roleID}/permissions/{permissionID}", h.DetachPermission)
 		})
-	})
 
-	// Permission-based routes (example)
-	router.Route("/api/v1/orders", func(router chi.Router) {
-		router.Use(middleware.Authenticate(session))
-
-		// Create order - requires permission
-		router.With(h.RequirePermission("order:create")).Post("/", func(w http.ResponseWriter, r *http.Request) {
-			// Create order endpoint
-		})
-
-		// Read all orders - admin permission
-		router.With(h.RequirePermission("order:read_all")).Get("/all", func(w http.ResponseWriter, r *http.Request) {
-			// List all orders endpoint
+		// Permissions
+		router.With(h.RequirePermission("role:read")).Get("/permissions", h.ListPermissions)
+		router.Group(func(router chi.Router) {
+			router.Use(h.RequirePermission("permission:write"))
+			router.Post("/permissions", h.CreatePermission)
+			router.Put("/permissions/{permissionID}", h.UpdatePermission)
+			router.Delete("/permissions/{permissionID}", h.DeletePermission)
 		})
 	})
-
-	return nil
 }
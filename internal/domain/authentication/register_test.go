@@ -0,0 +1,71 @@
+package authentication
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gmhafiz/scs/v2"
+	"github.com/gmhafiz/scs/v2/memstore"
+	"github.com/go-chi/chi/v5"
+
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+func TestAccountRoutesRejectAPIKeys(t *testing.T) {
+	session := scs.New()
+	session.Store = memstore.New()
+	h := &Handler{session: session}
+
+	verify := func(context.Context, string) (middleware.APIKey, error) {
+		return middleware.APIKey{ID: 1, UserID: 7, Scopes: []string{"*:*"}}, nil
+	}
+
+	router := chi.NewRouter()
+	router.Use(middleware.BearerAuth(session, verify))
+	router.Use(middleware.LoadAndSave(session))
+	h.routes(router)
+
+	routes := []struct {
+		method string
+		path   string
+	}{
+		{http.MethodPost, "/api/v1/logout"},
+		{http.MethodPost, "/api/v1/restricted/change-password"},
+		{http.MethodPost, "/api/v1/restricted/2fa/enroll"},
+		{http.MethodPost, "/api/v1/restricted/2fa/confirm"},
+		{http.MethodPost, "/api/v1/restricted/2fa/disable"},
+		{http.MethodPost, "/api/v1/restricted/2fa/recovery-codes"},
+		{http.MethodGet, "/api/v1/restricted/api-keys"},
+		{http.MethodPost, "/api/v1/restricted/api-keys"},
+		{http.MethodDelete, "/api/v1/restricted/api-keys/1"},
+		{http.MethodGet, "/api/v1/restricted/sessions"},
+		{http.MethodDelete, "/api/v1/restricted/sessions"},
+		{http.MethodDelete, "/api/v1/restricted/sessions/abc"},
+		{http.MethodGet, "/api/v1/restricted/identities"},
+		{http.MethodDelete, "/api/v1/restricted/identities/1"},
+	}
+
+	for _, route := range routes {
+		t.Run(route.method+" "+route.path, func(t *testing.T) {
+			r := httptest.NewRequest(route.method, route.path, nil)
+			r.Header.Set("Authorization", "Bearer key")
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, r)
+
+			if rr.Code != http.StatusForbidden {
+				t.Errorf("status = %d, want 403", rr.Code)
+			}
+		})
+	}
+
+	// Keys still reach the routes they are meant for.
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/restricted/", nil)
+	r.Header.Set("Authorization", "Bearer key")
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, r)
+	if rr.Code != http.StatusOK {
+		t.Errorf("protected route returned %d to a key, want 200", rr.Code)
+	}
+}
@@ -3,26 +3,38 @@ package authentication
 import (
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
 	"database/sql"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
+	"sync"
 	"time"
 
 	"github.com/alexedwards/argon2id"
 	"github.com/gmhafiz/scs/v2"
 
+	"github.com/thang1834/go-goss/config"
 	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/ent/gen/apikey"
+	"github.com/thang1834/go-goss/ent/gen/permission"
+	"github.com/thang1834/go-goss/ent/gen/recoverycode"
 	"github.com/thang1834/go-goss/ent/gen/role"
-	"github.com/thang1834/go-goss/ent/gen/session"
+	"github.com/thang1834/go-goss/ent/gen/rolepermission"
 	"github.com/thang1834/go-goss/ent/gen/user"
+	"github.com/thang1834/go-goss/ent/gen/useridentity"
 	"github.com/thang1834/go-goss/ent/gen/userpermission"
 	"github.com/thang1834/go-goss/ent/gen/userrole"
+	"github.com/thang1834/go-goss/ent/gen/usertoken"
+	"github.com/thang1834/go-goss/internal/middleware"
+	"github.com/thang1834/go-goss/third_party/oidc"
 )
 
 type repo struct {
 	ent     *gen.Client
 	db      *sql.DB
 	session *scs.SessionManager
+	cfg     config.Auth
 }
 
 var (
@@ -31,6 +43,41 @@ var (
 	ErrUserNotFound      = errors.New("user not found")
 	ErrInvalidPassword   = errors.New("invalid password")
 	ErrUserInactive      = errors.New("user account is inactive")
+	ErrUserLocked        = errors.New("user account is locked")
+	ErrUserNotLocked     = errors.New("user account is not locked")
+	ErrEmailNotVerified  = errors.New("email address has not been verified")
+	ErrInvalidToken      = errors.New("token is invalid or has expired")
+
+	ErrTwoFactorEnabled     = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorNotEnrolled = errors.New("two-factor authentication has not been enrolled")
+	ErrTwoFactorNotEnabled  = errors.New("two-factor authentication is not enabled")
+	ErrInvalidCode          = errors.New("invalid authentication code")
+
+	ErrAPIKeyNotFound = errors.New("api key not found")
+
+	ErrRoleNotFound        = errors.New("role not found")
+	ErrRoleNameTaken       = errors.New("role name is already taken")
+	ErrPermissionNotFound  = errors.New("permission not found")
+	ErrPermissionNameTaken = errors.New("permission name is already taken")
+
+	ErrIdentityEmailUnverified = errors.New("the provider has not verified this email address")
+	ErrIdentityNotLinkable     = errors.New("an account with this email address exists but has not been verified, log in with its password and verify it first")
+	ErrIdentityNotFound        = errors.New("linked identity not found")
+)
+
+// apiKeyPrefix marks API keys so they are recognisable, e.g. by secret
+// scanners.
+const apiKeyPrefix = "gk_"
+
+const (
+	statusActive = "active"
+	statusLocked = "locked"
+)
+
+// Purposes of the single-use tokens kept in user_tokens.
+const (
+	tokenEmailVerification = "email_verification"
+	tokenPasswordReset     = "password_reset"
 )
 
 type Repo interface {
@@ -44,22 +91,64 @@ type Repo interface {
 	GetUserByID(ctx context.Context, userID uint64) (*gen.User, error)
 	GetUserByEmail(ctx context.Context, email string) (*gen.User, error)
 	UpdateUserStatus(ctx context.Context, userID uint64, status string) error
+	LockUser(ctx context.Context, email string) error
+	UnlockUser(ctx context.Context, userID uint64) (*gen.User, error)
 
 	// Role and Permission operations
 	GetUserRoles(ctx context.Context, userID uint64) ([]string, error)
 	GetUserPermissions(ctx context.Context, userID uint64) ([]string, error)
+	NextGrantExpiry(ctx context.Context, userID uint64) (time.Time, error)
 	GetUserWithRoles(ctx context.Context, userID uint64) (*gen.User, error)
 
 	// Role management
 	GetAllRoles(ctx context.Context) ([]*gen.Role, error)
+	ListRoles(ctx context.Context) ([]*gen.Role, error)
+	GetRole(ctx context.Context, roleID uint64) (*gen.Role, error)
+	CreateRole(ctx context.Context, req RoleRequest) (*gen.Role, error)
+	UpdateRole(ctx context.Context, roleID uint64, req RoleRequest) (*gen.Role, error)
+	SetRoleActive(ctx context.Context, roleID uint64, active bool) error
+	AttachPermissionToRole(ctx context.Context, roleID, permissionID uint64) error
+	DetachPermissionFromRole(ctx context.Context, roleID, permissionID uint64) error
+	RoleMemberIDs(ctx context.Context, roleID uint64) ([]uint64, error)
 	AssignRoleToUser(ctx context.Context, userID uint64, roleID uint64, assignedBy uint64) error
 	RemoveRoleFromUser(ctx context.Context, userID uint64, roleID uint64) error
 
 	// Permission management
 	GetAllPermissions(ctx context.Context) ([]*gen.Permission, error)
-	AssignPermissionToUser(ctx context.Context, userID uint64, permissionID uint64, grantedBy uint64) error
+	CreatePermission(ctx context.Context, req PermissionRequest) (*gen.Permission, error)
+	UpdatePermission(ctx context.Context, permissionID uint64, req PermissionRequest) (*gen.Permission, error)
+	DeletePermission(ctx context.Context, permissionID uint64) error
+	PermissionHolderIDs(ctx context.Context, permissionID uint64) ([]uint64, error)
+	GetUserDirectPermissions(ctx context.Context, userID uint64) ([]*gen.UserPermission, error)
+	AssignPermissionToUser(ctx context.Context, userID uint64, permissionID uint64, grantedBy uint64, expiresAt *time.Time) error
 	RemovePermissionFromUser(ctx context.Context, userID uint64, permissionID uint64) error
 
+	// Single-use tokens
+	CreateToken(ctx context.Context, userID uint64, purpose string, ttl time.Duration) (string, error)
+	LastTokenAt(ctx context.Context, userID uint64, purpose string) (time.Time, error)
+	VerifyEmail(ctx context.Context, token string) (*gen.User, error)
+	ResetPassword(ctx context.Context, token, newPassword string) (uint64, error)
+
+	// Two-factor authentication
+	CheckPassword(ctx context.Context, userID uint64, password string) error
+	StartTwoFactor(ctx context.Context, userID uint64, secret string) error
+	EnableTwoFactor(ctx context.Context, userID uint64, step int64, recoveryCodeHashes []string) error
+	DisableTwoFactor(ctx context.Context, userID uint64) error
+	UseTOTPStep(ctx context.Context, userID uint64, step int64) error
+	UseRecoveryCode(ctx context.Context, userID uint64, codeHash string) error
+	ReplaceRecoveryCodes(ctx context.Context, userID uint64, codeHashes []string) error
+
+	// API keys
+	CreateAPIKey(ctx context.Context, userID uint64, req CreateAPIKeyRequest) (*gen.APIKey, string, error)
+	ListAPIKeys(ctx context.Context, userID uint64) ([]*gen.APIKey, error)
+	RevokeAPIKey(ctx context.Context, userID, keyID uint64) error
+	VerifyAPIKey(ctx context.Context, token string) (middleware.APIKey, error)
+
+	// Linked identities
+	LoginWithIdentity(ctx context.Context, provider string, claims *oidc.Claims) (*gen.User, error)
+	ListIdentities(ctx context.Context, userID uint64) ([]*gen.UserIdentity, error)
+	UnlinkIdentity(ctx context.Context, userID, identityID uint64) error
+
 	// CSRF token
 	Csrf(ctx context.Context) (string, error)
 }
@@ -76,7 +165,7 @@ func (r *repo) Register(ctx context.Context, req RegisterRequest) (*gen.User, er
 		SetLastName(req.LastName).
 		SetEmail(req.Email).
 		SetPasswordHash(hashedPassword).
-		SetStatus("active").
+		SetStatus(statusActive).
 		SetCreatedAt(time.Now()).
 		SetUpdatedAt(time.Now())
 
@@ -107,46 +196,47 @@ func (r *repo) Register(ctx context.Context, req RegisterRequest) (*gen.User, er
 func (r *repo) Login(ctx context.Context, req LoginRequest) (*gen.User, bool, error) {
 	u, err := r.ent.User.Query().Where(user.EmailEqualFold(req.Email)).First(ctx)
 	if err != nil {
+		// Hash anyway so an unknown email takes as long as a wrong password.
+		_, _ = argon2id.ComparePasswordAndHash(req.Password, dummyHash())
 		return nil, false, ErrUserNotFound
 	}
 
-	if u.Status != "active" {
-		return nil, false, ErrUserInactive
-	}
-
 	match, err := argon2id.ComparePasswordAndHash(req.Password, u.PasswordHash)
 	if err != nil {
 		return nil, false, ErrInvalidPassword
 	}
+	if !match {
+		return u, false, nil
+	}
+
+	// Account state is only revealed to someone who knows the password.
+	switch {
+	case u.Status == statusLocked:
+		return nil, false, ErrUserLocked
+	case u.Status != statusActive:
+		return nil, false, ErrUserInactive
+	case r.cfg.RequireVerifiedEmail && u.VerifiedAt == nil:
+		return nil, false, ErrEmailNotVerified
+	}
 
-	return u, match, nil
+	return u, true, nil
 }
 
+// Logout deletes every session of a user through the session store's index,
+// which both postgresstore and redisstore keep.
 func (r *repo) Logout(ctx context.Context, userID uint64) (bool, error) {
-	var found bool
-	rows := r.db.QueryRowContext(ctx, `
-		SELECT CASE
-		WHEN EXISTS(SELECT *
-					FROM sessions
-					WHERE sessions.user_id = $1)
-			THEN true
-		ELSE false
-		END
-	;`, userID)
-
-	err := rows.Scan(&found)
-	if err != nil {
-		return false, err
+	index, ok := r.session.Store.(middleware.SessionIndex)
+	if !ok {
+		return false, ErrSessionsNotIndexed
 	}
 
-	if !found {
-		return false, ErrNotLoggedIn
-	}
-
-	_, err = r.ent.Session.Delete().Where(session.UserIDEQ(userID)).Exec(ctx)
+	n, err := index.RevokeOtherUserSessions(ctx, userID, "")
 	if err != nil {
 		return false, err
 	}
+	if n == 0 {
+		return false, ErrNotLoggedIn
+	}
 
 	return true, nil
 }
@@ -192,6 +282,44 @@ func (r *repo) UpdateUserStatus(ctx context.Context, userID uint64, status strin
 		Exec(ctx)
 }
 
+// LockUser locks an active account after too many failed logins.
+func (r *repo) LockUser(ctx context.Context, email string) error {
+	return r.ent.User.Update().
+		Where(
+			user.EmailEqualFold(email),
+			user.StatusEQ(statusActive),
+		).
+		SetStatus(statusLocked).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+}
+
+func (r *repo) UnlockUser(ctx context.Context, userID uint64) (*gen.User, error) {
+	n, err := r.ent.User.Update().
+		Where(
+			user.IDEQ(userID),
+			user.StatusEQ(statusLocked),
+		).
+		SetStatus(statusActive).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		exists, err := r.ent.User.Query().Where(user.IDEQ(userID)).Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, ErrUserNotFound
+		}
+		return nil, ErrUserNotLocked
+	}
+
+	return r.GetUserByID(ctx, userID)
+}
+
 // Role and Permission operations
 func (r *repo) GetUserWithRoles(ctx context.Context, userID uint64) (*gen.User, error) {
 	return r.ent.User.
@@ -199,14 +327,10 @@ func (r *repo) GetUserWithRoles(ctx context.Context, userID uint64) (*gen.User,
 		Where(user.IDEQ(userID)).
 		WithUserRoles(func(q *gen.UserRoleQuery) {
 			q.Where(userrole.IsActiveEQ(true)).
-				Where(func(s *gen.Selector) {
-					s.Where(
-						gen.Or(
-							gen.IsNull("expires_at"),
-							gen.GT("expires_at", time.Now()),
-						),
-					)
-				}).
+				Where(userrole.Or(
+					userrole.ExpiresAtIsNil(),
+					userrole.ExpiresAtGT(time.Now()),
+				)).
 				WithRole(func(rq *gen.RoleQuery) {
 					rq.Where(role.IsActiveEQ(true))
 				})
@@ -221,14 +345,10 @@ func (r *repo) GetUserRoles(ctx context.Context, userID uint64) ([]string, error
 		QueryUserRoles().
 		Where(
 			userrole.IsActiveEQ(true),
-			func(s *gen.Selector) {
-				s.Where(
-					gen.Or(
-						gen.IsNull("expires_at"),
-						gen.GT("expires_at", time.Now()),
-					),
-				)
-			},
+			userrole.Or(
+				userrole.ExpiresAtIsNil(),
+				userrole.ExpiresAtGT(time.Now()),
+			),
 		).
 		QueryRole().
 		Where(role.IsActiveEQ(true)).
@@ -245,6 +365,48 @@ func (r *repo) GetUserRoles(ctx context.Context, userID uint64) ([]string, error
 	return roles, nil
 }
 
+// NextGrantExpiry returns when the first of the user's active role and
+// permission grants expires, or the zero time if none of them does.
+func (r *repo) NextGrantExpiry(ctx context.Context, userID uint64) (time.Time, error) {
+	now := time.Now()
+
+	var next time.Time
+	ur, err := r.ent.UserRole.Query().
+		Where(
+			userrole.UserIDEQ(userID),
+			userrole.IsActiveEQ(true),
+			userrole.ExpiresAtGT(now),
+			userrole.HasRoleWith(role.IsActiveEQ(true)),
+		).
+		Order(gen.Asc(userrole.FieldExpiresAt)).
+		First(ctx)
+	switch {
+	case err == nil:
+		next = ur.ExpiresAt
+	case !gen.IsNotFound(err):
+		return time.Time{}, err
+	}
+
+	up, err := r.ent.UserPermission.Query().
+		Where(
+			userpermission.UserIDEQ(userID),
+			userpermission.IsActiveEQ(true),
+			userpermission.ExpiresAtGT(now),
+		).
+		Order(gen.Asc(userpermission.FieldExpiresAt)).
+		First(ctx)
+	switch {
+	case err == nil:
+		if next.IsZero() || up.ExpiresAt.Before(next) {
+			next = up.ExpiresAt
+		}
+	case !gen.IsNotFound(err):
+		return time.Time{}, err
+	}
+
+	return next, nil
+}
+
 func (r *repo) GetUserPermissions(ctx context.Context, userID uint64) ([]string, error) {
 	// Get direct user permissions
 	directPermissions, err := r.ent.User.
@@ -253,14 +415,10 @@ func (r *repo) GetUserPermissions(ctx context.Context, userID uint64) ([]string,
 		QueryUserPermissions().
 		Where(
 			userpermission.IsActiveEQ(true),
-			func(s *gen.Selector) {
-				s.Where(
-					gen.Or(
-						gen.IsNull("expires_at"),
-						gen.GT("expires_at", time.Now()),
-					),
-				)
-			},
+			userpermission.Or(
+				userpermission.ExpiresAtIsNil(),
+				userpermission.ExpiresAtGT(time.Now()),
+			),
 		).
 		QueryPermission().
 		All(ctx)
@@ -275,14 +433,10 @@ func (r *repo) GetUserPermissions(ctx context.Context, userID uint64) ([]string,
 		QueryUserRoles().
 		Where(
 			userrole.IsActiveEQ(true),
-			func(s *gen.Selector) {
-				s.Where(
-					gen.Or(
-						gen.IsNull("expires_at"),
-						gen.GT("expires_at", time.Now()),
-					),
-				)
-			},
+			userrole.Or(
+				userrole.ExpiresAtIsNil(),
+				userrole.ExpiresAtGT(time.Now()),
+			),
 		).
 		QueryRole().
 		Where(role.IsActiveEQ(true)).
@@ -317,25 +471,171 @@ func (r *repo) GetAllRoles(ctx context.Context) ([]*gen.Role, error) {
 	return r.ent.Role.Query().Where(role.IsActiveEQ(true)).All(ctx)
 }
 
-func (r *repo) AssignRoleToUser(ctx context.Context, userID uint64, roleID uint64, assignedBy uint64) error {
-	// Check if assignment already exists
-	exists, err := r.ent.UserRole.
-		Query().
+// ListRoles returns every role, including deactivated ones, with their
+// permissions.
+func (r *repo) ListRoles(ctx context.Context) ([]*gen.Role, error) {
+	return r.ent.Role.Query().
+		WithRolePermissions(func(q *gen.RolePermissionQuery) {
+			q.WithPermission()
+		}).
+		Order(gen.Asc(role.FieldName)).
+		All(ctx)
+}
+
+func (r *repo) GetRole(ctx context.Context, roleID uint64) (*gen.Role, error) {
+	found, err := r.ent.Role.Query().
+		Where(role.IDEQ(roleID)).
+		WithRolePermissions(func(q *gen.RolePermissionQuery) {
+			q.WithPermission()
+		}).
+		Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, ErrRoleNotFound
+		}
+		return nil, err
+	}
+	return found, nil
+}
+
+func (r *repo) CreateRole(ctx context.Context, req RoleRequest) (*gen.Role, error) {
+	created, err := r.ent.Role.Create().
+		SetName(req.Name).
+		SetDescription(req.Description).
+		Save(ctx)
+	if err != nil {
+		if gen.IsConstraintError(err) {
+			return nil, ErrRoleNameTaken
+		}
+		return nil, err
+	}
+	return created, nil
+}
+
+func (r *repo) UpdateRole(ctx context.Context, roleID uint64, req RoleRequest) (*gen.Role, error) {
+	err := r.ent.Role.UpdateOneID(roleID).
+		SetName(req.Name).
+		SetDescription(req.Description).
+		Exec(ctx)
+	if err != nil {
+		switch {
+		case gen.IsNotFound(err):
+			return nil, ErrRoleNotFound
+		case gen.IsConstraintError(err):
+			return nil, ErrRoleNameTaken
+		}
+		return nil, err
+	}
+	return r.GetRole(ctx, roleID)
+}
+
+// SetRoleActive activates or deactivates a role. Members of a deactivated
+// role keep their assignment but lose its permissions.
+func (r *repo) SetRoleActive(ctx context.Context, roleID uint64, active bool) error {
+	err := r.ent.Role.UpdateOneID(roleID).SetIsActive(active).Exec(ctx)
+	if gen.IsNotFound(err) {
+		return ErrRoleNotFound
+	}
+	return err
+}
+
+func (r *repo) AttachPermissionToRole(ctx context.Context, roleID, permissionID uint64) error {
+	if err := r.roleAndPermissionExist(ctx, roleID, permissionID); err != nil {
+		return err
+	}
+
+	attached, err := r.ent.RolePermission.Query().
 		Where(
-			userrole.UserIDEQ(userID),
-			userrole.RoleIDEQ(roleID),
-			userrole.IsActiveEQ(true),
+			rolepermission.RoleIDEQ(roleID),
+			rolepermission.PermissionIDEQ(permissionID),
 		).
 		Exist(ctx)
+	if err != nil || attached {
+		return err
+	}
+
+	return r.ent.RolePermission.Create().
+		SetRoleID(roleID).
+		SetPermissionID(permissionID).
+		Exec(ctx)
+}
+
+func (r *repo) DetachPermissionFromRole(ctx context.Context, roleID, permissionID uint64) error {
+	if err := r.roleAndPermissionExist(ctx, roleID, permissionID); err != nil {
+		return err
+	}
+
+	_, err := r.ent.RolePermission.Delete().
+		Where(
+			rolepermission.RoleIDEQ(roleID),
+			rolepermission.PermissionIDEQ(permissionID),
+		).
+		Exec(ctx)
+	return err
+}
+
+func (r *repo) roleAndPermissionExist(ctx context.Context, roleID, permissionID uint64) error {
+	exists, err := r.ent.Role.Query().Where(role.IDEQ(roleID)).Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrRoleNotFound
+	}
+
+	exists, err = r.ent.Permission.Query().Where(permission.IDEQ(permissionID)).Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrPermissionNotFound
+	}
+	return nil
+}
+
+// RoleMemberIDs returns the users assigned a role, whose cached permissions
+// change with it.
+func (r *repo) RoleMemberIDs(ctx context.Context, roleID uint64) ([]uint64, error) {
+	members, err := r.ent.UserRole.Query().
+		Where(userrole.RoleIDEQ(roleID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, 0, len(members))
+	for _, m := range members {
+		ids = append(ids, m.UserID)
+	}
+	return ids, nil
+}
+
+// AssignRoleToUser assigns a role, reactivating an earlier assignment if the
+// role was removed before.
+func (r *repo) AssignRoleToUser(ctx context.Context, userID uint64, roleID uint64, assignedBy uint64) error {
+	exists, err := r.ent.Role.Query().Where(role.IDEQ(roleID)).Exist(ctx)
 	if err != nil {
 		return err
 	}
+	if !exists {
+		return ErrRoleNotFound
+	}
 
-	if exists {
-		return nil // Already assigned
+	updated, err := r.ent.UserRole.
+		Update().
+		Where(
+			userrole.UserIDEQ(userID),
+			userrole.RoleIDEQ(roleID),
+		).
+		SetAssignedBy(assignedBy).
+		SetIsActive(true).
+		SetAssignedAt(time.Now()).
+		ClearExpiresAt().
+		Save(ctx)
+	if err != nil || updated > 0 {
+		return err
 	}
 
-	// Create new assignment
 	return r.ent.UserRole.
 		Create().
 		SetUserID(userID).
@@ -362,25 +662,138 @@ func (r *repo) GetAllPermissions(ctx context.Context) ([]*gen.Permission, error)
 	return r.ent.Permission.Query().All(ctx)
 }
 
-func (r *repo) AssignPermissionToUser(ctx context.Context, userID uint64, permissionID uint64, grantedBy uint64) error {
-	// Check if assignment already exists
-	exists, err := r.ent.UserPermission.
-		Query().
+func (r *repo) CreatePermission(ctx context.Context, req PermissionRequest) (*gen.Permission, error) {
+	created, err := r.ent.Permission.Create().
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetResource(req.Resource).
+		SetAction(req.Action).
+		Save(ctx)
+	if err != nil {
+		if gen.IsConstraintError(err) {
+			return nil, ErrPermissionNameTaken
+		}
+		return nil, err
+	}
+	return created, nil
+}
+
+func (r *repo) UpdatePermission(ctx context.Context, permissionID uint64, req PermissionRequest) (*gen.Permission, error) {
+	updated, err := r.ent.Permission.UpdateOneID(permissionID).
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetResource(req.Resource).
+		SetAction(req.Action).
+		Save(ctx)
+	if err != nil {
+		switch {
+		case gen.IsNotFound(err):
+			return nil, ErrPermissionNotFound
+		case gen.IsConstraintError(err):
+			return nil, ErrPermissionNameTaken
+		}
+		return nil, err
+	}
+	return updated, nil
+}
+
+// DeletePermission deletes a permission together with its grants to roles
+// and users.
+func (r *repo) DeletePermission(ctx context.Context, permissionID uint64) error {
+	tx, err := r.ent.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.RolePermission.Delete().Where(rolepermission.PermissionIDEQ(permissionID)).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = tx.UserPermission.Delete().Where(userpermission.PermissionIDEQ(permissionID)).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Permission.DeleteOneID(permissionID).Exec(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return ErrPermissionNotFound
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// PermissionHolderIDs returns the users granted a permission directly or
+// through any of their roles.
+func (r *repo) PermissionHolderIDs(ctx context.Context, permissionID uint64) ([]uint64, error) {
+	direct, err := r.ent.UserPermission.Query().
+		Where(userpermission.PermissionIDEQ(permissionID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	viaRoles, err := r.ent.UserRole.Query().
+		Where(userrole.HasRoleWith(
+			role.HasRolePermissionsWith(rolepermission.PermissionIDEQ(permissionID)),
+		)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, 0, len(direct)+len(viaRoles))
+	for _, up := range direct {
+		ids = append(ids, up.UserID)
+	}
+	for _, ur := range viaRoles {
+		ids = append(ids, ur.UserID)
+	}
+	return ids, nil
+}
+
+// GetUserDirectPermissions returns the permissions granted to a user
+// directly rather than through a role, including revoked and expired ones.
+func (r *repo) GetUserDirectPermissions(ctx context.Context, userID uint64) ([]*gen.UserPermission, error) {
+	return r.ent.UserPermission.Query().
+		Where(userpermission.UserIDEQ(userID)).
+		WithPermission().
+		Order(gen.Asc(userpermission.FieldID)).
+		All(ctx)
+}
+
+// AssignPermissionToUser grants a permission directly until expiresAt, or
+// indefinitely if it is nil. Granting it again replaces the expiry.
+func (r *repo) AssignPermissionToUser(ctx context.Context, userID uint64, permissionID uint64, grantedBy uint64, expiresAt *time.Time) error {
+	exists, err := r.ent.Permission.Query().Where(permission.IDEQ(permissionID)).Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrPermissionNotFound
+	}
+
+	update := r.ent.UserPermission.
+		Update().
 		Where(
 			userpermission.UserIDEQ(userID),
 			userpermission.PermissionIDEQ(permissionID),
-			userpermission.IsActiveEQ(true),
 		).
-		Exist(ctx)
-	if err != nil {
-		return err
+		SetGrantedBy(grantedBy).
+		SetIsActive(true).
+		SetGrantedAt(time.Now()).
+		SetNillableExpiresAt(expiresAt)
+	if expiresAt == nil {
+		update.ClearExpiresAt()
 	}
-
-	if exists {
-		return nil // Already assigned
+	updated, err := update.Save(ctx)
+	if err != nil || updated > 0 {
+		return err
 	}
 
-	// Create new assignment
 	return r.ent.UserPermission.
 		Create().
 		SetUserID(userID).
@@ -388,6 +801,7 @@ func (r *repo) AssignPermissionToUser(ctx context.Context, userID uint64, permis
 		SetGrantedBy(grantedBy).
 		SetIsActive(true).
 		SetGrantedAt(time.Now()).
+		SetNillableExpiresAt(expiresAt).
 		Exec(ctx)
 }
 
@@ -402,6 +816,534 @@ func (r *repo) RemovePermissionFromUser(ctx context.Context, userID uint64, perm
 		Exec(ctx)
 }
 
+// Single-use tokens
+
+// CreateToken issues a single-use token for purpose, replacing any unused
+// token the user still holds for the same purpose. Only its hash is stored.
+func (r *repo) CreateToken(ctx context.Context, userID uint64, purpose string, ttl time.Duration) (string, error) {
+	token, err := generateToken()
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := r.ent.Tx(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.UserToken.Delete().
+		Where(
+			usertoken.UserIDEQ(userID),
+			usertoken.PurposeEQ(purpose),
+			usertoken.UsedAtIsNil(),
+		).
+		Exec(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	err = tx.UserToken.Create().
+		SetUserID(userID).
+		SetPurpose(purpose).
+		SetTokenHash(hashToken(token)).
+		SetExpiresAt(time.Now().Add(ttl)).
+		Exec(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return token, tx.Commit()
+}
+
+// LastTokenAt returns when the user was last issued a token for purpose, or
+// the zero time if never.
+func (r *repo) LastTokenAt(ctx context.Context, userID uint64, purpose string) (time.Time, error) {
+	t, err := r.ent.UserToken.Query().
+		Where(
+			usertoken.UserIDEQ(userID),
+			usertoken.PurposeEQ(purpose),
+		).
+		Order(gen.Desc(usertoken.FieldCreatedAt)).
+		First(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, err
+	}
+	return t.CreatedAt, nil
+}
+
+func (r *repo) VerifyEmail(ctx context.Context, token string) (*gen.User, error) {
+	tx, err := r.ent.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	t, err := consumeToken(ctx, tx, tokenEmailVerification, token)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := tx.User.Get(ctx, t.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if u.VerifiedAt == nil {
+		u, err = tx.User.UpdateOne(u).SetVerifiedAt(time.Now()).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return u, tx.Commit()
+}
+
+// ResetPassword sets a new password for the owner of a password reset token
+// and returns their ID.
+func (r *repo) ResetPassword(ctx context.Context, token, newPassword string) (uint64, error) {
+	hashedPassword, err := argon2id.CreateHash(newPassword, argon2id.DefaultParams)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := r.ent.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	t, err := consumeToken(ctx, tx, tokenPasswordReset, token)
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.User.UpdateOneID(t.UserID).
+		SetPasswordHash(hashedPassword).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return t.UserID, tx.Commit()
+}
+
+// consumeToken marks an unused, unexpired token as used. The row lock makes
+// concurrent attempts with the same token succeed at most once.
+func consumeToken(ctx context.Context, tx *gen.Tx, purpose, token string) (*gen.UserToken, error) {
+	t, err := tx.UserToken.Query().
+		Where(
+			usertoken.TokenHashEQ(hashToken(token)),
+			usertoken.PurposeEQ(purpose),
+		).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, ErrInvalidToken
+		}
+		return nil, err
+	}
+
+	if t.UsedAt != nil || time.Now().After(t.ExpiresAt) {
+		return nil, ErrInvalidToken
+	}
+
+	return tx.UserToken.UpdateOne(t).SetUsedAt(time.Now()).Save(ctx)
+}
+
+// Two-factor authentication
+
+func (r *repo) CheckPassword(ctx context.Context, userID uint64, password string) error {
+	u, err := r.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	match, err := argon2id.ComparePasswordAndHash(password, u.PasswordHash)
+	if err != nil || !match {
+		return ErrInvalidPassword
+	}
+	return nil
+}
+
+// StartTwoFactor stores a new, not yet confirmed TOTP secret. Enrolling
+// again before confirming replaces the secret.
+func (r *repo) StartTwoFactor(ctx context.Context, userID uint64, secret string) error {
+	n, err := r.ent.User.Update().
+		Where(
+			user.IDEQ(userID),
+			user.TotpEnabledAtIsNil(),
+		).
+		SetTotpSecret(secret).
+		ClearTotpLastStep().
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrTwoFactorEnabled
+	}
+	return nil
+}
+
+// EnableTwoFactor confirms the enrolled secret. step is the time step of the
+// code used to confirm, so that code cannot be replayed to log in.
+func (r *repo) EnableTwoFactor(ctx context.Context, userID uint64, step int64, recoveryCodeHashes []string) error {
+	tx, err := r.ent.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	u, err := tx.User.Query().Where(user.IDEQ(userID)).ForUpdate().Only(ctx)
+	if err != nil {
+		return err
+	}
+	switch {
+	case u.TotpEnabledAt != nil:
+		return ErrTwoFactorEnabled
+	case u.TotpSecret == "":
+		return ErrTwoFactorNotEnrolled
+	}
+
+	err = tx.User.UpdateOne(u).
+		SetTotpEnabledAt(time.Now()).
+		SetTotpLastStep(step).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = replaceRecoveryCodes(ctx, tx, userID, recoveryCodeHashes); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *repo) DisableTwoFactor(ctx context.Context, userID uint64) error {
+	tx, err := r.ent.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = tx.User.UpdateOneID(userID).
+		ClearTotpSecret().
+		ClearTotpEnabledAt().
+		ClearTotpLastStep().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.RecoveryCode.Delete().Where(recoverycode.UserIDEQ(userID)).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UseTOTPStep records step as used. Steps only move forward, so a code that
+// was already accepted, or an older one, fails with ErrInvalidCode.
+func (r *repo) UseTOTPStep(ctx context.Context, userID uint64, step int64) error {
+	n, err := r.ent.User.Update().
+		Where(
+			user.IDEQ(userID),
+			user.Or(
+				user.TotpLastStepIsNil(),
+				user.TotpLastStepLT(step),
+			),
+		).
+		SetTotpLastStep(step).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrInvalidCode
+	}
+	return nil
+}
+
+func (r *repo) UseRecoveryCode(ctx context.Context, userID uint64, codeHash string) error {
+	n, err := r.ent.RecoveryCode.Update().
+		Where(
+			recoverycode.UserIDEQ(userID),
+			recoverycode.CodeHashEQ(codeHash),
+			recoverycode.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrInvalidCode
+	}
+	return nil
+}
+
+func (r *repo) ReplaceRecoveryCodes(ctx context.Context, userID uint64, codeHashes []string) error {
+	tx, err := r.ent.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err = replaceRecoveryCodes(ctx, tx, userID, codeHashes); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func replaceRecoveryCodes(ctx context.Context, tx *gen.Tx, userID uint64, codeHashes []string) error {
+	_, err := tx.RecoveryCode.Delete().Where(recoverycode.UserIDEQ(userID)).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	builders := make([]*gen.RecoveryCodeCreate, 0, len(codeHashes))
+	for _, h := range codeHashes {
+		builders = append(builders, tx.RecoveryCode.Create().
+			SetUserID(userID).
+			SetCodeHash(h))
+	}
+
+	return tx.RecoveryCode.CreateBulk(builders...).Exec(ctx)
+}
+
+// API keys
+
+// CreateAPIKey stores a new key and returns it together with the plain key,
+// which is never available again.
+func (r *repo) CreateAPIKey(ctx context.Context, userID uint64, req CreateAPIKeyRequest) (*gen.APIKey, string, error) {
+	token, err := generateToken()
+	if err != nil {
+		return nil, "", err
+	}
+	token = apiKeyPrefix + token
+
+	key, err := r.ent.APIKey.Create().
+		SetUserID(userID).
+		SetName(req.Name).
+		SetPrefix(token[:len(apiKeyPrefix)+6]).
+		SetKeyHash(hashToken(token)).
+		SetScopes(req.Scopes).
+		SetNillableExpiresAt(req.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return key, token, nil
+}
+
+func (r *repo) ListAPIKeys(ctx context.Context, userID uint64) ([]*gen.APIKey, error) {
+	return r.ent.APIKey.Query().
+		Where(
+			apikey.UserIDEQ(userID),
+			apikey.RevokedAtIsNil(),
+		).
+		Order(gen.Desc(apikey.FieldID)).
+		All(ctx)
+}
+
+func (r *repo) RevokeAPIKey(ctx context.Context, userID, keyID uint64) error {
+	n, err := r.ent.APIKey.Update().
+		Where(
+			apikey.IDEQ(keyID),
+			apikey.UserIDEQ(userID),
+			apikey.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+// VerifyAPIKey resolves a bearer token to its key. Revoked and expired keys,
+// and keys of accounts that are not active, are rejected.
+func (r *repo) VerifyAPIKey(ctx context.Context, token string) (middleware.APIKey, error) {
+	now := time.Now()
+
+	key, err := r.ent.APIKey.Query().
+		Where(
+			apikey.KeyHashEQ(hashToken(token)),
+			apikey.RevokedAtIsNil(),
+			apikey.Or(
+				apikey.ExpiresAtIsNil(),
+				apikey.ExpiresAtGT(now),
+			),
+			apikey.HasUserWith(user.StatusEQ(statusActive)),
+		).
+		Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return middleware.APIKey{}, middleware.ErrInvalidAPIKey
+		}
+		return middleware.APIKey{}, err
+	}
+
+	// Record usage at most once a minute rather than on every request.
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) > time.Minute {
+		err = r.ent.APIKey.UpdateOne(key).SetLastUsedAt(now).Exec(ctx)
+		if err != nil {
+			return middleware.APIKey{}, err
+		}
+	}
+
+	return middleware.APIKey{
+		ID:     key.ID,
+		UserID: key.UserID,
+		Scopes: key.Scopes,
+	}, nil
+}
+
+// Linked identities
+
+// LoginWithIdentity returns the user linked to the provider's subject. An
+// unknown subject with a verified email is linked to the account with that
+// email, or to a new account if there is none.
+func (r *repo) LoginWithIdentity(ctx context.Context, provider string, claims *oidc.Claims) (*gen.User, error) {
+	tx, err := r.ent.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+
+	identity, err := tx.UserIdentity.Query().
+		Where(
+			useridentity.ProviderEQ(provider),
+			useridentity.SubjectEQ(claims.Subject),
+		).
+		WithUser().
+		Only(ctx)
+	switch {
+	case err == nil:
+		err = tx.UserIdentity.UpdateOne(identity).SetLastLoginAt(now).Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return identity.Edges.User, tx.Commit()
+	case !gen.IsNotFound(err):
+		return nil, err
+	}
+
+	if claims.Email == "" || !claims.EmailVerified {
+		return nil, ErrIdentityEmailUnverified
+	}
+
+	u, err := tx.User.Query().Where(user.EmailEqualFold(claims.Email)).Only(ctx)
+	switch {
+	case gen.IsNotFound(err):
+		u, err = createIdentityUser(ctx, tx, claims)
+		if err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	case u.VerifiedAt == nil:
+		// Anyone can register an address they do not own. Linking would hand
+		// the provider's user an account whose password someone else set.
+		return nil, ErrIdentityNotLinkable
+	}
+
+	err = tx.UserIdentity.Create().
+		SetUserID(u.ID).
+		SetProvider(provider).
+		SetSubject(claims.Subject).
+		SetEmail(claims.Email).
+		SetLastLoginAt(now).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return u, tx.Commit()
+}
+
+// createIdentityUser creates a verified customer account for a new external
+// identity. Its random password is never shown, so it can only be logged
+// into with a password after a password reset.
+func createIdentityUser(ctx context.Context, tx *gen.Tx, claims *oidc.Claims) (*gen.User, error) {
+	password, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+	hashedPassword, err := argon2id.CreateHash(password, argon2id.DefaultParams)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	u, err := tx.User.Create().
+		SetFirstName(claims.GivenName).
+		SetLastName(claims.FamilyName).
+		SetEmail(claims.Email).
+		SetPasswordHash(hashedPassword).
+		SetStatus(statusActive).
+		SetVerifiedAt(now).
+		SetCreatedAt(now).
+		SetUpdatedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	customerRole, err := tx.Role.Query().Where(role.NameEQ("customer")).First(ctx)
+	if err == nil {
+		err = tx.UserRole.Create().
+			SetUserID(u.ID).
+			SetRoleID(customerRole.ID).
+			SetAssignedBy(u.ID).
+			SetIsActive(true).
+			SetAssignedAt(now).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return u, nil
+}
+
+func (r *repo) ListIdentities(ctx context.Context, userID uint64) ([]*gen.UserIdentity, error) {
+	return r.ent.UserIdentity.Query().
+		Where(useridentity.UserIDEQ(userID)).
+		Order(gen.Asc(useridentity.FieldID)).
+		All(ctx)
+}
+
+func (r *repo) UnlinkIdentity(ctx context.Context, userID, identityID uint64) error {
+	n, err := r.ent.UserIdentity.Delete().
+		Where(
+			useridentity.IDEQ(identityID),
+			useridentity.UserIDEQ(userID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrIdentityNotFound
+	}
+	return nil
+}
+
 // CSRF token
 func (r *repo) Csrf(ctx context.Context) (string, error) {
 	token, err := generateToken()
@@ -417,11 +1359,12 @@ func (r *repo) Csrf(ctx context.Context) (string, error) {
 	return token, nil
 }
 
-func NewRepo(ent *gen.Client, db *sql.DB, manager *scs.SessionManager) *repo {
+func NewRepo(ent *gen.Client, db *sql.DB, manager *scs.SessionManager, cfg config.Auth) *repo {
 	return &repo{
 		ent:     ent,
 		db:      db,
 		session: manager,
+		cfg:     cfg,
 	}
 }
 
@@ -433,3 +1376,25 @@ func generateToken() (string, error) {
 	}
 	return base64.RawURLEncoding.EncodeToString(b), nil
 }
+
+// hashToken hashes a mailed token before it touches the database, the way
+// postgresstore does with session tokens. SHA-256 is used because, unlike a
+// session token, a leaked hash must not let anyone forge a matching token.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+var (
+	dummyHashOnce  sync.Once
+	dummyHashValue string
+)
+
+// dummyHash is compared against when a login email does not exist, so that
+// the request costs the same argon2id work as a real one.
+func dummyHash() string {
+	dummyHashOnce.Do(func() {
+		dummyHashValue, _ = argon2id.CreateHash("dummy password", argon2id.DefaultParams)
+	})
+	return dummyHashValue
+}
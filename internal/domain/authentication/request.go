@@ -1,5 +1,7 @@
 package authentication
 
+import "time"
+
 type RegisterRequest struct {
 	FirstName  string `json:"first_name"`
 	MiddleName string `json:"middle_name,omitempty"`
@@ -27,4 +29,49 @@ type AssignRoleRequest struct {
 type AssignPermissionRequest struct {
 	UserID       uint64 `json:"user_id"`
 	PermissionID uint64   `json:"permission_id"`
+	// ExpiresAt ends the grant. It never expires if omitted.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+type ResendVerificationRequest struct {
+	Email string `json:"email"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"new_password"`
+}
+
+type TwoFactorCodeRequest struct {
+	// Code is a TOTP code, or a recovery code where accepted.
+	Code string `json:"code"`
+}
+
+type DisableTwoFactorRequest struct {
+	Password string `json:"password"`
+	Code     string `json:"code"`
+}
+
+type CreateAPIKeyRequest struct {
+	Name string `json:"name"`
+	// Scopes are permission strings and must be a subset of the user's own.
+	Scopes    []string   `json:"scopes"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+type RoleRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type PermissionRequest struct {
+	// Name defaults to "resource:action".
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Resource    string `json:"resource"`
+	Action      string `json:"action"`
 }
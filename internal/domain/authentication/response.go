@@ -1,5 +1,12 @@
 package authentication
 
+import (
+	"time"
+
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
 type UserResponse struct {
 	ID         uint64   `json:"id"`
 	FirstName  string   `json:"first_name"`
@@ -24,10 +31,28 @@ type AuthStatusResponse struct {
 }
 
 type RoleResponse struct {
-	ID          uint64 `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	IsActive    bool   `json:"is_active"`
+	ID          uint64                `json:"id"`
+	Name        string                `json:"name"`
+	Description string                `json:"description"`
+	IsActive    bool                  `json:"is_active"`
+	Permissions []*PermissionResponse `json:"permissions"`
+}
+
+func RoleResource(r *gen.Role) *RoleResponse {
+	permissions := make([]*PermissionResponse, 0, len(r.Edges.RolePermissions))
+	for _, rp := range r.Edges.RolePermissions {
+		if rp.Edges.Permission != nil {
+			permissions = append(permissions, PermissionResource(rp.Edges.Permission))
+		}
+	}
+
+	return &RoleResponse{
+		ID:          r.ID,
+		Name:        r.Name,
+		Description: r.Description,
+		IsActive:    r.IsActive,
+		Permissions: permissions,
+	}
 }
 
 type PermissionResponse struct {
@@ -37,3 +62,117 @@ type PermissionResponse struct {
 	Resource    string `json:"resource"`
 	Action      string `json:"action"`
 }
+
+func PermissionResource(p *gen.Permission) *PermissionResponse {
+	return &PermissionResponse{
+		ID:          p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+		Resource:    p.Resource,
+		Action:      p.Action,
+	}
+}
+
+type UserPermissionResponse struct {
+	Permission *PermissionResponse `json:"permission"`
+	GrantedBy  uint64              `json:"granted_by"`
+	GrantedAt  time.Time           `json:"granted_at"`
+	ExpiresAt  *time.Time          `json:"expires_at,omitempty"`
+	IsActive   bool                `json:"is_active"`
+}
+
+func UserPermissionResource(up *gen.UserPermission) *UserPermissionResponse {
+	res := &UserPermissionResponse{
+		GrantedBy: up.GrantedBy,
+		GrantedAt: up.GrantedAt,
+		IsActive:  up.IsActive,
+	}
+	if up.Edges.Permission != nil {
+		res.Permission = PermissionResource(up.Edges.Permission)
+	}
+	if !up.ExpiresAt.IsZero() {
+		res.ExpiresAt = &up.ExpiresAt
+	}
+	return res
+}
+
+type LoginResponse struct {
+	TwoFactorRequired bool `json:"two_factor_required"`
+}
+
+type TwoFactorEnrollResponse struct {
+	Secret string `json:"secret"`
+	URI    string `json:"otpauth_uri"`
+}
+
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type APIKeyResponse struct {
+	ID         uint64     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	// Key is only returned when the key is created.
+	Key string `json:"key,omitempty"`
+}
+
+func APIKeyResource(k *gen.APIKey) *APIKeyResponse {
+	return &APIKeyResponse{
+		ID:         k.ID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     k.Scopes,
+		ExpiresAt:  k.ExpiresAt,
+		LastUsedAt: k.LastUsedAt,
+		CreatedAt:  k.CreatedAt,
+	}
+}
+
+type IdentityResponse struct {
+	ID          uint64     `json:"id"`
+	Provider    string     `json:"provider"`
+	Email       string     `json:"email"`
+	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+func IdentityResource(i *gen.UserIdentity) *IdentityResponse {
+	return &IdentityResponse{
+		ID:          i.ID,
+		Provider:    i.Provider,
+		Email:       i.Email,
+		LastLoginAt: i.LastLoginAt,
+		CreatedAt:   i.CreatedAt,
+	}
+}
+
+type SessionResponse struct {
+	ID         string    `json:"id"`
+	IPAddress  string    `json:"ip_address"`
+	UserAgent  string    `json:"user_agent"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Current    bool      `json:"current"`
+}
+
+func SessionResource(s middleware.Session, currentID string) *SessionResponse {
+	return &SessionResponse{
+		ID:         s.ID,
+		IPAddress:  s.IPAddress,
+		UserAgent:  s.UserAgent,
+		CreatedAt:  s.CreatedAt,
+		LastSeenAt: s.LastSeenAt,
+		ExpiresAt:  s.Expiry,
+		Current:    s.ID == currentID,
+	}
+}
+
+type RevokeSessionsResponse struct {
+	Revoked int `json:"revoked"`
+}
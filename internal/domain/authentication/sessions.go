@@ -0,0 +1,120 @@
+package authentication
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/thang1834/go-goss/internal/middleware"
+	"github.com/thang1834/go-goss/internal/utility/param"
+	"github.com/thang1834/go-goss/internal/utility/respond"
+)
+
+var (
+	ErrSessionNotFound    = errors.New("session not found")
+	ErrSessionsNotIndexed = errors.New("the session store cannot list sessions")
+)
+
+// ListSessions lists the current user's active sessions
+// @Summary List my sessions
+// @Success 200 {array} SessionResponse
+// @Failure 403
+// @router /api/v1/restricted/sessions [get]
+func (h *Handler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	index, userID, currentID, ok := h.sessionIndex(w, r)
+	if !ok {
+		return
+	}
+
+	sessions, err := index.ListUserSessions(r.Context(), userID)
+	if err != nil {
+		respond.Status(w, http.StatusInternalServerError)
+		return
+	}
+
+	res := make([]*SessionResponse, 0, len(sessions))
+	for _, s := range sessions {
+		res = append(res, SessionResource(s, currentID))
+	}
+
+	respond.Json(w, http.StatusOK, res)
+}
+
+// RevokeSession logs out one of the current user's sessions. Revoking the
+// current session is the same as logging out.
+// @Summary Revoke a session
+// @Param sessionID path string true "Session ID"
+// @Success 204
+// @Failure 404
+// @router /api/v1/restricted/sessions/{sessionID} [delete]
+func (h *Handler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	index, userID, currentID, ok := h.sessionIndex(w, r)
+	if !ok {
+		return
+	}
+
+	id := param.String(r, "sessionID")
+	if id == currentID {
+		if err := h.session.Destroy(r.Context()); err != nil {
+			respond.Status(w, http.StatusInternalServerError)
+			return
+		}
+		respond.Status(w, http.StatusNoContent)
+		return
+	}
+
+	found, err := index.RevokeUserSession(r.Context(), userID, id)
+	if err != nil {
+		respond.Status(w, http.StatusInternalServerError)
+		return
+	}
+	if !found {
+		respond.Error(w, http.StatusNotFound, ErrSessionNotFound)
+		return
+	}
+
+	respond.Status(w, http.StatusNoContent)
+}
+
+// RevokeOtherSessions logs out every session of the current user except the
+// one making the request
+// @Summary Revoke all other sessions
+// @Success 200 {object} RevokeSessionsResponse
+// @router /api/v1/restricted/sessions [delete]
+func (h *Handler) RevokeOtherSessions(w http.ResponseWriter, r *http.Request) {
+	index, userID, currentID, ok := h.sessionIndex(w, r)
+	if !ok {
+		return
+	}
+
+	n, err := index.RevokeOtherUserSessions(r.Context(), userID, currentID)
+	if err != nil {
+		respond.Status(w, http.StatusInternalServerError)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, RevokeSessionsResponse{Revoked: n})
+}
+
+// sessionIndex returns the session store's index together with the current
+// user and the ID of their current session.
+func (h *Handler) sessionIndex(w http.ResponseWriter, r *http.Request) (middleware.SessionIndex, uint64, string, bool) {
+	index, ok := h.session.Store.(middleware.SessionIndex)
+	if !ok {
+		respond.Error(w, http.StatusNotImplemented, ErrSessionsNotIndexed)
+		return nil, 0, "", false
+	}
+
+	userID, ok := h.session.Get(r.Context(), string(middleware.KeyID)).(uint64)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return nil, 0, "", false
+	}
+
+	currentID, err := index.SessionID(h.session.Token(r.Context()))
+	if err != nil {
+		respond.Status(w, http.StatusInternalServerError)
+		return nil, 0, "", false
+	}
+
+	return index, userID, currentID, true
+}
@@ -0,0 +1,345 @@
+package authentication
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/internal/middleware"
+	"github.com/thang1834/go-goss/internal/utility/request"
+	"github.com/thang1834/go-goss/internal/utility/respond"
+	"github.com/thang1834/go-goss/internal/utility/totp"
+)
+
+const (
+	// Session keys of a login waiting for its second factor.
+	keyPendingUserID   = "pending_2fa_user_id"
+	keyPendingSince    = "pending_2fa_since"
+	keyPendingFailures = "pending_2fa_failures"
+
+	pendingTwoFactorTTL  = 5 * time.Minute
+	maxTwoFactorFailures = 5
+
+	// totpSkew accepts codes one step either side of now for clock drift.
+	totpSkew          = 1
+	recoveryCodeCount = 10
+)
+
+var (
+	ErrTwoFactorRequired = errors.New("two-factor authentication must be enabled for this role")
+	ErrNoPendingLogin    = errors.New("no login is waiting for a two-factor code")
+)
+
+var recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// LoginTwoFactor completes a login that is waiting for its second factor
+// @Summary Submit two-factor code
+// @Param code body TwoFactorCodeRequest true "TOTP or recovery code"
+// @Success 200
+// @Failure 400
+// @Failure 401
+// @router /api/v1/login/2fa [post]
+func (h *Handler) LoginTwoFactor(w http.ResponseWriter, r *http.Request) {
+	var req TwoFactorCodeRequest
+	err := request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	ctx := r.Context()
+	ip := middleware.ClientIP(r)
+
+	userID, ok := h.session.Get(ctx, keyPendingUserID).(uint64)
+	since := h.session.GetInt64(ctx, keyPendingSince)
+	if !ok || time.Since(time.Unix(since, 0)) > pendingTwoFactorTTL {
+		h.clearPendingLogin(ctx)
+		respond.Error(w, http.StatusUnauthorized, ErrNoPendingLogin)
+		return
+	}
+
+	user, err := h.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		respond.Status(w, http.StatusInternalServerError)
+		return
+	}
+
+	if h.throttled(ctx, w, user.Email, ip) {
+		return
+	}
+
+	if err = h.checkSecondFactor(ctx, user, req.Code, true); err != nil {
+		if !errors.Is(err, ErrInvalidCode) {
+			respond.Status(w, http.StatusInternalServerError)
+			return
+		}
+
+		// Wrong codes back off like wrong passwords, so that logging in
+		// again for a fresh pending login does not allow more guesses.
+		h.loginFailed(ctx, user.Email, ip)
+
+		// Too many wrong codes and the password has to be entered again.
+		failures := h.session.GetInt(ctx, keyPendingFailures) + 1
+		if failures >= maxTwoFactorFailures {
+			h.clearPendingLogin(ctx)
+		} else {
+			h.session.Put(ctx, keyPendingFailures, failures)
+		}
+		respond.Error(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	if err = h.session.RenewToken(ctx); err != nil {
+		respond.Error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	h.clearPendingLogin(ctx)
+	h.session.Put(ctx, string(middleware.KeyID), user.ID)
+	h.loginSucceeded(ctx, user)
+
+	respond.Status(w, http.StatusOK)
+}
+
+// EnrollTwoFactor creates a TOTP secret for the current user. It only takes
+// effect once confirmed with ConfirmTwoFactor.
+// @Summary Enroll in two-factor authentication
+// @Success 200 {object} TwoFactorEnrollResponse
+// @Failure 409
+// @router /api/v1/restricted/2fa/enroll [post]
+func (h *Handler) EnrollTwoFactor(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		respond.Status(w, http.StatusInternalServerError)
+		return
+	}
+
+	err = h.repo.StartTwoFactor(r.Context(), user.ID, secret)
+	if err != nil {
+		h.twoFactorError(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, TwoFactorEnrollResponse{
+		Secret: secret,
+		URI:    totp.URI(h.cfg.TOTPIssuer, user.Email, secret),
+	})
+}
+
+// ConfirmTwoFactor enables two-factor authentication with a code from the
+// enrolled secret and returns the recovery codes. They are shown only once.
+// @Summary Confirm two-factor authentication
+// @Param code body TwoFactorCodeRequest true "TOTP code"
+// @Success 200 {object} RecoveryCodesResponse
+// @Failure 400
+// @Failure 409
+// @router /api/v1/restricted/2fa/confirm [post]
+func (h *Handler) ConfirmTwoFactor(w http.ResponseWriter, r *http.Request) {
+	var req TwoFactorCodeRequest
+	err := request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	user, ok := h.currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	switch {
+	case user.TotpEnabledAt != nil:
+		h.twoFactorError(w, ErrTwoFactorEnabled)
+		return
+	case user.TotpSecret == "":
+		h.twoFactorError(w, ErrTwoFactorNotEnrolled)
+		return
+	}
+
+	step, ok := totp.Validate(user.TotpSecret, req.Code, time.Now(), totpSkew)
+	if !ok {
+		h.twoFactorError(w, ErrInvalidCode)
+		return
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		respond.Status(w, http.StatusInternalServerError)
+		return
+	}
+
+	err = h.repo.EnableTwoFactor(r.Context(), user.ID, step, hashes)
+	if err != nil {
+		h.twoFactorError(w, err)
+		return
+	}
+
+	h.invalidateUserCache(r.Context(), user.ID)
+
+	respond.Json(w, http.StatusOK, RecoveryCodesResponse{RecoveryCodes: codes})
+}
+
+// DisableTwoFactor turns two-factor authentication off. Both the password
+// and a current code are required.
+// @Summary Disable two-factor authentication
+// @Param disable body DisableTwoFactorRequest true "password and code"
+// @Success 204
+// @Failure 400
+// @router /api/v1/restricted/2fa/disable [post]
+func (h *Handler) DisableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	var req DisableTwoFactorRequest
+	err := request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	user, ok := h.currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	if err = h.repo.CheckPassword(r.Context(), user.ID, req.Password); err != nil {
+		h.twoFactorError(w, err)
+		return
+	}
+	if err = h.checkSecondFactor(r.Context(), user, req.Code, true); err != nil {
+		h.twoFactorError(w, err)
+		return
+	}
+
+	if err = h.repo.DisableTwoFactor(r.Context(), user.ID); err != nil {
+		h.twoFactorError(w, err)
+		return
+	}
+
+	h.invalidateUserCache(r.Context(), user.ID)
+
+	respond.Status(w, http.StatusNoContent)
+}
+
+// RegenerateRecoveryCodes replaces all recovery codes, used or not
+// @Summary Regenerate recovery codes
+// @Param code body TwoFactorCodeRequest true "TOTP code"
+// @Success 200 {object} RecoveryCodesResponse
+// @Failure 400
+// @router /api/v1/restricted/2fa/recovery-codes [post]
+func (h *Handler) RegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	var req TwoFactorCodeRequest
+	err := request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	user, ok := h.currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	if err = h.checkSecondFactor(r.Context(), user, req.Code, false); err != nil {
+		h.twoFactorError(w, err)
+		return
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		respond.Status(w, http.StatusInternalServerError)
+		return
+	}
+
+	if err = h.repo.ReplaceRecoveryCodes(r.Context(), user.ID, hashes); err != nil {
+		h.twoFactorError(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, RecoveryCodesResponse{RecoveryCodes: codes})
+}
+
+// checkSecondFactor accepts a TOTP code, or a recovery code when
+// allowRecovery is set. Either can be used only once.
+func (h *Handler) checkSecondFactor(ctx context.Context, user *gen.User, code string, allowRecovery bool) error {
+	if user.TotpEnabledAt == nil {
+		return ErrTwoFactorNotEnabled
+	}
+
+	if step, ok := totp.Validate(user.TotpSecret, code, time.Now(), totpSkew); ok {
+		return h.repo.UseTOTPStep(ctx, user.ID, step)
+	}
+
+	if !allowRecovery {
+		return ErrInvalidCode
+	}
+	return h.repo.UseRecoveryCode(ctx, user.ID, hashToken(normaliseRecoveryCode(code)))
+}
+
+func (h *Handler) currentUser(w http.ResponseWriter, r *http.Request) (*gen.User, bool) {
+	userID, ok := h.session.Get(r.Context(), string(middleware.KeyID)).(uint64)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return nil, false
+	}
+
+	user, err := h.repo.GetUserByID(r.Context(), userID)
+	if err != nil {
+		respond.Status(w, http.StatusUnauthorized)
+		return nil, false
+	}
+	return user, true
+}
+
+func (h *Handler) clearPendingLogin(ctx context.Context) {
+	h.session.Remove(ctx, keyPendingUserID)
+	h.session.Remove(ctx, keyPendingSince)
+	h.session.Remove(ctx, keyPendingFailures)
+}
+
+func (h *Handler) twoFactorError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrTwoFactorEnabled):
+		respond.Error(w, http.StatusConflict, err)
+	case errors.Is(err, ErrTwoFactorNotEnrolled),
+		errors.Is(err, ErrTwoFactorNotEnabled),
+		errors.Is(err, ErrInvalidCode):
+		respond.Error(w, http.StatusBadRequest, err)
+	case errors.Is(err, ErrInvalidPassword):
+		respond.Error(w, http.StatusBadRequest, errors.New("password is incorrect"))
+	default:
+		respond.Status(w, http.StatusInternalServerError)
+	}
+}
+
+// newRecoveryCodes returns fresh recovery codes formatted for the user, and
+// the hashes to store.
+func newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+
+	b := make([]byte, 5)
+	for i := range codes {
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		code := strings.ToLower(recoveryEncoding.EncodeToString(b))
+		codes[i] = code[:4] + "-" + code[4:]
+		hashes[i] = hashToken(code)
+	}
+	return codes, hashes, nil
+}
+
+// normaliseRecoveryCode undoes the formatting of newRecoveryCodes, so codes
+// are accepted with or without the dash and in any case.
+func normaliseRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.ReplaceAll(code, "-", "")
+}
@@ -0,0 +1,136 @@
+package authentication
+
+import (
+	"context"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gmhafiz/scs/v2"
+	"github.com/gmhafiz/scs/v2/memstore"
+	"github.com/go-chi/chi/v5"
+
+	"github.com/thang1834/go-goss/config"
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/internal/middleware"
+	"github.com/thang1834/go-goss/internal/utility/totp"
+)
+
+func TestRecoveryCodesMatchTheirHashes(t *testing.T) {
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(codes) != recoveryCodeCount || len(hashes) != recoveryCodeCount {
+		t.Fatalf("got %d codes and %d hashes", len(codes), len(hashes))
+	}
+
+	seen := make(map[string]bool)
+	for i, code := range codes {
+		if len(code) != 9 || code[4] != '-' {
+			t.Errorf("code %q is not formatted as xxxx-xxxx", code)
+		}
+		if seen[code] {
+			t.Errorf("duplicate code %q", code)
+		}
+		seen[code] = true
+
+		for _, typed := range []string{code, strings.ToUpper(code), strings.ReplaceAll(code, "-", ""), " " + code + " "} {
+			if hashToken(normaliseRecoveryCode(typed)) != hashes[i] {
+				t.Errorf("%q does not match the hash of %q", typed, code)
+			}
+		}
+	}
+}
+
+// twoFactorRepo stubs the part of Repo used by LoginTwoFactor.
+type twoFactorRepo struct {
+	Repo
+	user *gen.User
+}
+
+func (r *twoFactorRepo) GetUserByID(context.Context, uint64) (*gen.User, error) {
+	return r.user, nil
+}
+
+func (r *twoFactorRepo) UseTOTPStep(context.Context, uint64, int64) error {
+	return nil
+}
+
+func (r *twoFactorRepo) UseRecoveryCode(context.Context, uint64, string) error {
+	return ErrInvalidCode
+}
+
+func (r *twoFactorRepo) LockUser(context.Context, string) error {
+	return nil
+}
+
+func TestLoginTwoFactorBackoff(t *testing.T) {
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	repo := &twoFactorRepo{user: &gen.User{ID: 7, Email: "jane@example.com", TotpSecret: secret, TotpEnabledAt: &now}}
+
+	session := scs.New()
+	session.Store = memstore.New()
+	store := &memoryAttempts{now: time.Now, attempts: map[string]Attempts{}}
+	h := &Handler{repo: repo, session: session, limiter: NewLoginLimiter(store, config.Auth{})}
+
+	router := chi.NewRouter()
+	router.Use(middleware.LoadAndSave(session))
+	router.Get("/pending", func(w http.ResponseWriter, r *http.Request) {
+		session.Put(r.Context(), keyPendingUserID, repo.user.ID)
+		session.Put(r.Context(), keyPendingSince, time.Now().Unix())
+	})
+	router.Post("/api/v1/login/2fa", h.LoginTwoFactor)
+	app := httptest.NewServer(router)
+	t.Cleanup(app.Close)
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Jar: jar}
+
+	res, err := client.Get(app.URL + "/pending")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	submit := func(code string) int {
+		t.Helper()
+		res, err := client.Post(app.URL+"/api/v1/login/2fa", "application/json", strings.NewReader(`{"code":"`+code+`"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		return res.StatusCode
+	}
+
+	if code := submit("wrong"); code != http.StatusUnauthorized {
+		t.Fatalf("wrong code returned %d, want 401", code)
+	}
+	if a := store.attempts[emailKey(repo.user.Email)]; a.Failures != 1 {
+		t.Errorf("email has %d failures after a wrong code, want 1", a.Failures)
+	}
+	if a := store.attempts["ip:127.0.0.1"]; a.Failures != 1 {
+		t.Errorf("IP has %d failures after a wrong code, want 1", a.Failures)
+	}
+
+	code, err := totp.Code(secret, totp.Step(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status := submit(code); status != http.StatusOK {
+		t.Fatalf("good code returned %d, want 200", status)
+	}
+	if _, ok := store.attempts[emailKey(repo.user.Email)]; ok {
+		t.Error("email failures were kept after the second factor was accepted")
+	}
+}
@@ -0,0 +1,125 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/internal/utility/request"
+	"github.com/thang1834/go-goss/internal/utility/respond"
+	"github.com/thang1834/go-goss/third_party/mailer"
+)
+
+// VerifyEmail marks the account owning the token as verified
+// @Summary Verify email address
+// @Param token query string true "token from the verification email"
+// @Success 200
+// @Failure 400
+// @router /api/v1/verify-email [get]
+func (h *Handler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		respond.Error(w, http.StatusBadRequest, ErrInvalidToken)
+		return
+	}
+
+	user, err := h.repo.VerifyEmail(r.Context(), token)
+	if err != nil {
+		if errors.Is(err, ErrInvalidToken) {
+			respond.Error(w, http.StatusBadRequest, err)
+			return
+		}
+		respond.Status(w, http.StatusInternalServerError)
+		return
+	}
+
+	h.invalidateUserCache(r.Context(), user.ID)
+
+	respond.Status(w, http.StatusOK)
+}
+
+// ResendVerification mails a fresh verification link. The response is the
+// same whether or not the address belongs to an unverified account.
+// @Summary Resend verification email
+// @Param email body ResendVerificationRequest true "account email"
+// @Success 202
+// @Failure 400
+// @router /api/v1/verify-email/resend [post]
+func (h *Handler) ResendVerification(w http.ResponseWriter, r *http.Request) {
+	var req ResendVerificationRequest
+	err := request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	req.Email = strings.TrimSpace(req.Email)
+	if req.Email == "" {
+		respond.Error(w, http.StatusBadRequest, ErrEmailRequired)
+		return
+	}
+
+	user, err := h.repo.GetUserByEmail(r.Context(), req.Email)
+	if err == nil {
+		if err = h.sendVerification(r.Context(), user); err != nil {
+			log.Printf("sending verification email to user %d: %v", user.ID, err)
+		}
+	} else if !gen.IsNotFound(err) {
+		log.Printf("resend verification: %v", err)
+	}
+
+	respond.Status(w, http.StatusAccepted)
+}
+
+// sendVerification mails a verification link unless the user is already
+// verified or was sent one less than VerificationResendInterval ago.
+func (h *Handler) sendVerification(ctx context.Context, user *gen.User) error {
+	if user.VerifiedAt != nil {
+		return nil
+	}
+
+	last, err := h.repo.LastTokenAt(ctx, user.ID, tokenEmailVerification)
+	if err != nil {
+		return err
+	}
+	if time.Since(last) < h.cfg.VerificationResendInterval {
+		return nil
+	}
+
+	token, err := h.repo.CreateToken(ctx, user.ID, tokenEmailVerification, h.cfg.VerificationTTL)
+	if err != nil {
+		return err
+	}
+
+	link, err := tokenLink(h.cfg.VerificationURL, token)
+	if err != nil {
+		return err
+	}
+
+	return h.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf("Hi %s,\n\nPlease confirm your email address by opening the link below. It expires in %s.\n\n%s\n",
+			user.FirstName, h.cfg.VerificationTTL, link),
+	})
+}
+
+// tokenLink appends token to base as the "token" query parameter.
+func tokenLink(base, token string) (string, error) {
+	link, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+
+	q := link.Query()
+	q.Set("token", token)
+	link.RawQuery = q.Encode()
+
+	return link.String(), nil
+}
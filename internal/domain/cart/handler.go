@@ -0,0 +1,201 @@
+package cart
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gmhafiz/scs/v2"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/thang1834/go-goss/internal/middleware"
+	"github.com/thang1834/go-goss/internal/utility/message"
+	"github.com/thang1834/go-goss/internal/utility/param"
+	"github.com/thang1834/go-goss/internal/utility/request"
+	"github.com/thang1834/go-goss/internal/utility/respond"
+	"github.com/thang1834/go-goss/internal/utility/validate"
+)
+
+// keyCartID is the session key holding an anonymous visitor's cart ID.
+const keyCartID = "cart_id"
+
+type Handler struct {
+	useCase  UseCase
+	validate *validator.Validate
+	session  *scs.SessionManager
+}
+
+func NewHandler(useCase UseCase, v *validator.Validate, session *scs.SessionManager) *Handler {
+	return &Handler{
+		useCase:  useCase,
+		validate: v,
+		session:  session,
+	}
+}
+
+// Get returns the current user's or session's cart
+// @Summary Get cart
+// @Success 200 {object} Res
+// @Failure 500
+// @router /api/v1/cart [get]
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	res, err := h.useCase.Get(r.Context(), h.owner(r.Context()))
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, res)
+}
+
+// AddItem adds a product to the cart, increasing its quantity if already present
+// @Summary Add item to cart
+// @Param item body AddItemRequest true "product and quantity"
+// @Success 200 {object} Res
+// @Failure 400
+// @Failure 404
+// @Failure 409
+// @router /api/v1/cart/items [post]
+func (h *Handler) AddItem(w http.ResponseWriter, r *http.Request) {
+	var req AddItemRequest
+	err := request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	errs := validate.Validate(h.validate, req)
+	if errs != nil {
+		respond.Errors(w, http.StatusBadRequest, errs)
+		return
+	}
+
+	owner := h.owner(r.Context())
+	res, err := h.useCase.AddItem(r.Context(), owner, req)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	// Remember a freshly created anonymous cart in the session.
+	if owner.UserID == 0 && owner.CartID != res.ID {
+		h.session.Put(r.Context(), keyCartID, res.ID)
+	}
+
+	respond.Json(w, http.StatusOK, res)
+}
+
+// UpdateItem sets the quantity of a product already in the cart
+// @Summary Update cart item quantity
+// @Param productID path int true "product ID"
+// @Param item body UpdateItemRequest true "quantity"
+// @Success 200 {object} Res
+// @Failure 400
+// @Failure 404
+// @Failure 409
+// @router /api/v1/cart/items/{productID} [put]
+func (h *Handler) UpdateItem(w http.ResponseWriter, r *http.Request) {
+	productID, err := param.UInt64(r, "productID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	var req UpdateItemRequest
+	err = request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	errs := validate.Validate(h.validate, req)
+	if errs != nil {
+		respond.Errors(w, http.StatusBadRequest, errs)
+		return
+	}
+
+	res, err := h.useCase.UpdateItem(r.Context(), h.owner(r.Context()), productID, req)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, res)
+}
+
+// RemoveItem removes a product from the cart
+// @Summary Remove cart item
+// @Param productID path int true "product ID"
+// @Success 200 {object} Res
+// @Failure 404
+// @router /api/v1/cart/items/{productID} [delete]
+func (h *Handler) RemoveItem(w http.ResponseWriter, r *http.Request) {
+	productID, err := param.UInt64(r, "productID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	res, err := h.useCase.RemoveItem(r.Context(), h.owner(r.Context()), productID)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, res)
+}
+
+// Clear empties the cart
+// @Summary Clear cart
+// @Success 204
+// @router /api/v1/cart [delete]
+func (h *Handler) Clear(w http.ResponseWriter, r *http.Request) {
+	err := h.useCase.Clear(r.Context(), h.owner(r.Context()))
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Status(w, http.StatusNoContent)
+}
+
+// MergeSessionCart moves the anonymous cart kept in the session into the
+// user's cart. It is registered as an authentication login hook.
+func (h *Handler) MergeSessionCart(ctx context.Context, userID uint64) error {
+	cartID, ok := h.session.Get(ctx, keyCartID).(uint64)
+	if !ok {
+		return nil
+	}
+
+	err := h.useCase.Merge(ctx, cartID, userID)
+	if err != nil {
+		return err
+	}
+
+	h.session.Remove(ctx, keyCartID)
+
+	return nil
+}
+
+func (h *Handler) owner(ctx context.Context) Owner {
+	if userID, ok := h.session.Get(ctx, string(middleware.KeyID)).(uint64); ok {
+		return Owner{UserID: userID}
+	}
+
+	cartID, _ := h.session.Get(ctx, keyCartID).(uint64)
+	return Owner{CartID: cartID}
+}
+
+// error maps domain errors to their HTTP status code.
+func (h *Handler) error(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrNotFound),
+		errors.Is(err, ErrItemNotFound),
+		errors.Is(err, ErrProductNotFound):
+		respond.Error(w, http.StatusNotFound, err)
+	case errors.Is(err, ErrInsufficientStock):
+		respond.Error(w, http.StatusConflict, err)
+	default:
+		respond.Error(w, http.StatusInternalServerError, message.ErrInternalError)
+	}
+}
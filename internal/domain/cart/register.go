@@ -0,0 +1,23 @@
+package cart
+
+import (
+	"github.com/gmhafiz/scs/v2"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+func RegisterHTTPEndPoints(router *chi.Mux, validator *validator.Validate, uc UseCase, session *scs.SessionManager) *Handler {
+	h := NewHandler(uc, validator, session)
+
+	// Cart routes are open to anonymous visitors. Their cart lives in the
+	// session until they log in.
+	router.Route("/api/v1/cart", func(router chi.Router) {
+		router.Get("/", h.Get)
+		router.Delete("/", h.Clear)
+		router.Post("/items", h.AddItem)
+		router.Put("/items/{productID}", h.UpdateItem)
+		router.Delete("/items/{productID}", h.RemoveItem)
+	})
+
+	return h
+}
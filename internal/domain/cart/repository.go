@@ -0,0 +1,247 @@
+package cart
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/ent/gen/cart"
+	"github.com/thang1834/go-goss/ent/gen/cartitem"
+)
+
+var (
+	ErrNotFound          = errors.New("cart not found")
+	ErrItemNotFound      = errors.New("product is not in the cart")
+	ErrProductNotFound   = errors.New("product not found")
+	ErrInsufficientStock = errors.New("not enough stock for the requested quantity")
+)
+
+type Repo interface {
+	UserCart(ctx context.Context, userID uint64) (*gen.Cart, error)
+	SessionCart(ctx context.Context, cartID uint64) (*gen.Cart, error)
+	Create(ctx context.Context, userID *uint64) (*gen.Cart, error)
+
+	Items(ctx context.Context, cartID uint64) ([]*gen.CartItem, error)
+	Item(ctx context.Context, cartID, productID uint64) (*gen.CartItem, error)
+	Product(ctx context.Context, productID uint64) (*gen.Product, error)
+	SetQuantity(ctx context.Context, cartID, productID uint64, quantity int) error
+	RemoveItem(ctx context.Context, cartID, productID uint64) error
+	Clear(ctx context.Context, cartID uint64) error
+
+	Merge(ctx context.Context, fromCartID, toCartID uint64) error
+}
+
+type repo struct {
+	ent *gen.Client
+}
+
+func NewRepo(ent *gen.Client) *repo {
+	return &repo{
+		ent: ent,
+	}
+}
+
+func (r *repo) UserCart(ctx context.Context, userID uint64) (*gen.Cart, error) {
+	c, err := r.ent.Cart.Query().Where(cart.UserIDEQ(userID)).Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+// SessionCart returns an anonymous cart. Carts that have since been claimed
+// by a user are not returned.
+func (r *repo) SessionCart(ctx context.Context, cartID uint64) (*gen.Cart, error) {
+	c, err := r.ent.Cart.Query().
+		Where(
+			cart.IDEQ(cartID),
+			cart.UserIDIsNil(),
+		).
+		Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+func (r *repo) Create(ctx context.Context, userID *uint64) (*gen.Cart, error) {
+	c, err := r.ent.Cart.Create().SetNillableUserID(userID).Save(ctx)
+	if err != nil {
+		// Two concurrent requests may both try to create the user's cart.
+		// carts_user_id_key lets only one of them win.
+		if gen.IsConstraintError(err) && userID != nil {
+			return r.UserCart(ctx, *userID)
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+func (r *repo) Items(ctx context.Context, cartID uint64) ([]*gen.CartItem, error) {
+	return r.ent.CartItem.Query().
+		Where(cartitem.CartIDEQ(cartID)).
+		WithProduct().
+		Order(cartitem.ByProductID()).
+		All(ctx)
+}
+
+func (r *repo) Item(ctx context.Context, cartID, productID uint64) (*gen.CartItem, error) {
+	item, err := r.ent.CartItem.Query().
+		Where(
+			cartitem.CartIDEQ(cartID),
+			cartitem.ProductIDEQ(productID),
+		).
+		Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, ErrItemNotFound
+		}
+		return nil, err
+	}
+	return item, nil
+}
+
+func (r *repo) Product(ctx context.Context, productID uint64) (*gen.Product, error) {
+	p, err := r.ent.Product.Get(ctx, productID)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, ErrProductNotFound
+		}
+		return nil, err
+	}
+	return p, nil
+}
+
+func (r *repo) SetQuantity(ctx context.Context, cartID, productID uint64, quantity int) error {
+	return setQuantity(ctx, r.ent, cartID, productID, quantity)
+}
+
+func (r *repo) RemoveItem(ctx context.Context, cartID, productID uint64) error {
+	n, err := r.ent.CartItem.Delete().
+		Where(
+			cartitem.CartIDEQ(cartID),
+			cartitem.ProductIDEQ(productID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrItemNotFound
+	}
+	return touch(ctx, r.ent, cartID)
+}
+
+func (r *repo) Clear(ctx context.Context, cartID uint64) error {
+	_, err := r.ent.CartItem.Delete().Where(cartitem.CartIDEQ(cartID)).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return touch(ctx, r.ent, cartID)
+}
+
+// Merge moves every item of an anonymous cart into another cart, adding up
+// quantities of products present in both and capping them at the available
+// stock. The emptied anonymous cart is deleted.
+func (r *repo) Merge(ctx context.Context, fromCartID, toCartID uint64) error {
+	tx, err := r.ent.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	from, err := tx.CartItem.Query().
+		Where(cartitem.CartIDEQ(fromCartID)).
+		WithProduct().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	existing, err := tx.CartItem.Query().Where(cartitem.CartIDEQ(toCartID)).All(ctx)
+	if err != nil {
+		return err
+	}
+	current := make(map[uint64]int, len(existing))
+	for _, item := range existing {
+		current[item.ProductID] = item.Quantity
+	}
+
+	for _, item := range from {
+		quantity := mergeQuantity(current[item.ProductID], item.Quantity, item.Edges.Product.StockQuantity)
+		if quantity == 0 {
+			continue
+		}
+		err = setQuantity(ctx, tx.Client(), toCartID, item.ProductID, quantity)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.CartItem.Delete().Where(cartitem.CartIDEQ(fromCartID)).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	err = tx.Cart.DeleteOneID(fromCartID).Exec(ctx)
+	if err != nil && !gen.IsNotFound(err) {
+		return err
+	}
+
+	if err = touch(ctx, tx.Client(), toCartID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// setQuantity updates the quantity of a cart line, inserting the line when the
+// product is not in the cart yet.
+func setQuantity(ctx context.Context, client *gen.Client, cartID, productID uint64, quantity int) error {
+	n, err := client.CartItem.Update().
+		Where(
+			cartitem.CartIDEQ(cartID),
+			cartitem.ProductIDEQ(productID),
+		).
+		SetQuantity(quantity).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		err = client.CartItem.Create().
+			SetCartID(cartID).
+			SetProductID(productID).
+			SetQuantity(quantity).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return touch(ctx, client, cartID)
+}
+
+// touch bumps the cart's updated_at.
+func touch(ctx context.Context, client *gen.Client, cartID uint64) error {
+	return client.Cart.UpdateOneID(cartID).SetUpdatedAt(time.Now()).Exec(ctx)
+}
+
+// mergeQuantity adds two cart quantities without exceeding stock.
+func mergeQuantity(a, b, stock int) int {
+	quantity := a + b
+	if quantity > stock {
+		quantity = stock
+	}
+	if quantity < 0 {
+		quantity = 0
+	}
+	return quantity
+}
@@ -0,0 +1,10 @@
+package cart
+
+type AddItemRequest struct {
+	ProductID uint64 `json:"product_id" validate:"required"`
+	Quantity  int    `json:"quantity" validate:"required,gte=1"`
+}
+
+type UpdateItemRequest struct {
+	Quantity int `json:"quantity" validate:"required,gte=1"`
+}
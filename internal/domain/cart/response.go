@@ -0,0 +1,59 @@
+package cart
+
+import (
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/internal/utility/money"
+)
+
+type Res struct {
+	ID            uint64     `json:"id,omitempty"`
+	Items         []*ItemRes `json:"items"`
+	TotalQuantity int        `json:"total_quantity"`
+	Subtotal      float64    `json:"subtotal"`
+}
+
+type ItemRes struct {
+	ProductID     uint64  `json:"product_id"`
+	Name          string  `json:"name"`
+	Slug          string  `json:"slug"`
+	UnitPrice     float64 `json:"unit_price"`
+	Quantity      int     `json:"quantity"`
+	LineTotal     float64 `json:"line_total"`
+	StockQuantity int     `json:"stock_quantity"`
+	InStock       bool    `json:"in_stock"`
+}
+
+// Resource computes line totals and the subtotal from the current product
+// prices. Items must have their product edge loaded.
+func Resource(c *gen.Cart, items []*gen.CartItem) *Res {
+	res := &Res{
+		Items: make([]*ItemRes, 0, len(items)),
+	}
+	if c != nil {
+		res.ID = c.ID
+	}
+
+	for _, item := range items {
+		p := item.Edges.Product
+		if p == nil {
+			continue
+		}
+
+		line := money.LineTotal(p.Price, item.Quantity)
+		res.Items = append(res.Items, &ItemRes{
+			ProductID:     p.ID,
+			Name:          p.Name,
+			Slug:          p.Slug,
+			UnitPrice:     p.Price,
+			Quantity:      item.Quantity,
+			LineTotal:     line,
+			StockQuantity: p.StockQuantity,
+			InStock:       item.Quantity <= p.StockQuantity,
+		})
+		res.TotalQuantity += item.Quantity
+		res.Subtotal += line
+	}
+	res.Subtotal = money.Round(res.Subtotal)
+
+	return res
+}
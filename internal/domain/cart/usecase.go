@@ -0,0 +1,181 @@
+package cart
+
+import (
+	"context"
+	"errors"
+
+	"github.com/thang1834/go-goss/ent/gen"
+)
+
+// Owner identifies whose cart a request operates on. Logged-in users are
+// identified by UserID. Anonymous visitors carry the ID of a session-scoped
+// cart, or zero when they have not added anything yet.
+type Owner struct {
+	UserID uint64
+	CartID uint64
+}
+
+type UseCase interface {
+	Get(ctx context.Context, owner Owner) (*Res, error)
+	AddItem(ctx context.Context, owner Owner, req AddItemRequest) (*Res, error)
+	UpdateItem(ctx context.Context, owner Owner, productID uint64, req UpdateItemRequest) (*Res, error)
+	RemoveItem(ctx context.Context, owner Owner, productID uint64) (*Res, error)
+	Clear(ctx context.Context, owner Owner) error
+	Merge(ctx context.Context, sessionCartID, userID uint64) error
+}
+
+type Cart struct {
+	repo Repo
+}
+
+func New(repo Repo) *Cart {
+	return &Cart{
+		repo: repo,
+	}
+}
+
+func (u *Cart) Get(ctx context.Context, owner Owner) (*Res, error) {
+	c, err := u.find(ctx, owner)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return Resource(nil, nil), nil
+		}
+		return nil, err
+	}
+
+	return u.view(ctx, c)
+}
+
+func (u *Cart) AddItem(ctx context.Context, owner Owner, req AddItemRequest) (*Res, error) {
+	c, err := u.findOrCreate(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := u.repo.Product(ctx, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	quantity := req.Quantity
+	item, err := u.repo.Item(ctx, c.ID, req.ProductID)
+	switch {
+	case err == nil:
+		quantity += item.Quantity
+	case !errors.Is(err, ErrItemNotFound):
+		return nil, err
+	}
+
+	if quantity > p.StockQuantity {
+		return nil, ErrInsufficientStock
+	}
+
+	if err = u.repo.SetQuantity(ctx, c.ID, p.ID, quantity); err != nil {
+		return nil, err
+	}
+
+	return u.view(ctx, c)
+}
+
+func (u *Cart) UpdateItem(ctx context.Context, owner Owner, productID uint64, req UpdateItemRequest) (*Res, error) {
+	c, err := u.find(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = u.repo.Item(ctx, c.ID, productID); err != nil {
+		return nil, err
+	}
+
+	p, err := u.repo.Product(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if req.Quantity > p.StockQuantity {
+		return nil, ErrInsufficientStock
+	}
+
+	if err = u.repo.SetQuantity(ctx, c.ID, productID, req.Quantity); err != nil {
+		return nil, err
+	}
+
+	return u.view(ctx, c)
+}
+
+func (u *Cart) RemoveItem(ctx context.Context, owner Owner, productID uint64) (*Res, error) {
+	c, err := u.find(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.repo.RemoveItem(ctx, c.ID, productID); err != nil {
+		return nil, err
+	}
+
+	return u.view(ctx, c)
+}
+
+func (u *Cart) Clear(ctx context.Context, owner Owner) error {
+	c, err := u.find(ctx, owner)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	return u.repo.Clear(ctx, c.ID)
+}
+
+// Merge folds an anonymous session cart into the user's own cart, creating
+// the user's cart when they do not have one yet.
+func (u *Cart) Merge(ctx context.Context, sessionCartID, userID uint64) error {
+	from, err := u.repo.SessionCart(ctx, sessionCartID)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	to, err := u.findOrCreate(ctx, Owner{UserID: userID})
+	if err != nil {
+		return err
+	}
+
+	return u.repo.Merge(ctx, from.ID, to.ID)
+}
+
+func (u *Cart) find(ctx context.Context, owner Owner) (*gen.Cart, error) {
+	switch {
+	case owner.UserID != 0:
+		return u.repo.UserCart(ctx, owner.UserID)
+	case owner.CartID != 0:
+		return u.repo.SessionCart(ctx, owner.CartID)
+	default:
+		return nil, ErrNotFound
+	}
+}
+
+func (u *Cart) findOrCreate(ctx context.Context, owner Owner) (*gen.Cart, error) {
+	c, err := u.find(ctx, owner)
+	if err == nil {
+		return c, nil
+	}
+	if !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+
+	if owner.UserID != 0 {
+		return u.repo.Create(ctx, &owner.UserID)
+	}
+	return u.repo.Create(ctx, nil)
+}
+
+func (u *Cart) view(ctx context.Context, c *gen.Cart) (*Res, error) {
+	items, err := u.repo.Items(ctx, c.ID)
+	if err != nil {
+		return nil, err
+	}
+	return Resource(c, items), nil
+}
@@ -0,0 +1,228 @@
+package category
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/thang1834/go-goss/internal/utility/message"
+	"github.com/thang1834/go-goss/internal/utility/param"
+	"github.com/thang1834/go-goss/internal/utility/request"
+	"github.com/thang1834/go-goss/internal/utility/respond"
+	"github.com/thang1834/go-goss/internal/utility/validate"
+)
+
+type Handler struct {
+	useCase  UseCase
+	validate *validator.Validate
+}
+
+func NewHandler(useCase UseCase, v *validator.Validate) *Handler {
+	return &Handler{
+		useCase:  useCase,
+		validate: v,
+	}
+}
+
+// Tree returns the whole category tree
+// @Summary Category tree
+// @Success 200 {array} Node
+// @Failure 500
+// @router /api/v1/categories [get]
+func (h *Handler) Tree(w http.ResponseWriter, r *http.Request) {
+	tree, err := h.useCase.Tree(r.Context())
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.JsonWithETag(w, r, http.StatusOK, tree, "")
+}
+
+// Get returns a single category by slug
+// @Summary Get a category
+// @Param slug path string true "category slug"
+// @Success 200 {object} Res
+// @Failure 404
+// @router /api/v1/categories/{slug} [get]
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	c, err := h.useCase.ReadBySlug(r.Context(), param.String(r, "slug"))
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.JsonWithETag(w, r, http.StatusOK, Resource(c), respond.ETag(c.UpdatedAt))
+}
+
+// Subtree returns a category with all of its descendants
+// @Summary Category subtree
+// @Param slug path string true "category slug"
+// @Success 200 {object} Node
+// @Failure 404
+// @router /api/v1/categories/{slug}/tree [get]
+func (h *Handler) Subtree(w http.ResponseWriter, r *http.Request) {
+	node, err := h.useCase.Subtree(r.Context(), param.String(r, "slug"))
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.JsonWithETag(w, r, http.StatusOK, node, "")
+}
+
+// Breadcrumbs returns the path from the root category down to slug
+// @Summary Category breadcrumbs
+// @Param slug path string true "category slug"
+// @Success 200 {array} Crumb
+// @Failure 404
+// @router /api/v1/categories/{slug}/breadcrumbs [get]
+func (h *Handler) Breadcrumbs(w http.ResponseWriter, r *http.Request) {
+	path, err := h.useCase.Breadcrumbs(r.Context(), param.String(r, "slug"))
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.JsonWithETag(w, r, http.StatusOK, path, "")
+}
+
+// Create creates a category, optionally under a parent
+// @Summary Create a category
+// @Param category body CreateRequest true "category"
+// @Success 201 {object} Res
+// @Failure 400
+// @Failure 409
+// @router /api/v1/manage/categories [post]
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	var req CreateRequest
+	err := request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	errs := validate.Validate(h.validate, req)
+	if errs != nil {
+		respond.Errors(w, http.StatusBadRequest, errs)
+		return
+	}
+
+	c, err := h.useCase.Create(r.Context(), req)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusCreated, Resource(c))
+}
+
+// Update renames a category
+// @Summary Update a category
+// @Param categoryID path int true "category ID"
+// @Param category body UpdateRequest true "category"
+// @Success 200 {object} Res
+// @Failure 400
+// @Failure 404
+// @Failure 409
+// @router /api/v1/manage/categories/{categoryID} [put]
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	categoryID, err := param.UInt64(r, "categoryID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	var req UpdateRequest
+	err = request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	errs := validate.Validate(h.validate, req)
+	if errs != nil {
+		respond.Errors(w, http.StatusBadRequest, errs)
+		return
+	}
+
+	c, err := h.useCase.Update(r.Context(), categoryID, req)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, Resource(c))
+}
+
+// Move re-parents a category, rejecting moves that would create a cycle
+// @Summary Move a category
+// @Param categoryID path int true "category ID"
+// @Param parent body MoveRequest true "new parent, null for root"
+// @Success 200 {object} Res
+// @Failure 400
+// @Failure 404
+// @Failure 409
+// @router /api/v1/manage/categories/{categoryID}/move [patch]
+func (h *Handler) Move(w http.ResponseWriter, r *http.Request) {
+	categoryID, err := param.UInt64(r, "categoryID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	var req MoveRequest
+	err = request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	c, err := h.useCase.Move(r.Context(), categoryID, req.ParentID)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, Resource(c))
+}
+
+// Delete removes a category that has no sub-categories or products
+// @Summary Delete a category
+// @Param categoryID path int true "category ID"
+// @Success 204
+// @Failure 404
+// @Failure 409
+// @router /api/v1/manage/categories/{categoryID} [delete]
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	categoryID, err := param.UInt64(r, "categoryID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	err = h.useCase.Delete(r.Context(), categoryID)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Status(w, http.StatusNoContent)
+}
+
+// error maps domain errors to their HTTP status code.
+func (h *Handler) error(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		respond.Error(w, http.StatusNotFound, err)
+	case errors.Is(err, ErrSlugTaken), errors.Is(err, ErrCategoryInUse), errors.Is(err, ErrCycle):
+		respond.Error(w, http.StatusConflict, err)
+	case errors.Is(err, ErrInvalidSlug),
+		errors.Is(err, ErrSlugFromName),
+		errors.Is(err, ErrParentNotFound):
+		respond.Error(w, http.StatusBadRequest, err)
+	default:
+		respond.Error(w, http.StatusInternalServerError, message.ErrInternalError)
+	}
+}
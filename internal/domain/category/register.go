@@ -0,0 +1,35 @@
+package category
+
+import (
+	"github.com/gmhafiz/scs/v2"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/thang1834/go-goss/internal/domain/authentication"
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+func RegisterHTTPEndPoints(router *chi.Mux, validator *validator.Validate, uc UseCase, session *scs.SessionManager, auth *authentication.Handler) *Handler {
+	h := NewHandler(uc, validator)
+
+	// Public category routes
+	router.Route("/api/v1/categories", func(router chi.Router) {
+		router.Get("/", h.Tree)
+		router.Get("/{slug}", h.Get)
+		router.Get("/{slug}/tree", h.Subtree)
+		router.Get("/{slug}/breadcrumbs", h.Breadcrumbs)
+	})
+
+	// Category management routes
+	router.Route("/api/v1/manage/categories", func(router chi.Router) {
+		router.Use(middleware.Authenticate(session))
+		router.Use(auth.RequirePermission("category:write"))
+
+		router.Post("/", h.Create)
+		router.Put("/{categoryID}", h.Update)
+		router.Patch("/{categoryID}/move", h.Move)
+		router.Delete("/{categoryID}", h.Delete)
+	})
+
+	return h
+}
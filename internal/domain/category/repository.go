@@ -0,0 +1,220 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/ent/gen/category"
+)
+
+var (
+	ErrNotFound       = errors.New("category not found")
+	ErrParentNotFound = errors.New("parent category not found")
+	ErrSlugTaken      = errors.New("slug is already taken")
+	ErrCycle          = errors.New("a category cannot be moved under itself or one of its descendants")
+	ErrCategoryInUse  = errors.New("category still has sub-categories or products")
+)
+
+type Repo interface {
+	All(ctx context.Context) ([]*gen.Category, error)
+	Read(ctx context.Context, categoryID uint64) (*gen.Category, error)
+	ReadBySlug(ctx context.Context, slug string) (*gen.Category, error)
+	Create(ctx context.Context, req CreateRequest) (*gen.Category, error)
+	Update(ctx context.Context, categoryID uint64, req UpdateRequest) (*gen.Category, error)
+	Move(ctx context.Context, categoryID uint64, parentID *uint64) (*gen.Category, error)
+	Delete(ctx context.Context, categoryID uint64) error
+
+	SlugExists(ctx context.Context, slug string, exceptID uint64) (bool, error)
+}
+
+type repo struct {
+	ent *gen.Client
+}
+
+func NewRepo(ent *gen.Client) *repo {
+	return &repo{
+		ent: ent,
+	}
+}
+
+func (r *repo) All(ctx context.Context) ([]*gen.Category, error) {
+	return r.ent.Category.Query().Order(gen.Asc(category.FieldID)).All(ctx)
+}
+
+func (r *repo) Read(ctx context.Context, categoryID uint64) (*gen.Category, error) {
+	c, err := r.ent.Category.Get(ctx, categoryID)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+func (r *repo) ReadBySlug(ctx context.Context, slug string) (*gen.Category, error) {
+	c, err := r.ent.Category.Query().Where(category.SlugEQ(slug)).Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+func (r *repo) Create(ctx context.Context, req CreateRequest) (*gen.Category, error) {
+	if req.ParentID != nil {
+		exists, err := r.ent.Category.Query().Where(category.IDEQ(*req.ParentID)).Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, ErrParentNotFound
+		}
+	}
+
+	c, err := r.ent.Category.Create().
+		SetName(req.Name).
+		SetSlug(req.Slug).
+		SetNillableParentID(req.ParentID).
+		Save(ctx)
+	if err != nil {
+		if gen.IsConstraintError(err) {
+			return nil, ErrSlugTaken
+		}
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (r *repo) Update(ctx context.Context, categoryID uint64, req UpdateRequest) (*gen.Category, error) {
+	c, err := r.ent.Category.UpdateOneID(categoryID).
+		SetName(req.Name).
+		SetSlug(req.Slug).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		switch {
+		case gen.IsNotFound(err):
+			return nil, ErrNotFound
+		case gen.IsConstraintError(err):
+			return nil, ErrSlugTaken
+		}
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Move re-parents a category. The table is locked against concurrent writers
+// for the duration of the transaction because two moves that each look valid
+// on their own can still close a loop when they run side by side.
+func (r *repo) Move(ctx context.Context, categoryID uint64, parentID *uint64) (*gen.Category, error) {
+	tx, err := r.ent.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `LOCK TABLE categories IN SHARE ROW EXCLUSIVE MODE`)
+	if err != nil {
+		return nil, err
+	}
+
+	if parentID != nil {
+		if *parentID == categoryID {
+			return nil, ErrCycle
+		}
+
+		exists, err := tx.Category.Query().Where(category.IDEQ(*parentID)).Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, ErrParentNotFound
+		}
+
+		cycle, err := isAncestor(ctx, tx, categoryID, *parentID)
+		if err != nil {
+			return nil, err
+		}
+		if cycle {
+			return nil, ErrCycle
+		}
+	}
+
+	builder := tx.Category.UpdateOneID(categoryID).SetUpdatedAt(time.Now())
+	if parentID != nil {
+		builder = builder.SetParentID(*parentID)
+	} else {
+		builder = builder.ClearParentID()
+	}
+
+	c, err := builder.Save(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (r *repo) Delete(ctx context.Context, categoryID uint64) error {
+	err := r.ent.Category.DeleteOneID(categoryID).Exec(ctx)
+	if err != nil {
+		switch {
+		case gen.IsNotFound(err):
+			return ErrNotFound
+		case gen.IsConstraintError(err):
+			return ErrCategoryInUse
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *repo) SlugExists(ctx context.Context, slug string, exceptID uint64) (bool, error) {
+	return r.ent.Category.Query().
+		Where(
+			category.SlugEQ(slug),
+			category.IDNEQ(exceptID),
+		).
+		Exist(ctx)
+}
+
+// isAncestor reports whether ancestorID appears on the path from categoryID
+// up to its root, walking parent_id with a recursive CTE.
+func isAncestor(ctx context.Context, tx *gen.Tx, ancestorID, categoryID uint64) (bool, error) {
+	rows, err := tx.QueryContext(ctx, `
+		WITH RECURSIVE path AS (
+			SELECT id, parent_id FROM categories WHERE id = $1
+			UNION
+			SELECT c.id, c.parent_id FROM categories c JOIN path p ON c.id = p.parent_id
+		)
+		SELECT EXISTS(SELECT 1 FROM path WHERE id = $2)`, categoryID, ancestorID)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	var found bool
+	if !rows.Next() {
+		return false, fmt.Errorf("checking category ancestry: %w", rows.Err())
+	}
+	if err = rows.Scan(&found); err != nil {
+		return false, err
+	}
+
+	return found, rows.Err()
+}
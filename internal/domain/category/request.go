@@ -0,0 +1,17 @@
+package category
+
+type CreateRequest struct {
+	Name     string  `json:"name" validate:"required,max=100"`
+	Slug     string  `json:"slug,omitempty" validate:"omitempty,max=150"`
+	ParentID *uint64 `json:"parent_id,omitempty"`
+}
+
+type UpdateRequest struct {
+	Name string `json:"name" validate:"required,max=100"`
+	Slug string `json:"slug,omitempty" validate:"omitempty,max=150"`
+}
+
+// MoveRequest re-parents a category. A null parent_id moves it to the root.
+type MoveRequest struct {
+	ParentID *uint64 `json:"parent_id"`
+}
@@ -0,0 +1,43 @@
+package category
+
+import (
+	"time"
+
+	"github.com/thang1834/go-goss/ent/gen"
+)
+
+type Res struct {
+	ID        uint64    `json:"id"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	ParentID  uint64    `json:"parent_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func Resource(c *gen.Category) *Res {
+	return &Res{
+		ID:        c.ID,
+		Name:      c.Name,
+		Slug:      c.Slug,
+		ParentID:  c.ParentID,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+// Node is a category together with its nested sub-categories.
+type Node struct {
+	ID       uint64  `json:"id"`
+	Name     string  `json:"name"`
+	Slug     string  `json:"slug"`
+	ParentID uint64  `json:"parent_id,omitempty"`
+	Children []*Node `json:"children"`
+}
+
+// Crumb is a single step in a breadcrumb trail from the root category.
+type Crumb struct {
+	ID   uint64 `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
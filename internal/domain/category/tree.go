@@ -0,0 +1,91 @@
+package category
+
+import (
+	"sort"
+
+	"github.com/thang1834/go-goss/ent/gen"
+)
+
+// buildTree assembles a flat list of categories into a forest. Categories
+// without a parent, or whose parent is not in the list, become roots.
+// Siblings are ordered by name.
+func buildTree(categories []*gen.Category) []*Node {
+	nodes := make(map[uint64]*Node, len(categories))
+	for _, c := range categories {
+		nodes[c.ID] = &Node{
+			ID:       c.ID,
+			Name:     c.Name,
+			Slug:     c.Slug,
+			ParentID: c.ParentID,
+			Children: []*Node{},
+		}
+	}
+
+	roots := make([]*Node, 0)
+	for _, c := range categories {
+		node := nodes[c.ID]
+		parent, ok := nodes[c.ParentID]
+		if c.ParentID == 0 || !ok {
+			roots = append(roots, node)
+			continue
+		}
+		parent.Children = append(parent.Children, node)
+	}
+
+	sortNodes(roots)
+
+	return roots
+}
+
+func sortNodes(nodes []*Node) {
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+	for _, n := range nodes {
+		sortNodes(n.Children)
+	}
+}
+
+// findNode searches the forest depth-first for the node with the given slug.
+func findNode(nodes []*Node, slug string) *Node {
+	for _, n := range nodes {
+		if n.Slug == slug {
+			return n
+		}
+		if found := findNode(n.Children, slug); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// breadcrumbs returns the path from the root down to the category with the
+// given slug, or false when no such category exists.
+func breadcrumbs(categories []*gen.Category, slug string) ([]Crumb, bool) {
+	byID := make(map[uint64]*gen.Category, len(categories))
+	var current *gen.Category
+	for _, c := range categories {
+		byID[c.ID] = c
+		if c.Slug == slug {
+			current = c
+		}
+	}
+	if current == nil {
+		return nil, false
+	}
+
+	var path []Crumb
+	visited := make(map[uint64]bool)
+	for current != nil && !visited[current.ID] {
+		visited[current.ID] = true
+		path = append(path, Crumb{ID: current.ID, Name: current.Name, Slug: current.Slug})
+		current = byID[current.ParentID]
+	}
+
+	// reverse so that the root comes first
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path, true
+}
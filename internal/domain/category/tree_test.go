@@ -0,0 +1,68 @@
+package category
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thang1834/go-goss/ent/gen"
+)
+
+func fixture() []*gen.Category {
+	return []*gen.Category{
+		{ID: 1, Name: "Fashion", Slug: "fashion"},
+		{ID: 2, Name: "Men", Slug: "men", ParentID: 1},
+		{ID: 3, Name: "Women", Slug: "women", ParentID: 1},
+		{ID: 4, Name: "Shirts", Slug: "men-shirts", ParentID: 2},
+		{ID: 5, Name: "Electronics", Slug: "electronics"},
+	}
+}
+
+func TestBuildTree(t *testing.T) {
+	roots := buildTree(fixture())
+
+	if len(roots) != 2 {
+		t.Fatalf("got %d roots, want 2", len(roots))
+	}
+	if roots[0].Slug != "electronics" || roots[1].Slug != "fashion" {
+		t.Errorf("roots not sorted by name: %s, %s", roots[0].Slug, roots[1].Slug)
+	}
+
+	fashion := roots[1]
+	if len(fashion.Children) != 2 {
+		t.Fatalf("fashion has %d children, want 2", len(fashion.Children))
+	}
+	if got := fashion.Children[0].Children[0].Slug; got != "men-shirts" {
+		t.Errorf("grandchild = %s, want men-shirts", got)
+	}
+}
+
+func TestFindNode(t *testing.T) {
+	roots := buildTree(fixture())
+
+	if n := findNode(roots, "men-shirts"); n == nil || n.ID != 4 {
+		t.Errorf("findNode(men-shirts) = %v, want ID 4", n)
+	}
+	if n := findNode(roots, "missing"); n != nil {
+		t.Errorf("findNode(missing) = %v, want nil", n)
+	}
+}
+
+func TestBreadcrumbs(t *testing.T) {
+	got, ok := breadcrumbs(fixture(), "men-shirts")
+	if !ok {
+		t.Fatal("expected breadcrumbs to be found")
+	}
+
+	want := []Crumb{
+		{ID: 1, Name: "Fashion", Slug: "fashion"},
+		{ID: 2, Name: "Men", Slug: "men"},
+		{ID: 4, Name: "Shirts", Slug: "men-shirts"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("breadcrumbs() = %v, want %v", got, want)
+	}
+
+	if _, ok := breadcrumbs(fixture(), "missing"); ok {
+		t.Error("expected missing slug to not be found")
+	}
+}
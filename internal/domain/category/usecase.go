@@ -0,0 +1,139 @@
+package category
+
+import (
+	"context"
+	"errors"
+
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/internal/utility/slug"
+)
+
+var (
+	ErrInvalidSlug  = errors.New("slug may only contain lowercase letters, digits and hyphens")
+	ErrSlugFromName = errors.New("unable to generate a slug from name")
+)
+
+type UseCase interface {
+	Tree(ctx context.Context) ([]*Node, error)
+	Subtree(ctx context.Context, slug string) (*Node, error)
+	Breadcrumbs(ctx context.Context, slug string) ([]Crumb, error)
+	ReadBySlug(ctx context.Context, slug string) (*gen.Category, error)
+	Create(ctx context.Context, req CreateRequest) (*gen.Category, error)
+	Update(ctx context.Context, categoryID uint64, req UpdateRequest) (*gen.Category, error)
+	Move(ctx context.Context, categoryID uint64, parentID *uint64) (*gen.Category, error)
+	Delete(ctx context.Context, categoryID uint64) error
+}
+
+type Category struct {
+	repo Repo
+}
+
+func New(repo Repo) *Category {
+	return &Category{
+		repo: repo,
+	}
+}
+
+func (u *Category) Tree(ctx context.Context) ([]*Node, error) {
+	all, err := u.repo.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return buildTree(all), nil
+}
+
+func (u *Category) Subtree(ctx context.Context, slug string) (*Node, error) {
+	roots, err := u.Tree(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	node := findNode(roots, slug)
+	if node == nil {
+		return nil, ErrNotFound
+	}
+	return node, nil
+}
+
+func (u *Category) Breadcrumbs(ctx context.Context, slug string) ([]Crumb, error) {
+	all, err := u.repo.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	path, ok := breadcrumbs(all, slug)
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return path, nil
+}
+
+func (u *Category) ReadBySlug(ctx context.Context, slug string) (*gen.Category, error) {
+	return u.repo.ReadBySlug(ctx, slug)
+}
+
+func (u *Category) Create(ctx context.Context, req CreateRequest) (*gen.Category, error) {
+	s, err := u.resolveSlug(ctx, req.Slug, req.Name, 0)
+	if err != nil {
+		return nil, err
+	}
+	req.Slug = s
+
+	return u.repo.Create(ctx, req)
+}
+
+func (u *Category) Update(ctx context.Context, categoryID uint64, req UpdateRequest) (*gen.Category, error) {
+	current, err := u.repo.Read(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Slug == "" {
+		req.Slug = current.Slug
+	} else if req.Slug != current.Slug {
+		s, err := u.resolveSlug(ctx, req.Slug, req.Name, categoryID)
+		if err != nil {
+			return nil, err
+		}
+		req.Slug = s
+	}
+
+	return u.repo.Update(ctx, categoryID, req)
+}
+
+func (u *Category) Move(ctx context.Context, categoryID uint64, parentID *uint64) (*gen.Category, error) {
+	return u.repo.Move(ctx, categoryID, parentID)
+}
+
+func (u *Category) Delete(ctx context.Context, categoryID uint64) error {
+	return u.repo.Delete(ctx, categoryID)
+}
+
+// resolveSlug validates a client supplied slug, or generates a unique one
+// from name when none is given.
+func (u *Category) resolveSlug(ctx context.Context, requested, name string, exceptID uint64) (string, error) {
+	if requested != "" {
+		if !slug.Valid(requested) {
+			return "", ErrInvalidSlug
+		}
+		exists, err := u.repo.SlugExists(ctx, requested, exceptID)
+		if err != nil {
+			return "", err
+		}
+		if exists {
+			return "", ErrSlugTaken
+		}
+		return requested, nil
+	}
+
+	s, err := slug.Unique(ctx, name, func(ctx context.Context, candidate string) (bool, error) {
+		return u.repo.SlugExists(ctx, candidate, exceptID)
+	})
+	switch {
+	case errors.Is(err, slug.ErrEmpty):
+		return "", ErrSlugFromName
+	case errors.Is(err, slug.ErrExhausted):
+		return "", ErrSlugTaken
+	}
+	return s, err
+}
@@ -0,0 +1,109 @@
+package discount
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/internal/utility/money"
+)
+
+const (
+	TypePercentage = "percentage"
+	TypeFixed      = "fixed"
+)
+
+var (
+	ErrInactive      = errors.New("discount code is not active")
+	ErrExhausted     = errors.New("discount code has reached its usage limit")
+	ErrMinOrder      = errors.New("order does not reach the discount's minimum value")
+	ErrType          = errors.New("discount has an unknown type")
+	ErrNotApplicable = errors.New("discount does not apply to any item in the cart")
+)
+
+// Item is a cart or order line the discount is evaluated against.
+type Item struct {
+	ProductID  uint64
+	CategoryID uint64
+	UnitPrice  float64
+	Quantity   int
+}
+
+// Scope restricts a discount to products and categories. A discount with an
+// empty scope applies to every item. A category in scope covers its whole
+// subtree, which is walked through Parents.
+type Scope struct {
+	Products   map[uint64]bool
+	Categories map[uint64]bool
+	Parents    map[uint64]uint64
+}
+
+func (s Scope) restricted() bool {
+	return len(s.Products) > 0 || len(s.Categories) > 0
+}
+
+func (s Scope) covers(item Item) bool {
+	if !s.restricted() || s.Products[item.ProductID] {
+		return true
+	}
+
+	// The walk is bounded in case of a corrupted, cyclic tree.
+	for c, depth := item.CategoryID, 0; c != 0 && depth <= len(s.Parents); c, depth = s.Parents[c], depth+1 {
+		if s.Categories[c] {
+			return true
+		}
+	}
+	return false
+}
+
+// Quote is the outcome of evaluating a discount against a set of items.
+type Quote struct {
+	Subtotal float64
+	// Eligible is the part of Subtotal the discount's scope covers.
+	Eligible float64
+	Amount   float64
+	Total    float64
+}
+
+// Evaluate validates d at time now and computes what it takes off items.
+// The minimum order value is checked against the whole subtotal while the
+// discount itself only applies to the eligible items.
+func Evaluate(d *gen.Discount, scope Scope, items []Item, now time.Time) (*Quote, error) {
+	if now.Before(d.StartDate) || now.After(d.EndDate) {
+		return nil, ErrInactive
+	}
+	if d.UsageLimit > 0 && d.UsageCount >= d.UsageLimit {
+		return nil, ErrExhausted
+	}
+
+	var q Quote
+	for _, item := range items {
+		line := money.LineTotal(item.UnitPrice, item.Quantity)
+		q.Subtotal += line
+		if scope.covers(item) {
+			q.Eligible += line
+		}
+	}
+	q.Subtotal = money.Round(q.Subtotal)
+	q.Eligible = money.Round(q.Eligible)
+
+	if d.MinOrderValue > 0 && q.Subtotal < d.MinOrderValue {
+		return nil, ErrMinOrder
+	}
+	if q.Eligible == 0 {
+		return nil, ErrNotApplicable
+	}
+
+	switch d.DiscountType {
+	case TypePercentage:
+		q.Amount = q.Eligible * d.DiscountValue / 100
+	case TypeFixed:
+		q.Amount = d.DiscountValue
+	default:
+		return nil, ErrType
+	}
+	q.Amount = money.Round(min(max(q.Amount, 0), q.Eligible))
+	q.Total = money.Round(q.Subtotal - q.Amount)
+
+	return &q, nil
+}
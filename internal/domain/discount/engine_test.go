@@ -0,0 +1,89 @@
+package discount
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thang1834/go-goss/ent/gen"
+)
+
+func TestEvaluate(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	active := func(d gen.Discount) *gen.Discount {
+		d.StartDate = now.Add(-time.Hour)
+		d.EndDate = now.Add(time.Hour)
+		return &d
+	}
+
+	// Category 3 is a child of 2, which is a child of 1.
+	items := []Item{
+		{ProductID: 10, CategoryID: 3, UnitPrice: 20, Quantity: 2},
+		{ProductID: 11, CategoryID: 4, UnitPrice: 9.99, Quantity: 1},
+	}
+	parents := map[uint64]uint64{3: 2, 2: 1}
+
+	tests := []struct {
+		name     string
+		discount *gen.Discount
+		scope    Scope
+		want     Quote
+		err      error
+	}{
+		{
+			name:     "percentage of whole cart",
+			discount: active(gen.Discount{DiscountType: TypePercentage, DiscountValue: 10}),
+			want:     Quote{Subtotal: 49.99, Eligible: 49.99, Amount: 5, Total: 44.99},
+		},
+		{
+			name:     "fixed capped at eligible",
+			discount: active(gen.Discount{DiscountType: TypeFixed, DiscountValue: 15}),
+			scope:    Scope{Products: map[uint64]bool{11: true}},
+			want:     Quote{Subtotal: 49.99, Eligible: 9.99, Amount: 9.99, Total: 40},
+		},
+		{
+			name:     "ancestor category in scope",
+			discount: active(gen.Discount{DiscountType: TypePercentage, DiscountValue: 50}),
+			scope:    Scope{Categories: map[uint64]bool{1: true}, Parents: parents},
+			want:     Quote{Subtotal: 49.99, Eligible: 40, Amount: 20, Total: 29.99},
+		},
+		{
+			name:     "nothing in scope",
+			discount: active(gen.Discount{DiscountType: TypeFixed, DiscountValue: 5}),
+			scope:    Scope{Products: map[uint64]bool{99: true}, Parents: parents},
+			err:      ErrNotApplicable,
+		},
+		{
+			name:     "minimum order counts the whole cart",
+			discount: active(gen.Discount{DiscountType: TypeFixed, DiscountValue: 5, MinOrderValue: 50}),
+			err:      ErrMinOrder,
+		},
+		{
+			name:     "usage limit",
+			discount: active(gen.Discount{DiscountType: TypeFixed, DiscountValue: 5, UsageLimit: 1, UsageCount: 1}),
+			err:      ErrExhausted,
+		},
+		{
+			name:     "unknown type",
+			discount: active(gen.Discount{DiscountType: "bogo", DiscountValue: 5}),
+			err:      ErrType,
+		},
+		{
+			name:     "not started",
+			discount: &gen.Discount{DiscountType: TypeFixed, DiscountValue: 5, StartDate: now.Add(time.Hour), EndDate: now.Add(2 * time.Hour)},
+			err:      ErrInactive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Evaluate(tt.discount, tt.scope, items, now)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if err == nil && *got != tt.want {
+				t.Errorf("got %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
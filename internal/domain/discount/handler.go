@@ -0,0 +1,169 @@
+package discount
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gmhafiz/scs/v2"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/thang1834/go-goss/internal/middleware"
+	"github.com/thang1834/go-goss/internal/utility/message"
+	"github.com/thang1834/go-goss/internal/utility/param"
+	"github.com/thang1834/go-goss/internal/utility/request"
+	"github.com/thang1834/go-goss/internal/utility/respond"
+	"github.com/thang1834/go-goss/internal/utility/validate"
+)
+
+type Handler struct {
+	useCase  UseCase
+	validate *validator.Validate
+	session  *scs.SessionManager
+}
+
+func NewHandler(useCase UseCase, v *validator.Validate, session *scs.SessionManager) *Handler {
+	return &Handler{
+		useCase:  useCase,
+		validate: v,
+		session:  session,
+	}
+}
+
+// Preview shows what a discount code would take off the current user's cart
+// @Summary Preview a discount code
+// @Param code body PreviewRequest true "discount code"
+// @Success 200 {object} QuoteRes
+// @Failure 400
+// @Failure 404
+// @router /api/v1/discounts/preview [post]
+func (h *Handler) Preview(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.session.Get(r.Context(), string(middleware.KeyID)).(uint64)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	var req PreviewRequest
+	err := request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	errs := validate.Validate(h.validate, req)
+	if errs != nil {
+		respond.Errors(w, http.StatusBadRequest, errs)
+		return
+	}
+
+	d, q, err := h.useCase.Preview(r.Context(), userID, req.Code)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, QuoteResource(d, q))
+}
+
+// Get returns a discount with the ETag to update it with
+// @Summary Get a discount
+// @Param discountID path int true "discount ID"
+// @Success 200 {object} Res
+// @Success 304
+// @Failure 400
+// @Failure 404
+// @router /api/v1/manage/discounts/{discountID} [get]
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	discountID, err := param.UInt64(r, "discountID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	d, err := h.useCase.Read(r.Context(), discountID)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.JsonWithETag(w, r, http.StatusOK, Resource(d), respond.ETag(d.UpdatedAt))
+}
+
+// Update replaces a discount's terms. With an If-Match header, the discount
+// is only updated if its ETag is listed.
+// @Summary Update a discount
+// @Param discountID path int true "discount ID"
+// @Param If-Match header string false "ETag of the discount as last read"
+// @Param discount body UpdateRequest true "discount"
+// @Success 200 {object} Res
+// @Failure 400
+// @Failure 404
+// @Failure 409
+// @Failure 412
+// @router /api/v1/manage/discounts/{discountID} [put]
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	discountID, err := param.UInt64(r, "discountID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	var req UpdateRequest
+	err = request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	errs := validate.Validate(h.validate, req)
+	if errs != nil {
+		respond.Errors(w, http.StatusBadRequest, errs)
+		return
+	}
+
+	versions, _ := request.IfMatch(r)
+
+	d, err := h.useCase.Update(r.Context(), discountID, req, versions)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.JsonWithETag(w, r, http.StatusOK, Resource(d), respond.ETag(d.UpdatedAt))
+}
+
+// error maps domain errors to their HTTP status code.
+func (h *Handler) error(w http.ResponseWriter, err error) {
+	if code, ok := Status(err); ok {
+		respond.Error(w, code, err)
+		return
+	}
+	switch {
+	case errors.Is(err, ErrCodeTaken):
+		respond.Error(w, http.StatusConflict, err)
+	case errors.Is(err, message.ErrPreconditionFailed):
+		respond.Error(w, http.StatusPreconditionFailed, err)
+	case errors.Is(err, ErrPercentage), errors.Is(err, ErrUsageLimit):
+		respond.Error(w, http.StatusBadRequest, err)
+	default:
+		respond.Error(w, http.StatusInternalServerError, message.ErrInternalError)
+	}
+}
+
+// Status returns the HTTP status code for a discount error. Other domains
+// redeeming codes use it before falling back to their own mapping.
+func Status(err error) (int, bool) {
+	switch {
+	case errors.Is(err, ErrNotFound), errors.Is(err, ErrNotAssigned):
+		return http.StatusNotFound, true
+	case errors.Is(err, ErrInactive),
+		errors.Is(err, ErrExhausted),
+		errors.Is(err, ErrMinOrder),
+		errors.Is(err, ErrNotApplicable),
+		errors.Is(err, ErrVoucherUsed),
+		errors.Is(err, ErrEmptyCart),
+		errors.Is(err, ErrType):
+		return http.StatusBadRequest, true
+	}
+	return 0, false
+}
@@ -0,0 +1,87 @@
+package discount
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/internal/utility/message"
+	"github.com/thang1834/go-goss/internal/utility/respond"
+)
+
+// versionedUseCase stubs Update with a single stored discount version.
+type versionedUseCase struct {
+	UseCase
+	current *gen.Discount
+}
+
+func (u *versionedUseCase) Update(_ context.Context, _ uint64, req UpdateRequest, versions []time.Time) (*gen.Discount, error) {
+	if versions != nil && !slices.ContainsFunc(versions, u.current.UpdatedAt.Equal) {
+		return nil, message.ErrPreconditionFailed
+	}
+	if req.UsageLimit > 0 && req.UsageLimit < u.current.UsageCount {
+		return nil, ErrUsageLimit
+	}
+	u.current = &gen.Discount{ID: u.current.ID, Code: req.Code, UsageCount: u.current.UsageCount, UpdatedAt: u.current.UpdatedAt.Add(time.Second)}
+	return u.current, nil
+}
+
+func TestUpdateIfMatch(t *testing.T) {
+	uc := &versionedUseCase{current: &gen.Discount{ID: 1, Code: "SALE", UpdatedAt: time.Now().Round(time.Microsecond)}}
+	h := NewHandler(uc, validator.New(), nil)
+
+	router := chi.NewRouter()
+	router.Put("/api/v1/manage/discounts/{discountID}", h.Update)
+
+	body := `{"code":"SALE10","discount_type":"percentage","discount_value":10,
+		"start_date":"2026-01-01T00:00:00Z","end_date":"2026-12-31T00:00:00Z"}`
+	update := func(ifMatch string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPut, "/api/v1/manage/discounts/1", strings.NewReader(body))
+		r.Header.Set("If-Match", ifMatch)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		return w
+	}
+
+	etag := respond.ETag(uc.current.UpdatedAt)
+	w := update(etag)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", w.Code)
+	}
+	if got := w.Header().Get("ETag"); got == "" || got == etag {
+		t.Errorf("ETag = %q, want the new version", got)
+	}
+
+	if w = update(etag); w.Code != http.StatusPreconditionFailed {
+		t.Errorf("stale ETag got %d, want 412", w.Code)
+	}
+}
+
+func TestUpdateRejectsInvalidTerms(t *testing.T) {
+	uc := &versionedUseCase{current: &gen.Discount{ID: 1, Code: "SALE", UsageCount: 5}}
+	h := NewHandler(uc, validator.New(), nil)
+
+	router := chi.NewRouter()
+	router.Put("/api/v1/manage/discounts/{discountID}", h.Update)
+
+	for name, body := range map[string]string{
+		"end before start": `{"code":"SALE","discount_type":"fixed","discount_value":10,
+			"start_date":"2026-12-31T00:00:00Z","end_date":"2026-01-01T00:00:00Z"}`,
+		"limit below usage": `{"code":"SALE","discount_type":"fixed","discount_value":10,"usage_limit":4,
+			"start_date":"2026-01-01T00:00:00Z","end_date":"2026-12-31T00:00:00Z"}`,
+	} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/api/v1/manage/discounts/1", strings.NewReader(body)))
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want 400", name, w.Code)
+		}
+	}
+}
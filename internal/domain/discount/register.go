@@ -0,0 +1,31 @@
+package discount
+
+import (
+	"github.com/gmhafiz/scs/v2"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/thang1834/go-goss/internal/domain/authentication"
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+func RegisterHTTPEndPoints(router *chi.Mux, validator *validator.Validate, uc UseCase, session *scs.SessionManager, auth *authentication.Handler) *Handler {
+	h := NewHandler(uc, validator, session)
+
+	router.Route("/api/v1/discounts", func(router chi.Router) {
+		router.Use(middleware.Authenticate(session))
+
+		router.Post("/preview", h.Preview)
+	})
+
+	// Discount management routes
+	router.Route("/api/v1/manage/discounts", func(router chi.Router) {
+		router.Use(middleware.Authenticate(session))
+		router.Use(auth.RequirePermission("discount:write"))
+
+		router.Get("/{discountID}", h.Get)
+		router.Put("/{discountID}", h.Update)
+	})
+
+	return h
+}
@@ -0,0 +1,299 @@
+package discount
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/ent/gen/cart"
+	"github.com/thang1834/go-goss/ent/gen/cartitem"
+	"github.com/thang1834/go-goss/ent/gen/category"
+	"github.com/thang1834/go-goss/ent/gen/discount"
+	"github.com/thang1834/go-goss/ent/gen/discountcategory"
+	"github.com/thang1834/go-goss/ent/gen/discountproduct"
+	"github.com/thang1834/go-goss/ent/gen/uservoucher"
+	"github.com/thang1834/go-goss/internal/utility/message"
+)
+
+var (
+	ErrNotFound    = errors.New("discount code not found")
+	ErrNotAssigned = errors.New("discount code is not assigned to this user")
+	ErrVoucherUsed = errors.New("voucher has already been used")
+	ErrEmptyCart   = errors.New("cart is empty")
+	ErrCodeTaken   = errors.New("discount code is already in use")
+	ErrUsageLimit  = errors.New("usage_limit cannot be lower than the number of times the code was used")
+)
+
+type Repo interface {
+	CartItems(ctx context.Context, userID uint64) ([]Item, error)
+	Preview(ctx context.Context, code string, userID uint64, items []Item) (*gen.Discount, *Quote, error)
+	Read(ctx context.Context, discountID uint64) (*gen.Discount, error)
+	Update(ctx context.Context, discountID uint64, req UpdateRequest, versions []time.Time) (*gen.Discount, error)
+}
+
+type repo struct {
+	ent *gen.Client
+}
+
+func NewRepo(ent *gen.Client) *repo {
+	return &repo{
+		ent: ent,
+	}
+}
+
+func (r *repo) CartItems(ctx context.Context, userID uint64) ([]Item, error) {
+	lines, err := r.ent.CartItem.Query().
+		Where(cartitem.HasCartWith(cart.UserIDEQ(userID))).
+		WithProduct().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]Item, 0, len(lines))
+	for _, line := range lines {
+		items = append(items, Item{
+			ProductID:  line.ProductID,
+			CategoryID: line.Edges.Product.CategoryID,
+			UnitPrice:  line.Edges.Product.Price,
+			Quantity:   line.Quantity,
+		})
+	}
+	return items, nil
+}
+
+// Preview evaluates a code without redeeming it.
+func (r *repo) Preview(ctx context.Context, code string, userID uint64, items []Item) (*gen.Discount, *Quote, error) {
+	tx, err := r.ent.Tx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	d, _, q, err := check(ctx, tx, code, userID, items, false)
+	return d, q, err
+}
+
+func (r *repo) Read(ctx context.Context, discountID uint64) (*gen.Discount, error) {
+	d, err := r.ent.Discount.Get(ctx, discountID)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return d, nil
+}
+
+// Update replaces a discount's terms. With versions set, the discount is only
+// updated if its updated_at is one of them.
+func (r *repo) Update(ctx context.Context, discountID uint64, req UpdateRequest, versions []time.Time) (*gen.Discount, error) {
+	builder := r.ent.Discount.UpdateOneID(discountID).
+		SetCode(req.Code).
+		SetDescription(req.Description).
+		SetDiscountType(req.DiscountType).
+		SetDiscountValue(req.DiscountValue).
+		SetStartDate(req.StartDate).
+		SetEndDate(req.EndDate).
+		SetUsageLimit(req.UsageLimit).
+		SetMinOrderValue(req.MinOrderValue).
+		SetUpdatedAt(time.Now())
+
+	if versions != nil {
+		builder = builder.Where(discount.UpdatedAtIn(versions...))
+	}
+	if req.UsageLimit > 0 {
+		// Checked in the update itself so that a concurrent redemption
+		// cannot take usage_count past the new limit.
+		builder = builder.Where(discount.UsageCountLTE(req.UsageLimit))
+	}
+
+	d, err := builder.Save(ctx)
+	if err != nil {
+		switch {
+		case gen.IsNotFound(err):
+			current, getErr := r.ent.Discount.Get(ctx, discountID)
+			switch {
+			case gen.IsNotFound(getErr):
+				return nil, ErrNotFound
+			case getErr != nil:
+				return nil, getErr
+			case req.UsageLimit > 0 && current.UsageCount > req.UsageLimit:
+				return nil, ErrUsageLimit
+			}
+			return nil, message.ErrPreconditionFailed
+		case gen.IsConstraintError(err):
+			return nil, ErrCodeTaken
+		}
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// Redeem evaluates a code for an order being placed within tx and consumes
+// it: usage_count is incremented and the user's voucher, if the discount is
+// voucher-only, is marked used. The discount and voucher rows stay locked
+// until tx ends, so concurrent orders cannot exceed usage_limit or reuse a
+// voucher.
+func Redeem(ctx context.Context, tx *gen.Tx, code string, userID uint64, items []Item) (*gen.Discount, *Quote, error) {
+	d, v, q, err := check(ctx, tx, code, userID, items, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = tx.Discount.UpdateOneID(d.ID).AddUsageCount(1).Exec(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	if v != nil {
+		err = tx.UserVoucher.UpdateOneID(v.ID).
+			SetIsUsed(true).
+			SetUsedAt(time.Now()).
+			Exec(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return d, q, nil
+}
+
+// Release gives back a code redeemed by an order that was cancelled within
+// tx: usage_count is decremented and the user's voucher, if any, can be used
+// again.
+func Release(ctx context.Context, tx *gen.Tx, discountID, userID uint64) error {
+	err := tx.Discount.Update().
+		Where(
+			discount.IDEQ(discountID),
+			discount.UsageCountGT(0),
+		).
+		AddUsageCount(-1).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return tx.UserVoucher.Update().
+		Where(
+			uservoucher.DiscountIDEQ(discountID),
+			uservoucher.UserIDEQ(userID),
+			uservoucher.IsUsedEQ(true),
+		).
+		SetIsUsed(false).
+		ClearUsedAt().
+		Exec(ctx)
+}
+
+// check loads a discount with its scope and the user's voucher and evaluates
+// it against items. Discounts that have vouchers assigned can only be used by
+// those users, once each.
+func check(ctx context.Context, tx *gen.Tx, code string, userID uint64, items []Item, lock bool) (*gen.Discount, *gen.UserVoucher, *Quote, error) {
+	if len(items) == 0 {
+		return nil, nil, nil, ErrEmptyCart
+	}
+
+	query := tx.Discount.Query().Where(discount.CodeEQ(code))
+	if lock {
+		query = query.ForUpdate()
+	}
+	d, err := query.Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, nil, nil, ErrNotFound
+		}
+		return nil, nil, nil, err
+	}
+
+	v, err := voucher(ctx, tx, d.ID, userID, lock)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	s, err := scope(ctx, tx, d.ID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	q, err := Evaluate(d, s, items, time.Now())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return d, v, q, nil
+}
+
+// voucher returns the user's voucher for a voucher-only discount, and nil
+// for discounts open to everyone.
+func voucher(ctx context.Context, tx *gen.Tx, discountID, userID uint64, lock bool) (*gen.UserVoucher, error) {
+	assigned, err := tx.UserVoucher.Query().Where(uservoucher.DiscountIDEQ(discountID)).Exist(ctx)
+	if err != nil || !assigned {
+		return nil, err
+	}
+
+	query := tx.UserVoucher.Query().
+		Where(
+			uservoucher.DiscountIDEQ(discountID),
+			uservoucher.UserIDEQ(userID),
+		)
+	if lock {
+		query = query.ForUpdate()
+	}
+	v, err := query.Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, ErrNotAssigned
+		}
+		return nil, err
+	}
+	if v.IsUsed {
+		return nil, ErrVoucherUsed
+	}
+	return v, nil
+}
+
+func scope(ctx context.Context, tx *gen.Tx, discountID uint64) (Scope, error) {
+	s := Scope{
+		Products:   make(map[uint64]bool),
+		Categories: make(map[uint64]bool),
+		Parents:    make(map[uint64]uint64),
+	}
+
+	products, err := tx.DiscountProduct.Query().
+		Where(discountproduct.DiscountIDEQ(discountID)).
+		All(ctx)
+	if err != nil {
+		return s, err
+	}
+	for _, p := range products {
+		s.Products[p.ProductID] = true
+	}
+
+	categories, err := tx.DiscountCategory.Query().
+		Where(discountcategory.DiscountIDEQ(discountID)).
+		All(ctx)
+	if err != nil {
+		return s, err
+	}
+	if len(categories) == 0 {
+		return s, nil
+	}
+	for _, c := range categories {
+		s.Categories[c.CategoryID] = true
+	}
+
+	all, err := tx.Category.Query().
+		Select(category.FieldID, category.FieldParentID).
+		All(ctx)
+	if err != nil {
+		return s, err
+	}
+	for _, c := range all {
+		if c.ParentID != 0 {
+			s.Parents[c.ID] = c.ParentID
+		}
+	}
+
+	return s, nil
+}
@@ -0,0 +1,20 @@
+package discount
+
+import "time"
+
+type PreviewRequest struct {
+	Code string `json:"code" validate:"required,max=50"`
+}
+
+// UpdateRequest replaces a discount's terms. A zero UsageLimit or
+// MinOrderValue removes the limit.
+type UpdateRequest struct {
+	Code          string    `json:"code" validate:"required,max=50"`
+	Description   string    `json:"description,omitempty"`
+	DiscountType  string    `json:"discount_type" validate:"required,oneof=percentage fixed"`
+	DiscountValue float64   `json:"discount_value" validate:"gt=0"`
+	StartDate     time.Time `json:"start_date" validate:"required"`
+	EndDate       time.Time `json:"end_date" validate:"required,gtfield=StartDate"`
+	UsageLimit    int       `json:"usage_limit,omitempty" validate:"gte=0"`
+	MinOrderValue float64   `json:"min_order_value,omitempty" validate:"gte=0"`
+}
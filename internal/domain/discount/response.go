@@ -0,0 +1,59 @@
+package discount
+
+import (
+	"time"
+
+	"github.com/thang1834/go-goss/ent/gen"
+)
+
+type QuoteRes struct {
+	Code        string  `json:"code"`
+	Description string  `json:"description,omitempty"`
+	Subtotal    float64 `json:"subtotal"`
+	Eligible    float64 `json:"eligible"`
+	Discount    float64 `json:"discount"`
+	Total       float64 `json:"total"`
+}
+
+func QuoteResource(d *gen.Discount, q *Quote) *QuoteRes {
+	return &QuoteRes{
+		Code:        d.Code,
+		Description: d.Description,
+		Subtotal:    q.Subtotal,
+		Eligible:    q.Eligible,
+		Discount:    q.Amount,
+		Total:       q.Total,
+	}
+}
+
+type Res struct {
+	ID            uint64    `json:"id"`
+	Code          string    `json:"code"`
+	Description   string    `json:"description,omitempty"`
+	DiscountType  string    `json:"discount_type"`
+	DiscountValue float64   `json:"discount_value"`
+	StartDate     time.Time `json:"start_date"`
+	EndDate       time.Time `json:"end_date"`
+	UsageLimit    int       `json:"usage_limit,omitempty"`
+	UsageCount    int       `json:"usage_count"`
+	MinOrderValue float64   `json:"min_order_value,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+func Resource(d *gen.Discount) *Res {
+	return &Res{
+		ID:            d.ID,
+		Code:          d.Code,
+		Description:   d.Description,
+		DiscountType:  d.DiscountType,
+		DiscountValue: d.DiscountValue,
+		StartDate:     d.StartDate,
+		EndDate:       d.EndDate,
+		UsageLimit:    d.UsageLimit,
+		UsageCount:    d.UsageCount,
+		MinOrderValue: d.MinOrderValue,
+		CreatedAt:     d.CreatedAt,
+		UpdatedAt:     d.UpdatedAt,
+	}
+}
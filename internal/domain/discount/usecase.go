@@ -0,0 +1,49 @@
+package discount
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/thang1834/go-goss/ent/gen"
+)
+
+var ErrPercentage = errors.New("a percentage discount cannot exceed 100")
+
+type UseCase interface {
+	Preview(ctx context.Context, userID uint64, code string) (*gen.Discount, *Quote, error)
+	Read(ctx context.Context, discountID uint64) (*gen.Discount, error)
+	Update(ctx context.Context, discountID uint64, req UpdateRequest, versions []time.Time) (*gen.Discount, error)
+}
+
+type Discount struct {
+	repo Repo
+}
+
+func New(repo Repo) *Discount {
+	return &Discount{
+		repo: repo,
+	}
+}
+
+// Preview evaluates a code against the user's cart without redeeming it.
+func (u *Discount) Preview(ctx context.Context, userID uint64, code string) (*gen.Discount, *Quote, error) {
+	items, err := u.repo.CartItems(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return u.repo.Preview(ctx, code, userID, items)
+}
+
+func (u *Discount) Read(ctx context.Context, discountID uint64) (*gen.Discount, error) {
+	return u.repo.Read(ctx, discountID)
+}
+
+func (u *Discount) Update(ctx context.Context, discountID uint64, req UpdateRequest, versions []time.Time) (*gen.Discount, error) {
+	if req.DiscountType == TypePercentage && req.DiscountValue > 100 {
+		return nil, ErrPercentage
+	}
+
+	return u.repo.Update(ctx, discountID, req, versions)
+}
@@ -0,0 +1,34 @@
+package order
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/thang1834/go-goss/internal/utility/filter"
+)
+
+type Filter struct {
+	Base filter.Filter
+
+	UserID uint64
+	Status string
+	// From and To bound created_at. To is exclusive; a date without a time
+	// includes the whole day.
+	From time.Time
+	To   time.Time
+}
+
+func Filters(queries url.Values) *Filter {
+	f := filter.New(queries)
+
+	userID, _ := strconv.ParseUint(queries.Get("user_id"), 10, 64)
+
+	return &Filter{
+		Base:   *f,
+		UserID: userID,
+		Status: queries.Get("status"),
+		From:   filter.ParseTime(queries.Get("from"), false),
+		To:     filter.ParseTime(queries.Get("to"), true),
+	}
+}
@@ -0,0 +1,308 @@
+package order
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gmhafiz/scs/v2"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/thang1834/go-goss/internal/domain/discount"
+	"github.com/thang1834/go-goss/internal/middleware"
+	"github.com/thang1834/go-goss/internal/utility/message"
+	"github.com/thang1834/go-goss/internal/utility/param"
+	"github.com/thang1834/go-goss/internal/utility/request"
+	"github.com/thang1834/go-goss/internal/utility/respond"
+	"github.com/thang1834/go-goss/internal/utility/validate"
+)
+
+type Handler struct {
+	useCase  UseCase
+	validate *validator.Validate
+	session  *scs.SessionManager
+}
+
+func NewHandler(useCase UseCase, v *validator.Validate, session *scs.SessionManager) *Handler {
+	return &Handler{
+		useCase:  useCase,
+		validate: v,
+		session:  session,
+	}
+}
+
+// Checkout places an order for everything in the current user's cart
+// @Summary Checkout
+// @Description Reserves stock, applies an optional discount code and empties the cart.
+// @Param order body CheckoutRequest true "shipping details"
+// @Success 201 {object} Res
+// @Failure 400
+// @Failure 401
+// @Failure 409
+// @router /api/v1/orders [post]
+func (h *Handler) Checkout(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.session.Get(r.Context(), string(middleware.KeyID)).(uint64)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	var req CheckoutRequest
+	err := request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	errs := validate.Validate(h.validate, req)
+	if errs != nil {
+		respond.Errors(w, http.StatusBadRequest, errs)
+		return
+	}
+
+	o, err := h.useCase.Checkout(r.Context(), userID, req)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.JsonWithVersion(w, r, http.StatusCreated, Resource(o), o.UpdatedAt)
+}
+
+// List lists the current user's orders
+// @Summary List my orders
+// @Param page query int false "page number"
+// @Param limit query int false "items per page"
+// @Param sort query string false "e.g. created_at,desc"
+// @Success 200 {object} respond.Standard
+// @Failure 401
+// @router /api/v1/orders [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.session.Get(r.Context(), string(middleware.KeyID)).(uint64)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	orders, total, err := h.useCase.ListOwn(r.Context(), userID, Filters(r.URL.Query()))
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	list := Resources(orders)
+	respond.Json(w, http.StatusOK, respond.Standard{
+		Data: list,
+		Meta: respond.Meta{
+			Size:  len(list),
+			Total: total,
+		},
+	})
+}
+
+// Get returns one of the current user's orders with its items
+// @Summary Get my order
+// @Param orderID path int true "order ID"
+// @Success 200 {object} Res
+// @Success 304
+// @Failure 400
+// @Failure 404
+// @router /api/v1/orders/{orderID} [get]
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.session.Get(r.Context(), string(middleware.KeyID)).(uint64)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	orderID, err := param.UInt64(r, "orderID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	o, err := h.useCase.ReadOwn(r.Context(), userID, orderID)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.JsonWithVersion(w, r, http.StatusOK, Resource(o), o.UpdatedAt)
+}
+
+// Timeline returns the status history of one of the current user's orders
+// @Summary My order timeline
+// @Param orderID path int true "order ID"
+// @Success 200 {array} EventRes
+// @Failure 400
+// @Failure 404
+// @router /api/v1/orders/{orderID}/timeline [get]
+func (h *Handler) Timeline(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.session.Get(r.Context(), string(middleware.KeyID)).(uint64)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	orderID, err := param.UInt64(r, "orderID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	history, err := h.useCase.Timeline(r.Context(), userID, orderID)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, Timeline(history))
+}
+
+// ListAll lists every order
+// @Summary List all orders
+// @Description Filter by status, user_id and created_at with from and to (RFC 3339 or YYYY-MM-DD).
+// @Param status query string false "order status"
+// @Param from query string false "created on or after"
+// @Param to query string false "created before, a date includes the whole day"
+// @Param page query int false "page number"
+// @Param limit query int false "items per page"
+// @Param sort query string false "e.g. total_price,desc"
+// @Success 200 {object} respond.Standard
+// @Failure 400
+// @router /api/v1/orders/all [get]
+func (h *Handler) ListAll(w http.ResponseWriter, r *http.Request) {
+	orders, total, err := h.useCase.List(r.Context(), Filters(r.URL.Query()))
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	list := Resources(orders)
+	respond.Json(w, http.StatusOK, respond.Standard{
+		Data: list,
+		Meta: respond.Meta{
+			Size:  len(list),
+			Total: total,
+		},
+	})
+}
+
+// GetAny returns any order with its items
+// @Summary Get an order
+// @Param orderID path int true "order ID"
+// @Success 200 {object} Res
+// @Success 304
+// @Failure 400
+// @Failure 404
+// @router /api/v1/orders/all/{orderID} [get]
+func (h *Handler) GetAny(w http.ResponseWriter, r *http.Request) {
+	orderID, err := param.UInt64(r, "orderID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	o, err := h.useCase.Read(r.Context(), orderID)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.JsonWithVersion(w, r, http.StatusOK, Resource(o), o.UpdatedAt)
+}
+
+// History returns the status history of any order
+// @Summary Order timeline
+// @Param orderID path int true "order ID"
+// @Success 200 {array} EventRes
+// @Failure 400
+// @Failure 404
+// @router /api/v1/orders/all/{orderID}/timeline [get]
+func (h *Handler) History(w http.ResponseWriter, r *http.Request) {
+	orderID, err := param.UInt64(r, "orderID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	history, err := h.useCase.History(r.Context(), orderID)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, Timeline(history))
+}
+
+// UpdateStatus moves an order to another status. With an If-Match header,
+// the order is only changed if its ETag is listed. Paid and refunded are
+// refused, those follow payments.
+// @Summary Change order status
+// @Param orderID path int true "order ID"
+// @Param If-Match header string false "ETag of the order as last read"
+// @Param status body TransitionRequest true "new status"
+// @Success 200 {object} Res
+// @Failure 400
+// @Failure 404
+// @Failure 409
+// @Failure 412
+// @router /api/v1/orders/all/{orderID}/status [patch]
+func (h *Handler) UpdateStatus(w http.ResponseWriter, r *http.Request) {
+	orderID, err := param.UInt64(r, "orderID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	var req TransitionRequest
+	err = request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	errs := validate.Validate(h.validate, req)
+	if errs != nil {
+		respond.Errors(w, http.StatusBadRequest, errs)
+		return
+	}
+
+	var changedBy *uint64
+	if userID, ok := h.session.Get(r.Context(), string(middleware.KeyID)).(uint64); ok {
+		changedBy = &userID
+	}
+
+	versions, _ := request.IfMatch(r)
+
+	o, err := h.useCase.Transition(r.Context(), orderID, req.Status, changedBy, req.Note, versions)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.JsonWithVersion(w, r, http.StatusOK, Resource(o), o.UpdatedAt)
+}
+
+// error maps domain errors to their HTTP status code.
+func (h *Handler) error(w http.ResponseWriter, err error) {
+	if code, ok := discount.Status(err); ok {
+		respond.Error(w, code, err)
+		return
+	}
+
+	switch {
+	case errors.Is(err, ErrNotFound):
+		respond.Error(w, http.StatusNotFound, err)
+	case errors.Is(err, ErrInsufficientStock),
+		errors.Is(err, ErrProductNotFound),
+		errors.Is(err, ErrInvalidTransition),
+		errors.Is(err, ErrPaymentStatus):
+		respond.Error(w, http.StatusConflict, err)
+	case errors.Is(err, message.ErrPreconditionFailed):
+		respond.Error(w, http.StatusPreconditionFailed, err)
+	case errors.Is(err, ErrEmptyCart), errors.Is(err, ErrUnknownStatus):
+		respond.Error(w, http.StatusBadRequest, err)
+	default:
+		respond.Error(w, http.StatusInternalServerError, message.ErrInternalError)
+	}
+}
@@ -0,0 +1,35 @@
+package order
+
+import (
+	"github.com/gmhafiz/scs/v2"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/thang1834/go-goss/internal/domain/authentication"
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+func RegisterHTTPEndPoints(router *chi.Mux, validator *validator.Validate, uc UseCase, session *scs.SessionManager, auth *authentication.Handler) *Handler {
+	h := NewHandler(uc, validator, session)
+
+	router.Route("/api/v1/orders", func(router chi.Router) {
+		router.Use(middleware.Authenticate(session))
+
+		router.With(auth.RequirePermission("order:create")).Post("/", h.Checkout)
+		router.Get("/", h.List)
+		router.Get("/{orderID}", h.Get)
+		router.Get("/{orderID}/timeline", h.Timeline)
+
+		// Order administration
+		router.Route("/all", func(router chi.Router) {
+			router.Use(auth.RequirePermission("order:read_all"))
+
+			router.Get("/", h.ListAll)
+			router.Get("/{orderID}", h.GetAny)
+			router.Get("/{orderID}/timeline", h.History)
+			router.With(auth.RequirePermission("order:update_status")).Patch("/{orderID}/status", h.UpdateStatus)
+		})
+	})
+
+	return h
+}
@@ -0,0 +1,373 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/ent/gen/cart"
+	"github.com/thang1834/go-goss/ent/gen/cartitem"
+	"github.com/thang1834/go-goss/ent/gen/order"
+	"github.com/thang1834/go-goss/ent/gen/orderitem"
+	"github.com/thang1834/go-goss/ent/gen/orderstatushistory"
+	"github.com/thang1834/go-goss/ent/gen/predicate"
+	"github.com/thang1834/go-goss/ent/gen/product"
+	"github.com/thang1834/go-goss/internal/domain/discount"
+	"github.com/thang1834/go-goss/internal/utility/message"
+	"github.com/thang1834/go-goss/internal/utility/money"
+)
+
+var (
+	ErrNotFound          = errors.New("order not found")
+	ErrEmptyCart         = errors.New("cart is empty")
+	ErrProductNotFound   = errors.New("product not found")
+	ErrInsufficientStock = errors.New("not enough stock")
+)
+
+var sortable = map[string]string{
+	"created_at":  order.FieldCreatedAt,
+	"total_price": order.FieldTotalPrice,
+	"status":      order.FieldStatus,
+}
+
+type Repo interface {
+	Checkout(ctx context.Context, userID uint64, req CheckoutRequest) (uint64, error)
+	ReadOwn(ctx context.Context, userID, orderID uint64) (*gen.Order, error)
+	ListOwn(ctx context.Context, userID uint64, f *Filter) ([]*gen.Order, int, error)
+
+	Read(ctx context.Context, orderID uint64) (*gen.Order, error)
+	List(ctx context.Context, f *Filter) ([]*gen.Order, int, error)
+	Transition(ctx context.Context, orderID uint64, to string, changedBy *uint64, note string, versions []time.Time) error
+	History(ctx context.Context, orderID uint64) ([]*gen.OrderStatusHistory, error)
+}
+
+type repo struct {
+	ent *gen.Client
+}
+
+func NewRepo(ent *gen.Client) *repo {
+	return &repo{
+		ent: ent,
+	}
+}
+
+// Checkout turns the user's cart into an order in a single transaction.
+//
+// Product rows are locked with SELECT ... FOR UPDATE in ID order, so
+// concurrent checkouts of the same product queue up behind each other and
+// see the stock left by the previous one. Locking in a fixed order keeps two
+// checkouts with overlapping carts from deadlocking. The cart row is locked
+// first, so a second checkout of the same cart, such as a double submit,
+// waits for the first and then finds the cart empty.
+func (r *repo) Checkout(ctx context.Context, userID uint64, req CheckoutRequest) (uint64, error) {
+	tx, err := r.ent.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	c, err := tx.Cart.Query().Where(cart.UserIDEQ(userID)).ForUpdate().Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return 0, ErrEmptyCart
+		}
+		return 0, err
+	}
+
+	items, err := tx.CartItem.Query().
+		Where(cartitem.CartIDEQ(c.ID)).
+		Order(cartitem.ByProductID()).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if len(items) == 0 {
+		return 0, ErrEmptyCart
+	}
+
+	ids := make([]uint64, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ProductID)
+	}
+	slices.Sort(ids)
+
+	locked, err := tx.Product.Query().
+		Where(product.IDIn(ids...)).
+		Order(product.ByID()).
+		ForUpdate().
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	products := make(map[uint64]*gen.Product, len(locked))
+	for _, p := range locked {
+		products[p.ID] = p
+	}
+
+	var subtotal float64
+	for _, item := range items {
+		p, ok := products[item.ProductID]
+		if !ok {
+			return 0, ErrProductNotFound
+		}
+		if p.StockQuantity < item.Quantity {
+			return 0, fmt.Errorf("%w for %s", ErrInsufficientStock, p.Name)
+		}
+		subtotal += money.LineTotal(p.Price, item.Quantity)
+	}
+	subtotal = money.Round(subtotal)
+
+	var (
+		discountID *uint64
+		amount     float64
+	)
+	if req.DiscountCode != "" {
+		lines := make([]discount.Item, 0, len(items))
+		for _, item := range items {
+			p := products[item.ProductID]
+			lines = append(lines, discount.Item{
+				ProductID:  p.ID,
+				CategoryID: p.CategoryID,
+				UnitPrice:  p.Price,
+				Quantity:   item.Quantity,
+			})
+		}
+
+		d, q, err := discount.Redeem(ctx, tx, req.DiscountCode, userID, lines)
+		if err != nil {
+			return 0, err
+		}
+		discountID = &d.ID
+		amount = q.Amount
+	}
+
+	create := tx.Order.Create().
+		SetUserID(userID).
+		SetStatus(StatusPending).
+		SetTotalPrice(money.Round(subtotal - amount)).
+		SetDiscountAmount(amount).
+		SetNillableDiscountID(discountID).
+		SetShippingAddress(req.ShippingAddress)
+	if req.PaymentMethod != "" {
+		create.SetPaymentMethod(req.PaymentMethod)
+	}
+	o, err := create.Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	lines := make([]*gen.OrderItemCreate, 0, len(items))
+	for _, item := range items {
+		lines = append(lines, tx.OrderItem.Create().
+			SetOrderID(o.ID).
+			SetProductID(item.ProductID).
+			SetQuantity(item.Quantity).
+			SetUnitPrice(products[item.ProductID].Price))
+	}
+	if err = tx.OrderItem.CreateBulk(lines...).Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	err = tx.OrderStatusHistory.Create().
+		SetOrderID(o.ID).
+		SetToStatus(StatusPending).
+		SetChangedBy(userID).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, item := range items {
+		err = tx.Product.UpdateOneID(item.ProductID).
+			AddStockQuantity(-item.Quantity).
+			Exec(ctx)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if _, err = tx.CartItem.Delete().Where(cartitem.CartIDEQ(c.ID)).Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return o.ID, nil
+}
+
+func (r *repo) ReadOwn(ctx context.Context, userID, orderID uint64) (*gen.Order, error) {
+	return r.read(ctx, order.IDEQ(orderID), order.UserIDEQ(userID))
+}
+
+func (r *repo) ListOwn(ctx context.Context, userID uint64, f *Filter) ([]*gen.Order, int, error) {
+	f.UserID = userID
+
+	return r.List(ctx, f)
+}
+
+func (r *repo) Read(ctx context.Context, orderID uint64) (*gen.Order, error) {
+	return r.read(ctx, order.IDEQ(orderID))
+}
+
+func (r *repo) read(ctx context.Context, ps ...predicate.Order) (*gen.Order, error) {
+	o, err := r.ent.Order.Query().
+		Where(ps...).
+		WithItems(func(q *gen.OrderItemQuery) {
+			q.WithProduct().Order(orderitem.ByID())
+		}).
+		Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return o, nil
+}
+
+func (r *repo) List(ctx context.Context, f *Filter) ([]*gen.Order, int, error) {
+	query := r.ent.Order.Query()
+
+	if f.UserID != 0 {
+		query = query.Where(order.UserIDEQ(f.UserID))
+	}
+	if f.Status != "" {
+		query = query.Where(order.StatusEQ(f.Status))
+	}
+	if !f.From.IsZero() {
+		query = query.Where(order.CreatedAtGTE(f.From))
+	}
+	if !f.To.IsZero() {
+		query = query.Where(order.CreatedAtLT(f.To))
+	}
+
+	return list(ctx, query, f)
+}
+
+// Transition moves an order to another status and records the change. With
+// versions set, the order is only changed if its updated_at is one of them.
+func (r *repo) Transition(ctx context.Context, orderID uint64, to string, changedBy *uint64, note string, versions []time.Time) error {
+	tx, err := r.ent.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var ps []predicate.Order
+	if versions != nil {
+		ps = append(ps, order.UpdatedAtIn(versions...))
+	}
+
+	if err = TransitionTx(ctx, tx, orderID, to, changedBy, note, ps...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// TransitionTx moves an order to another status within tx, so that other
+// domains can change an order together with their own records. The order
+// row is locked so concurrent transitions are validated one after the other.
+// Cancelling an order puts its items back in stock.
+//
+// The order is only changed if it matches ps, and ErrPreconditionFailed is
+// returned otherwise.
+func TransitionTx(ctx context.Context, tx *gen.Tx, orderID uint64, to string, changedBy *uint64, note string, ps ...predicate.Order) error {
+	o, err := tx.Order.Query().Where(order.IDEQ(orderID)).ForUpdate().Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	if err = checkTransition(o.Status, to); err != nil {
+		return fmt.Errorf("%w: %s to %s", err, o.Status, to)
+	}
+
+	err = tx.Order.UpdateOneID(orderID).Where(ps...).SetStatus(to).Exec(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			// The order was found and locked above, so it did not match ps.
+			return message.ErrPreconditionFailed
+		}
+		return err
+	}
+
+	err = tx.OrderStatusHistory.Create().
+		SetOrderID(orderID).
+		SetFromStatus(o.Status).
+		SetToStatus(to).
+		SetNillableChangedBy(changedBy).
+		SetNote(note).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if to == StatusCancelled {
+		items, err := tx.OrderItem.Query().Where(orderitem.OrderIDEQ(orderID)).All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			err = tx.Product.UpdateOneID(item.ProductID).
+				AddStockQuantity(item.Quantity).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		if o.DiscountID != nil {
+			if err = discount.Release(ctx, tx, *o.DiscountID, o.UserID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *repo) History(ctx context.Context, orderID uint64) ([]*gen.OrderStatusHistory, error) {
+	return r.ent.OrderStatusHistory.Query().
+		Where(orderstatushistory.OrderIDEQ(orderID)).
+		Order(orderstatushistory.ByCreatedAt(), orderstatushistory.ByID()).
+		All(ctx)
+}
+
+// list counts, sorts and paginates an order query.
+func list(ctx context.Context, query *gen.OrderQuery, f *Filter) ([]*gen.Order, int, error) {
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for key, direction := range f.Base.Sort {
+		column, ok := sortable[key]
+		if !ok {
+			continue
+		}
+		if direction == "DESC" {
+			query = query.Order(gen.Desc(column))
+		} else {
+			query = query.Order(gen.Asc(column))
+		}
+	}
+	query = query.Order(gen.Desc(order.FieldID))
+
+	if !f.Base.DisablePaging {
+		query = query.Limit(f.Base.Limit).Offset(f.Base.Offset)
+	}
+
+	orders, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return orders, total, nil
+}
@@ -0,0 +1,260 @@
+package order
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/lib/pq"
+
+	"github.com/thang1834/go-goss/config"
+	"github.com/thang1834/go-goss/ent/gen"
+)
+
+var dsn string
+
+func TestMain(m *testing.M) {
+	getwd, err := os.Getwd()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	if strings.Contains(getwd, "/internal/domain/order") {
+		err := os.Chdir("../../../")
+		if err != nil {
+			log.Println(err)
+		}
+	}
+
+	cfg := config.New()
+
+	dsn = fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=disable",
+		cfg.Database.User,
+		cfg.Database.Pass,
+		cfg.Database.Host,
+		cfg.Database.Port,
+		cfg.Database.TestName,
+	)
+
+	code := m.Run()
+	os.Exit(code)
+}
+
+// newTestClient returns a client on a schema of its own in the test
+// database, created from the ent schema and dropped when the test ends.
+func newTestClient(t *testing.T) *gen.Client {
+	t.Helper()
+
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	schema := fmt.Sprintf("order_test_%d", time.Now().UnixNano())
+	if _, err = db.Exec("CREATE SCHEMA " + schema); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _, _ = db.Exec("DROP SCHEMA " + schema + " CASCADE") })
+
+	drv, err := entsql.Open(dialect.Postgres, dsn+"&search_path="+schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := gen.NewClient(gen.Driver(drv))
+	t.Cleanup(func() { _ = client.Close() })
+
+	if err = client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+func TestCheckoutLastUnit(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	p := client.Product.Create().
+		SetName("Last one").
+		SetSlug("last-one").
+		SetPrice(10).
+		SetStockQuantity(1).
+		SaveX(ctx)
+
+	var buyers []uint64
+	for i := range 2 {
+		u := client.User.Create().
+			SetEmail(fmt.Sprintf("buyer%d@example.com", i)).
+			SetPasswordHash("x").
+			SaveX(ctx)
+		c := client.Cart.Create().SetUserID(u.ID).SaveX(ctx)
+		client.CartItem.Create().SetCartID(c.ID).SetProductID(p.ID).SetQuantity(1).ExecX(ctx)
+		buyers = append(buyers, u.ID)
+	}
+
+	r := NewRepo(client)
+
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	errs := make([]error, len(buyers))
+	for i, userID := range buyers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			_, errs[i] = r.Checkout(ctx, userID, CheckoutRequest{ShippingAddress: "1 Test Street"})
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	var succeeded, outOfStock int
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			succeeded++
+		case errors.Is(err, ErrInsufficientStock):
+			outOfStock++
+		default:
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if succeeded != 1 || outOfStock != 1 {
+		t.Errorf("%d checkouts succeeded and %d ran out of stock, want one each", succeeded, outOfStock)
+	}
+
+	if stock := client.Product.GetX(ctx, p.ID).StockQuantity; stock != 0 {
+		t.Errorf("stock is %d, want 0", stock)
+	}
+	if n := client.Order.Query().CountX(ctx); n != 1 {
+		t.Errorf("%d orders were placed, want 1", n)
+	}
+}
+
+func TestCheckoutSameCartTwice(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	p := client.Product.Create().
+		SetName("Plenty").
+		SetSlug("plenty").
+		SetPrice(10).
+		SetStockQuantity(10).
+		SaveX(ctx)
+	u := client.User.Create().
+		SetEmail("double@example.com").
+		SetPasswordHash("x").
+		SaveX(ctx)
+	c := client.Cart.Create().SetUserID(u.ID).SaveX(ctx)
+	client.CartItem.Create().SetCartID(c.ID).SetProductID(p.ID).SetQuantity(2).ExecX(ctx)
+
+	r := NewRepo(client)
+
+	// A double submit: the same cart checked out twice at once.
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	errs := make([]error, 2)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			_, errs[i] = r.Checkout(ctx, u.ID, CheckoutRequest{ShippingAddress: "1 Test Street"})
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	var succeeded, empty int
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			succeeded++
+		case errors.Is(err, ErrEmptyCart):
+			empty++
+		default:
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if succeeded != 1 || empty != 1 {
+		t.Errorf("%d checkouts succeeded and %d found the cart empty, want one each", succeeded, empty)
+	}
+
+	if stock := client.Product.GetX(ctx, p.ID).StockQuantity; stock != 8 {
+		t.Errorf("stock is %d, want 8", stock)
+	}
+	if n := client.Order.Query().CountX(ctx); n != 1 {
+		t.Errorf("%d orders were placed, want 1", n)
+	}
+}
+
+func TestCancelReleasesDiscount(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	p := client.Product.Create().
+		SetName("Voucher item").
+		SetSlug("voucher-item").
+		SetPrice(20).
+		SetStockQuantity(5).
+		SaveX(ctx)
+	u := client.User.Create().
+		SetEmail("voucher@example.com").
+		SetPasswordHash("x").
+		SaveX(ctx)
+	c := client.Cart.Create().SetUserID(u.ID).SaveX(ctx)
+	client.CartItem.Create().SetCartID(c.ID).SetProductID(p.ID).SetQuantity(1).ExecX(ctx)
+
+	now := time.Now()
+	d := client.Discount.Create().
+		SetCode("ONCE").
+		SetDiscountType("fixed").
+		SetDiscountValue(5).
+		SetStartDate(now.Add(-time.Hour)).
+		SetEndDate(now.Add(time.Hour)).
+		SaveX(ctx)
+	client.UserVoucher.Create().SetUserID(u.ID).SetDiscountID(d.ID).ExecX(ctx)
+
+	r := NewRepo(client)
+
+	orderID, err := r.Checkout(ctx, u.ID, CheckoutRequest{ShippingAddress: "1 Test Street", DiscountCode: "ONCE"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = r.Transition(ctx, orderID, StatusCancelled, nil, "", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if count := client.Discount.GetX(ctx, d.ID).UsageCount; count != 0 {
+		t.Errorf("usage count is %d after cancelling, want 0", count)
+	}
+	if v := client.UserVoucher.Query().OnlyX(ctx); v.IsUsed || v.UsedAt != nil {
+		t.Errorf("voucher is still used after cancelling: %+v", v)
+	}
+	if stock := client.Product.GetX(ctx, p.ID).StockQuantity; stock != 5 {
+		t.Errorf("stock is %d after cancelling, want 5", stock)
+	}
+}
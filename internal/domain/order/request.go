@@ -0,0 +1,12 @@
+package order
+
+type CheckoutRequest struct {
+	ShippingAddress string `json:"shipping_address" validate:"required,max=1000"`
+	PaymentMethod   string `json:"payment_method" validate:"omitempty,max=50"`
+	DiscountCode    string `json:"discount_code" validate:"omitempty,max=50"`
+}
+
+type TransitionRequest struct {
+	Status string `json:"status" validate:"required,oneof=pending paid fulfilled shipped delivered cancelled refunded"`
+	Note   string `json:"note" validate:"max=1000"`
+}
@@ -0,0 +1,88 @@
+package order
+
+import (
+	"time"
+
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/internal/utility/money"
+)
+
+type Res struct {
+	ID              uint64     `json:"id"`
+	Status          string     `json:"status"`
+	Subtotal        float64    `json:"subtotal"`
+	DiscountAmount  float64    `json:"discount_amount"`
+	TotalPrice      float64    `json:"total_price"`
+	PaymentMethod   string     `json:"payment_method,omitempty"`
+	ShippingAddress string     `json:"shipping_address"`
+	Items           []*ItemRes `json:"items,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+type ItemRes struct {
+	ProductID uint64  `json:"product_id"`
+	Name      string  `json:"name,omitempty"`
+	Quantity  int     `json:"quantity"`
+	UnitPrice float64 `json:"unit_price"`
+	LineTotal float64 `json:"line_total"`
+}
+
+func Resource(o *gen.Order) *Res {
+	res := &Res{
+		ID:              o.ID,
+		Status:          o.Status,
+		Subtotal:        money.Round(o.TotalPrice + o.DiscountAmount),
+		DiscountAmount:  o.DiscountAmount,
+		TotalPrice:      o.TotalPrice,
+		PaymentMethod:   o.PaymentMethod,
+		ShippingAddress: o.ShippingAddress,
+		CreatedAt:       o.CreatedAt,
+		UpdatedAt:       o.UpdatedAt,
+	}
+
+	for _, item := range o.Edges.Items {
+		i := &ItemRes{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+			LineTotal: money.LineTotal(item.UnitPrice, item.Quantity),
+		}
+		if item.Edges.Product != nil {
+			i.Name = item.Edges.Product.Name
+		}
+		res.Items = append(res.Items, i)
+	}
+
+	return res
+}
+
+func Resources(orders []*gen.Order) []*Res {
+	res := make([]*Res, 0, len(orders))
+	for _, o := range orders {
+		res = append(res, Resource(o))
+	}
+	return res
+}
+
+type EventRes struct {
+	FromStatus string    `json:"from_status,omitempty"`
+	ToStatus   string    `json:"to_status"`
+	ChangedBy  *uint64   `json:"changed_by,omitempty"`
+	Note       string    `json:"note,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func Timeline(history []*gen.OrderStatusHistory) []*EventRes {
+	res := make([]*EventRes, 0, len(history))
+	for _, h := range history {
+		res = append(res, &EventRes{
+			FromStatus: h.FromStatus,
+			ToStatus:   h.ToStatus,
+			ChangedBy:  h.ChangedBy,
+			Note:       h.Note,
+			CreatedAt:  h.CreatedAt,
+		})
+	}
+	return res
+}
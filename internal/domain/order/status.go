@@ -0,0 +1,65 @@
+package order
+
+import "errors"
+
+const (
+	StatusPending   = "pending"
+	StatusPaid      = "paid"
+	StatusFulfilled = "fulfilled"
+	StatusShipped   = "shipped"
+	StatusDelivered = "delivered"
+	StatusCancelled = "cancelled"
+	StatusRefunded  = "refunded"
+)
+
+var (
+	ErrUnknownStatus     = errors.New("unknown order status")
+	ErrInvalidTransition = errors.New("order cannot move to the requested status")
+	ErrPaymentStatus     = errors.New("paid and refunded are only set through a payment")
+)
+
+// transitions lists the statuses each status may move to. Cancelled and
+// refunded orders are final.
+var transitions = map[string][]string{
+	StatusPending:   {StatusPaid, StatusCancelled},
+	StatusPaid:      {StatusFulfilled, StatusRefunded},
+	StatusFulfilled: {StatusShipped, StatusRefunded},
+	StatusShipped:   {StatusDelivered},
+	StatusDelivered: {StatusRefunded},
+	StatusCancelled: nil,
+	StatusRefunded:  nil,
+}
+
+// ValidStatus reports whether status is part of the order lifecycle.
+func ValidStatus(status string) bool {
+	_, ok := transitions[status]
+	return ok
+}
+
+// CanTransition reports whether an order in status from may move to status to.
+func CanTransition(from, to string) bool {
+	for _, next := range transitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// setByPayment reports whether status follows money moving at a payment
+// provider. Only the payment domain moves orders there.
+func setByPayment(status string) bool {
+	return status == StatusPaid || status == StatusRefunded
+}
+
+// checkTransition returns why an order cannot move from one status to
+// another, or nil when it can.
+func checkTransition(from, to string) error {
+	if !ValidStatus(to) {
+		return ErrUnknownStatus
+	}
+	if !CanTransition(from, to) {
+		return ErrInvalidTransition
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package order
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckTransition(t *testing.T) {
+	tests := []struct {
+		from, to string
+		err      error
+	}{
+		{StatusPending, StatusPaid, nil},
+		{StatusPending, StatusCancelled, nil},
+		{StatusPaid, StatusFulfilled, nil},
+		{StatusFulfilled, StatusShipped, nil},
+		{StatusShipped, StatusDelivered, nil},
+		{StatusDelivered, StatusRefunded, nil},
+		{StatusPending, StatusShipped, ErrInvalidTransition},
+		{StatusShipped, StatusCancelled, ErrInvalidTransition},
+		{StatusCancelled, StatusPaid, ErrInvalidTransition},
+		{StatusRefunded, StatusPaid, ErrInvalidTransition},
+		{StatusPaid, StatusPaid, ErrInvalidTransition},
+		{StatusPaid, "lost", ErrUnknownStatus},
+	}
+
+	for _, tt := range tests {
+		if err := checkTransition(tt.from, tt.to); !errors.Is(err, tt.err) {
+			t.Errorf("%s -> %s: err = %v, want %v", tt.from, tt.to, err, tt.err)
+		}
+	}
+}
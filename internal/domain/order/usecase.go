@@ -0,0 +1,85 @@
+package order
+
+import (
+	"context"
+	"time"
+
+	"github.com/thang1834/go-goss/ent/gen"
+)
+
+type UseCase interface {
+	Checkout(ctx context.Context, userID uint64, req CheckoutRequest) (*gen.Order, error)
+	ReadOwn(ctx context.Context, userID, orderID uint64) (*gen.Order, error)
+	ListOwn(ctx context.Context, userID uint64, f *Filter) ([]*gen.Order, int, error)
+	Timeline(ctx context.Context, userID, orderID uint64) ([]*gen.OrderStatusHistory, error)
+
+	Read(ctx context.Context, orderID uint64) (*gen.Order, error)
+	List(ctx context.Context, f *Filter) ([]*gen.Order, int, error)
+	History(ctx context.Context, orderID uint64) ([]*gen.OrderStatusHistory, error)
+	Transition(ctx context.Context, orderID uint64, to string, changedBy *uint64, note string, versions []time.Time) (*gen.Order, error)
+}
+
+type Order struct {
+	repo Repo
+}
+
+func New(repo Repo) *Order {
+	return &Order{
+		repo: repo,
+	}
+}
+
+func (u *Order) Checkout(ctx context.Context, userID uint64, req CheckoutRequest) (*gen.Order, error) {
+	orderID, err := u.repo.Checkout(ctx, userID, req)
+	if err != nil {
+		return nil, err
+	}
+	return u.repo.ReadOwn(ctx, userID, orderID)
+}
+
+func (u *Order) ReadOwn(ctx context.Context, userID, orderID uint64) (*gen.Order, error) {
+	return u.repo.ReadOwn(ctx, userID, orderID)
+}
+
+func (u *Order) ListOwn(ctx context.Context, userID uint64, f *Filter) ([]*gen.Order, int, error) {
+	return u.repo.ListOwn(ctx, userID, f)
+}
+
+// Timeline returns the status history of one of the user's own orders.
+func (u *Order) Timeline(ctx context.Context, userID, orderID uint64) ([]*gen.OrderStatusHistory, error) {
+	if _, err := u.repo.ReadOwn(ctx, userID, orderID); err != nil {
+		return nil, err
+	}
+	return u.repo.History(ctx, orderID)
+}
+
+func (u *Order) Read(ctx context.Context, orderID uint64) (*gen.Order, error) {
+	return u.repo.Read(ctx, orderID)
+}
+
+func (u *Order) List(ctx context.Context, f *Filter) ([]*gen.Order, int, error) {
+	if f.Status != "" && !ValidStatus(f.Status) {
+		return nil, 0, ErrUnknownStatus
+	}
+	return u.repo.List(ctx, f)
+}
+
+func (u *Order) History(ctx context.Context, orderID uint64) ([]*gen.OrderStatusHistory, error) {
+	if _, err := u.repo.Read(ctx, orderID); err != nil {
+		return nil, err
+	}
+	return u.repo.History(ctx, orderID)
+}
+
+// Transition moves an order to status to. changedBy is nil for changes made
+// by the system rather than a user. A non-nil versions lists the updated_at
+// the order must still have.
+func (u *Order) Transition(ctx context.Context, orderID uint64, to string, changedBy *uint64, note string, versions []time.Time) (*gen.Order, error) {
+	if setByPayment(to) {
+		return nil, ErrPaymentStatus
+	}
+	if err := u.repo.Transition(ctx, orderID, to, changedBy, note, versions); err != nil {
+		return nil, err
+	}
+	return u.repo.Read(ctx, orderID)
+}
@@ -0,0 +1,117 @@
+package payment
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// FakeDeclineToken makes the fake provider decline an authorization.
+const FakeDeclineToken = "tok_decline"
+
+var errFakeTransaction = errors.New("fake: unknown transaction or invalid state")
+
+// Fake is an in-process payment provider for development and tests. It
+// keeps transactions in memory and signs its webhooks with a shared secret.
+type Fake struct {
+	secret    string
+	tolerance time.Duration
+
+	mu           sync.Mutex
+	transactions map[string]*Result
+}
+
+func NewFake(secret string, tolerance time.Duration) *Fake {
+	return &Fake{
+		secret:       secret,
+		tolerance:    tolerance,
+		transactions: make(map[string]*Result),
+	}
+}
+
+func (f *Fake) Name() string {
+	return "fake"
+}
+
+func (f *Fake) Authorize(_ context.Context, req AuthorizeRequest) (*Result, error) {
+	res := &Result{
+		TransactionID: "fake_" + randomHex(12),
+		Status:        StatusAuthorized,
+		Amount:        req.Amount,
+	}
+	if req.Token == FakeDeclineToken || req.Amount <= 0 {
+		res.Status = StatusFailed
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.transactions[res.TransactionID] = res
+
+	r := *res
+	return &r, nil
+}
+
+func (f *Fake) Capture(_ context.Context, transactionID string, amount float64) (*Result, error) {
+	return f.move(transactionID, amount, StatusAuthorized, StatusCaptured)
+}
+
+func (f *Fake) Refund(_ context.Context, transactionID string, amount float64) (*Result, error) {
+	return f.move(transactionID, amount, StatusCaptured, StatusRefunded)
+}
+
+func (f *Fake) VerifyWebhook(header http.Header, payload []byte) (*Event, error) {
+	err := VerifySignature(f.secret, header.Get(SignatureHeader), payload, f.tolerance, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	var e Event
+	if err = json.Unmarshal(payload, &e); err != nil {
+		return nil, err
+	}
+	if e.ID == "" || e.TransactionID == "" {
+		return nil, ErrInvalidSignature
+	}
+	return &e, nil
+}
+
+// Webhook builds a signed callback for a transaction, as the provider would
+// send it.
+func (f *Fake) Webhook(e Event) (http.Header, []byte, error) {
+	if e.ID == "" {
+		e.ID = "evt_" + randomHex(12)
+	}
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	header := make(http.Header)
+	header.Set(SignatureHeader, Sign(f.secret, time.Now(), payload))
+	return header, payload, nil
+}
+
+func (f *Fake) move(transactionID string, amount float64, from, to string) (*Result, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	t, ok := f.transactions[transactionID]
+	if !ok || t.Status != from || amount > t.Amount {
+		return nil, errFakeTransaction
+	}
+	t.Status = to
+
+	r := *t
+	return &r, nil
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
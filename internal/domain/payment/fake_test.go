@@ -0,0 +1,87 @@
+package payment
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestFake(t *testing.T) {
+	ctx := context.Background()
+	f := NewFake("secret", time.Minute)
+
+	auth, err := f.Authorize(ctx, AuthorizeRequest{OrderID: 1, Amount: 42.5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if auth.Status != StatusAuthorized {
+		t.Fatalf("status = %s, want %s", auth.Status, StatusAuthorized)
+	}
+
+	if _, err = f.Refund(ctx, auth.TransactionID, auth.Amount); err == nil {
+		t.Error("refunded a transaction that was never captured")
+	}
+
+	res, err := f.Capture(ctx, auth.TransactionID, auth.Amount)
+	if err != nil || res.Status != StatusCaptured {
+		t.Fatalf("capture: %v, %v", res, err)
+	}
+	if _, err = f.Capture(ctx, auth.TransactionID, auth.Amount); err == nil {
+		t.Error("captured the same transaction twice")
+	}
+
+	res, err = f.Refund(ctx, auth.TransactionID, auth.Amount)
+	if err != nil || res.Status != StatusRefunded {
+		t.Fatalf("refund: %v, %v", res, err)
+	}
+
+	declined, err := f.Authorize(ctx, AuthorizeRequest{OrderID: 2, Amount: 10, Token: FakeDeclineToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if declined.Status != StatusFailed {
+		t.Errorf("status = %s, want %s", declined.Status, StatusFailed)
+	}
+}
+
+func TestFakeWebhook(t *testing.T) {
+	f := NewFake("secret", time.Minute)
+
+	header, payload, err := f.Webhook(Event{TransactionID: "fake_1", Status: StatusCaptured, Amount: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e, err := f.VerifyWebhook(header, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.ID == "" || e.TransactionID != "fake_1" || e.Status != StatusCaptured {
+		t.Errorf("unexpected event %+v", e)
+	}
+
+	other := NewFake("other", time.Minute)
+	if _, err = other.VerifyWebhook(header, payload); err == nil {
+		t.Error("accepted a webhook signed with another secret")
+	}
+}
+
+func TestApplied(t *testing.T) {
+	tests := []struct {
+		current, to string
+		want        bool
+	}{
+		{StatusAuthorized, StatusAuthorized, true},
+		{StatusCaptured, StatusAuthorized, true},
+		{StatusRefunded, StatusCaptured, true},
+		{StatusAuthorized, StatusCaptured, false},
+		{StatusPending, StatusFailed, false},
+		{StatusFailed, StatusFailed, true},
+	}
+
+	for _, tt := range tests {
+		if got := applied(tt.current, tt.to); got != tt.want {
+			t.Errorf("applied(%s, %s) = %v, want %v", tt.current, tt.to, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package payment
+
+import (
+	"net/url"
+
+	"github.com/thang1834/go-goss/internal/utility/filter"
+)
+
+// Webhook event states accepted by EventFilter.
+const (
+	EventPending   = "pending"
+	EventProcessed = "processed"
+	EventFailed    = "failed"
+)
+
+type EventFilter struct {
+	Base filter.Filter
+
+	Provider string
+	State    string
+}
+
+func EventFilters(queries url.Values) *EventFilter {
+	f := filter.New(queries)
+
+	return &EventFilter{
+		Base:     *f,
+		Provider: queries.Get("provider"),
+		State:    queries.Get("state"),
+	}
+}
@@ -0,0 +1,206 @@
+package payment
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gmhafiz/scs/v2"
+	"github.com/go-playground/validator/v10"
+
+	orderDomain "github.com/thang1834/go-goss/internal/domain/order"
+	"github.com/thang1834/go-goss/internal/middleware"
+	"github.com/thang1834/go-goss/internal/utility/message"
+	"github.com/thang1834/go-goss/internal/utility/param"
+	"github.com/thang1834/go-goss/internal/utility/request"
+	"github.com/thang1834/go-goss/internal/utility/respond"
+	"github.com/thang1834/go-goss/internal/utility/validate"
+)
+
+// maxWebhookSize bounds the webhook body read into memory.
+const maxWebhookSize = 1 << 20
+
+type Handler struct {
+	useCase  UseCase
+	validate *validator.Validate
+	session  *scs.SessionManager
+}
+
+func NewHandler(useCase UseCase, v *validator.Validate, session *scs.SessionManager) *Handler {
+	return &Handler{
+		useCase:  useCase,
+		validate: v,
+		session:  session,
+	}
+}
+
+// Pay pays for one of the current user's pending orders
+// @Summary Pay for an order
+// @Param payment body PayRequest true "order and payment provider"
+// @Success 201 {object} Res
+// @Failure 400
+// @Failure 402 {object} Res
+// @Failure 404
+// @Failure 409
+// @router /api/v1/payments [post]
+func (h *Handler) Pay(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.session.Get(r.Context(), string(middleware.KeyID)).(uint64)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	var req PayRequest
+	err := request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	errs := validate.Validate(h.validate, req)
+	if errs != nil {
+		respond.Errors(w, http.StatusBadRequest, errs)
+		return
+	}
+
+	p, err := h.useCase.Pay(r.Context(), userID, req)
+	if errors.Is(err, ErrDeclined) {
+		respond.Json(w, http.StatusPaymentRequired, Resource(p))
+		return
+	}
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusCreated, Resource(p))
+}
+
+// Refund refunds a captured payment in full
+// @Summary Refund a payment
+// @Param paymentID path int true "payment ID"
+// @Success 200 {object} Res
+// @Failure 400
+// @Failure 404
+// @Failure 409
+// @router /api/v1/manage/payments/{paymentID}/refund [post]
+func (h *Handler) Refund(w http.ResponseWriter, r *http.Request) {
+	paymentID, err := param.UInt64(r, "paymentID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	var changedBy *uint64
+	if userID, ok := h.session.Get(r.Context(), string(middleware.KeyID)).(uint64); ok {
+		changedBy = &userID
+	}
+
+	p, err := h.useCase.Refund(r.Context(), paymentID, changedBy)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, Resource(p))
+}
+
+// Webhook receives a signed callback from a payment provider
+// @Summary Payment provider webhook
+// @Description Verifies the X-Webhook-Signature header, stores the event and applies it once.
+// @Param provider path string true "provider name"
+// @Success 200 {object} EventRes
+// @Failure 400
+// @Failure 401
+// @Failure 404
+// @router /api/v1/webhooks/payments/{provider} [post]
+func (h *Handler) Webhook(w http.ResponseWriter, r *http.Request) {
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookSize))
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ev, err := h.useCase.Receive(r.Context(), param.String(r, "provider"), r.Header, payload)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, EventResource(ev))
+}
+
+// Events lists stored webhook events
+// @Summary List webhook events
+// @Param provider query string false "provider name"
+// @Param state query string false "pending, processed or failed"
+// @Param page query int false "page number"
+// @Param limit query int false "items per page"
+// @Success 200 {object} respond.Standard
+// @router /api/v1/manage/payments/webhooks [get]
+func (h *Handler) Events(w http.ResponseWriter, r *http.Request) {
+	events, total, err := h.useCase.Events(r.Context(), EventFilters(r.URL.Query()))
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	list := EventResources(events)
+	respond.Json(w, http.StatusOK, respond.Standard{
+		Data: list,
+		Meta: respond.Meta{
+			Size:  len(list),
+			Total: total,
+		},
+	})
+}
+
+// Replay processes a stored webhook event again
+// @Summary Replay a webhook event
+// @Param eventID path int true "webhook event ID"
+// @Success 200 {object} EventRes
+// @Failure 404
+// @Failure 409 {object} EventRes
+// @router /api/v1/manage/payments/webhooks/{eventID}/replay [post]
+func (h *Handler) Replay(w http.ResponseWriter, r *http.Request) {
+	eventID, err := param.UInt64(r, "eventID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	ev, err := h.useCase.Replay(r.Context(), eventID)
+	if err != nil {
+		if ev == nil {
+			h.error(w, err)
+			return
+		}
+		respond.Json(w, http.StatusConflict, EventResource(ev))
+		return
+	}
+
+	respond.Json(w, http.StatusOK, EventResource(ev))
+}
+
+// error maps domain errors to their HTTP status code.
+func (h *Handler) error(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrNotFound),
+		errors.Is(err, ErrOrderNotFound),
+		errors.Is(err, ErrEventNotFound),
+		errors.Is(err, ErrUnknownProvider):
+		respond.Error(w, http.StatusNotFound, err)
+	case errors.Is(err, ErrOrderNotPayable),
+		errors.Is(err, ErrNotRefundable),
+		errors.Is(err, ErrOrderNotRefundable),
+		errors.Is(err, ErrDuplicateTransaction),
+		errors.Is(err, ErrInvalidTransition),
+		errors.Is(err, ErrAmountMismatch),
+		errors.Is(err, orderDomain.ErrInvalidTransition):
+		respond.Error(w, http.StatusConflict, err)
+	case errors.Is(err, ErrInvalidSignature), errors.Is(err, ErrStaleWebhook):
+		respond.Error(w, http.StatusUnauthorized, err)
+	default:
+		respond.Error(w, http.StatusInternalServerError, message.ErrInternalError)
+	}
+}
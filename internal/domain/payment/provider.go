@@ -0,0 +1,105 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+var (
+	ErrUnknownProvider  = errors.New("unknown payment provider")
+	ErrDeclined         = errors.New("payment was declined")
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrStaleWebhook     = errors.New("webhook timestamp outside tolerance")
+)
+
+// PaymentProvider is a payment gateway. Implementations talk to a single
+// provider and report every outcome through Result, identified by the
+// provider's transaction ID.
+type PaymentProvider interface {
+	// Name is the provider's identifier, used in URLs and stored as the
+	// payment method.
+	Name() string
+	// Authorize reserves amount on the customer's payment instrument.
+	Authorize(ctx context.Context, req AuthorizeRequest) (*Result, error)
+	// Capture collects a previously authorized transaction.
+	Capture(ctx context.Context, transactionID string, amount float64) (*Result, error)
+	// Refund returns a captured amount to the customer.
+	Refund(ctx context.Context, transactionID string, amount float64) (*Result, error)
+	// VerifyWebhook authenticates an asynchronous callback and decodes it.
+	VerifyWebhook(header http.Header, payload []byte) (*Event, error)
+}
+
+type AuthorizeRequest struct {
+	OrderID uint64
+	Amount  float64
+	// Token identifies the customer's payment instrument at the provider.
+	Token string
+}
+
+// Result is the outcome of a provider call.
+type Result struct {
+	TransactionID string
+	Status        string
+	Amount        float64
+}
+
+// Event is a verified provider callback.
+type Event struct {
+	ID            string  `json:"id"`
+	TransactionID string  `json:"transaction_id"`
+	Status        string  `json:"status"`
+	Amount        float64 `json:"amount"`
+}
+
+// Providers holds the configured payment providers by name.
+type Providers map[string]PaymentProvider
+
+func NewProviders(providers ...PaymentProvider) Providers {
+	p := make(Providers, len(providers))
+	for _, provider := range providers {
+		p[provider.Name()] = provider
+	}
+	return p
+}
+
+func (p Providers) Get(name string) (PaymentProvider, error) {
+	provider, ok := p[name]
+	if !ok {
+		return nil, ErrUnknownProvider
+	}
+	return provider, nil
+}
+
+// Timeout bounds every call to provider by d, so that a slow gateway does not
+// hold the rows locked around it for long.
+func Timeout(provider PaymentProvider, d time.Duration) PaymentProvider {
+	if d <= 0 {
+		return provider
+	}
+	return &timeoutProvider{PaymentProvider: provider, timeout: d}
+}
+
+type timeoutProvider struct {
+	PaymentProvider
+	timeout time.Duration
+}
+
+func (p *timeoutProvider) Authorize(ctx context.Context, req AuthorizeRequest) (*Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	return p.PaymentProvider.Authorize(ctx, req)
+}
+
+func (p *timeoutProvider) Capture(ctx context.Context, transactionID string, amount float64) (*Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	return p.PaymentProvider.Capture(ctx, transactionID, amount)
+}
+
+func (p *timeoutProvider) Refund(ctx context.Context, transactionID string, amount float64) (*Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	return p.PaymentProvider.Refund(ctx, transactionID, amount)
+}
@@ -0,0 +1,40 @@
+package payment
+
+import (
+	"github.com/gmhafiz/scs/v2"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/thang1834/go-goss/internal/domain/authentication"
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+func RegisterHTTPEndPoints(router *chi.Mux, validator *validator.Validate, uc UseCase, session *scs.SessionManager, auth *authentication.Handler) *Handler {
+	h := NewHandler(uc, validator, session)
+
+	router.Route("/api/v1/payments", func(router chi.Router) {
+		router.Use(middleware.Authenticate(session))
+
+		router.Post("/", h.Pay)
+	})
+
+	// Provider callbacks are authenticated by their signature. The server
+	// skips session handling for everything under /api/v1/webhooks/.
+	router.Post("/api/v1/webhooks/payments/{provider}", h.Webhook)
+
+	// Payment management routes
+	router.Route("/api/v1/manage/payments", func(router chi.Router) {
+		router.Use(middleware.Authenticate(session))
+
+		router.With(auth.RequirePermission("payment:refund")).Post("/{paymentID}/refund", h.Refund)
+
+		router.Route("/webhooks", func(router chi.Router) {
+			router.Use(auth.RequirePermission("payment:webhook"))
+
+			router.Get("/", h.Events)
+			router.Post("/{eventID}/replay", h.Replay)
+		})
+	})
+
+	return h
+}
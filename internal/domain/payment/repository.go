@@ -0,0 +1,413 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/ent/gen/order"
+	"github.com/thang1834/go-goss/ent/gen/payment"
+	"github.com/thang1834/go-goss/ent/gen/webhookevent"
+	orderDomain "github.com/thang1834/go-goss/internal/domain/order"
+	"github.com/thang1834/go-goss/internal/utility/money"
+)
+
+var (
+	ErrNotFound             = errors.New("payment not found")
+	ErrOrderNotFound        = errors.New("order not found")
+	ErrOrderNotPayable      = errors.New("order is not awaiting payment")
+	ErrNotRefundable        = errors.New("only captured payments can be refunded")
+	ErrOrderNotRefundable   = errors.New("order cannot be refunded in its current status")
+	ErrDuplicateTransaction = errors.New("transaction has already been recorded")
+	ErrEventNotFound        = errors.New("webhook event not found")
+	ErrAmountMismatch       = errors.New("event amount does not match the payment")
+)
+
+// orderStatus maps payment outcomes to the order status they lead to.
+var orderStatus = map[string]string{
+	StatusCaptured: orderDomain.StatusPaid,
+	StatusRefunded: orderDomain.StatusRefunded,
+}
+
+type Repo interface {
+	Pay(ctx context.Context, userID, orderID uint64, provider PaymentProvider, token string) (*gen.Payment, error)
+	Refund(ctx context.Context, paymentID uint64, provider PaymentProvider, changedBy *uint64) error
+	Read(ctx context.Context, paymentID uint64) (*gen.Payment, error)
+	Apply(ctx context.Context, transactionID, status string, changedBy *uint64) (bool, error)
+
+	StoreEvent(ctx context.Context, provider string, e *Event, payload []byte) (*gen.WebhookEvent, error)
+	Event(ctx context.Context, eventID uint64) (*gen.WebhookEvent, error)
+	Events(ctx context.Context, f *EventFilter) ([]*gen.WebhookEvent, int, error)
+	ProcessEvent(ctx context.Context, eventID uint64, force bool) (bool, error)
+	FailEvent(ctx context.Context, eventID uint64, cause error) error
+}
+
+type repo struct {
+	ent *gen.Client
+}
+
+func NewRepo(ent *gen.Client) *repo {
+	return &repo{
+		ent: ent,
+	}
+}
+
+// Pay authorizes and captures the full amount of one of the user's pending
+// orders through provider. The authorization is recorded before the money is
+// captured, so a capture is never left without a payment row; the capture
+// itself runs outside any transaction and is applied in a transaction of its
+// own.
+//
+// A declined authorization is still recorded and returned together with
+// ErrDeclined. An authorization whose capture fails stays recorded as
+// authorized.
+func (r *repo) Pay(ctx context.Context, userID, orderID uint64, provider PaymentProvider, token string) (*gen.Payment, error) {
+	p, err := r.authorize(ctx, userID, orderID, provider, token)
+	if err != nil {
+		return p, err
+	}
+
+	res, err := provider.Capture(ctx, *p.TransactionID, p.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = r.Apply(ctx, res.TransactionID, res.Status, &userID); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// authorize records an authorization for the order. The order row is locked
+// while the provider is asked, so concurrent attempts to pay it run one after
+// the other and the later ones find the payment already under way.
+func (r *repo) authorize(ctx context.Context, userID, orderID uint64, provider PaymentProvider, token string) (*gen.Payment, error) {
+	tx, err := r.ent.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	o, err := tx.Order.Query().
+		Where(
+			order.IDEQ(orderID),
+			order.UserIDEQ(userID),
+		).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, err
+	}
+	if o.Status != orderDomain.StatusPending {
+		return nil, ErrOrderNotPayable
+	}
+
+	live, err := tx.Payment.Query().
+		Where(
+			payment.OrderIDEQ(o.ID),
+			payment.StatusIn(StatusPending, StatusAuthorized, StatusCaptured),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if live {
+		return nil, ErrOrderNotPayable
+	}
+
+	auth, err := provider.Authorize(ctx, AuthorizeRequest{
+		OrderID: o.ID,
+		Amount:  o.TotalPrice,
+		Token:   token,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := create(ctx, tx, o.ID, provider.Name(), auth)
+	if err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	if auth.Status == StatusFailed {
+		return p, ErrDeclined
+	}
+	return p, nil
+}
+
+// Refund returns a captured payment in full through provider. The payment
+// and its order are locked and checked before the provider is asked, so the
+// money is only returned if the refund can also be recorded. Providers are
+// wrapped in Timeout, which bounds how long the rows stay locked.
+func (r *repo) Refund(ctx context.Context, paymentID uint64, provider PaymentProvider, changedBy *uint64) error {
+	tx, err := r.ent.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	p, err := tx.Payment.Query().
+		Where(payment.IDEQ(paymentID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return ErrNotFound
+		}
+		return err
+	}
+	if p.Status != StatusCaptured || p.TransactionID == nil {
+		return ErrNotRefundable
+	}
+
+	if p.OrderID != 0 {
+		o, err := tx.Order.Query().
+			Where(order.IDEQ(p.OrderID)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil && !gen.IsNotFound(err) {
+			return err
+		}
+		if o != nil && !orderDomain.CanTransition(o.Status, orderDomain.StatusRefunded) {
+			return fmt.Errorf("%w: %s", ErrOrderNotRefundable, o.Status)
+		}
+	}
+
+	res, err := provider.Refund(ctx, *p.TransactionID, p.Amount)
+	if err != nil {
+		return err
+	}
+	if _, err = apply(ctx, tx, res.TransactionID, res.Status, changedBy); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// create records the outcome of an authorization within tx.
+func create(ctx context.Context, tx *gen.Tx, orderID uint64, provider string, res *Result) (*gen.Payment, error) {
+	p, err := tx.Payment.Create().
+		SetOrderID(orderID).
+		SetAmount(res.Amount).
+		SetMethod(provider).
+		SetStatus(res.Status).
+		SetTransactionID(res.TransactionID).
+		Save(ctx)
+	if err != nil {
+		if gen.IsConstraintError(err) {
+			return nil, ErrDuplicateTransaction
+		}
+		return nil, err
+	}
+	return p, nil
+}
+
+func (r *repo) Read(ctx context.Context, paymentID uint64) (*gen.Payment, error) {
+	p, err := r.ent.Payment.Get(ctx, paymentID)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return p, nil
+}
+
+// Apply records a provider outcome for a transaction and moves its order
+// along in the same database transaction. Apply reports false when the
+// outcome had already been applied.
+func (r *repo) Apply(ctx context.Context, transactionID, status string, changedBy *uint64) (bool, error) {
+	tx, err := r.ent.Tx(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	ok, err := apply(ctx, tx, transactionID, status, changedBy)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// StoreEvent adds a verified webhook to the inbox. A delivery of an event
+// that is already stored returns the stored row.
+func (r *repo) StoreEvent(ctx context.Context, provider string, e *Event, payload []byte) (*gen.WebhookEvent, error) {
+	ev, err := r.ent.WebhookEvent.Create().
+		SetProvider(provider).
+		SetEventID(e.ID).
+		SetTransactionID(e.TransactionID).
+		SetStatus(e.Status).
+		SetAmount(e.Amount).
+		SetPayload(string(payload)).
+		Save(ctx)
+	if err == nil {
+		return ev, nil
+	}
+	if !gen.IsConstraintError(err) {
+		return nil, err
+	}
+
+	return r.ent.WebhookEvent.Query().
+		Where(
+			webhookevent.ProviderEQ(provider),
+			webhookevent.EventIDEQ(e.ID),
+		).
+		Only(ctx)
+}
+
+func (r *repo) Event(ctx context.Context, eventID uint64) (*gen.WebhookEvent, error) {
+	ev, err := r.ent.WebhookEvent.Get(ctx, eventID)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, ErrEventNotFound
+		}
+		return nil, err
+	}
+	return ev, nil
+}
+
+func (r *repo) Events(ctx context.Context, f *EventFilter) ([]*gen.WebhookEvent, int, error) {
+	query := r.ent.WebhookEvent.Query()
+
+	if f.Provider != "" {
+		query = query.Where(webhookevent.ProviderEQ(f.Provider))
+	}
+	switch f.State {
+	case EventProcessed:
+		query = query.Where(webhookevent.ProcessedAtNotNil())
+	case EventPending:
+		query = query.Where(webhookevent.ProcessedAtIsNil())
+	case EventFailed:
+		query = query.Where(webhookevent.ProcessedAtIsNil(), webhookevent.LastErrorNotNil())
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Order(gen.Desc(webhookevent.FieldID))
+	if !f.Base.DisablePaging {
+		query = query.Limit(f.Base.Limit).Offset(f.Base.Offset)
+	}
+
+	events, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return events, total, nil
+}
+
+// ProcessEvent applies a stored webhook from the outcome its provider
+// decoded when it was received. The inbox row is locked and marked
+// processed in the same transaction as the payment update, so an event is
+// applied exactly once however often it is delivered. A processed event is
+// skipped unless force is set, which replays it; replays are still subject
+// to the payment's own idempotency.
+func (r *repo) ProcessEvent(ctx context.Context, eventID uint64, force bool) (bool, error) {
+	tx, err := r.ent.Tx(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	ev, err := tx.WebhookEvent.Query().
+		Where(webhookevent.IDEQ(eventID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return false, ErrEventNotFound
+		}
+		return false, err
+	}
+	if ev.ProcessedAt != nil && !force {
+		return false, nil
+	}
+
+	// The payload is kept for inspection only, it is in the provider's
+	// own format.
+	p, err := tx.Payment.Query().
+		Where(payment.TransactionIDEQ(ev.TransactionID)).
+		Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return false, ErrNotFound
+		}
+		return false, err
+	}
+	if money.Round(ev.Amount) != money.Round(p.Amount) {
+		return false, fmt.Errorf("%w: %.2f for %.2f", ErrAmountMismatch, ev.Amount, p.Amount)
+	}
+
+	ok, err := apply(ctx, tx, ev.TransactionID, ev.Status, nil)
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.WebhookEvent.UpdateOneID(ev.ID).
+		AddAttempts(1).
+		ClearLastError().
+		SetProcessedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return ok, tx.Commit()
+}
+
+// FailEvent records a failed attempt to process a stored webhook.
+func (r *repo) FailEvent(ctx context.Context, eventID uint64, cause error) error {
+	return r.ent.WebhookEvent.UpdateOneID(eventID).
+		AddAttempts(1).
+		SetLastError(cause.Error()).
+		Exec(ctx)
+}
+
+// apply moves the payment identified by transactionID to status within tx.
+// The payment row is locked, so an outcome delivered more than once, even
+// concurrently, is applied once; later deliveries report false.
+func apply(ctx context.Context, tx *gen.Tx, transactionID, status string, changedBy *uint64) (bool, error) {
+	p, err := tx.Payment.Query().
+		Where(payment.TransactionIDEQ(transactionID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return false, ErrNotFound
+		}
+		return false, err
+	}
+
+	if applied(p.Status, status) {
+		return false, nil
+	}
+	if !canTransition(p.Status, status) {
+		return false, fmt.Errorf("%w: %s to %s", ErrInvalidTransition, p.Status, status)
+	}
+
+	if err = tx.Payment.UpdateOneID(p.ID).SetStatus(status).Exec(ctx); err != nil {
+		return false, err
+	}
+
+	if to, ok := orderStatus[status]; ok && p.OrderID != 0 {
+		note := fmt.Sprintf("payment %s %s", transactionID, status)
+		err = orderDomain.TransitionTx(ctx, tx, p.OrderID, to, changedBy, note)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,7 @@
+package payment
+
+type PayRequest struct {
+	OrderID  uint64 `json:"order_id" validate:"required"`
+	Provider string `json:"provider" validate:"required,max=50"`
+	Token    string `json:"token" validate:"max=255"`
+}
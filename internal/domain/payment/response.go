@@ -0,0 +1,68 @@
+package payment
+
+import (
+	"time"
+
+	"github.com/thang1834/go-goss/ent/gen"
+)
+
+type Res struct {
+	ID            uint64    `json:"id"`
+	OrderID       uint64    `json:"order_id"`
+	Amount        float64   `json:"amount"`
+	Method        string    `json:"method"`
+	Status        string    `json:"status"`
+	TransactionID string    `json:"transaction_id,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+func Resource(p *gen.Payment) *Res {
+	res := &Res{
+		ID:        p.ID,
+		OrderID:   p.OrderID,
+		Amount:    p.Amount,
+		Method:    p.Method,
+		Status:    p.Status,
+		CreatedAt: p.CreatedAt,
+	}
+	if p.TransactionID != nil {
+		res.TransactionID = *p.TransactionID
+	}
+	return res
+}
+
+type EventRes struct {
+	ID            uint64     `json:"id"`
+	Provider      string     `json:"provider"`
+	EventID       string     `json:"event_id"`
+	TransactionID string     `json:"transaction_id,omitempty"`
+	Status        string     `json:"status,omitempty"`
+	Amount        float64    `json:"amount,omitempty"`
+	Attempts      int        `json:"attempts"`
+	LastError     string     `json:"last_error,omitempty"`
+	ReceivedAt    time.Time  `json:"received_at"`
+	ProcessedAt   *time.Time `json:"processed_at,omitempty"`
+}
+
+func EventResource(ev *gen.WebhookEvent) *EventRes {
+	return &EventRes{
+		ID:            ev.ID,
+		Provider:      ev.Provider,
+		EventID:       ev.EventID,
+		TransactionID: ev.TransactionID,
+		Status:        ev.Status,
+		Amount:        ev.Amount,
+		Attempts:      ev.Attempts,
+		LastError:     ev.LastError,
+		ReceivedAt:    ev.ReceivedAt,
+		ProcessedAt:   ev.ProcessedAt,
+	}
+}
+
+func EventResources(events []*gen.WebhookEvent) []*EventRes {
+	res := make([]*EventRes, 0, len(events))
+	for _, ev := range events {
+		res = append(res, EventResource(ev))
+	}
+	return res
+}
@@ -0,0 +1,67 @@
+package payment
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SignatureHeader carries webhook signatures in the form "t=<unix>,v1=<hex>".
+// The HMAC-SHA256 covers "<unix>.<payload>" so a captured request cannot be
+// replayed with a fresh timestamp.
+const SignatureHeader = "X-Webhook-Signature"
+
+// Sign returns the SignatureHeader value for payload signed at t.
+func Sign(secret string, t time.Time, payload []byte) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+	return fmt.Sprintf("t=%s,v1=%s", ts, mac(secret, ts, payload))
+}
+
+// VerifySignature checks a SignatureHeader value against payload and rejects
+// signatures older or newer than tolerance.
+func VerifySignature(secret, header string, payload []byte, tolerance time.Duration, now time.Time) error {
+	var ts, sig string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "t":
+			ts = value
+		case "v1":
+			sig = value
+		}
+	}
+	if ts == "" || sig == "" {
+		return ErrInvalidSignature
+	}
+
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if d := now.Sub(time.Unix(unix, 0)); d > tolerance || d < -tolerance {
+		return ErrStaleWebhook
+	}
+
+	want, err := hex.DecodeString(mac(secret, ts, payload))
+	if err != nil {
+		return err
+	}
+	got, err := hex.DecodeString(sig)
+	if err != nil || !hmac.Equal(got, want) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+func mac(secret, ts string, payload []byte) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(ts))
+	h.Write([]byte("."))
+	h.Write(payload)
+	return hex.EncodeToString(h.Sum(nil))
+}
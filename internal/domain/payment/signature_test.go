@@ -0,0 +1,41 @@
+package payment
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestVerifySignature(t *testing.T) {
+	const secret = "s3cret"
+	now := time.Unix(1_800_000_000, 0)
+	payload := []byte(`{"id":"evt_1"}`)
+	header := Sign(secret, now, payload)
+
+	tests := []struct {
+		name    string
+		secret  string
+		header  string
+		payload []byte
+		now     time.Time
+		err     error
+	}{
+		{"valid", secret, header, payload, now, nil},
+		{"within tolerance", secret, header, payload, now.Add(4 * time.Minute), nil},
+		{"too old", secret, header, payload, now.Add(6 * time.Minute), ErrStaleWebhook},
+		{"from the future", secret, header, payload, now.Add(-6 * time.Minute), ErrStaleWebhook},
+		{"wrong secret", "other", header, payload, now, ErrInvalidSignature},
+		{"tampered payload", secret, header, []byte(`{"id":"evt_2"}`), now, ErrInvalidSignature},
+		{"missing signature", secret, "t=1800000000", payload, now, ErrInvalidSignature},
+		{"garbage", secret, "nonsense", payload, now, ErrInvalidSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifySignature(tt.secret, tt.header, tt.payload, 5*time.Minute, tt.now)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("err = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package payment
+
+import "errors"
+
+const (
+	StatusPending    = "pending"
+	StatusAuthorized = "authorized"
+	StatusCaptured   = "captured"
+	StatusRefunded   = "refunded"
+	StatusFailed     = "failed"
+)
+
+var ErrInvalidTransition = errors.New("payment cannot move to the requested status")
+
+// transitions lists the statuses each payment status may move to.
+var transitions = map[string][]string{
+	StatusPending:    {StatusAuthorized, StatusCaptured, StatusFailed},
+	StatusAuthorized: {StatusCaptured, StatusFailed},
+	StatusCaptured:   {StatusRefunded},
+	StatusRefunded:   nil,
+	StatusFailed:     nil,
+}
+
+// progress orders the successful statuses. An outcome that does not move a
+// payment forward has already been applied.
+var progress = map[string]int{
+	StatusPending:    0,
+	StatusAuthorized: 1,
+	StatusCaptured:   2,
+	StatusRefunded:   3,
+}
+
+// applied reports whether a payment in status current already reflects
+// outcome to, as happens when a provider delivers the same result twice or
+// out of order.
+func applied(current, to string) bool {
+	if current == to {
+		return true
+	}
+	c, ok := progress[current]
+	t, known := progress[to]
+	return ok && known && t < c
+}
+
+func canTransition(from, to string) bool {
+	for _, next := range transitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
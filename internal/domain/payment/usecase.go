@@ -0,0 +1,136 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/thang1834/go-goss/ent/gen"
+)
+
+type UseCase interface {
+	Pay(ctx context.Context, userID uint64, req PayRequest) (*gen.Payment, error)
+	Refund(ctx context.Context, paymentID uint64, changedBy *uint64) (*gen.Payment, error)
+	Process(ctx context.Context, transactionID, status string, changedBy *uint64) (bool, error)
+
+	Receive(ctx context.Context, provider string, header http.Header, payload []byte) (*gen.WebhookEvent, error)
+	Replay(ctx context.Context, eventID uint64) (*gen.WebhookEvent, error)
+	Events(ctx context.Context, f *EventFilter) ([]*gen.WebhookEvent, int, error)
+}
+
+type Payment struct {
+	repo      Repo
+	providers Providers
+}
+
+func New(repo Repo, providers Providers) *Payment {
+	return &Payment{
+		repo:      repo,
+		providers: providers,
+	}
+}
+
+// Pay authorizes and captures the full amount of a pending order. A declined
+// authorization is still recorded and returned together with ErrDeclined.
+func (u *Payment) Pay(ctx context.Context, userID uint64, req PayRequest) (*gen.Payment, error) {
+	provider, err := u.providers.Get(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := u.repo.Pay(ctx, userID, req.OrderID, provider, req.Token)
+	if err != nil {
+		return p, err
+	}
+
+	return u.repo.Read(ctx, p.ID)
+}
+
+// Refund returns a captured payment in full through the provider that took it.
+func (u *Payment) Refund(ctx context.Context, paymentID uint64, changedBy *uint64) (*gen.Payment, error) {
+	p, err := u.repo.Read(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+	if p.Status != StatusCaptured || p.TransactionID == nil {
+		return nil, ErrNotRefundable
+	}
+
+	provider, err := u.providers.Get(p.Method)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.repo.Refund(ctx, p.ID, provider, changedBy); err != nil {
+		return nil, err
+	}
+
+	return u.repo.Read(ctx, p.ID)
+}
+
+// Process applies a provider outcome. Outcomes already applied to the
+// transaction are ignored and reported as false.
+func (u *Payment) Process(ctx context.Context, transactionID, status string, changedBy *uint64) (bool, error) {
+	return u.repo.Apply(ctx, transactionID, status, changedBy)
+}
+
+// Receive verifies a provider callback, stores it in the inbox and processes
+// it. Once stored, a failure to process is recorded on the event instead of
+// being returned, so it can be replayed later.
+func (u *Payment) Receive(ctx context.Context, provider string, header http.Header, payload []byte) (*gen.WebhookEvent, error) {
+	p, err := u.providers.Get(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := p.VerifyWebhook(header, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	ev, err := u.repo.StoreEvent(ctx, p.Name(), e, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.processEvent(ctx, ev.ID, false); err != nil {
+		log.Printf("webhook event %d: %v", ev.ID, err)
+	}
+
+	return u.repo.Event(ctx, ev.ID)
+}
+
+// Replay processes a stored webhook again, whether or not it succeeded
+// before.
+func (u *Payment) Replay(ctx context.Context, eventID uint64) (*gen.WebhookEvent, error) {
+	if _, err := u.repo.Event(ctx, eventID); err != nil {
+		return nil, err
+	}
+
+	if err := u.processEvent(ctx, eventID, true); err != nil {
+		ev, readErr := u.repo.Event(ctx, eventID)
+		if readErr != nil {
+			return nil, readErr
+		}
+		return ev, err
+	}
+
+	return u.repo.Event(ctx, eventID)
+}
+
+func (u *Payment) Events(ctx context.Context, f *EventFilter) ([]*gen.WebhookEvent, int, error) {
+	return u.repo.Events(ctx, f)
+}
+
+func (u *Payment) processEvent(ctx context.Context, eventID uint64, force bool) error {
+	_, err := u.repo.ProcessEvent(ctx, eventID, force)
+	if err == nil {
+		return nil
+	}
+
+	if failErr := u.repo.FailEvent(ctx, eventID, err); failErr != nil {
+		return errors.Join(err, failErr)
+	}
+	return err
+}
@@ -0,0 +1,40 @@
+package product
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/thang1834/go-goss/internal/utility/filter"
+)
+
+type Filter struct {
+	Base filter.Filter
+
+	Name       string
+	CategoryID uint64
+	// Category is a category slug. Products of all its descendant
+	// categories are included as well.
+	Category string
+	MinPrice float64
+	MaxPrice float64
+}
+
+func Filters(queries url.Values) *Filter {
+	f := filter.New(queries)
+	if queries.Has("name") {
+		f.Search = true
+	}
+
+	categoryID, _ := strconv.ParseUint(queries.Get("category_id"), 10, 64)
+	minPrice, _ := strconv.ParseFloat(queries.Get("min_price"), 64)
+	maxPrice, _ := strconv.ParseFloat(queries.Get("max_price"), 64)
+
+	return &Filter{
+		Base:       *f,
+		Name:       queries.Get("name"),
+		CategoryID: categoryID,
+		Category:   queries.Get("category"),
+		MinPrice:   minPrice,
+		MaxPrice:   maxPrice,
+	}
+}
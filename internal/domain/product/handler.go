@@ -0,0 +1,209 @@
+package product
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/thang1834/go-goss/internal/utility/message"
+	"github.com/thang1834/go-goss/internal/utility/param"
+	"github.com/thang1834/go-goss/internal/utility/request"
+	"github.com/thang1834/go-goss/internal/utility/respond"
+	"github.com/thang1834/go-goss/internal/utility/validate"
+)
+
+type Handler struct {
+	useCase  UseCase
+	validate *validator.Validate
+}
+
+func NewHandler(useCase UseCase, v *validator.Validate) *Handler {
+	return &Handler{
+		useCase:  useCase,
+		validate: v,
+	}
+}
+
+// List lists products with pagination and filtering
+// @Summary List products
+// @Description Lists products. Filter by name, category_id, category (slug, includes sub-categories), min_price and max_price. Sort by name, price, created_at or avg_rating.
+// @Param page query int false "page number"
+// @Param limit query int false "items per page"
+// @Param sort query string false "e.g. price,desc"
+// @Success 200 {object} respond.Standard
+// @Failure 500
+// @router /api/v1/products [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	f := Filters(r.URL.Query())
+
+	products, total, err := h.useCase.List(r.Context(), f)
+	if err != nil {
+		respond.Error(w, http.StatusInternalServerError, message.ErrInternalError)
+		return
+	}
+
+	list := Resources(products)
+	respond.JsonWithETag(w, r, http.StatusOK, respond.Standard{
+		Data: list,
+		Meta: respond.Meta{
+			Size:  len(list),
+			Total: total,
+		},
+	}, "")
+}
+
+// Get returns a single product by ID
+// @Summary Get a product
+// @Param productID path int true "product ID"
+// @Success 200 {object} Res
+// @Success 304
+// @Failure 400
+// @Failure 404
+// @router /api/v1/products/{productID} [get]
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	productID, err := param.UInt64(r, "productID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	p, err := h.useCase.Read(r.Context(), productID)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.JsonWithETag(w, r, http.StatusOK, Resource(p), respond.ETag(p.UpdatedAt))
+}
+
+// GetBySlug returns a single product by its slug
+// @Summary Get a product by slug
+// @Param slug path string true "product slug"
+// @Success 200 {object} Res
+// @Success 304
+// @Failure 404
+// @router /api/v1/products/slug/{slug} [get]
+func (h *Handler) GetBySlug(w http.ResponseWriter, r *http.Request) {
+	p, err := h.useCase.ReadBySlug(r.Context(), param.String(r, "slug"))
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.JsonWithETag(w, r, http.StatusOK, Resource(p), respond.ETag(p.UpdatedAt))
+}
+
+// Create creates a new product. Slug is generated from name when omitted.
+// @Summary Create a product
+// @Param product body CreateRequest true "product"
+// @Success 201 {object} Res
+// @Failure 400
+// @Failure 409
+// @router /api/v1/manage/products [post]
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	var req CreateRequest
+	err := request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	errs := validate.Validate(h.validate, req)
+	if errs != nil {
+		respond.Errors(w, http.StatusBadRequest, errs)
+		return
+	}
+
+	p, err := h.useCase.Create(r.Context(), req)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.JsonWithETag(w, r, http.StatusCreated, Resource(p), respond.ETag(p.UpdatedAt))
+}
+
+// Update replaces a product's attributes. An omitted slug keeps the current one.
+// With an If-Match header, the product is only updated if its ETag is listed.
+// @Summary Update a product
+// @Param productID path int true "product ID"
+// @Param If-Match header string false "ETag of the product as last read"
+// @Param product body UpdateRequest true "product"
+// @Success 200 {object} Res
+// @Failure 400
+// @Failure 404
+// @Failure 409
+// @Failure 412
+// @router /api/v1/manage/products/{productID} [put]
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	productID, err := param.UInt64(r, "productID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	var req UpdateRequest
+	err = request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	errs := validate.Validate(h.validate, req)
+	if errs != nil {
+		respond.Errors(w, http.StatusBadRequest, errs)
+		return
+	}
+
+	versions, _ := request.IfMatch(r)
+
+	p, err := h.useCase.Update(r.Context(), productID, req, versions)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.JsonWithETag(w, r, http.StatusOK, Resource(p), respond.ETag(p.UpdatedAt))
+}
+
+// Delete removes a product
+// @Summary Delete a product
+// @Param productID path int true "product ID"
+// @Success 204
+// @Failure 404
+// @Failure 409
+// @router /api/v1/manage/products/{productID} [delete]
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	productID, err := param.UInt64(r, "productID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	err = h.useCase.Delete(r.Context(), productID)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Status(w, http.StatusNoContent)
+}
+
+// error maps domain errors to their HTTP status code.
+func (h *Handler) error(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		respond.Error(w, http.StatusNotFound, err)
+	case errors.Is(err, ErrSlugTaken), errors.Is(err, ErrProductInUse):
+		respond.Error(w, http.StatusConflict, err)
+	case errors.Is(err, message.ErrPreconditionFailed):
+		respond.Error(w, http.StatusPreconditionFailed, err)
+	case errors.Is(err, ErrInvalidSlug),
+		errors.Is(err, ErrSlugFromName),
+		errors.Is(err, ErrCategoryNotFound):
+		respond.Error(w, http.StatusBadRequest, err)
+	default:
+		respond.Error(w, http.StatusInternalServerError, message.ErrInternalError)
+	}
+}
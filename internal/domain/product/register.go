@@ -0,0 +1,33 @@
+package product
+
+import (
+	"github.com/gmhafiz/scs/v2"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/thang1834/go-goss/internal/domain/authentication"
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+func RegisterHTTPEndPoints(router *chi.Mux, validator *validator.Validate, uc UseCase, session *scs.SessionManager, auth *authentication.Handler) *Handler {
+	h := NewHandler(uc, validator)
+
+	// Public catalog routes
+	router.Route("/api/v1/products", func(router chi.Router) {
+		router.Get("/", h.List)
+		router.Get("/{productID}", h.Get)
+		router.Get("/slug/{slug}", h.GetBySlug)
+	})
+
+	// Product management routes
+	router.Route("/api/v1/manage/products", func(router chi.Router) {
+		router.Use(middleware.Authenticate(session))
+		router.Use(auth.RequirePermission("product:write"))
+
+		router.Post("/", h.Create)
+		router.Put("/{productID}", h.Update)
+		router.Delete("/{productID}", h.Delete)
+	})
+
+	return h
+}
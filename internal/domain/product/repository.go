@@ -0,0 +1,234 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/ent/gen/category"
+	"github.com/thang1834/go-goss/ent/gen/predicate"
+	"github.com/thang1834/go-goss/ent/gen/product"
+	"github.com/thang1834/go-goss/internal/utility/message"
+)
+
+var (
+	ErrNotFound         = errors.New("product not found")
+	ErrSlugTaken        = errors.New("slug is already taken")
+	ErrCategoryNotFound = errors.New("category not found")
+	ErrProductInUse     = errors.New("product is referenced by existing orders or carts")
+)
+
+// sortable whitelists the columns a client may sort the product list by.
+var sortable = map[string]string{
+	"name":       product.FieldName,
+	"price":      product.FieldPrice,
+	"created_at": product.FieldCreatedAt,
+	"avg_rating": product.FieldAvgRating,
+}
+
+type Repo interface {
+	List(ctx context.Context, f *Filter) ([]*gen.Product, int, error)
+	Read(ctx context.Context, productID uint64) (*gen.Product, error)
+	ReadBySlug(ctx context.Context, slug string) (*gen.Product, error)
+	Create(ctx context.Context, req CreateRequest) (*gen.Product, error)
+	Update(ctx context.Context, productID uint64, req UpdateRequest, versions []time.Time) (*gen.Product, error)
+	Delete(ctx context.Context, productID uint64) error
+
+	SlugExists(ctx context.Context, slug string, exceptID uint64) (bool, error)
+	CategoryExists(ctx context.Context, categoryID uint64) (bool, error)
+}
+
+type repo struct {
+	ent *gen.Client
+}
+
+func NewRepo(ent *gen.Client) *repo {
+	return &repo{
+		ent: ent,
+	}
+}
+
+func (r *repo) List(ctx context.Context, f *Filter) ([]*gen.Product, int, error) {
+	query := r.ent.Product.Query()
+
+	if f.Name != "" {
+		query = query.Where(product.NameContainsFold(f.Name))
+	}
+	if f.CategoryID != 0 {
+		query = query.Where(product.CategoryIDEQ(f.CategoryID))
+	}
+	if f.Category != "" {
+		query = query.Where(inCategoryTree(f.Category))
+	}
+	if f.MinPrice > 0 {
+		query = query.Where(product.PriceGTE(f.MinPrice))
+	}
+	if f.MaxPrice > 0 {
+		query = query.Where(product.PriceLTE(f.MaxPrice))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for key, order := range f.Base.Sort {
+		column, ok := sortable[key]
+		if !ok {
+			continue
+		}
+		if order == "DESC" {
+			query = query.Order(gen.Desc(column))
+		} else {
+			query = query.Order(gen.Asc(column))
+		}
+	}
+	query = query.Order(gen.Asc(product.FieldID))
+
+	if !f.Base.DisablePaging {
+		query = query.Limit(f.Base.Limit).Offset(f.Base.Offset)
+	}
+
+	products, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return products, total, nil
+}
+
+func (r *repo) Read(ctx context.Context, productID uint64) (*gen.Product, error) {
+	p, err := r.ent.Product.Get(ctx, productID)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return p, nil
+}
+
+func (r *repo) ReadBySlug(ctx context.Context, slug string) (*gen.Product, error) {
+	p, err := r.ent.Product.Query().Where(product.SlugEQ(slug)).Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return p, nil
+}
+
+func (r *repo) Create(ctx context.Context, req CreateRequest) (*gen.Product, error) {
+	builder := r.ent.Product.Create().
+		SetName(req.Name).
+		SetSlug(req.Slug).
+		SetDescription(req.Description).
+		SetPrice(req.Price).
+		SetStockQuantity(req.StockQuantity).
+		SetNillableCategoryID(req.CategoryID)
+
+	p, err := builder.Save(ctx)
+	if err != nil {
+		if gen.IsConstraintError(err) {
+			return nil, ErrSlugTaken
+		}
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Update changes a product. With versions set, the product is only changed
+// if its updated_at is one of them, and ErrPreconditionFailed is returned
+// otherwise.
+func (r *repo) Update(ctx context.Context, productID uint64, req UpdateRequest, versions []time.Time) (*gen.Product, error) {
+	builder := r.ent.Product.UpdateOneID(productID).
+		SetName(req.Name).
+		SetSlug(req.Slug).
+		SetDescription(req.Description).
+		SetPrice(req.Price).
+		SetStockQuantity(req.StockQuantity).
+		SetUpdatedAt(time.Now())
+
+	if req.CategoryID != nil {
+		builder = builder.SetCategoryID(*req.CategoryID)
+	} else {
+		builder = builder.ClearCategoryID()
+	}
+
+	if versions != nil {
+		builder = builder.Where(product.UpdatedAtIn(versions...))
+	}
+
+	p, err := builder.Save(ctx)
+	if err != nil {
+		switch {
+		case gen.IsNotFound(err) && versions != nil:
+			exists, existsErr := r.ent.Product.Query().Where(product.IDEQ(productID)).Exist(ctx)
+			if existsErr != nil {
+				return nil, existsErr
+			}
+			if exists {
+				return nil, message.ErrPreconditionFailed
+			}
+			return nil, ErrNotFound
+		case gen.IsNotFound(err):
+			return nil, ErrNotFound
+		case gen.IsConstraintError(err):
+			return nil, ErrSlugTaken
+		}
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (r *repo) Delete(ctx context.Context, productID uint64) error {
+	err := r.ent.Product.DeleteOneID(productID).Exec(ctx)
+	if err != nil {
+		switch {
+		case gen.IsNotFound(err):
+			return ErrNotFound
+		case gen.IsConstraintError(err):
+			return ErrProductInUse
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *repo) SlugExists(ctx context.Context, slug string, exceptID uint64) (bool, error) {
+	return r.ent.Product.Query().
+		Where(
+			product.SlugEQ(slug),
+			product.IDNEQ(exceptID),
+		).
+		Exist(ctx)
+}
+
+func (r *repo) CategoryExists(ctx context.Context, categoryID uint64) (bool, error) {
+	return r.ent.Category.Query().Where(category.IDEQ(categoryID)).Exist(ctx)
+}
+
+// inCategoryTree matches products that belong to the category with the given
+// slug or to any of its descendants, walking categories.parent_id with a
+// recursive CTE.
+func inCategoryTree(categorySlug string) predicate.Product {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString(s.C(product.FieldCategoryID)).
+				WriteString(` IN (
+				WITH RECURSIVE tree AS (
+					SELECT id FROM categories WHERE slug = `).Arg(categorySlug).
+				WriteString(`
+					UNION
+					SELECT c.id FROM categories c JOIN tree t ON c.parent_id = t.id
+				)
+				SELECT id FROM tree)`)
+		}))
+	}
+}
@@ -0,0 +1,19 @@
+package product
+
+type CreateRequest struct {
+	Name          string  `json:"name" validate:"required,max=200"`
+	Slug          string  `json:"slug,omitempty" validate:"omitempty,max=200"`
+	Description   string  `json:"description,omitempty"`
+	Price         float64 `json:"price" validate:"gte=0"`
+	StockQuantity int     `json:"stock_quantity" validate:"gte=0"`
+	CategoryID    *uint64 `json:"category_id,omitempty"`
+}
+
+type UpdateRequest struct {
+	Name          string  `json:"name" validate:"required,max=200"`
+	Slug          string  `json:"slug,omitempty" validate:"omitempty,max=200"`
+	Description   string  `json:"description,omitempty"`
+	Price         float64 `json:"price" validate:"gte=0"`
+	StockQuantity int     `json:"stock_quantity" validate:"gte=0"`
+	CategoryID    *uint64 `json:"category_id,omitempty"`
+}
@@ -0,0 +1,45 @@
+package product
+
+import (
+	"time"
+
+	"github.com/thang1834/go-goss/ent/gen"
+)
+
+type Res struct {
+	ID            uint64    `json:"id"`
+	Name          string    `json:"name"`
+	Slug          string    `json:"slug"`
+	Description   string    `json:"description,omitempty"`
+	Price         float64   `json:"price"`
+	StockQuantity int       `json:"stock_quantity"`
+	AvgRating     float64   `json:"avg_rating"`
+	ReviewCount   int       `json:"review_count"`
+	CategoryID    uint64    `json:"category_id,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+func Resource(p *gen.Product) *Res {
+	return &Res{
+		ID:            p.ID,
+		Name:          p.Name,
+		Slug:          p.Slug,
+		Description:   p.Description,
+		Price:         p.Price,
+		StockQuantity: p.StockQuantity,
+		AvgRating:     p.AvgRating,
+		ReviewCount:   p.ReviewCount,
+		CategoryID:    p.CategoryID,
+		CreatedAt:     p.CreatedAt,
+		UpdatedAt:     p.UpdatedAt,
+	}
+}
+
+func Resources(products []*gen.Product) []*Res {
+	res := make([]*Res, 0, len(products))
+	for _, p := range products {
+		res = append(res, Resource(p))
+	}
+	return res
+}
@@ -0,0 +1,135 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/internal/utility/slug"
+)
+
+var (
+	ErrInvalidSlug  = errors.New("slug may only contain lowercase letters, digits and hyphens")
+	ErrSlugFromName = errors.New("unable to generate a slug from name")
+)
+
+type UseCase interface {
+	List(ctx context.Context, f *Filter) ([]*gen.Product, int, error)
+	Read(ctx context.Context, productID uint64) (*gen.Product, error)
+	ReadBySlug(ctx context.Context, slug string) (*gen.Product, error)
+	Create(ctx context.Context, req CreateRequest) (*gen.Product, error)
+	Update(ctx context.Context, productID uint64, req UpdateRequest, versions []time.Time) (*gen.Product, error)
+	Delete(ctx context.Context, productID uint64) error
+}
+
+type Product struct {
+	repo Repo
+}
+
+func New(repo Repo) *Product {
+	return &Product{
+		repo: repo,
+	}
+}
+
+func (u *Product) List(ctx context.Context, f *Filter) ([]*gen.Product, int, error) {
+	return u.repo.List(ctx, f)
+}
+
+func (u *Product) Read(ctx context.Context, productID uint64) (*gen.Product, error) {
+	return u.repo.Read(ctx, productID)
+}
+
+func (u *Product) ReadBySlug(ctx context.Context, slug string) (*gen.Product, error) {
+	return u.repo.ReadBySlug(ctx, slug)
+}
+
+func (u *Product) Create(ctx context.Context, req CreateRequest) (*gen.Product, error) {
+	s, err := u.resolveSlug(ctx, req.Slug, req.Name, 0)
+	if err != nil {
+		return nil, err
+	}
+	req.Slug = s
+
+	if err := u.checkCategory(ctx, req.CategoryID); err != nil {
+		return nil, err
+	}
+
+	return u.repo.Create(ctx, req)
+}
+
+// Update changes a product. A nil versions changes it whatever its
+// updated_at, otherwise it must be one of versions.
+func (u *Product) Update(ctx context.Context, productID uint64, req UpdateRequest, versions []time.Time) (*gen.Product, error) {
+	current, err := u.repo.Read(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	// An omitted slug keeps the existing one so that renaming a product does
+	// not break links that are already out there.
+	if req.Slug == "" {
+		req.Slug = current.Slug
+	} else if req.Slug != current.Slug {
+		s, err := u.resolveSlug(ctx, req.Slug, req.Name, productID)
+		if err != nil {
+			return nil, err
+		}
+		req.Slug = s
+	}
+
+	if err := u.checkCategory(ctx, req.CategoryID); err != nil {
+		return nil, err
+	}
+
+	return u.repo.Update(ctx, productID, req, versions)
+}
+
+func (u *Product) Delete(ctx context.Context, productID uint64) error {
+	return u.repo.Delete(ctx, productID)
+}
+
+// resolveSlug validates a client supplied slug, or generates one from name
+// when none is given. Generated slugs get a numeric suffix on conflict while
+// an explicit slug that is already taken is reported as ErrSlugTaken.
+func (u *Product) resolveSlug(ctx context.Context, requested, name string, exceptID uint64) (string, error) {
+	if requested != "" {
+		if !slug.Valid(requested) {
+			return "", ErrInvalidSlug
+		}
+		exists, err := u.repo.SlugExists(ctx, requested, exceptID)
+		if err != nil {
+			return "", err
+		}
+		if exists {
+			return "", ErrSlugTaken
+		}
+		return requested, nil
+	}
+
+	s, err := slug.Unique(ctx, name, func(ctx context.Context, candidate string) (bool, error) {
+		return u.repo.SlugExists(ctx, candidate, exceptID)
+	})
+	switch {
+	case errors.Is(err, slug.ErrEmpty):
+		return "", ErrSlugFromName
+	case errors.Is(err, slug.ErrExhausted):
+		return "", ErrSlugTaken
+	}
+	return s, err
+}
+
+func (u *Product) checkCategory(ctx context.Context, categoryID *uint64) error {
+	if categoryID == nil {
+		return nil
+	}
+	exists, err := u.repo.CategoryExists(ctx, *categoryID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrCategoryNotFound
+	}
+	return nil
+}
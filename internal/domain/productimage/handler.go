@@ -0,0 +1,219 @@
+package productimage
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gmhafiz/scs/v2"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/thang1834/go-goss/internal/utility/message"
+	"github.com/thang1834/go-goss/internal/utility/param"
+	"github.com/thang1834/go-goss/internal/utility/request"
+	"github.com/thang1834/go-goss/internal/utility/respond"
+	"github.com/thang1834/go-goss/internal/utility/validate"
+)
+
+const (
+	// maxRequestSize caps the whole multipart body. The usecase enforces
+	// the configured limit on the image itself.
+	maxRequestSize = 32 << 20
+	maxMemory      = 8 << 20
+	formField      = "image"
+)
+
+type Handler struct {
+	useCase  UseCase
+	validate *validator.Validate
+	session  *scs.SessionManager
+}
+
+func NewHandler(useCase UseCase, v *validator.Validate, session *scs.SessionManager) *Handler {
+	return &Handler{
+		useCase:  useCase,
+		validate: v,
+		session:  session,
+	}
+}
+
+// List returns a product's images in display order
+// @Summary List product images
+// @Param productID path int true "product ID"
+// @Success 200 {array} Res
+// @Failure 404
+// @router /api/v1/products/{productID}/images [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	productID, err := param.UInt64(r, "productID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	images, err := h.useCase.List(r.Context(), productID)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, Resources(images))
+}
+
+// Upload stores a new product image and its thumbnails
+// @Summary Upload product image
+// @Accept multipart/form-data
+// @Param productID path int true "product ID"
+// @Param image formData file true "jpeg, png or gif"
+// @Param primary formData bool false "make this the primary image"
+// @Success 201 {object} Res
+// @Failure 400
+// @Failure 404
+// @Failure 413
+// @Failure 415
+// @router /api/v1/manage/products/{productID}/images [post]
+func (h *Handler) Upload(w http.ResponseWriter, r *http.Request) {
+	productID, err := param.UInt64(r, "productID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxRequestSize)
+	if err = r.ParseMultipartForm(maxMemory); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			respond.Error(w, http.StatusRequestEntityTooLarge, ErrTooLarge)
+			return
+		}
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, _, err := r.FormFile(formField)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+	defer file.Close()
+
+	primary, _ := strconv.ParseBool(r.FormValue("primary"))
+
+	img, err := h.useCase.Upload(r.Context(), productID, file, primary)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusCreated, Resource(img))
+}
+
+// SetPrimary makes an image the product's primary image
+// @Summary Set primary product image
+// @Param productID path int true "product ID"
+// @Param imageID path int true "image ID"
+// @Success 204
+// @Failure 404
+// @router /api/v1/manage/products/{productID}/images/{imageID}/primary [put]
+func (h *Handler) SetPrimary(w http.ResponseWriter, r *http.Request) {
+	productID, err := param.UInt64(r, "productID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+	imageID, err := param.UInt64(r, "imageID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	if err = h.useCase.SetPrimary(r.Context(), productID, imageID); err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Status(w, http.StatusNoContent)
+}
+
+// Reorder sets the display order of a product's images
+// @Summary Reorder product images
+// @Param productID path int true "product ID"
+// @Param order body ReorderRequest true "every image ID in the new order"
+// @Success 200 {array} Res
+// @Failure 400
+// @Failure 404
+// @Failure 422
+// @router /api/v1/manage/products/{productID}/images/order [put]
+func (h *Handler) Reorder(w http.ResponseWriter, r *http.Request) {
+	productID, err := param.UInt64(r, "productID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	var req ReorderRequest
+	err = request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	errs := validate.Validate(h.validate, req)
+	if errs != nil {
+		respond.Errors(w, http.StatusBadRequest, errs)
+		return
+	}
+
+	images, err := h.useCase.Reorder(r.Context(), productID, req)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, Resources(images))
+}
+
+// Delete removes an image and its stored files
+// @Summary Delete product image
+// @Param productID path int true "product ID"
+// @Param imageID path int true "image ID"
+// @Success 204
+// @Failure 404
+// @router /api/v1/manage/products/{productID}/images/{imageID} [delete]
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	productID, err := param.UInt64(r, "productID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+	imageID, err := param.UInt64(r, "imageID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	if err = h.useCase.Delete(r.Context(), productID, imageID); err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Status(w, http.StatusNoContent)
+}
+
+// error maps domain errors to their HTTP status code.
+func (h *Handler) error(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrNotFound), errors.Is(err, ErrProductNotFound):
+		respond.Error(w, http.StatusNotFound, err)
+	case errors.Is(err, ErrTooLarge):
+		respond.Error(w, http.StatusRequestEntityTooLarge, err)
+	case errors.Is(err, ErrUnsupportedType):
+		respond.Error(w, http.StatusUnsupportedMediaType, err)
+	case errors.Is(err, ErrInvalidImage):
+		respond.Error(w, http.StatusBadRequest, err)
+	case errors.Is(err, ErrInvalidOrder):
+		respond.Error(w, http.StatusUnprocessableEntity, err)
+	default:
+		respond.Error(w, http.StatusInternalServerError, message.ErrInternalError)
+	}
+}
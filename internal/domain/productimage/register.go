@@ -0,0 +1,28 @@
+package productimage
+
+import (
+	"github.com/gmhafiz/scs/v2"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/thang1834/go-goss/internal/domain/authentication"
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+func RegisterHTTPEndPoints(router *chi.Mux, validator *validator.Validate, uc UseCase, session *scs.SessionManager, auth *authentication.Handler) *Handler {
+	h := NewHandler(uc, validator, session)
+
+	router.Get("/api/v1/products/{productID}/images", h.List)
+
+	router.Route("/api/v1/manage/products/{productID}/images", func(router chi.Router) {
+		router.Use(middleware.Authenticate(session))
+		router.Use(auth.RequirePermission("product:write"))
+
+		router.Post("/", h.Upload)
+		router.Put("/order", h.Reorder)
+		router.Put("/{imageID}/primary", h.SetPrimary)
+		router.Delete("/{imageID}", h.Delete)
+	})
+
+	return h
+}
@@ -0,0 +1,239 @@
+package productimage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/ent/gen/product"
+	"github.com/thang1834/go-goss/ent/gen/productimage"
+)
+
+var (
+	ErrNotFound        = errors.New("image not found")
+	ErrProductNotFound = errors.New("product not found")
+	ErrInvalidOrder    = errors.New("image_ids must list every image of the product exactly once")
+)
+
+// Image describes a stored upload before it gets a row.
+type Image struct {
+	URL         string
+	StorageKey  string
+	ContentType string
+	Thumbnails  map[string]string
+	Primary     bool
+}
+
+type Repo interface {
+	List(ctx context.Context, productID uint64) ([]*gen.ProductImage, error)
+	Create(ctx context.Context, productID uint64, img Image) (*gen.ProductImage, error)
+	SetPrimary(ctx context.Context, productID, imageID uint64) error
+	Reorder(ctx context.Context, productID uint64, imageIDs []uint64) error
+	Delete(ctx context.Context, productID, imageID uint64) (*gen.ProductImage, error)
+}
+
+type repo struct {
+	ent *gen.Client
+}
+
+func NewRepo(ent *gen.Client) *repo {
+	return &repo{
+		ent: ent,
+	}
+}
+
+func (r *repo) List(ctx context.Context, productID uint64) ([]*gen.ProductImage, error) {
+	exists, err := r.ent.Product.Query().Where(product.IDEQ(productID)).Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrProductNotFound
+	}
+
+	return r.ent.ProductImage.Query().
+		Where(productimage.ProductIDEQ(productID)).
+		Order(gen.Asc(productimage.FieldPosition), gen.Asc(productimage.FieldID)).
+		All(ctx)
+}
+
+// Create appends an image to the product's gallery. The first image of a
+// product always becomes its primary image.
+func (r *repo) Create(ctx context.Context, productID uint64, img Image) (*gen.ProductImage, error) {
+	var created *gen.ProductImage
+	err := r.withProduct(ctx, productID, func(tx *gen.Tx) error {
+		images, err := tx.ProductImage.Query().
+			Where(productimage.ProductIDEQ(productID)).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+
+		position := 0
+		for _, i := range images {
+			position = max(position, i.Position+1)
+		}
+
+		primary := img.Primary || len(images) == 0
+		if primary {
+			if err = unsetPrimary(ctx, tx, productID); err != nil {
+				return err
+			}
+		}
+
+		created, err = tx.ProductImage.Create().
+			SetProductID(productID).
+			SetImageURL(img.URL).
+			SetStorageKey(img.StorageKey).
+			SetContentType(img.ContentType).
+			SetThumbnails(img.Thumbnails).
+			SetIsPrimary(primary).
+			SetPosition(position).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (r *repo) SetPrimary(ctx context.Context, productID, imageID uint64) error {
+	return r.withProduct(ctx, productID, func(tx *gen.Tx) error {
+		img, err := read(ctx, tx, productID, imageID)
+		if err != nil {
+			return err
+		}
+		if img.IsPrimary {
+			return nil
+		}
+
+		if err = unsetPrimary(ctx, tx, productID); err != nil {
+			return err
+		}
+
+		return tx.ProductImage.UpdateOneID(imageID).SetIsPrimary(true).Exec(ctx)
+	})
+}
+
+func (r *repo) Reorder(ctx context.Context, productID uint64, imageIDs []uint64) error {
+	return r.withProduct(ctx, productID, func(tx *gen.Tx) error {
+		ids, err := tx.ProductImage.Query().
+			Where(productimage.ProductIDEQ(productID)).
+			IDs(ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(ids) != len(imageIDs) {
+			return ErrInvalidOrder
+		}
+		pending := make(map[uint64]bool, len(ids))
+		for _, id := range ids {
+			pending[id] = true
+		}
+		for _, id := range imageIDs {
+			if !pending[id] {
+				return ErrInvalidOrder
+			}
+			delete(pending, id)
+		}
+
+		for position, id := range imageIDs {
+			err = tx.ProductImage.UpdateOneID(id).SetPosition(position).Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Delete removes the image row and returns it so the caller can remove the
+// stored files. When the primary image goes, the next one in line takes over.
+func (r *repo) Delete(ctx context.Context, productID, imageID uint64) (*gen.ProductImage, error) {
+	var deleted *gen.ProductImage
+	err := r.withProduct(ctx, productID, func(tx *gen.Tx) error {
+		var err error
+		deleted, err = read(ctx, tx, productID, imageID)
+		if err != nil {
+			return err
+		}
+
+		if err = tx.ProductImage.DeleteOneID(imageID).Exec(ctx); err != nil {
+			return err
+		}
+		if !deleted.IsPrimary {
+			return nil
+		}
+
+		next, err := tx.ProductImage.Query().
+			Where(productimage.ProductIDEQ(productID)).
+			Order(gen.Asc(productimage.FieldPosition), gen.Asc(productimage.FieldID)).
+			First(ctx)
+		if err != nil {
+			if gen.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		return tx.ProductImage.UpdateOneID(next.ID).SetIsPrimary(true).Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deleted, nil
+}
+
+// withProduct runs fn in a transaction holding the product row lock, which
+// serialises every change to that product's gallery.
+func (r *repo) withProduct(ctx context.Context, productID uint64, fn func(tx *gen.Tx) error) error {
+	tx, err := r.ent.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Product.Query().Where(product.IDEQ(productID)).ForUpdate().Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return ErrProductNotFound
+		}
+		return err
+	}
+
+	if err = fn(tx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func read(ctx context.Context, tx *gen.Tx, productID, imageID uint64) (*gen.ProductImage, error) {
+	img, err := tx.ProductImage.Query().
+		Where(
+			productimage.IDEQ(imageID),
+			productimage.ProductIDEQ(productID),
+		).
+		Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return img, nil
+}
+
+func unsetPrimary(ctx context.Context, tx *gen.Tx, productID uint64) error {
+	return tx.ProductImage.Update().
+		Where(
+			productimage.ProductIDEQ(productID),
+			productimage.IsPrimary(true),
+		).
+		SetIsPrimary(false).
+		Exec(ctx)
+}
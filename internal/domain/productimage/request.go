@@ -0,0 +1,6 @@
+package productimage
+
+type ReorderRequest struct {
+	// ImageIDs lists every image of the product in the new display order.
+	ImageIDs []uint64 `json:"image_ids" validate:"required,min=1,dive,required"`
+}
@@ -0,0 +1,37 @@
+package productimage
+
+import (
+	"time"
+
+	"github.com/thang1834/go-goss/ent/gen"
+)
+
+type Res struct {
+	ID         uint64            `json:"id"`
+	ProductID  uint64            `json:"product_id"`
+	URL        string            `json:"url"`
+	IsPrimary  bool              `json:"is_primary"`
+	Position   int               `json:"position"`
+	Thumbnails map[string]string `json:"thumbnails,omitempty"`
+	CreatedAt  time.Time         `json:"created_at"`
+}
+
+func Resource(img *gen.ProductImage) *Res {
+	return &Res{
+		ID:         img.ID,
+		ProductID:  img.ProductID,
+		URL:        img.ImageURL,
+		IsPrimary:  img.IsPrimary,
+		Position:   img.Position,
+		Thumbnails: img.Thumbnails,
+		CreatedAt:  img.CreatedAt,
+	}
+}
+
+func Resources(images []*gen.ProductImage) []*Res {
+	res := make([]*Res, 0, len(images))
+	for _, img := range images {
+		res = append(res, Resource(img))
+	}
+	return res
+}
@@ -0,0 +1,57 @@
+package productimage
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestFit(t *testing.T) {
+	tests := []struct {
+		w, h, size   int
+		wantW, wantH int
+	}{
+		{100, 50, 150, 100, 50},
+		{1200, 600, 150, 150, 75},
+		{600, 1200, 150, 75, 150},
+		{1000, 1000, 150, 150, 150},
+		{3000, 1, 150, 150, 1},
+	}
+
+	for _, tt := range tests {
+		w, h := fit(tt.w, tt.h, tt.size)
+		if w != tt.wantW || h != tt.wantH {
+			t.Errorf("fit(%d, %d, %d) = %d x %d, want %d x %d", tt.w, tt.h, tt.size, w, h, tt.wantW, tt.wantH)
+		}
+	}
+}
+
+func TestThumbnailAverages(t *testing.T) {
+	// Left half black, right half white.
+	src := image.NewRGBA(image.Rect(0, 0, 4, 2))
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 4; x++ {
+			c := color.RGBA{A: 255}
+			if x >= 2 {
+				c = color.RGBA{R: 255, G: 255, B: 255, A: 255}
+			}
+			src.Set(x, y, c)
+		}
+	}
+
+	got := thumbnail(src, 2)
+	if b := got.Bounds(); b.Dx() != 2 || b.Dy() != 1 {
+		t.Fatalf("bounds = %v, want 2x1", b)
+	}
+	if c := color.RGBAModel.Convert(got.At(0, 0)).(color.RGBA); c.R != 0 {
+		t.Errorf("left pixel = %v, want black", c)
+	}
+	if c := color.RGBAModel.Convert(got.At(1, 0)).(color.RGBA); c.R != 255 {
+		t.Errorf("right pixel = %v, want white", c)
+	}
+
+	small := thumbnail(src, 10)
+	if small.Bounds().Dx() != 4 {
+		t.Errorf("small images must not be scaled up, got %v", small.Bounds())
+	}
+}
@@ -0,0 +1,190 @@
+package productimage
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/thang1834/go-goss/config"
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/third_party/blobstore"
+)
+
+var (
+	ErrUnsupportedType = errors.New("only jpeg, png and gif images are accepted")
+	ErrTooLarge        = errors.New("image exceeds the maximum upload size")
+	ErrInvalidImage    = errors.New("image could not be decoded")
+)
+
+type UseCase interface {
+	List(ctx context.Context, productID uint64) ([]*gen.ProductImage, error)
+	Upload(ctx context.Context, productID uint64, file io.Reader, primary bool) (*gen.ProductImage, error)
+	SetPrimary(ctx context.Context, productID, imageID uint64) error
+	Reorder(ctx context.Context, productID uint64, req ReorderRequest) ([]*gen.ProductImage, error)
+	Delete(ctx context.Context, productID, imageID uint64) error
+}
+
+type ProductImage struct {
+	repo    Repo
+	store   blobstore.BlobStore
+	sizes   []int
+	maxSize int64
+}
+
+func New(repo Repo, store blobstore.BlobStore, cfg config.Storage) *ProductImage {
+	return &ProductImage{
+		repo:    repo,
+		store:   store,
+		sizes:   cfg.ThumbnailSizes,
+		maxSize: cfg.MaxUploadSize,
+	}
+}
+
+func (u *ProductImage) List(ctx context.Context, productID uint64) ([]*gen.ProductImage, error) {
+	return u.repo.List(ctx, productID)
+}
+
+// Upload validates the file, writes the original and its thumbnails to the
+// blob store and records the image. Blobs are removed again if recording
+// fails.
+func (u *ProductImage) Upload(ctx context.Context, productID uint64, file io.Reader, primary bool) (*gen.ProductImage, error) {
+	data, err := io.ReadAll(io.LimitReader(file, u.maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > u.maxSize {
+		return nil, ErrTooLarge
+	}
+
+	contentType := http.DetectContentType(data)
+	ext, ok := formats[contentType]
+	if !ok {
+		return nil, ErrUnsupportedType
+	}
+
+	src, err := decode(data, contentType)
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+
+	key, err := newKey(productID, ext)
+	if err != nil {
+		return nil, err
+	}
+
+	img := Image{
+		URL:         u.store.URL(key),
+		StorageKey:  key,
+		ContentType: contentType,
+		Thumbnails:  make(map[string]string, len(u.sizes)),
+		Primary:     primary,
+	}
+
+	written := []string{key}
+	err = u.store.Put(ctx, key, bytes.NewReader(data), contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, size := range u.sizes {
+		var buf bytes.Buffer
+		if err = encode(&buf, thumbnail(src, size), contentType); err != nil {
+			u.remove(ctx, written)
+			return nil, err
+		}
+
+		thumb := thumbnailKey(key, size, contentType)
+		if err = u.store.Put(ctx, thumb, &buf, thumbnailType(contentType)); err != nil {
+			u.remove(ctx, written)
+			return nil, err
+		}
+		written = append(written, thumb)
+		img.Thumbnails[strconv.Itoa(size)] = u.store.URL(thumb)
+	}
+
+	created, err := u.repo.Create(ctx, productID, img)
+	if err != nil {
+		u.remove(ctx, written)
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (u *ProductImage) SetPrimary(ctx context.Context, productID, imageID uint64) error {
+	return u.repo.SetPrimary(ctx, productID, imageID)
+}
+
+func (u *ProductImage) Reorder(ctx context.Context, productID uint64, req ReorderRequest) ([]*gen.ProductImage, error) {
+	if err := u.repo.Reorder(ctx, productID, req.ImageIDs); err != nil {
+		return nil, err
+	}
+	return u.repo.List(ctx, productID)
+}
+
+// Delete removes the image row first, then its files. A failure to remove a
+// file is only logged, the row is already gone.
+func (u *ProductImage) Delete(ctx context.Context, productID, imageID uint64) error {
+	img, err := u.repo.Delete(ctx, productID, imageID)
+	if err != nil {
+		return err
+	}
+
+	u.remove(ctx, blobKeys(img))
+
+	return nil
+}
+
+func (u *ProductImage) remove(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := u.store.Delete(ctx, key); err != nil {
+			log.Printf("productimage: removing blob %s: %v", key, err)
+		}
+	}
+}
+
+// blobKeys returns the keys of the original and every thumbnail of img.
+// Images created before uploads went through the blob store have none.
+func blobKeys(img *gen.ProductImage) []string {
+	if img.StorageKey == "" {
+		return nil
+	}
+
+	keys := []string{img.StorageKey}
+	for size := range img.Thumbnails {
+		n, err := strconv.Atoi(size)
+		if err != nil {
+			continue
+		}
+		keys = append(keys, thumbnailKey(img.StorageKey, n, img.ContentType))
+	}
+	return keys
+}
+
+func newKey(productID uint64, ext string) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("products/%d/%s%s", productID, hex.EncodeToString(b), ext), nil
+}
+
+func thumbnailKey(key string, size int, contentType string) string {
+	base := key[:len(key)-len(extension(key))]
+	return fmt.Sprintf("%s_%d%s", base, size, formats[thumbnailType(contentType)])
+}
+
+func extension(key string) string {
+	if i := strings.LastIndexByte(key, '.'); i > strings.LastIndexByte(key, '/') {
+		return key[i:]
+	}
+	return ""
+}
@@ -0,0 +1,31 @@
+package review
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/thang1834/go-goss/internal/utility/filter"
+)
+
+type Filter struct {
+	Base filter.Filter
+
+	ProductID uint64
+	UserID    uint64
+	Rating    int
+}
+
+func Filters(queries url.Values) *Filter {
+	f := filter.New(queries)
+
+	productID, _ := strconv.ParseUint(queries.Get("product_id"), 10, 64)
+	userID, _ := strconv.ParseUint(queries.Get("user_id"), 10, 64)
+	rating, _ := strconv.Atoi(queries.Get("rating"))
+
+	return &Filter{
+		Base:      *f,
+		ProductID: productID,
+		UserID:    userID,
+		Rating:    rating,
+	}
+}
@@ -0,0 +1,200 @@
+package review
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gmhafiz/scs/v2"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/thang1834/go-goss/internal/middleware"
+	"github.com/thang1834/go-goss/internal/utility/message"
+	"github.com/thang1834/go-goss/internal/utility/param"
+	"github.com/thang1834/go-goss/internal/utility/request"
+	"github.com/thang1834/go-goss/internal/utility/respond"
+	"github.com/thang1834/go-goss/internal/utility/validate"
+)
+
+type Handler struct {
+	useCase  UseCase
+	validate *validator.Validate
+	session  *scs.SessionManager
+}
+
+func NewHandler(useCase UseCase, v *validator.Validate, session *scs.SessionManager) *Handler {
+	return &Handler{
+		useCase:  useCase,
+		validate: v,
+		session:  session,
+	}
+}
+
+// List lists reviews with pagination
+// @Summary List reviews
+// @Description Filter by product_id, user_id and rating. Sort by rating or created_at.
+// @Param product_id query int false "product ID"
+// @Param page query int false "page number"
+// @Param limit query int false "items per page"
+// @Param sort query string false "e.g. rating,desc"
+// @Success 200 {object} respond.Standard
+// @Failure 500
+// @router /api/v1/reviews [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	reviews, total, err := h.useCase.List(r.Context(), Filters(r.URL.Query()))
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	list := Resources(reviews)
+	respond.JsonWithETag(w, r, http.StatusOK, respond.Standard{
+		Data: list,
+		Meta: respond.Meta{
+			Size:  len(list),
+			Total: total,
+		},
+	}, "")
+}
+
+// Create reviews a product the current user has received
+// @Summary Review a product
+// @Param review body CreateRequest true "review"
+// @Success 201 {object} Res
+// @Failure 400
+// @Failure 403
+// @Failure 409
+// @router /api/v1/reviews [post]
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.session.Get(r.Context(), string(middleware.KeyID)).(uint64)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	var req CreateRequest
+	err := request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	errs := validate.Validate(h.validate, req)
+	if errs != nil {
+		respond.Errors(w, http.StatusBadRequest, errs)
+		return
+	}
+
+	rv, err := h.useCase.Create(r.Context(), userID, req)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusCreated, Resource(rv))
+}
+
+// Update edits one of the current user's reviews
+// @Summary Update my review
+// @Param reviewID path int true "review ID"
+// @Param review body UpdateRequest true "review"
+// @Success 200 {object} Res
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @router /api/v1/reviews/{reviewID} [put]
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.session.Get(r.Context(), string(middleware.KeyID)).(uint64)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	reviewID, err := param.UInt64(r, "reviewID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	var req UpdateRequest
+	err = request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	errs := validate.Validate(h.validate, req)
+	if errs != nil {
+		respond.Errors(w, http.StatusBadRequest, errs)
+		return
+	}
+
+	rv, err := h.useCase.Update(r.Context(), userID, reviewID, req)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, Resource(rv))
+}
+
+// Delete removes one of the current user's reviews
+// @Summary Delete my review
+// @Param reviewID path int true "review ID"
+// @Success 204
+// @Failure 404
+// @router /api/v1/reviews/{reviewID} [delete]
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.session.Get(r.Context(), string(middleware.KeyID)).(uint64)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	reviewID, err := param.UInt64(r, "reviewID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	if err = h.useCase.Delete(r.Context(), userID, reviewID); err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Status(w, http.StatusNoContent)
+}
+
+// Remove deletes any review
+// @Summary Moderate a review
+// @Param reviewID path int true "review ID"
+// @Success 204
+// @Failure 404
+// @router /api/v1/manage/reviews/{reviewID} [delete]
+func (h *Handler) Remove(w http.ResponseWriter, r *http.Request) {
+	reviewID, err := param.UInt64(r, "reviewID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	if err = h.useCase.Remove(r.Context(), reviewID); err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Status(w, http.StatusNoContent)
+}
+
+// error maps domain errors to their HTTP status code.
+func (h *Handler) error(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrNotFound), errors.Is(err, ErrProductNotFound):
+		respond.Error(w, http.StatusNotFound, err)
+	case errors.Is(err, ErrNotPurchased):
+		respond.Error(w, http.StatusForbidden, err)
+	case errors.Is(err, ErrAlreadyReviewed):
+		respond.Error(w, http.StatusConflict, err)
+	default:
+		respond.Error(w, http.StatusInternalServerError, message.ErrInternalError)
+	}
+}
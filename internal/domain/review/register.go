@@ -0,0 +1,36 @@
+package review
+
+import (
+	"github.com/gmhafiz/scs/v2"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/thang1834/go-goss/internal/domain/authentication"
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+func RegisterHTTPEndPoints(router *chi.Mux, validator *validator.Validate, uc UseCase, session *scs.SessionManager, auth *authentication.Handler) *Handler {
+	h := NewHandler(uc, validator, session)
+
+	router.Route("/api/v1/reviews", func(router chi.Router) {
+		router.Get("/", h.List)
+
+		router.Group(func(router chi.Router) {
+			router.Use(middleware.Authenticate(session))
+
+			router.Post("/", h.Create)
+			router.Put("/{reviewID}", h.Update)
+			router.Delete("/{reviewID}", h.Delete)
+		})
+	})
+
+	// Review moderation routes
+	router.Route("/api/v1/manage/reviews", func(router chi.Router) {
+		router.Use(middleware.Authenticate(session))
+		router.Use(auth.RequirePermission("review:moderate"))
+
+		router.Delete("/{reviewID}", h.Remove)
+	})
+
+	return h
+}
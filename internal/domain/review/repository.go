@@ -0,0 +1,227 @@
+package review
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/ent/gen/order"
+	"github.com/thang1834/go-goss/ent/gen/orderitem"
+	"github.com/thang1834/go-goss/ent/gen/product"
+	"github.com/thang1834/go-goss/ent/gen/review"
+	orderDomain "github.com/thang1834/go-goss/internal/domain/order"
+)
+
+var (
+	ErrNotFound        = errors.New("review not found")
+	ErrProductNotFound = errors.New("product not found")
+	ErrNotPurchased    = errors.New("only customers with a delivered order of this product can review it")
+	ErrAlreadyReviewed = errors.New("you have already reviewed this product")
+)
+
+var sortable = map[string]string{
+	"rating":     review.FieldRating,
+	"created_at": review.FieldCreatedAt,
+}
+
+type Repo interface {
+	List(ctx context.Context, f *Filter) ([]*gen.Review, int, error)
+	Read(ctx context.Context, reviewID uint64) (*gen.Review, error)
+	Purchased(ctx context.Context, userID, productID uint64) (bool, error)
+	Create(ctx context.Context, userID uint64, req CreateRequest) (*gen.Review, error)
+	Update(ctx context.Context, reviewID uint64, req UpdateRequest) (*gen.Review, error)
+	Delete(ctx context.Context, reviewID uint64) error
+}
+
+type repo struct {
+	ent *gen.Client
+}
+
+func NewRepo(ent *gen.Client) *repo {
+	return &repo{
+		ent: ent,
+	}
+}
+
+func (r *repo) List(ctx context.Context, f *Filter) ([]*gen.Review, int, error) {
+	query := r.ent.Review.Query()
+
+	if f.ProductID != 0 {
+		query = query.Where(review.ProductIDEQ(f.ProductID))
+	}
+	if f.UserID != 0 {
+		query = query.Where(review.UserIDEQ(f.UserID))
+	}
+	if f.Rating != 0 {
+		query = query.Where(review.RatingEQ(f.Rating))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for key, direction := range f.Base.Sort {
+		column, ok := sortable[key]
+		if !ok {
+			continue
+		}
+		if direction == "DESC" {
+			query = query.Order(gen.Desc(column))
+		} else {
+			query = query.Order(gen.Asc(column))
+		}
+	}
+	query = query.Order(gen.Desc(review.FieldID))
+
+	if !f.Base.DisablePaging {
+		query = query.Limit(f.Base.Limit).Offset(f.Base.Offset)
+	}
+
+	reviews, err := query.WithUser().All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return reviews, total, nil
+}
+
+func (r *repo) Read(ctx context.Context, reviewID uint64) (*gen.Review, error) {
+	rv, err := r.ent.Review.Query().
+		Where(review.IDEQ(reviewID)).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return rv, nil
+}
+
+// Purchased reports whether the user has a delivered order containing the
+// product.
+func (r *repo) Purchased(ctx context.Context, userID, productID uint64) (bool, error) {
+	return r.ent.OrderItem.Query().
+		Where(
+			orderitem.ProductIDEQ(productID),
+			orderitem.HasOrderWith(
+				order.UserIDEQ(userID),
+				order.StatusEQ(orderDomain.StatusDelivered),
+			),
+		).
+		Exist(ctx)
+}
+
+func (r *repo) Create(ctx context.Context, userID uint64, req CreateRequest) (*gen.Review, error) {
+	var reviewID uint64
+	err := r.withProduct(ctx, req.ProductID, func(tx *gen.Tx) error {
+		rv, err := tx.Review.Create().
+			SetUserID(userID).
+			SetProductID(req.ProductID).
+			SetRating(req.Rating).
+			SetComment(req.Comment).
+			Save(ctx)
+		if err != nil {
+			if gen.IsConstraintError(err) {
+				return ErrAlreadyReviewed
+			}
+			return err
+		}
+		reviewID = rv.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Read(ctx, reviewID)
+}
+
+func (r *repo) Update(ctx context.Context, reviewID uint64, req UpdateRequest) (*gen.Review, error) {
+	current, err := r.Read(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.withProduct(ctx, current.ProductID, func(tx *gen.Tx) error {
+		return tx.Review.UpdateOneID(reviewID).
+			SetRating(req.Rating).
+			SetComment(req.Comment).
+			Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Read(ctx, reviewID)
+}
+
+func (r *repo) Delete(ctx context.Context, reviewID uint64) error {
+	current, err := r.Read(ctx, reviewID)
+	if err != nil {
+		return err
+	}
+
+	return r.withProduct(ctx, current.ProductID, func(tx *gen.Tx) error {
+		return tx.Review.DeleteOneID(reviewID).Exec(ctx)
+	})
+}
+
+// withProduct runs fn in a transaction holding the product row lock and then
+// recomputes the product's avg_rating and review_count from its reviews.
+// Holding the lock serialises concurrent review changes of one product, so
+// the aggregates always match the reviews table.
+func (r *repo) withProduct(ctx context.Context, productID uint64, fn func(tx *gen.Tx) error) error {
+	tx, err := r.ent.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Product.Query().Where(product.IDEQ(productID)).ForUpdate().Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return ErrProductNotFound
+		}
+		return err
+	}
+
+	if err = fn(tx); err != nil {
+		return err
+	}
+
+	var stats []struct {
+		Avg   sql.NullFloat64 `json:"avg"`
+		Count int             `json:"count"`
+	}
+	err = tx.Review.Query().
+		Where(review.ProductIDEQ(productID)).
+		Aggregate(
+			gen.As(gen.Mean(review.FieldRating), "avg"),
+			gen.As(gen.Count(), "count"),
+		).
+		Scan(ctx, &stats)
+	if err != nil {
+		return err
+	}
+
+	var avg float64
+	var count int
+	if len(stats) > 0 {
+		avg = math.Round(stats[0].Avg.Float64*100) / 100
+		count = stats[0].Count
+	}
+
+	err = tx.Product.UpdateOneID(productID).
+		SetAvgRating(avg).
+		SetReviewCount(count).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
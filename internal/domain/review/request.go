@@ -0,0 +1,12 @@
+package review
+
+type CreateRequest struct {
+	ProductID uint64 `json:"product_id" validate:"required"`
+	Rating    int    `json:"rating" validate:"required,min=1,max=5"`
+	Comment   string `json:"comment" validate:"max=5000"`
+}
+
+type UpdateRequest struct {
+	Rating  int    `json:"rating" validate:"required,min=1,max=5"`
+	Comment string `json:"comment" validate:"max=5000"`
+}
@@ -0,0 +1,42 @@
+package review
+
+import (
+	"time"
+
+	"github.com/thang1834/go-goss/ent/gen"
+)
+
+type Res struct {
+	ID        uint64    `json:"id"`
+	ProductID uint64    `json:"product_id"`
+	UserID    uint64    `json:"user_id"`
+	Author    string    `json:"author,omitempty"`
+	Rating    int       `json:"rating"`
+	Comment   string    `json:"comment,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func Resource(r *gen.Review) *Res {
+	res := &Res{
+		ID:        r.ID,
+		ProductID: r.ProductID,
+		UserID:    r.UserID,
+		Rating:    r.Rating,
+		Comment:   r.Comment,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+	if r.Edges.User != nil {
+		res.Author = r.Edges.User.FirstName
+	}
+	return res
+}
+
+func Resources(reviews []*gen.Review) []*Res {
+	res := make([]*Res, 0, len(reviews))
+	for _, r := range reviews {
+		res = append(res, Resource(r))
+	}
+	return res
+}
@@ -0,0 +1,83 @@
+package review
+
+import (
+	"context"
+
+	"github.com/thang1834/go-goss/ent/gen"
+)
+
+type UseCase interface {
+	List(ctx context.Context, f *Filter) ([]*gen.Review, int, error)
+	Create(ctx context.Context, userID uint64, req CreateRequest) (*gen.Review, error)
+	Update(ctx context.Context, userID, reviewID uint64, req UpdateRequest) (*gen.Review, error)
+	Delete(ctx context.Context, userID, reviewID uint64) error
+	Remove(ctx context.Context, reviewID uint64) error
+}
+
+type Review struct {
+	repo Repo
+}
+
+func New(repo Repo) *Review {
+	return &Review{
+		repo: repo,
+	}
+}
+
+func (u *Review) List(ctx context.Context, f *Filter) ([]*gen.Review, int, error) {
+	return u.repo.List(ctx, f)
+}
+
+func (u *Review) Create(ctx context.Context, userID uint64, req CreateRequest) (*gen.Review, error) {
+	if err := u.mustHavePurchased(ctx, userID, req.ProductID); err != nil {
+		return nil, err
+	}
+	return u.repo.Create(ctx, userID, req)
+}
+
+func (u *Review) Update(ctx context.Context, userID, reviewID uint64, req UpdateRequest) (*gen.Review, error) {
+	current, err := u.own(ctx, userID, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if err = u.mustHavePurchased(ctx, userID, current.ProductID); err != nil {
+		return nil, err
+	}
+	return u.repo.Update(ctx, reviewID, req)
+}
+
+func (u *Review) Delete(ctx context.Context, userID, reviewID uint64) error {
+	if _, err := u.own(ctx, userID, reviewID); err != nil {
+		return err
+	}
+	return u.repo.Delete(ctx, reviewID)
+}
+
+// Remove deletes any review. It is meant for moderators.
+func (u *Review) Remove(ctx context.Context, reviewID uint64) error {
+	return u.repo.Delete(ctx, reviewID)
+}
+
+// own returns a review written by the user. Reviews by others are reported
+// as not found.
+func (u *Review) own(ctx context.Context, userID, reviewID uint64) (*gen.Review, error) {
+	rv, err := u.repo.Read(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if rv.UserID != userID {
+		return nil, ErrNotFound
+	}
+	return rv, nil
+}
+
+func (u *Review) mustHavePurchased(ctx context.Context, userID, productID uint64) error {
+	ok, err := u.repo.Purchased(ctx, userID, productID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNotPurchased
+	}
+	return nil
+}
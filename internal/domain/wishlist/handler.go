@@ -0,0 +1,173 @@
+package wishlist
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gmhafiz/scs/v2"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/thang1834/go-goss/internal/middleware"
+	"github.com/thang1834/go-goss/internal/utility/message"
+	"github.com/thang1834/go-goss/internal/utility/param"
+	"github.com/thang1834/go-goss/internal/utility/request"
+	"github.com/thang1834/go-goss/internal/utility/respond"
+	"github.com/thang1834/go-goss/internal/utility/validate"
+)
+
+type Handler struct {
+	useCase  UseCase
+	validate *validator.Validate
+	session  *scs.SessionManager
+}
+
+func NewHandler(useCase UseCase, v *validator.Validate, session *scs.SessionManager) *Handler {
+	return &Handler{
+		useCase:  useCase,
+		validate: v,
+		session:  session,
+	}
+}
+
+// List returns the current user's wishlist
+// @Summary Get wishlist
+// @Param price_dropped query bool false "only items cheaper than when added"
+// @Success 200 {array} ItemRes
+// @Failure 401
+// @router /api/v1/wishlist [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.session.Get(r.Context(), string(middleware.KeyID)).(uint64)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	onlyDropped := r.URL.Query().Get("price_dropped") == "true"
+
+	items, err := h.useCase.Items(r.Context(), userID, onlyDropped)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, Resources(items))
+}
+
+// Add puts a product on the wishlist, recording its current price
+// @Summary Add to wishlist
+// @Param item body AddItemRequest true "product"
+// @Success 200 {array} ItemRes
+// @Failure 400
+// @Failure 404
+// @router /api/v1/wishlist/items [post]
+func (h *Handler) Add(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.session.Get(r.Context(), string(middleware.KeyID)).(uint64)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	var req AddItemRequest
+	err := request.DecodeJSON(w, r, &req)
+	if err != nil {
+		respond.Error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	errs := validate.Validate(h.validate, req)
+	if errs != nil {
+		respond.Errors(w, http.StatusBadRequest, errs)
+		return
+	}
+
+	items, err := h.useCase.Add(r.Context(), userID, req)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Json(w, http.StatusOK, Resources(items))
+}
+
+// Remove takes a product off the wishlist
+// @Summary Remove from wishlist
+// @Param productID path int true "product ID"
+// @Success 204
+// @Failure 404
+// @router /api/v1/wishlist/items/{productID} [delete]
+func (h *Handler) Remove(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.session.Get(r.Context(), string(middleware.KeyID)).(uint64)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	productID, err := param.UInt64(r, "productID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	if err = h.useCase.Remove(r.Context(), userID, productID); err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Status(w, http.StatusNoContent)
+}
+
+// MoveToCart moves a wishlisted product into the cart
+// @Summary Move wishlist item to cart
+// @Param productID path int true "product ID"
+// @Param item body MoveToCartRequest false "quantity, defaults to 1"
+// @Success 204
+// @Failure 404
+// @Failure 409
+// @router /api/v1/wishlist/items/{productID}/move-to-cart [post]
+func (h *Handler) MoveToCart(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.session.Get(r.Context(), string(middleware.KeyID)).(uint64)
+	if !ok {
+		respond.Status(w, http.StatusUnauthorized)
+		return
+	}
+
+	productID, err := param.UInt64(r, "productID")
+	if err != nil {
+		respond.Status(w, http.StatusBadRequest)
+		return
+	}
+
+	var req MoveToCartRequest
+	if r.ContentLength != 0 {
+		err = request.DecodeJSON(w, r, &req)
+		if err != nil {
+			respond.Error(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	errs := validate.Validate(h.validate, req)
+	if errs != nil {
+		respond.Errors(w, http.StatusBadRequest, errs)
+		return
+	}
+
+	if err = h.useCase.MoveToCart(r.Context(), userID, productID, req); err != nil {
+		h.error(w, err)
+		return
+	}
+
+	respond.Status(w, http.StatusNoContent)
+}
+
+// error maps domain errors to their HTTP status code.
+func (h *Handler) error(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrNotFound), errors.Is(err, ErrProductNotFound):
+		respond.Error(w, http.StatusNotFound, err)
+	case errors.Is(err, ErrInsufficientStock):
+		respond.Error(w, http.StatusConflict, err)
+	default:
+		respond.Error(w, http.StatusInternalServerError, message.ErrInternalError)
+	}
+}
@@ -0,0 +1,24 @@
+package wishlist
+
+import (
+	"github.com/gmhafiz/scs/v2"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+func RegisterHTTPEndPoints(router *chi.Mux, validator *validator.Validate, uc UseCase, session *scs.SessionManager) *Handler {
+	h := NewHandler(uc, validator, session)
+
+	router.Route("/api/v1/wishlist", func(router chi.Router) {
+		router.Use(middleware.Authenticate(session))
+
+		router.Get("/", h.List)
+		router.Post("/items", h.Add)
+		router.Delete("/items/{productID}", h.Remove)
+		router.Post("/items/{productID}/move-to-cart", h.MoveToCart)
+	})
+
+	return h
+}
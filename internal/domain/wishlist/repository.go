@@ -0,0 +1,193 @@
+package wishlist
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/ent/gen/cart"
+	"github.com/thang1834/go-goss/ent/gen/cartitem"
+	"github.com/thang1834/go-goss/ent/gen/product"
+	"github.com/thang1834/go-goss/ent/gen/wishlist"
+	"github.com/thang1834/go-goss/ent/gen/wishlistitem"
+)
+
+var (
+	ErrNotFound          = errors.New("product is not in the wishlist")
+	ErrProductNotFound   = errors.New("product not found")
+	ErrInsufficientStock = errors.New("not enough stock for the requested quantity")
+)
+
+type Repo interface {
+	Items(ctx context.Context, userID uint64) ([]*gen.WishlistItem, error)
+	Add(ctx context.Context, userID, productID uint64) error
+	Remove(ctx context.Context, userID, productID uint64) error
+	MoveToCart(ctx context.Context, userID, productID uint64, quantity int) error
+}
+
+type repo struct {
+	ent *gen.Client
+}
+
+func NewRepo(ent *gen.Client) *repo {
+	return &repo{
+		ent: ent,
+	}
+}
+
+func (r *repo) Items(ctx context.Context, userID uint64) ([]*gen.WishlistItem, error) {
+	return r.ent.WishlistItem.Query().
+		Where(wishlistitem.HasWishlistWith(wishlist.UserIDEQ(userID))).
+		WithProduct().
+		Order(gen.Desc(wishlistitem.FieldAddedAt)).
+		All(ctx)
+}
+
+// Add puts a product on the user's wishlist with its current price. Adding
+// a product that is already there keeps its original price and date.
+func (r *repo) Add(ctx context.Context, userID, productID uint64) error {
+	p, err := r.ent.Product.Get(ctx, productID)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return ErrProductNotFound
+		}
+		return err
+	}
+
+	w, err := r.wishlist(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	err = r.ent.WishlistItem.Create().
+		SetWishlistID(w.ID).
+		SetProductID(p.ID).
+		SetPriceAtAdd(p.Price).
+		Exec(ctx)
+	if err != nil && !gen.IsConstraintError(err) {
+		return err
+	}
+	return nil
+}
+
+func (r *repo) Remove(ctx context.Context, userID, productID uint64) error {
+	n, err := r.ent.WishlistItem.Delete().
+		Where(
+			wishlistitem.HasWishlistWith(wishlist.UserIDEQ(userID)),
+			wishlistitem.ProductIDEQ(productID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// MoveToCart adds quantity of a wishlisted product to the user's cart and
+// takes it off the wishlist in one transaction. The product row is locked
+// while the stock is checked.
+func (r *repo) MoveToCart(ctx context.Context, userID, productID uint64, quantity int) error {
+	c, err := r.cart(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.ent.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	n, err := tx.WishlistItem.Delete().
+		Where(
+			wishlistitem.HasWishlistWith(wishlist.UserIDEQ(userID)),
+			wishlistitem.ProductIDEQ(productID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+
+	p, err := tx.Product.Query().Where(product.IDEQ(productID)).ForUpdate().Only(ctx)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			return ErrProductNotFound
+		}
+		return err
+	}
+
+	item, err := tx.CartItem.Query().
+		Where(
+			cartitem.CartIDEQ(c.ID),
+			cartitem.ProductIDEQ(productID),
+		).
+		Only(ctx)
+	switch {
+	case err == nil:
+		if item.Quantity+quantity > p.StockQuantity {
+			return ErrInsufficientStock
+		}
+		err = tx.CartItem.Update().
+			Where(
+				cartitem.CartIDEQ(c.ID),
+				cartitem.ProductIDEQ(productID),
+			).
+			AddQuantity(quantity).
+			Exec(ctx)
+	case gen.IsNotFound(err):
+		if quantity > p.StockQuantity {
+			return ErrInsufficientStock
+		}
+		err = tx.CartItem.Create().
+			SetCartID(c.ID).
+			SetProductID(productID).
+			SetQuantity(quantity).
+			Exec(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = tx.Cart.UpdateOneID(c.ID).SetUpdatedAt(time.Now()).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// wishlist returns the user's wishlist, creating it on first use.
+func (r *repo) wishlist(ctx context.Context, userID uint64) (*gen.Wishlist, error) {
+	w, err := r.ent.Wishlist.Query().Where(wishlist.UserIDEQ(userID)).Only(ctx)
+	if err == nil || !gen.IsNotFound(err) {
+		return w, err
+	}
+
+	w, err = r.ent.Wishlist.Create().SetUserID(userID).Save(ctx)
+	if err != nil && gen.IsConstraintError(err) {
+		// Lost a race with a concurrent request creating it.
+		return r.ent.Wishlist.Query().Where(wishlist.UserIDEQ(userID)).Only(ctx)
+	}
+	return w, err
+}
+
+// cart returns the user's cart, creating it on first use.
+func (r *repo) cart(ctx context.Context, userID uint64) (*gen.Cart, error) {
+	c, err := r.ent.Cart.Query().Where(cart.UserIDEQ(userID)).Only(ctx)
+	if err == nil || !gen.IsNotFound(err) {
+		return c, err
+	}
+
+	c, err = r.ent.Cart.Create().SetUserID(userID).Save(ctx)
+	if err != nil && gen.IsConstraintError(err) {
+		return r.ent.Cart.Query().Where(cart.UserIDEQ(userID)).Only(ctx)
+	}
+	return c, err
+}
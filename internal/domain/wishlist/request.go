@@ -0,0 +1,9 @@
+package wishlist
+
+type AddItemRequest struct {
+	ProductID uint64 `json:"product_id" validate:"required"`
+}
+
+type MoveToCartRequest struct {
+	Quantity int `json:"quantity" validate:"omitempty,gte=1"`
+}
@@ -0,0 +1,55 @@
+package wishlist
+
+import (
+	"time"
+
+	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/internal/utility/money"
+)
+
+type ItemRes struct {
+	ProductID  uint64  `json:"product_id"`
+	Name       string  `json:"name"`
+	Slug       string  `json:"slug"`
+	Price      float64 `json:"price"`
+	PriceAtAdd float64 `json:"price_at_add"`
+	// PriceDrop is how much cheaper the product is than when it was added.
+	PriceDrop    float64   `json:"price_drop"`
+	PriceDropped bool      `json:"price_dropped"`
+	InStock      bool      `json:"in_stock"`
+	AddedAt      time.Time `json:"added_at"`
+}
+
+func Resource(item *gen.WishlistItem) *ItemRes {
+	p := item.Edges.Product
+	drop := priceDrop(item.PriceAtAdd, p.Price)
+
+	return &ItemRes{
+		ProductID:    item.ProductID,
+		Name:         p.Name,
+		Slug:         p.Slug,
+		Price:        p.Price,
+		PriceAtAdd:   item.PriceAtAdd,
+		PriceDrop:    drop,
+		PriceDropped: drop > 0,
+		InStock:      p.StockQuantity > 0,
+		AddedAt:      item.AddedAt,
+	}
+}
+
+func Resources(items []*gen.WishlistItem) []*ItemRes {
+	res := make([]*ItemRes, 0, len(items))
+	for _, item := range items {
+		res = append(res, Resource(item))
+	}
+	return res
+}
+
+// priceDrop returns how much the price fell since it was recorded, or zero
+// when it did not fall or no price was recorded.
+func priceDrop(then, now float64) float64 {
+	if then <= 0 || now >= then {
+		return 0
+	}
+	return money.Round(then - now)
+}
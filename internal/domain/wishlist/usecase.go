@@ -0,0 +1,59 @@
+package wishlist
+
+import (
+	"context"
+
+	"github.com/thang1834/go-goss/ent/gen"
+)
+
+type UseCase interface {
+	Items(ctx context.Context, userID uint64, onlyPriceDropped bool) ([]*gen.WishlistItem, error)
+	Add(ctx context.Context, userID uint64, req AddItemRequest) ([]*gen.WishlistItem, error)
+	Remove(ctx context.Context, userID, productID uint64) error
+	MoveToCart(ctx context.Context, userID, productID uint64, req MoveToCartRequest) error
+}
+
+type Wishlist struct {
+	repo Repo
+}
+
+func New(repo Repo) *Wishlist {
+	return &Wishlist{
+		repo: repo,
+	}
+}
+
+// Items lists the user's wishlist, optionally only the items whose price has
+// dropped since they were added.
+func (u *Wishlist) Items(ctx context.Context, userID uint64, onlyPriceDropped bool) ([]*gen.WishlistItem, error) {
+	items, err := u.repo.Items(ctx, userID)
+	if err != nil || !onlyPriceDropped {
+		return items, err
+	}
+
+	dropped := make([]*gen.WishlistItem, 0, len(items))
+	for _, item := range items {
+		if priceDrop(item.PriceAtAdd, item.Edges.Product.Price) > 0 {
+			dropped = append(dropped, item)
+		}
+	}
+	return dropped, nil
+}
+
+func (u *Wishlist) Add(ctx context.Context, userID uint64, req AddItemRequest) ([]*gen.WishlistItem, error) {
+	if err := u.repo.Add(ctx, userID, req.ProductID); err != nil {
+		return nil, err
+	}
+	return u.repo.Items(ctx, userID)
+}
+
+func (u *Wishlist) Remove(ctx context.Context, userID, productID uint64) error {
+	return u.repo.Remove(ctx, userID, productID)
+}
+
+func (u *Wishlist) MoveToCart(ctx context.Context, userID, productID uint64, req MoveToCartRequest) error {
+	if req.Quantity == 0 {
+		req.Quantity = 1
+	}
+	return u.repo.MoveToCart(ctx, userID, productID, req.Quantity)
+}
@@ -0,0 +1,105 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gmhafiz/scs/v2"
+
+	"github.com/thang1834/go-goss/internal/utility/respond"
+)
+
+const KeyAPIKey key = "api_key"
+
+var (
+	ErrInvalidAPIKey   = errors.New("invalid api key")
+	ErrSessionRequired = errors.New("this endpoint cannot be used with an api key")
+)
+
+// APIKey is the principal of a request authenticated with a bearer key.
+type APIKey struct {
+	ID     uint64
+	UserID uint64
+	Scopes []string
+}
+
+// Allows reports whether the key's scopes cover permission.
+func (k APIKey) Allows(permission string) bool {
+	return slices.Contains(k.Scopes, permission) || slices.Contains(k.Scopes, "*:*")
+}
+
+// APIKeyVerifier resolves a bearer token, returning ErrInvalidAPIKey for
+// unknown, revoked or expired keys.
+type APIKeyVerifier func(ctx context.Context, token string) (APIKey, error)
+
+// APIKeyFrom returns the API key the request was authenticated with, if any.
+func APIKeyFrom(ctx context.Context) (APIKey, bool) {
+	k, ok := ctx.Value(KeyAPIKey).(APIKey)
+	return k, ok
+}
+
+// BearerAuth authenticates requests carrying an "Authorization: Bearer"
+// header. It must run before LoadAndSave. A valid key gets a fresh session
+// holding the key owner's KeyID, so handlers read the user the same way as
+// for cookie sessions. The session is never saved: LoadAndSave leaves these
+// requests alone and no cookie is written.
+func BearerAuth(m *scs.SessionManager, verify APIKeyVerifier) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := bearerToken(r)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			apiKey, err := verify(r.Context(), token)
+			if err != nil {
+				w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+				if errors.Is(err, ErrInvalidAPIKey) {
+					w.WriteHeader(http.StatusUnauthorized)
+				} else {
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+				return
+			}
+
+			ctx, err := m.Load(r.Context(), "")
+			if err != nil {
+				m.ErrorFunc(w, r, err)
+				return
+			}
+			m.Put(ctx, string(KeyID), apiKey.UserID)
+
+			ctx = context.WithValue(ctx, KeyAPIKey, apiKey)
+			ctx = context.WithValue(ctx, KeyID, apiKey.UserID)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// RequireSession answers 403 Forbidden to requests authenticated with an API
+// key. It guards account management, such as passwords, second factors,
+// sessions and the keys themselves, which a key must not reach whatever its
+// scopes.
+func RequireSession(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := APIKeyFrom(r.Context()); ok {
+			respond.Error(w, http.StatusForbidden, ErrSessionRequired)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gmhafiz/scs/v2"
+	"github.com/gmhafiz/scs/v2/memstore"
+)
+
+func TestBearerAuth(t *testing.T) {
+	session := scs.New()
+	session.Store = memstore.New()
+
+	verify := func(_ context.Context, token string) (APIKey, error) {
+		if token != "good" {
+			return APIKey{}, ErrInvalidAPIKey
+		}
+		return APIKey{ID: 1, UserID: 42, Scopes: []string{"order:create"}}, nil
+	}
+
+	var gotUserID uint64
+	var gotKey APIKey
+	h := BearerAuth(session, verify)(LoadAndSave(session)(Authenticate(session)(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotUserID, _ = session.Get(r.Context(), string(KeyID)).(uint64)
+			gotKey, _ = APIKeyFrom(r.Context())
+			w.WriteHeader(http.StatusOK)
+		}),
+	)))
+
+	tests := []struct {
+		name   string
+		header string
+		want   int
+	}{
+		{"valid key", "Bearer good", http.StatusOK},
+		{"scheme is case insensitive", "bearer good", http.StatusOK},
+		{"unknown key", "Bearer bad", http.StatusUnauthorized},
+		{"no credentials", "", http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotUserID, gotKey = 0, APIKey{}
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(rr, req)
+
+			if rr.Code != tt.want {
+				t.Fatalf("status = %d, want %d", rr.Code, tt.want)
+			}
+			if tt.want != http.StatusOK {
+				return
+			}
+			if gotUserID != 42 || gotKey.ID != 1 {
+				t.Errorf("user %d, key %+v", gotUserID, gotKey)
+			}
+			if cookie := rr.Header().Get("Set-Cookie"); cookie != "" {
+				t.Errorf("bearer requests must not get a session cookie, got %q", cookie)
+			}
+		})
+	}
+}
+
+func TestAPIKeyAllows(t *testing.T) {
+	k := APIKey{Scopes: []string{"order:create"}}
+	if !k.Allows("order:create") || k.Allows("order:read_all") {
+		t.Error("scopes are not matched exactly")
+	}
+	if !(APIKey{Scopes: []string{"*:*"}}).Allows("order:read_all") {
+		t.Error("*:* should allow everything")
+	}
+}
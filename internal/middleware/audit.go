@@ -14,13 +14,22 @@ const (
 
 type Action string
 
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Event describes the request being served. The audit hook on the ent
+// client completes it with each row the request changes.
 type Event struct {
 	ActorID    uint64    `db:"actor_id" json:"actor_id,omitempty"`
-	TableRowID int       `db:"table_row_id" json:"table_row_id,omitempty"`
+	TableRowID uint64    `db:"table_row_id" json:"table_row_id,omitempty"`
 	Table      string    `db:"table_name" json:"table,omitempty"`
 	Action     Action    `db:"action" json:"action,omitempty"`
 	OldValues  string    `db:"old_values" json:"old_values,omitempty"`
 	NewValues  string    `db:"new_values" json:"new_values,omitempty"`
+	Diff       string    `db:"diff" json:"diff,omitempty"`
 	HTTPMethod string    `db:"http_method" json:"http_method,omitempty"`
 	URL        string    `db:"url" json:"url,omitempty"`
 	IPAddress  string    `db:"ip_address" json:"ip_address,omitempty"`
@@ -34,7 +43,7 @@ func Audit(next http.Handler) http.Handler {
 			ActorID:    getUserID(r),
 			HTTPMethod: r.Method,
 			URL:        r.RequestURI,
-			IPAddress:  readUserIP(r),
+			IPAddress:  ClientIP(r),
 			UserAgent:  r.UserAgent(),
 		}
 
@@ -44,21 +53,11 @@ func Audit(next http.Handler) http.Handler {
 	})
 }
 
+// getUserID returns the user put in the context by LoadAndSave or BearerAuth.
 func getUserID(r *http.Request) uint64 {
-	userID, ok := r.Context().Value(KeySession).(uint64)
+	userID, ok := r.Context().Value(KeyID).(uint64)
 	if !ok {
 		return 0
 	}
 	return userID
 }
-
-func readUserIP(r *http.Request) string {
-	ipAddress := r.Header.Get("X-Real-Ip")
-	if ipAddress == "" {
-		ipAddress = r.Header.Get("X-Forwarded-For")
-	}
-	if ipAddress == "" {
-		ipAddress = r.RemoteAddr
-	}
-	return ipAddress
-}
@@ -21,6 +21,11 @@ const (
 func Authenticate(m *scs.SessionManager) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if _, ok := APIKeyFrom(r.Context()); ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
 			ctx := r.Context()
 			token := m.Token(ctx)
 			if token == "" {
@@ -52,6 +57,12 @@ func Authenticate(m *scs.SessionManager) func(http.Handler) http.Handler {
 func LoadAndSave(s *scs.SessionManager) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// BearerAuth already loaded a session that must not be saved.
+			if _, ok := APIKeyFrom(r.Context()); ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
 			var token string
 			cookie, err := r.Cookie(s.Cookie.Name)
 			if err == nil {
@@ -64,6 +75,10 @@ func LoadAndSave(s *scs.SessionManager) func(http.Handler) http.Handler {
 				return
 			}
 
+			if userID, ok := s.Get(ctx, string(KeyID)).(uint64); ok {
+				ctx = context.WithValue(ctx, KeyID, userID)
+			}
+
 			sr := r.WithContext(ctx)
 			bw := &bufferedResponseWriter{ResponseWriter: w}
 			next.ServeHTTP(bw, sr)
@@ -78,6 +93,14 @@ func LoadAndSave(s *scs.SessionManager) func(http.Handler) http.Handler {
 				userID = nil
 			}
 			ctx = context.WithValue(ctx, KeyID, userID)
+			ctx = context.WithValue(ctx, KeyClient, Client{
+				IPAddress: ClientIP(r),
+				UserAgent: r.UserAgent(),
+			})
+
+			if ok && time.Since(time.Unix(s.GetInt64(ctx, keyLastSeen), 0)) > lastSeenInterval {
+				s.Put(ctx, keyLastSeen, time.Now().Unix())
+			}
 
 			switch s.Status(ctx) {
 			case scs.Modified:
@@ -0,0 +1,218 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/thang1834/go-goss/internal/utility/respond"
+)
+
+// CachedResponse is a response kept by a ResponseCache.
+type CachedResponse struct {
+	Header http.Header
+	Body   []byte
+	Tags   []string
+	// RequestedAt is when the handler started to build the response.
+	RequestedAt time.Time
+	StoredAt    time.Time
+	Expires     time.Time
+}
+
+// ResponseCache keeps responses by key until they expire or one of their
+// tags is invalidated. It is implemented in process and in Redis.
+type ResponseCache interface {
+	// Get returns nil without an error when key is not cached.
+	Get(ctx context.Context, key string) (*CachedResponse, error)
+	// Set keeps res unless one of its tags was invalidated at or after
+	// res.RequestedAt, as the handler may then have read the rows the
+	// invalidation was meant to drop.
+	Set(ctx context.Context, key string, res CachedResponse) error
+	// Invalidate drops every response stored with any of tags, and any
+	// response to a request in flight that is stored later.
+	Invalidate(ctx context.Context, tags ...string) error
+}
+
+// CachePolicy caches the responses to requests whose path starts with one of
+// Prefixes under Tags.
+type CachePolicy struct {
+	Prefixes []string
+	Tags     []string
+}
+
+func (p CachePolicy) matches(r *http.Request) bool {
+	for _, prefix := range p.Prefixes {
+		if strings.HasPrefix(r.URL.Path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Cache serves GET requests matching one of policies from c, keyed by the
+// URL hash CacheByURL computes. On a miss the request is handled once and a
+// 200 OK response kept for ttl, however many identical requests arrive
+// meanwhile. Responses carry Cache-Control, Age and X-Cache headers, and
+// a request whose If-None-Match lists the ETag of the response gets 304 Not
+// Modified.
+//
+// Requests from a logged in user or with an API key are neither served from
+// nor stored in the cache, so it must run after BearerAuth and LoadAndSave.
+// The handler is still called when the cache is failing.
+func Cache(c ResponseCache, ttl time.Duration, policies ...CachePolicy) func(http.Handler) http.Handler {
+	var group singleflight.Group
+	maxAge := fmt.Sprintf("public, max-age=%d", seconds(ttl))
+
+	return func(next http.Handler) http.Handler {
+		handlers := make([]http.Handler, len(policies))
+		for i, policy := range policies {
+			handlers[i] = CacheByURL(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				ctx := r.Context()
+
+				key, ok := ctx.Value(CacheURL).(string)
+				if !ok {
+					next.ServeHTTP(w, r)
+					return
+				}
+
+				res, err := c.Get(ctx, key)
+				if err != nil {
+					log.Printf("cache: %v", err)
+					next.ServeHTTP(w, r)
+					return
+				}
+				if res != nil {
+					w.Header().Set("Cache-Control", maxAge)
+					w.Header().Set("X-Cache", "HIT")
+					writeCached(w, r, http.StatusOK, res)
+					return
+				}
+
+				// The handler is shared by every request waiting on it, so
+				// it is not cancelled when the first client goes away, and
+				// it is asked for the full response whatever the first
+				// client already has.
+				v, _, _ := group.Do(key, func() (any, error) {
+					ctx := context.WithoutCancel(ctx)
+					req := r.Clone(ctx)
+					req.Header.Del("If-None-Match")
+					req.Header.Del("If-Modified-Since")
+
+					requested := time.Now()
+					rec := &cachingResponseWriter{header: make(http.Header)}
+					next.ServeHTTP(rec, req)
+					rec.header.Del("Set-Cookie")
+
+					now := time.Now()
+					res := &CachedResponse{
+						Header:      rec.header,
+						Body:        rec.buf.Bytes(),
+						Tags:        policy.Tags,
+						RequestedAt: requested,
+						StoredAt:    now,
+						Expires:     now.Add(ttl),
+					}
+					if rec.statusCode() == http.StatusOK {
+						if err := c.Set(ctx, key, *res); err != nil {
+							log.Printf("cache: %v", err)
+						}
+					}
+					return cachedResult{code: rec.statusCode(), res: res}, nil
+				})
+
+				shared := v.(cachedResult)
+				if shared.code == http.StatusOK {
+					w.Header().Set("Cache-Control", maxAge)
+				}
+				w.Header().Set("X-Cache", "MISS")
+				writeCached(w, r, shared.code, shared.res)
+			}))
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			i := slices.IndexFunc(policies, func(p CachePolicy) bool { return p.matches(r) })
+			if i < 0 || r.Method != http.MethodGet {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if authenticated(r) {
+				w.Header().Set("Cache-Control", "private, no-store")
+				next.ServeHTTP(w, r)
+				return
+			}
+			handlers[i].ServeHTTP(w, r)
+		})
+	}
+}
+
+// authenticated tells whether the response may depend on who is asking.
+func authenticated(r *http.Request) bool {
+	if _, ok := r.Context().Value(KeyID).(uint64); ok {
+		return true
+	}
+	if _, ok := APIKeyFrom(r.Context()); ok {
+		return true
+	}
+	return r.Header.Get("Authorization") != ""
+}
+
+func writeCached(w http.ResponseWriter, r *http.Request, code int, res *CachedResponse) {
+	for name, values := range res.Header {
+		w.Header()[name] = slices.Clone(values)
+	}
+	age := max(time.Since(res.StoredAt), 0)
+	w.Header().Set("Age", strconv.Itoa(int(age.Seconds())))
+
+	if etag := res.Header.Get("ETag"); code == http.StatusOK && etag != "" && respond.NotModified(r, etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.WriteHeader(code)
+	_, _ = w.Write(res.Body)
+}
+
+type cachedResult struct {
+	code int
+	res  *CachedResponse
+}
+
+// cachingResponseWriter keeps the response instead of sending it, so that
+// it can be sent to every request waiting on it.
+type cachingResponseWriter struct {
+	header http.Header
+	buf    bytes.Buffer
+	code   int
+}
+
+func (rw *cachingResponseWriter) Header() http.Header {
+	return rw.header
+}
+
+func (rw *cachingResponseWriter) WriteHeader(code int) {
+	if rw.code == 0 {
+		rw.code = code
+	}
+}
+
+func (rw *cachingResponseWriter) Write(b []byte) (int, error) {
+	if rw.code == 0 {
+		rw.code = http.StatusOK
+	}
+	return rw.buf.Write(b)
+}
+
+func (rw *cachingResponseWriter) statusCode() int {
+	if rw.code == 0 {
+		return http.StatusOK
+	}
+	return rw.code
+}
@@ -0,0 +1,198 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type mapCache struct {
+	mu          sync.Mutex
+	entries     map[string]CachedResponse
+	invalidated time.Time
+}
+
+func (c *mapCache) Get(_ context.Context, key string) (*CachedResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	res, ok := c.entries[key]
+	if !ok {
+		return nil, nil
+	}
+	return &res, nil
+}
+
+func (c *mapCache) Set(_ context.Context, key string, res CachedResponse) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.invalidated.Before(res.RequestedAt) {
+		return nil
+	}
+	c.entries[key] = res
+	return nil
+}
+
+func (c *mapCache) Invalidate(_ context.Context, tags ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.entries)
+	c.invalidated = time.Now()
+	return nil
+}
+
+func TestCache(t *testing.T) {
+	var calls atomic.Int32
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/v1/products/404" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": 1}`))
+	})
+
+	c := &mapCache{entries: make(map[string]CachedResponse)}
+	mw := Cache(c, time.Minute, CachePolicy{Prefixes: []string{"/api/v1/products"}, Tags: []string{"products"}})(handler)
+
+	serve := func(method, path string, ctx context.Context) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		mw.ServeHTTP(rr, httptest.NewRequest(method, path, nil).WithContext(ctx))
+		return rr
+	}
+	anonymous := context.Background()
+
+	rr := serve(http.MethodGet, "/api/v1/products/1", anonymous)
+	if rr.Header().Get("X-Cache") != "MISS" || rr.Header().Get("Cache-Control") != "public, max-age=60" {
+		t.Fatalf("first request headers: %v", rr.Header())
+	}
+
+	rr = serve(http.MethodGet, "/api/v1/products/1", anonymous)
+	if rr.Header().Get("X-Cache") != "HIT" || rr.Header().Get("Age") != "0" {
+		t.Errorf("second request headers: %v", rr.Header())
+	}
+	if rr.Body.String() != `{"id": 1}` || rr.Header().Get("Content-Type") != "application/json" {
+		t.Errorf("cached response = %q, %v", rr.Body.String(), rr.Header())
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("handler called %d times, want 1", n)
+	}
+
+	loggedIn := context.WithValue(anonymous, KeyID, uint64(1))
+	rr = serve(http.MethodGet, "/api/v1/products/1", loggedIn)
+	if rr.Header().Get("X-Cache") != "" || rr.Header().Get("Cache-Control") != "private, no-store" {
+		t.Errorf("logged in request headers: %v", rr.Header())
+	}
+	serve(http.MethodGet, "/api/v1/products/404", anonymous)
+	serve(http.MethodGet, "/api/v1/products/404", anonymous)
+	serve(http.MethodPost, "/api/v1/products/1", anonymous)
+	serve(http.MethodGet, "/api/v1/orders", anonymous)
+	if n := calls.Load(); n != 6 {
+		t.Errorf("handler called %d times, want 6", n)
+	}
+	if len(c.entries) != 1 {
+		t.Errorf("%d responses cached, want 1", len(c.entries))
+	}
+}
+
+func TestCacheCoalesces(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		<-release
+		_, _ = w.Write([]byte("ok"))
+	})
+
+	c := &mapCache{entries: make(map[string]CachedResponse)}
+	mw := Cache(c, time.Minute, CachePolicy{Prefixes: []string{"/"}})(handler)
+
+	var wg sync.WaitGroup
+	bodies := make([]string, 10)
+	for i := range bodies {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rr := httptest.NewRecorder()
+			mw.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/categories", nil))
+			bodies[i] = rr.Body.String()
+		}()
+	}
+
+	// Let the requests pile up behind the first one.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := calls.Load(); n != 1 {
+		t.Errorf("handler called %d times, want 1", n)
+	}
+	for i, body := range bodies {
+		if body != "ok" {
+			t.Errorf("response %d = %q", i, body)
+		}
+	}
+}
+
+// A response built while its tag is invalidated may hold the data the
+// invalidation dropped, so it must not be stored.
+func TestCacheInvalidatedInFlight(t *testing.T) {
+	c := &mapCache{entries: make(map[string]CachedResponse)}
+	var calls int
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			_ = c.Invalidate(r.Context(), "products")
+		}
+		_, _ = w.Write([]byte("ok"))
+	})
+	mw := Cache(c, time.Minute, CachePolicy{Prefixes: []string{"/"}, Tags: []string{"products"}})(handler)
+
+	for _, want := range []string{"MISS", "MISS", "HIT"} {
+		rr := httptest.NewRecorder()
+		mw.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/products", nil))
+		if got := rr.Header().Get("X-Cache"); got != want {
+			t.Errorf("X-Cache = %q, want %q", got, want)
+		}
+	}
+}
+
+func TestCacheConditional(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"a"`)
+		if r.Header.Get("If-None-Match") == `"a"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	})
+
+	c := &mapCache{entries: make(map[string]CachedResponse)}
+	mw := Cache(c, time.Minute, CachePolicy{Prefixes: []string{"/"}})(handler)
+
+	serve := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/products", nil)
+		if ifNoneMatch != "" {
+			r.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		rr := httptest.NewRecorder()
+		mw.ServeHTTP(rr, r)
+		return rr
+	}
+
+	// The first request is conditional, but the full response is cached for
+	// the ones that are not.
+	if rr := serve(`"a"`); rr.Code != http.StatusNotModified {
+		t.Errorf("conditional miss: got %d, want 304", rr.Code)
+	}
+	if rr := serve(""); rr.Code != http.StatusOK || rr.Body.String() != "ok" {
+		t.Errorf("hit: got %d %q, want the full response", rr.Code, rr.Body.String())
+	}
+	if rr := serve(`"a"`); rr.Code != http.StatusNotModified || rr.Body.Len() > 0 {
+		t.Errorf("conditional hit: got %d %q, want 304", rr.Code, rr.Body.String())
+	}
+}
@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+const KeyClientIP key = "client_ip"
+
+// RealIP resolves the client address of each request for ClientIP. The
+// X-Real-Ip and X-Forwarded-For headers are only believed when the request
+// comes from one of the trusted reverse proxies, since any client can set
+// them. X-Forwarded-For is read from the right, skipping trusted proxies, so
+// that the address the outermost trusted proxy saw is used.
+//
+// It must run before anything that counts or records requests by address.
+func RealIP(trusted ...netip.Prefix) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip := realIP(r, trusted)
+			ctx := context.WithValue(r.Context(), KeyClientIP, ip)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// ClientIP returns the address RealIP resolved, or the host of RemoteAddr
+// for a request that did not go through it.
+func ClientIP(r *http.Request) string {
+	if ip, ok := r.Context().Value(KeyClientIP).(string); ok {
+		return ip
+	}
+	return remoteIP(r)
+}
+
+// ParseProxies parses the addresses and CIDR ranges of trusted proxies.
+func ParseProxies(proxies []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(proxies))
+	for _, proxy := range proxies {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+		if strings.Contains(proxy, "/") {
+			prefix, err := netip.ParsePrefix(proxy)
+			if err != nil {
+				return nil, err
+			}
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(proxy)
+		if err != nil {
+			return nil, err
+		}
+		prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return prefixes, nil
+}
+
+func realIP(r *http.Request, trusted []netip.Prefix) string {
+	remote := remoteIP(r)
+	if !isTrusted(remote, trusted) {
+		return remote
+	}
+
+	if ip := strings.TrimSpace(r.Header.Get("X-Real-Ip")); validIP(ip) {
+		return ip
+	}
+
+	forwarded := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		ip := strings.TrimSpace(forwarded[i])
+		if !validIP(ip) {
+			break
+		}
+		if !isTrusted(ip, trusted) || i == 0 {
+			return ip
+		}
+	}
+
+	return remote
+}
+
+// remoteIP is the address of the peer, without the port.
+func remoteIP(r *http.Request) string {
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
+
+func isTrusted(ip string, trusted []netip.Prefix) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range trusted {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func validIP(ip string) bool {
+	_, err := netip.ParseAddr(ip)
+	return err == nil
+}
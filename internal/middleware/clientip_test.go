@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRealIP(t *testing.T) {
+	trusted, err := ParseProxies([]string{"10.0.0.0/8", "192.0.2.10"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		remote    string
+		realIP    string
+		forwarded []string
+		want      string
+	}{
+		{"direct", "198.51.100.7:1234", "", nil, "198.51.100.7"},
+		{"spoofed headers from a client", "198.51.100.7:1234", "203.0.113.1", []string{"203.0.113.2"}, "198.51.100.7"},
+		{"real ip from a proxy", "10.1.2.3:80", "203.0.113.1", nil, "203.0.113.1"},
+		{"forwarded from a proxy", "192.0.2.10:80", "", []string{"203.0.113.2"}, "203.0.113.2"},
+		{"spoofed entry before the proxy's", "10.1.2.3:80", "", []string{"203.0.113.9, 203.0.113.2, 10.0.0.5"}, "203.0.113.2"},
+		{"several headers", "10.1.2.3:80", "", []string{"203.0.113.9", "203.0.113.2"}, "203.0.113.2"},
+		{"garbage from a proxy", "10.1.2.3:80", "nope", []string{"nope"}, "10.1.2.3"},
+		{"only proxies", "10.1.2.3:80", "", []string{"10.0.0.6, 10.0.0.5"}, "10.0.0.6"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.RemoteAddr = tt.remote
+			if tt.realIP != "" {
+				r.Header.Set("X-Real-Ip", tt.realIP)
+			}
+			for _, f := range tt.forwarded {
+				r.Header.Add("X-Forwarded-For", f)
+			}
+
+			var got string
+			RealIP(trusted...)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				got = ClientIP(r)
+			})).ServeHTTP(httptest.NewRecorder(), r)
+
+			if got != tt.want {
+				t.Errorf("ClientIP = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseProxiesRejects(t *testing.T) {
+	if _, err := ParseProxies([]string{"10.0.0.0/33"}); err == nil {
+		t.Error("accepted an invalid range")
+	}
+	if _, err := ParseProxies([]string{"proxy.local"}); err == nil {
+		t.Error("accepted a host name")
+	}
+}
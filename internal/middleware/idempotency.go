@@ -0,0 +1,207 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"time"
+)
+
+const (
+	IdempotencyHeader = "Idempotency-Key"
+
+	// maxIdempotencyKey bounds the length of a client supplied key.
+	maxIdempotencyKey = 255
+	// maxIdempotentBody bounds the request body read to fingerprint it.
+	maxIdempotentBody = 16 << 20
+)
+
+var (
+	ErrIdempotencyKeyInUse  = errors.New("a request with this idempotency key is still being processed")
+	ErrIdempotencyKeyReused = errors.New("this idempotency key was used for a different request")
+	ErrIdempotencyClaimLost = errors.New("the idempotency key was claimed again after the lock timed out")
+)
+
+// replayedHeaders are stored with a response and sent again on replay.
+var replayedHeaders = []string{"Content-Type", "Location", "ETag"}
+
+// IdempotentResponse is a response stored to be replayed.
+type IdempotentResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// IdempotencyStore keeps the responses to requests sent with an
+// Idempotency-Key header. It is implemented in Redis and in Postgres.
+type IdempotencyStore interface {
+	// Claim reserves key for the request identified by fingerprint until
+	// lockTimeout passes, on behalf of owner. It returns the stored response
+	// if a request with the key has completed, ErrIdempotencyKeyInUse while
+	// one is in flight, and ErrIdempotencyKeyReused if the key was used for
+	// another request.
+	Claim(ctx context.Context, key, owner, fingerprint string, lockTimeout time.Duration) (*IdempotentResponse, error)
+	// Complete stores the response to a key claimed by owner for ttl.
+	Complete(ctx context.Context, key, owner string, res IdempotentResponse, ttl time.Duration) error
+	// Release gives up a claim of owner without a response so that the
+	// request can be retried.
+	//
+	// Complete and Release leave the key alone and return
+	// ErrIdempotencyClaimLost once owner no longer holds the claim, because
+	// a retry took it over after lockTimeout.
+	Release(ctx context.Context, key, owner string) error
+}
+
+// Idempotency makes POST and PATCH requests sent with an Idempotency-Key
+// header safe to retry. The first request with a key is handled and its
+// response kept for ttl. Retries with the same method, URL and body get the
+// kept response again, marked with an "Idempotent-Replayed: true" header.
+//
+// A retry while the first request is still being handled gets 409 Conflict
+// and a key reused for a different request gets 422 Unprocessable Entity.
+// Server errors are not kept, so the request can be retried. A claim is given
+// up after lockTimeout in case the server handling it went away.
+//
+// Keys are scoped per user, or per IP address when logged out, so it must
+// run after BearerAuth and LoadAndSave.
+func Idempotency(store IdempotencyStore, ttl, lockTimeout time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			idempotencyKey := r.Header.Get(IdempotencyHeader)
+			if idempotencyKey == "" || (r.Method != http.MethodPost && r.Method != http.MethodPatch) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(idempotencyKey) > maxIdempotencyKey {
+				writeMessage(w, http.StatusBadRequest, "idempotency key is too long")
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIdempotentBody))
+			if err != nil {
+				writeMessage(w, http.StatusRequestEntityTooLarge, "request body is too large")
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			ctx := r.Context()
+			key := ByUser(r) + ":" + idempotencyKey
+			owner, err := newOwner()
+			if err != nil {
+				log.Printf("idempotency: %v", err)
+				writeMessage(w, http.StatusInternalServerError, "internal error")
+				return
+			}
+
+			stored, err := store.Claim(ctx, key, owner, fingerprint(r, body), lockTimeout)
+			switch {
+			case errors.Is(err, ErrIdempotencyKeyInUse):
+				writeMessage(w, http.StatusConflict, err.Error())
+				return
+			case errors.Is(err, ErrIdempotencyKeyReused):
+				writeMessage(w, http.StatusUnprocessableEntity, err.Error())
+				return
+			case err != nil:
+				log.Printf("idempotency: %v", err)
+				writeMessage(w, http.StatusInternalServerError, "internal error")
+				return
+			case stored != nil:
+				for name, values := range stored.Header {
+					w.Header()[name] = values
+				}
+				w.Header().Set("Idempotent-Replayed", "true")
+				w.WriteHeader(stored.StatusCode)
+				_, _ = w.Write(stored.Body)
+				return
+			}
+
+			rec := &recordingResponseWriter{ResponseWriter: w}
+			next.ServeHTTP(rec, r)
+
+			// The response is kept even if the client has gone away.
+			ctx = context.WithoutCancel(ctx)
+			if rec.statusCode() >= http.StatusInternalServerError {
+				err = store.Release(ctx, key, owner)
+			} else {
+				err = store.Complete(ctx, key, owner, rec.response(), ttl)
+			}
+			if err != nil {
+				log.Printf("idempotency: %v", err)
+			}
+		})
+	}
+}
+
+// fingerprint identifies a request by its method, URL and body.
+func fingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func writeMessage(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write([]byte(`{"message": "` + message + `"}`))
+}
+
+// recordingResponseWriter passes the response through while keeping a copy.
+type recordingResponseWriter struct {
+	http.ResponseWriter
+	buf  bytes.Buffer
+	code int
+}
+
+func (rw *recordingResponseWriter) WriteHeader(code int) {
+	if rw.code == 0 {
+		rw.code = code
+	}
+	rw.ResponseWriter.WriteHeader(code)
+}
+
+func (rw *recordingResponseWriter) Write(b []byte) (int, error) {
+	if rw.code == 0 {
+		rw.code = http.StatusOK
+	}
+	rw.buf.Write(b)
+	return rw.ResponseWriter.Write(b)
+}
+
+func (rw *recordingResponseWriter) statusCode() int {
+	if rw.code == 0 {
+		return http.StatusOK
+	}
+	return rw.code
+}
+
+func (rw *recordingResponseWriter) response() IdempotentResponse {
+	header := make(http.Header)
+	for _, name := range replayedHeaders {
+		if values := rw.Header().Values(name); len(values) > 0 {
+			header[name] = values
+		}
+	}
+
+	return IdempotentResponse{
+		StatusCode: rw.statusCode(),
+		Header:     header,
+		Body:       rw.buf.Bytes(),
+	}
+}
+
+// newOwner returns a random token that identifies one request's claim on an
+// idempotency key.
+func newOwner() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,162 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memoryIdempotency is an IdempotencyStore for tests.
+type memoryIdempotency struct {
+	mu      sync.Mutex
+	entries map[string]*memoryEntry
+}
+
+type memoryEntry struct {
+	owner       string
+	fingerprint string
+	response    *IdempotentResponse
+}
+
+func (m *memoryIdempotency) Claim(_ context.Context, key, owner, fingerprint string, _ time.Duration) (*IdempotentResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.entries[key]
+	switch {
+	case !ok:
+		m.entries[key] = &memoryEntry{owner: owner, fingerprint: fingerprint}
+		return nil, nil
+	case e.fingerprint != fingerprint:
+		return nil, ErrIdempotencyKeyReused
+	case e.response == nil:
+		return nil, ErrIdempotencyKeyInUse
+	}
+	return e.response, nil
+}
+
+func (m *memoryIdempotency) Complete(_ context.Context, key, owner string, res IdempotentResponse, _ time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.entries[key]
+	if !ok || e.owner != owner {
+		return ErrIdempotencyClaimLost
+	}
+	e.response = &res
+	return nil
+}
+
+func (m *memoryIdempotency) Release(_ context.Context, key, owner string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if e, ok := m.entries[key]; !ok || e.owner != owner {
+		return ErrIdempotencyClaimLost
+	}
+	delete(m.entries, key)
+	return nil
+}
+
+// takeOver hands a claim to another request, as a store does once the
+// lock has timed out.
+func (m *memoryIdempotency) takeOver(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[key] = &memoryEntry{owner: "retry", fingerprint: m.entries[key].fingerprint}
+}
+
+func TestIdempotency(t *testing.T) {
+	store := &memoryIdempotency{entries: make(map[string]*memoryEntry)}
+
+	var calls int
+	status := http.StatusCreated
+	h := Idempotency(store, time.Hour, time.Minute)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Location", "/api/v1/orders/1")
+		w.Header().Set("X-Request-Only", "1")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"id": 1}`))
+	}))
+
+	send := func(method, key, body string, userID uint64) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, "/api/v1/orders", strings.NewReader(body))
+		if key != "" {
+			r.Header.Set(IdempotencyHeader, key)
+		}
+		if userID != 0 {
+			r = r.WithContext(context.WithValue(r.Context(), KeyID, userID))
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+
+	first := send(http.MethodPost, "abc", `{"cart": 1}`, 1)
+	if first.Code != http.StatusCreated || calls != 1 {
+		t.Fatalf("first request: status %d after %d calls", first.Code, calls)
+	}
+	if first.Header().Get("Idempotent-Replayed") != "" {
+		t.Error("first response marked as replayed")
+	}
+
+	replay := send(http.MethodPost, "abc", `{"cart": 1}`, 1)
+	if calls != 1 {
+		t.Fatal("replayed request reached the handler")
+	}
+	if replay.Code != http.StatusCreated || replay.Body.String() != `{"id": 1}` {
+		t.Errorf("replay = %d %s, want the stored response", replay.Code, replay.Body)
+	}
+	if replay.Header().Get("Location") != "/api/v1/orders/1" || replay.Header().Get("Idempotent-Replayed") != "true" {
+		t.Errorf("replay headers = %v", replay.Header())
+	}
+	if replay.Header().Get("X-Request-Only") != "" {
+		t.Error("replay repeated a header that is not kept")
+	}
+
+	if w := send(http.MethodPost, "abc", `{"cart": 2}`, 1); w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("key reused with another body: status %d, want 422", w.Code)
+	}
+
+	if w := send(http.MethodPost, "abc", `{"cart": 1}`, 2); w.Code != http.StatusCreated || calls != 2 {
+		t.Errorf("another user's key: status %d after %d calls, want a new request", w.Code, calls)
+	}
+
+	if _, err := store.Claim(context.Background(), "user:1:busy", "other", fingerprint(httptest.NewRequest(http.MethodPost, "/api/v1/orders", nil), []byte("{}")), time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if w := send(http.MethodPost, "busy", `{}`, 1); w.Code != http.StatusConflict {
+		t.Errorf("key in flight: status %d, want 409", w.Code)
+	}
+
+	status = http.StatusInternalServerError
+	send(http.MethodPost, "failing", `{}`, 1)
+	status = http.StatusCreated
+	if w := send(http.MethodPost, "failing", `{}`, 1); w.Code != http.StatusCreated || calls != 4 {
+		t.Errorf("retry after a server error: status %d after %d calls, want it handled again", w.Code, calls)
+	}
+
+	// A request that outlived its lock must not store its response over the
+	// retry that took the key over.
+	slow := Idempotency(store, time.Hour, time.Minute)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		store.takeOver("user:1:slow")
+		w.WriteHeader(http.StatusCreated)
+	}))
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/orders", strings.NewReader(`{}`))
+	r.Header.Set(IdempotencyHeader, "slow")
+	slow.ServeHTTP(httptest.NewRecorder(), r.WithContext(context.WithValue(r.Context(), KeyID, uint64(1))))
+	if e := store.entries["user:1:slow"]; e.owner != "retry" || e.response != nil {
+		t.Errorf("claim taken over by a retry was completed by the first request: %+v", e)
+	}
+
+	calls = 0
+	send(http.MethodPut, "abc", `{"cart": 1}`, 1)
+	send(http.MethodPost, "", `{"cart": 1}`, 1)
+	send(http.MethodPost, "", `{"cart": 1}`, 1)
+	if calls != 3 {
+		t.Errorf("%d of 3 requests without a key or with another method were handled", calls)
+	}
+}
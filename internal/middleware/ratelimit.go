@@ -0,0 +1,142 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Rate allows Limit requests per Period. Up to Limit requests may arrive at
+// once, after which they are let through evenly across the period.
+type Rate struct {
+	Limit  int
+	Period time.Duration
+}
+
+// RateResult is the outcome of one request against a Rate.
+type RateResult struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// Reset is the time until the full limit is available again.
+	Reset time.Duration
+	// RetryAfter is the time until the next request is allowed when this one
+	// was not.
+	RetryAfter time.Duration
+}
+
+// RateLimiter counts requests per key. It is implemented in Redis and in
+// memory.
+type RateLimiter interface {
+	Allow(ctx context.Context, key string, rate Rate) (RateResult, error)
+}
+
+// RateKey identifies the client a request is counted against.
+type RateKey func(r *http.Request) string
+
+// ByIP counts requests per client address, as resolved by RealIP. Headers
+// set by the client itself are ignored, so that it cannot pick a fresh
+// bucket for every request.
+func ByIP(r *http.Request) string {
+	return "ip:" + ClientIP(r)
+}
+
+// ByUser counts requests per logged in user, and per address otherwise.
+func ByUser(r *http.Request) string {
+	if userID, ok := r.Context().Value(KeyID).(uint64); ok {
+		return fmt.Sprintf("user:%d", userID)
+	}
+	return ByIP(r)
+}
+
+// ByAPIKey counts requests per API key, so that each of a user's keys has
+// its own allowance, and falls back to ByUser.
+func ByAPIKey(r *http.Request) string {
+	if key, ok := APIKeyFrom(r.Context()); ok {
+		return fmt.Sprintf("key:%d", key.ID)
+	}
+	return ByUser(r)
+}
+
+// RatePolicy limits the requests whose path starts with one of Prefixes and,
+// if Methods is set, that use one of them.
+type RatePolicy struct {
+	Name     string
+	Prefixes []string
+	Methods  []string
+	Rate     Rate
+	Key      RateKey
+}
+
+func (p RatePolicy) matches(r *http.Request) bool {
+	if len(p.Methods) > 0 && !slices.Contains(p.Methods, r.Method) {
+		return false
+	}
+	for _, prefix := range p.Prefixes {
+		if strings.HasPrefix(r.URL.Path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// RateLimit counts each request against the first policy that matches it and
+// rejects it with 429 Too Many Requests once the policy's rate is used up.
+// Requests matching no policy, or a policy without a positive limit, are
+// not limited. Responses carry the RateLimit-* headers and rejected ones a
+// Retry-After header.
+//
+// The limit is not enforced while the limiter is failing, so that an outage
+// of Redis does not take the API down with it.
+//
+// It must run after BearerAuth and LoadAndSave to tell users apart.
+func RateLimit(l RateLimiter, policies ...RatePolicy) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			i := slices.IndexFunc(policies, func(p RatePolicy) bool { return p.matches(r) })
+			if i < 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+			policy := policies[i]
+			if policy.Rate.Limit <= 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			res, err := l.Allow(r.Context(), policy.Name+":"+policy.Key(r), policy.Rate)
+			if err != nil {
+				log.Printf("rate limit: %v", err)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			h := w.Header()
+			h.Set("RateLimit-Policy", fmt.Sprintf("%d;w=%d", policy.Rate.Limit, seconds(policy.Rate.Period)))
+			h.Set("RateLimit-Limit", strconv.Itoa(res.Limit))
+			h.Set("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+			h.Set("RateLimit-Reset", strconv.Itoa(seconds(res.Reset)))
+
+			if !res.Allowed {
+				h.Set("Retry-After", strconv.Itoa(seconds(res.RetryAfter)))
+				h.Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusTooManyRequests)
+				_, _ = w.Write([]byte(`{"message": "too many requests"}`))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// seconds rounds d up to whole seconds, as the headers expect.
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
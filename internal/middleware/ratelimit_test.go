@@ -0,0 +1,163 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type stubLimiter struct {
+	keys []string
+	res  RateResult
+	err  error
+}
+
+func (l *stubLimiter) Allow(_ context.Context, key string, rate Rate) (RateResult, error) {
+	l.keys = append(l.keys, key)
+	res := l.res
+	res.Limit = rate.Limit
+	return res, l.err
+}
+
+func TestRateLimit(t *testing.T) {
+	policies := []RatePolicy{
+		{Name: "auth", Prefixes: []string{"/api/v1/login"}, Rate: Rate{Limit: 5, Period: time.Minute}, Key: ByIP},
+		{Name: "catalog", Prefixes: []string{"/api/v1/products"}, Methods: []string{http.MethodGet}, Rate: Rate{Limit: 300, Period: time.Minute}, Key: ByAPIKey},
+		{Name: "api", Prefixes: []string{"/api/"}, Rate: Rate{Limit: 60, Period: time.Minute}, Key: ByAPIKey},
+	}
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name    string
+		method  string
+		path    string
+		ctx     func(context.Context) context.Context
+		wantKey string
+	}{
+		{"strict login", http.MethodPost, "/api/v1/login", nil, "auth:ip:192.0.2.1"},
+		{"catalog read", http.MethodGet, "/api/v1/products/1", nil, "catalog:ip:192.0.2.1"},
+		{"catalog write falls through", http.MethodPost, "/api/v1/products", nil, "api:ip:192.0.2.1"},
+		{"user", http.MethodGet, "/api/v1/orders", func(ctx context.Context) context.Context {
+			return context.WithValue(ctx, KeyID, uint64(7))
+		}, "api:user:7"},
+		{"api key", http.MethodGet, "/api/v1/orders", func(ctx context.Context) context.Context {
+			ctx = context.WithValue(ctx, KeyID, uint64(7))
+			return context.WithValue(ctx, KeyAPIKey, APIKey{ID: 3, UserID: 7})
+		}, "api:key:3"},
+		{"unlimited", http.MethodGet, "/version", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &stubLimiter{res: RateResult{Allowed: true, Remaining: 4, Reset: 1500 * time.Millisecond}}
+
+			r := httptest.NewRequest(tt.method, tt.path, nil)
+			r.RemoteAddr = "192.0.2.1:1234"
+			if tt.ctx != nil {
+				r = r.WithContext(tt.ctx(r.Context()))
+			}
+			w := httptest.NewRecorder()
+			RateLimit(l, policies...)(ok).ServeHTTP(w, r)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("status = %d, want 200", w.Code)
+			}
+			if tt.wantKey == "" {
+				if len(l.keys) != 0 || w.Header().Get("RateLimit-Limit") != "" {
+					t.Fatalf("request was limited with %v", l.keys)
+				}
+				return
+			}
+			if len(l.keys) != 1 || l.keys[0] != tt.wantKey {
+				t.Fatalf("keys = %v, want [%s]", l.keys, tt.wantKey)
+			}
+			if got := w.Header().Get("RateLimit-Remaining"); got != "4" {
+				t.Errorf("RateLimit-Remaining = %q, want 4", got)
+			}
+			if got := w.Header().Get("RateLimit-Reset"); got != "2" {
+				t.Errorf("RateLimit-Reset = %q, want 2", got)
+			}
+		})
+	}
+
+	t.Run("rejected", func(t *testing.T) {
+		l := &stubLimiter{res: RateResult{Reset: time.Minute, RetryAfter: 12 * time.Second}}
+
+		w := httptest.NewRecorder()
+		RateLimit(l, policies...)(ok).ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/v1/login", nil))
+
+		if w.Code != http.StatusTooManyRequests {
+			t.Fatalf("status = %d, want 429", w.Code)
+		}
+		want := map[string]string{
+			"RateLimit-Policy":    "5;w=60",
+			"RateLimit-Limit":     "5",
+			"RateLimit-Remaining": "0",
+			"RateLimit-Reset":     "60",
+			"Retry-After":         "12",
+		}
+		for name, value := range want {
+			if got := w.Header().Get(name); got != value {
+				t.Errorf("%s = %q, want %q", name, got, value)
+			}
+		}
+	})
+
+	t.Run("limiter failing", func(t *testing.T) {
+		l := &stubLimiter{err: errors.New("connection refused")}
+
+		w := httptest.NewRecorder()
+		RateLimit(l, policies...)(ok).ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/v1/login", nil))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("status = %d, want the request let through", w.Code)
+		}
+	})
+}
+
+func TestRateLimitIgnoresSpoofedAddress(t *testing.T) {
+	trusted, err := ParseProxies([]string{"10.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy := RatePolicy{Name: "auth", Prefixes: []string{"/api/v1/login"}, Rate: Rate{Limit: 5, Period: time.Minute}, Key: ByIP}
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name      string
+		remote    string
+		realIP    string
+		forwarded string
+	}{
+		{"direct", "192.0.2.1:1234", "", ""},
+		{"spoofed headers", "192.0.2.1:1234", "203.0.113.6", "203.0.113.5"},
+		{"through the proxy", "10.0.0.1:80", "", "203.0.113.5, 192.0.2.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &stubLimiter{res: RateResult{Allowed: true}}
+
+			r := httptest.NewRequest(http.MethodPost, "/api/v1/login", nil)
+			r.RemoteAddr = tt.remote
+			if tt.realIP != "" {
+				r.Header.Set("X-Real-Ip", tt.realIP)
+			}
+			if tt.forwarded != "" {
+				r.Header.Set("X-Forwarded-For", tt.forwarded)
+			}
+			RealIP(trusted...)(RateLimit(l, policy)(ok)).ServeHTTP(httptest.NewRecorder(), r)
+
+			if len(l.keys) != 1 || l.keys[0] != "auth:ip:192.0.2.1" {
+				t.Errorf("keys = %v, want [auth:ip:192.0.2.1]", l.keys)
+			}
+		})
+	}
+}
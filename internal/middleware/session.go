@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	KeyClient key = "client"
+
+	// keyLastSeen is kept in the session data. Updating it modifies the
+	// session, so that LoadAndSave commits it with the current client.
+	keyLastSeen = "last_seen"
+
+	// lastSeenInterval limits how often an otherwise unchanged session is
+	// written back just to record activity.
+	lastSeenInterval = time.Minute
+)
+
+// Client is the device a session was last used from. LoadAndSave puts it in
+// the context passed to the session store on commit.
+type Client struct {
+	IPAddress string
+	UserAgent string
+}
+
+func ClientFrom(ctx context.Context) (Client, bool) {
+	c, ok := ctx.Value(KeyClient).(Client)
+	return c, ok
+}
+
+// Session is a logged in session as listed by a SessionIndex. ID identifies
+// the session without revealing its token.
+type Session struct {
+	ID         string
+	IPAddress  string
+	UserAgent  string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	Expiry     time.Time
+}
+
+// SessionIndex is implemented by session stores that can find sessions by
+// user, so users can review and revoke their own sessions.
+type SessionIndex interface {
+	// SessionID returns the ID of the session with token.
+	SessionID(token string) (string, error)
+	ListUserSessions(ctx context.Context, userID uint64) ([]Session, error)
+	// RevokeUserSession deletes a session of userID, reporting whether it
+	// existed.
+	RevokeUserSession(ctx context.Context, userID uint64, id string) (bool, error)
+	// RevokeOtherUserSessions deletes all sessions of userID except keepID
+	// and returns how many were deleted. An empty keepID deletes them all.
+	RevokeOtherUserSessions(ctx context.Context, userID uint64, keepID string) (int, error)
+}
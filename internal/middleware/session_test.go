@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gmhafiz/scs/v2"
+	"github.com/gmhafiz/scs/v2/memstore"
+)
+
+// recordingStore records the client passed to each commit.
+type recordingStore struct {
+	*memstore.MemStore
+	commits []Client
+}
+
+func (s *recordingStore) FindCtx(_ context.Context, token string) ([]byte, bool, error) {
+	return s.Find(token)
+}
+
+func (s *recordingStore) CommitCtx(ctx context.Context, token string, b []byte, expiry time.Time) error {
+	client, _ := ClientFrom(ctx)
+	s.commits = append(s.commits, client)
+	return s.Commit(token, b, expiry)
+}
+
+func (s *recordingStore) DeleteCtx(_ context.Context, token string) error {
+	return s.Delete(token)
+}
+
+func TestLoadAndSaveRecordsClient(t *testing.T) {
+	store := &recordingStore{MemStore: memstore.New()}
+	session := scs.New()
+	session.Store = store
+
+	h := LoadAndSave(session)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/login" {
+			session.Put(r.Context(), string(KeyID), uint64(42))
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(path string, cookies []*http.Cookie) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("User-Agent", "test-agent")
+		req.Header.Set("X-Forwarded-For", "192.0.2.1, 10.0.0.1")
+		for _, c := range cookies {
+			req.AddCookie(c)
+		}
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, req)
+		return rr
+	}
+
+	// Anonymous visitors do not get a session just for being seen.
+	serve("/", nil)
+	if len(store.commits) != 0 {
+		t.Fatalf("got %d commits for an anonymous request, want 0", len(store.commits))
+	}
+
+	cookies := serve("/login", nil).Result().Cookies()
+	if len(store.commits) != 1 {
+		t.Fatalf("got %d commits after login, want 1", len(store.commits))
+	}
+	want := Client{IPAddress: "192.0.2.1", UserAgent: "test-agent"}
+	if store.commits[0] != want {
+		t.Errorf("committed client %+v, want %+v", store.commits[0], want)
+	}
+
+	// Activity within lastSeenInterval does not write the session again.
+	serve("/", cookies)
+	if len(store.commits) != 1 {
+		t.Errorf("got %d commits, want the session left unchanged", len(store.commits))
+	}
+}
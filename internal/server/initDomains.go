@@ -1,13 +1,20 @@
 package server
 
 import (
+	"context"
 	"embed"
+	"fmt"
 	"io/fs"
+	"log"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
 
+	"github.com/thang1834/go-goss/internal/domain/audit"
 	"github.com/thang1834/go-goss/internal/domain/authentication"
+	"github.com/thang1834/go-goss/internal/domain/cart"
+	"github.com/thang1834/go-goss/internal/domain/category"
+	"github.com/thang1834/go-goss/internal/domain/discount"
 	// authorHandler "github.com/thang1834/go-goss/internal/domain/author/handler"
 	// authorRepo "github.com/thang1834/go-goss/internal/domain/author/repository"
 	// authorUseCase "github.com/thang1834/go-goss/internal/domain/author/usecase"
@@ -15,8 +22,16 @@ import (
 	// bookRepo "github.com/thang1834/go-goss/internal/domain/book/repository"
 	// bookUseCase "github.com/thang1834/go-goss/internal/domain/book/usecase"
 	"github.com/thang1834/go-goss/internal/domain/health"
+	"github.com/thang1834/go-goss/internal/domain/order"
+	"github.com/thang1834/go-goss/internal/domain/payment"
+	"github.com/thang1834/go-goss/internal/domain/product"
+	"github.com/thang1834/go-goss/internal/domain/productimage"
+	"github.com/thang1834/go-goss/internal/domain/review"
+	"github.com/thang1834/go-goss/internal/domain/wishlist"
 	"github.com/thang1834/go-goss/internal/middleware"
 	"github.com/thang1834/go-goss/internal/utility/respond"
+	"github.com/thang1834/go-goss/third_party/blobstore"
+	"github.com/thang1834/go-goss/third_party/oidc"
 )
 
 func (s *Server) InitDomains() {
@@ -25,6 +40,16 @@ func (s *Server) InitDomains() {
 	s.initAuthentication()
 	// s.initAuthor()
 	s.initHealth()
+	s.initCategory()
+	s.initProduct()
+	s.initProductImage()
+	s.initCart()
+	s.initDiscount()
+	s.initOrder()
+	s.initPayment()
+	s.initReview()
+	s.initWishlist()
+	s.initAudit()
 	// s.initBook()
 }
 
@@ -87,6 +112,113 @@ func (s *Server) initSwagger() {
 // }
 
 func (s *Server) initAuthentication() {
-	repo := authentication.NewRepo(s.ent, s.db, s.session)
-	authentication.RegisterHTTPEndPoints(s.router, s.session, repo)
+	repo := authentication.NewRepo(s.ent, s.db, s.session, s.cfg.Auth)
+	redisAddr := fmt.Sprintf("%s:%s", s.cfg.Cache.Host, s.cfg.Cache.Port)
+
+	h, err := authentication.RegisterHTTPEndPoints(s.router, s.session, repo, redisAddr, s.mailer, s.loginAttempts(), s.cfg.Auth)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	s.auth = h
+
+	if cfg := s.cfg.OIDC; cfg.Issuer != "" {
+		p, err := oidc.New(context.Background(), oidc.Config{
+			Name:         cfg.Name,
+			Issuer:       cfg.Issuer,
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Scopes:       cfg.Scopes,
+		}, nil)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		h.AddOIDCProvider(p, cfg.LoginRedirectURL)
+	}
+}
+
+// loginAttempts counts failed logins in Redis when it is enabled and in
+// Postgres otherwise.
+func (s *Server) loginAttempts() authentication.AttemptStore {
+	switch {
+	case s.cluster != nil:
+		return authentication.NewRedisAttempts(s.cluster)
+	case s.cache != nil:
+		return authentication.NewRedisAttempts(s.cache)
+	default:
+		return authentication.NewPostgresAttempts(s.db)
+	}
+}
+
+func (s *Server) initProduct() {
+	repo := product.NewRepo(s.ent)
+	uc := product.New(repo)
+	product.RegisterHTTPEndPoints(s.router, s.validator, uc, s.session, s.auth)
+}
+
+func (s *Server) initProductImage() {
+	store, err := blobstore.New(s.cfg.Storage)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	if srv, ok := store.(blobstore.Server); ok {
+		s.router.Handle(s.cfg.Storage.BaseURL+"/*", srv.Handler())
+	}
+
+	repo := productimage.NewRepo(s.ent)
+	uc := productimage.New(repo, store, s.cfg.Storage)
+	productimage.RegisterHTTPEndPoints(s.router, s.validator, uc, s.session, s.auth)
+}
+
+func (s *Server) initCategory() {
+	repo := category.NewRepo(s.ent)
+	uc := category.New(repo)
+	category.RegisterHTTPEndPoints(s.router, s.validator, uc, s.session, s.auth)
+}
+
+func (s *Server) initCart() {
+	repo := cart.NewRepo(s.ent)
+	uc := cart.New(repo)
+	h := cart.RegisterHTTPEndPoints(s.router, s.validator, uc, s.session)
+	s.auth.OnLogin(h.MergeSessionCart)
+}
+
+func (s *Server) initOrder() {
+	repo := order.NewRepo(s.ent)
+	uc := order.New(repo)
+	order.RegisterHTTPEndPoints(s.router, s.validator, uc, s.session, s.auth)
+}
+
+func (s *Server) initPayment() {
+	providers := payment.NewProviders(
+		payment.Timeout(payment.NewFake(s.cfg.Payment.WebhookSecret, s.cfg.Payment.WebhookTolerance), s.cfg.Payment.ProviderTimeout),
+	)
+
+	repo := payment.NewRepo(s.ent)
+	uc := payment.New(repo, providers)
+	payment.RegisterHTTPEndPoints(s.router, s.validator, uc, s.session, s.auth)
+}
+
+func (s *Server) initDiscount() {
+	repo := discount.NewRepo(s.ent)
+	uc := discount.New(repo)
+	discount.RegisterHTTPEndPoints(s.router, s.validator, uc, s.session, s.auth)
+}
+
+func (s *Server) initReview() {
+	repo := review.NewRepo(s.ent)
+	uc := review.New(repo)
+	review.RegisterHTTPEndPoints(s.router, s.validator, uc, s.session, s.auth)
+}
+
+func (s *Server) initWishlist() {
+	repo := wishlist.NewRepo(s.ent)
+	uc := wishlist.New(repo)
+	wishlist.RegisterHTTPEndPoints(s.router, s.validator, uc, s.session)
+}
+
+func (s *Server) initAudit() {
+	repo := audit.NewRepo(s.ent)
+	uc := audit.New(repo)
+	audit.RegisterHTTPEndPoints(s.router, uc, s.session, s.auth)
 }
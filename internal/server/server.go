@@ -3,7 +3,6 @@ package server
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
 	"fmt"
 	"log"
 	"log/slog"
@@ -42,10 +41,17 @@ import (
 	"github.com/thang1834/go-goss/third_party/otlp"
 	//_ "github.com/thang1834/go-goss/docs"
 	"github.com/thang1834/go-goss/ent/gen"
+	"github.com/thang1834/go-goss/internal/domain/audit"
+	"github.com/thang1834/go-goss/internal/domain/authentication"
 	"github.com/thang1834/go-goss/internal/middleware"
 	db "github.com/thang1834/go-goss/third_party/database"
+	"github.com/thang1834/go-goss/third_party/idempotency"
+	"github.com/thang1834/go-goss/third_party/mailer"
 	"github.com/thang1834/go-goss/third_party/postgresstore"
+	"github.com/thang1834/go-goss/third_party/ratelimit"
 	redisLib "github.com/thang1834/go-goss/third_party/redis"
+	"github.com/thang1834/go-goss/third_party/redisstore"
+	"github.com/thang1834/go-goss/third_party/responsecache"
 	"github.com/thang1834/go-goss/third_party/validate"
 )
 
@@ -62,9 +68,16 @@ type Server struct {
 
 	session       *scs.SessionManager
 	sessionCloser *postgresstore.PostgresStore
+	auth          *authentication.Handler
+	audit         *audit.Recorder
+
+	idempotencyCloser *idempotency.Postgres
+	responses         middleware.ResponseCache
 
 	otlp *middleware.Config
 
+	mailer mailer.Mailer
+
 	validator *validator.Validate
 	cors      *cors.Cors
 	router    *chi.Mux
@@ -108,6 +121,7 @@ func (s *Server) Init() {
 	s.newRedis()
 	s.NewDatabase()
 	s.newValidator()
+	s.newMailer()
 	s.newAuthentication()
 	s.newRouter()
 	s.setGlobalMiddleware()
@@ -133,7 +147,10 @@ func (s *Server) setCors() {
 				http.MethodPatch,
 				http.MethodDelete,
 			},
-			AllowedHeaders:   []string{"*"},
+			AllowedHeaders: []string{"*"},
+			// Browsers hide the ETag from scripts unless it is exposed,
+			// and scripts need it to send If-Match.
+			ExposedHeaders:   []string{"ETag"},
 			AllowCredentials: true,
 		})
 }
@@ -202,10 +219,26 @@ func (s *Server) newValidator() {
 	s.validator = validate.New()
 }
 
+func (s *Server) newMailer() {
+	m, err := mailer.New(s.cfg.Mail)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	s.mailer = m
+}
+
 func (s *Server) newAuthentication() {
 	manager := scs.New()
-	manager.Store = postgresstore.New(s.sqlx.DB)
-	manager.CtxStore = postgresstore.New(s.sqlx.DB)
+	switch s.cfg.Session.Store {
+	case "redis":
+		store := redisstore.New(s.redisClient())
+		manager.Store = store
+		manager.CtxStore = store
+	default:
+		manager.Store = postgresstore.New(s.sqlx.DB)
+		manager.CtxStore = postgresstore.New(s.sqlx.DB)
+		s.sessionCloser = postgresstore.NewWithCleanupInterval(s.sqlx.DB, 30*time.Minute)
+	}
 	manager.Lifetime = s.cfg.Session.Duration
 	manager.Cookie.Name = s.cfg.Session.Name
 	manager.Cookie.Domain = s.cfg.Session.Domain
@@ -215,11 +248,22 @@ func (s *Server) newAuthentication() {
 	manager.Cookie.SameSite = http.SameSite(s.cfg.Session.SameSite)
 	manager.Cookie.Secure = s.cfg.Session.Secure
 
-	s.sessionCloser = postgresstore.NewWithCleanupInterval(s.sqlx.DB, 30*time.Minute)
-
 	s.session = manager
 }
 
+// redisClient returns the configured Redis cluster or single node client.
+func (s *Server) redisClient() redis.UniversalClient {
+	switch {
+	case s.cluster != nil:
+		return s.cluster
+	case s.cache != nil:
+		return s.cache
+	default:
+		log.Fatalln("the redis session store needs REDIS_ENABLE=true")
+		return nil
+	}
+}
+
 func (s *Server) newRouter() {
 	s.router = chi.NewRouter()
 }
@@ -230,10 +274,27 @@ func (s *Server) setGlobalMiddleware() {
 		w.WriteHeader(http.StatusNotFound)
 		_, _ = w.Write([]byte(`{"message": "endpoint not found"}`))
 	})
+	proxies, err := middleware.ParseProxies(s.cfg.Api.TrustedProxies)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	s.router.Use(middleware.RealIP(proxies...))
 	s.router.Use(s.cors.Handler)
 	s.router.Use(middleware.Otlp(s.cfg.OpenTelemetry.Enable))
 	s.router.Use(middleware.Json)
-	s.router.Use(middleware.LoadAndSave(s.session))
+	// Webhooks are called by payment providers, not browsers. They carry no
+	// session cookie and are authenticated by their signature instead.
+	s.router.Use(middleware.BearerAuth(s.session, s.apiKeys()))
+	s.router.Use(middleware.Skip(middleware.LoadAndSave(s.session), "/api/v1/webhooks/"))
+	if s.cfg.RateLimit.Enable {
+		s.router.Use(middleware.Skip(middleware.RateLimit(s.rateLimiter(), s.ratePolicies()...), "/api/v1/webhooks/"))
+	}
+	if cfg := s.cfg.Idempotency; cfg.Enable {
+		s.router.Use(middleware.Idempotency(s.idempotencyStore(), cfg.TTL, cfg.LockTimeout))
+	}
+	if ttl := s.cfg.Cache.CacheTime; ttl > 0 {
+		s.router.Use(middleware.Cache(s.responses, ttl, s.cachePolicies()...))
+	}
 	s.router.Use(middleware.Audit)
 	if s.cfg.Api.RequestLog {
 		s.router.Use(chiMiddleware.Logger)
@@ -241,6 +302,159 @@ func (s *Server) setGlobalMiddleware() {
 	s.router.Use(middleware.Recovery)
 }
 
+// apiKeys verifies bearer API keys. Global middleware is set up before the
+// domains, so it gets its own repository rather than the one in initAuthentication.
+func (s *Server) apiKeys() middleware.APIKeyVerifier {
+	return authentication.NewRepo(s.ent, s.db, s.session, s.cfg.Auth).VerifyAPIKey
+}
+
+// rateLimiter shares rate limits through Redis when it is enabled and keeps
+// them in memory otherwise.
+func (s *Server) rateLimiter() middleware.RateLimiter {
+	switch {
+	case s.cluster != nil:
+		return ratelimit.NewRedis(s.cluster)
+	case s.cache != nil:
+		return ratelimit.NewRedis(s.cache)
+	default:
+		return ratelimit.NewMemory()
+	}
+}
+
+// idempotencyStore keeps responses for Idempotency-Key retries in Redis
+// when it is enabled and in Postgres otherwise.
+func (s *Server) idempotencyStore() middleware.IdempotencyStore {
+	switch {
+	case s.cluster != nil:
+		return idempotency.NewRedis(s.cluster)
+	case s.cache != nil:
+		return idempotency.NewRedis(s.cache)
+	default:
+		store := idempotency.NewPostgres(s.db)
+		s.idempotencyCloser = store
+		return store
+	}
+}
+
+// responseCache keeps public responses in process, in front of Redis when it
+// is enabled so that every instance shares them.
+func (s *Server) responseCache() middleware.ResponseCache {
+	lru := responsecache.NewLRU(s.cfg.Cache.LRUSize)
+
+	switch {
+	case s.cluster != nil:
+		return responsecache.NewTiered(lru, responsecache.NewRedis(s.cluster))
+	case s.cache != nil:
+		return responsecache.NewTiered(lru, responsecache.NewRedis(s.cache))
+	default:
+		return lru
+	}
+}
+
+// cachePolicies list the public catalog endpoints whose responses are cached
+// and the tags they are invalidated by.
+func (s *Server) cachePolicies() []middleware.CachePolicy {
+	return []middleware.CachePolicy{
+		{Prefixes: []string{"/api/v1/products"}, Tags: []string{"products"}},
+		{Prefixes: []string{"/api/v1/categories"}, Tags: []string{"categories"}},
+		{Prefixes: []string{"/api/v1/reviews"}, Tags: []string{"reviews"}},
+	}
+}
+
+// cacheTags are the response cache tags invalidated when rows of a type
+// change. Products show their rating and category, so changes to reviews and
+// categories invalidate them too.
+var cacheTags = map[string][]string{
+	gen.TypeProduct:      {"products"},
+	gen.TypeProductImage: {"products"},
+	gen.TypeCategory:     {"categories", "products"},
+	gen.TypeReview:       {"reviews", "products"},
+}
+
+// invalidateResponses drops the cached responses that may show rows a
+// mutation changed. Within a transaction it waits for the commit, so that
+// the old rows cannot be cached again in between.
+func invalidateResponses(c middleware.ResponseCache) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			tags, ok := cacheTags[m.Type()]
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			val, err := next.Mutate(ctx, m)
+			if err != nil {
+				return val, err
+			}
+
+			invalidate := func(ctx context.Context) {
+				if err := c.Invalidate(context.WithoutCancel(ctx), tags...); err != nil {
+					log.Printf("cache: %v", err)
+				}
+			}
+
+			txer, ok := m.(interface{ Tx() (*gen.Tx, error) })
+			if !ok {
+				invalidate(ctx)
+				return val, nil
+			}
+			tx, err := txer.Tx()
+			if err != nil {
+				// Not within a transaction.
+				invalidate(ctx)
+				return val, nil
+			}
+			tx.OnCommit(func(next gen.Committer) gen.Committer {
+				return gen.CommitFunc(func(ctx context.Context, tx *gen.Tx) error {
+					if err := next.Commit(ctx, tx); err != nil {
+						return err
+					}
+					invalidate(ctx)
+					return nil
+				})
+			})
+
+			return val, nil
+		})
+	}
+}
+
+// ratePolicies are checked in order; the first matching one applies.
+func (s *Server) ratePolicies() []middleware.RatePolicy {
+	cfg := s.cfg.RateLimit
+
+	return []middleware.RatePolicy{
+		{
+			Name: "auth",
+			Prefixes: []string{
+				"/api/v1/login",
+				"/api/v1/register",
+				"/api/v1/password/",
+				"/api/v1/verify-email/resend",
+			},
+			Rate: middleware.Rate{Limit: cfg.AuthLimit, Period: cfg.AuthPeriod},
+			Key:  middleware.ByIP,
+		},
+		{
+			Name: "catalog",
+			Prefixes: []string{
+				"/api/v1/products",
+				"/api/v1/categories",
+				"/api/v1/reviews",
+			},
+			Methods: []string{http.MethodGet, http.MethodHead},
+			Rate:    middleware.Rate{Limit: cfg.CatalogLimit, Period: cfg.CatalogPeriod},
+			Key:     middleware.ByAPIKey,
+		},
+		{
+			Name:     "api",
+			Prefixes: []string{"/api/"},
+			Rate:     middleware.Rate{Limit: cfg.APILimit, Period: cfg.APIPeriod},
+			Key:      middleware.ByAPIKey,
+		},
+	}
+}
+
 func (s *Server) Migrate() {
 	log.Println("migrating...")
 
@@ -353,25 +567,13 @@ func (s *Server) newEnt(dsn string) {
 	drv := entsql.OpenDB(dialect.Postgres, otelDB)
 	client := gen.NewClient(gen.Driver(drv))
 
-	client.Use(func(next ent.Mutator) ent.Mutator {
-		return ent.MutateFunc(func(ctx context.Context, mutation ent.Mutation) (ent.Value, error) {
-			meta, ok := ctx.Value(middleware.KeyAuditID).(middleware.Event)
-			if !ok {
-				return next.Mutate(ctx, mutation)
-			}
-
-			val, err := next.Mutate(ctx, mutation)
-
-			meta.Table = mutation.Type()
-			meta.Action = middleware.Action(mutation.Op().String())
+	// The audit trail is written through the same client. Its writes carry
+	// no request context, so the hook passes them through.
+	s.audit = audit.NewRecorder(audit.NewRepo(client))
+	client.Use(audit.Hook(s.audit))
 
-			newValues, _ := json.Marshal(val)
-			meta.NewValues = string(newValues)
-			log.Println(meta)
-
-			return val, err
-		})
-	})
+	s.responses = s.responseCache()
+	client.Use(invalidateResponses(s.responses))
 
 	s.ent = client
 }
@@ -478,10 +680,18 @@ func gracefulShutdown(ctx context.Context, s *Server) error {
 }
 
 func (s *Server) closeResources(ctx context.Context) {
+	if err := s.audit.Close(ctx); err != nil {
+		log.Println(err)
+	}
 	_ = s.sqlx.Close()
 	_ = s.ent.Close()
 	s.cluster.Shutdown(ctx)
 	s.cache.Shutdown(ctx)
-	s.sessionCloser.StopCleanup()
+	if s.sessionCloser != nil {
+		s.sessionCloser.StopCleanup()
+	}
+	if s.idempotencyCloser != nil {
+		s.idempotencyCloser.StopCleanup()
+	}
 	defer s.otlp.Cancel()
 }
@@ -0,0 +1,20 @@
+package filter
+
+import "time"
+
+// ParseTime accepts RFC 3339 timestamps or plain dates. When endOfDay is set
+// a plain date is moved to the start of the following day, so that it can be
+// used as an exclusive upper bound that includes the whole day.
+func ParseTime(value string, endOfDay bool) time.Time {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t
+	}
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}
+	}
+	if endOfDay {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t
+}
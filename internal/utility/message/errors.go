@@ -10,5 +10,7 @@ var (
 
 	ErrNoRecord = errors.New("no record found")
 
+	ErrPreconditionFailed = errors.New("the resource has been modified since it was read")
+
 	ErrFetchingBook = errors.New("error fetching books")
 )
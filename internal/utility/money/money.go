@@ -0,0 +1,14 @@
+package money
+
+import "math"
+
+// Round rounds an amount to 2 decimal places, matching the NUMERIC(12,2)
+// columns prices and totals are stored in.
+func Round(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+// LineTotal returns unitPrice * quantity rounded to 2 decimal places.
+func LineTotal(unitPrice float64, quantity int) float64 {
+	return Round(unitPrice * float64(quantity))
+}
@@ -26,5 +26,5 @@ func Int(r *http.Request, param string) (int, error) {
 }
 
 func String(r *http.Request, param string) string {
-	return chi.URLParam(r, chi.URLParam(r, param))
+	return chi.URLParam(r, param)
 }
@@ -0,0 +1,28 @@
+package request
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/thang1834/go-goss/internal/utility/respond"
+)
+
+// IfMatch returns the row versions listed in the If-Match header as made by
+// respond.ETag. ok is false when the header is absent or "*", in which case
+// any version may be changed. Other tags, including weak ones, match no
+// version, so a header with only those gives an empty list.
+func IfMatch(r *http.Request) (versions []time.Time, ok bool) {
+	header := strings.TrimSpace(r.Header.Get("If-Match"))
+	if header == "" || header == "*" {
+		return nil, false
+	}
+
+	versions = []time.Time{}
+	for _, tag := range strings.Split(header, ",") {
+		if v, ok := respond.Version(tag); ok {
+			versions = append(versions, v)
+		}
+	}
+	return versions, true
+}
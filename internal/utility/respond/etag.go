@@ -0,0 +1,123 @@
+package respond
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+
+	"github.com/thang1834/go-goss/internal/utility/message"
+)
+
+// ETag is the entity tag of a row last changed at updatedAt. Clients send it
+// back in If-Match to update the row only if it has not changed since, which
+// request.IfMatch and Version turn back into updatedAt.
+//
+// Postgres keeps microseconds, so updatedAt is rounded to match the tag of
+// the row as it is read back.
+func ETag(updatedAt time.Time) string {
+	return `"v` + strconv.FormatInt(updatedAt.Round(time.Microsecond).UnixMicro(), 10) + `"`
+}
+
+// Version returns the updatedAt an entity tag made by ETag was made from.
+func Version(etag string) (time.Time, bool) {
+	tag, ok := strings.CutPrefix(strings.TrimSpace(etag), `"v`)
+	if !ok {
+		return time.Time{}, false
+	}
+	tag, ok = strings.CutSuffix(tag, `"`)
+	if !ok {
+		return time.Time{}, false
+	}
+	// Drop the hash JsonWithVersion adds.
+	tag, _, _ = strings.Cut(tag, ".")
+	micro, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.UnixMicro(micro), true
+}
+
+// JsonWithETag writes payload like Json, tagged with etag, or with a hash of
+// the encoded payload when etag is empty. A GET or HEAD request whose
+// If-None-Match header lists the tag gets 304 Not Modified without a body.
+func JsonWithETag(w http.ResponseWriter, r *http.Request, statusCode int, payload interface{}, etag string) {
+	data, ok := encode(w, payload)
+	if !ok {
+		return
+	}
+
+	if etag == "" {
+		etag = `"` + hash(data) + `"`
+	}
+	writeWithETag(w, r, statusCode, data, etag)
+}
+
+// JsonWithVersion writes payload like JsonWithETag, tagged with the ETag of
+// updatedAt and a hash of the encoded payload. It is meant for a row shown
+// together with rows it does not version, such as an order with the names of
+// its products: the tag changes with any of them, and Version still reads
+// updatedAt back from it for If-Match.
+func JsonWithVersion(w http.ResponseWriter, r *http.Request, statusCode int, payload interface{}, updatedAt time.Time) {
+	data, ok := encode(w, payload)
+	if !ok {
+		return
+	}
+
+	etag := strings.TrimSuffix(ETag(updatedAt), `"`) + "." + hash(data) + `"`
+	writeWithETag(w, r, statusCode, data, etag)
+}
+
+func encode(w http.ResponseWriter, payload interface{}) ([]byte, bool) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		log.Println(err)
+		Error(w, http.StatusInternalServerError, message.ErrInternalError)
+		return nil, false
+	}
+	if string(data) == "null" {
+		data = []byte("[]")
+	}
+	return data, true
+}
+
+func hash(data []byte) string {
+	return strconv.FormatUint(xxhash.Sum64(data), 16)
+}
+
+func writeWithETag(w http.ResponseWriter, r *http.Request, statusCode int, data []byte, etag string) {
+	w.Header().Set("ETag", etag)
+
+	if (r.Method == http.MethodGet || r.Method == http.MethodHead) && NotModified(r, etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	write(w, data)
+}
+
+// NotModified tells whether the If-None-Match header of r lists etag,
+// comparing tags weakly as RFC 9110 asks.
+func NotModified(r *http.Request, etag string) bool {
+	header := r.Header.Get("If-None-Match")
+	if header == "" {
+		return false
+	}
+	if strings.TrimSpace(header) == "*" {
+		return true
+	}
+
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, tag := range strings.Split(header, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(tag), "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+package respond
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestETag(t *testing.T) {
+	// Postgres rounds to the microsecond, so the tag of a row as saved and as
+	// read back must be the same.
+	saved := time.Date(2026, 1, 1, 0, 0, 0, 123456789, time.UTC)
+	read := time.Date(2026, 1, 1, 0, 0, 0, 123457000, time.UTC)
+	if ETag(saved) != ETag(read) {
+		t.Fatalf("ETag(%s) = %s, ETag(%s) = %s", saved, ETag(saved), read, ETag(read))
+	}
+
+	v, ok := Version(ETag(saved))
+	if !ok || !v.Equal(read) {
+		t.Errorf("Version(%s) = %s, %v, want %s", ETag(saved), v, ok, read)
+	}
+
+	for _, tag := range []string{`W/"v1"`, `"1"`, `"vx"`, `v1`, ``} {
+		if _, ok := Version(tag); ok {
+			t.Errorf("Version(%q) is ok", tag)
+		}
+	}
+}
+
+func TestJsonWithETag(t *testing.T) {
+	serve := func(method, ifNoneMatch string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, "/", nil)
+		if ifNoneMatch != "" {
+			r.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		JsonWithETag(w, r, http.StatusOK, map[string]int{"id": 1}, "")
+		return w
+	}
+
+	w := serve(http.MethodGet, "")
+	etag := w.Header().Get("ETag")
+	if w.Code != http.StatusOK || etag == "" || w.Body.String() != `{"id":1}` {
+		t.Fatalf("got %d %q with ETag %q", w.Code, w.Body.String(), etag)
+	}
+
+	tests := []struct {
+		method      string
+		ifNoneMatch string
+		want        int
+	}{
+		{http.MethodGet, etag, http.StatusNotModified},
+		{http.MethodGet, `"other", W/` + etag, http.StatusNotModified},
+		{http.MethodGet, "*", http.StatusNotModified},
+		{http.MethodGet, `"other"`, http.StatusOK},
+		{http.MethodPost, etag, http.StatusOK},
+	}
+	for _, tt := range tests {
+		w := serve(tt.method, tt.ifNoneMatch)
+		if w.Code != tt.want {
+			t.Errorf("%s with If-None-Match %s: got %d, want %d", tt.method, tt.ifNoneMatch, w.Code, tt.want)
+		}
+		if w.Code == http.StatusNotModified && w.Body.Len() > 0 {
+			t.Errorf("304 has a body: %q", w.Body.String())
+		}
+	}
+}
+
+func TestJsonWithVersion(t *testing.T) {
+	updatedAt := time.Date(2026, 1, 1, 0, 0, 0, 123457000, time.UTC)
+	etag := func(payload any) string {
+		w := httptest.NewRecorder()
+		JsonWithVersion(w, httptest.NewRequest(http.MethodGet, "/", nil), http.StatusOK, payload, updatedAt)
+		return w.Header().Get("ETag")
+	}
+
+	a := etag(map[string]string{"name": "a"})
+	if a == etag(map[string]string{"name": "b"}) {
+		t.Errorf("ETag %s did not change with the payload", a)
+	}
+	if v, ok := Version(a); !ok || !v.Equal(updatedAt) {
+		t.Errorf("Version(%s) = %s, %v, want %s", a, v, ok, updatedAt)
+	}
+}
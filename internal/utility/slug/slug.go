@@ -0,0 +1,93 @@
+package slug
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	maxLength = 150
+
+	// maxAttempts bounds how many numeric suffixes Unique tries before giving up.
+	maxAttempts = 50
+)
+
+var (
+	ErrEmpty     = errors.New("unable to generate a slug from name")
+	ErrExhausted = errors.New("unable to find an available slug")
+)
+
+// Make turns a free-form name into a lowercase, hyphen separated slug. Accents
+// are stripped so that "Áo thun nữ" becomes "ao-thun-nu".
+func Make(name string) string {
+	var b strings.Builder
+	lastHyphen := true
+
+	for _, r := range norm.NFD.String(name) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// combining accent left behind by NFD decomposition
+			continue
+		case r == 'đ' || r == 'Đ':
+			r = 'd'
+		}
+
+		r = unicode.ToLower(r)
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			b.WriteRune(r)
+			lastHyphen = false
+			continue
+		}
+
+		if !lastHyphen {
+			b.WriteByte('-')
+			lastHyphen = true
+		}
+	}
+
+	s := strings.Trim(b.String(), "-")
+	if len(s) > maxLength {
+		s = strings.TrimRight(s[:maxLength], "-")
+	}
+
+	return s
+}
+
+// WithSuffix appends a numeric suffix to base, used to resolve uniqueness
+// conflicts: "shirt" becomes "shirt-2", "shirt-3" and so on.
+func WithSuffix(base string, n int) string {
+	return fmt.Sprintf("%s-%d", base, n)
+}
+
+// Valid reports whether s is already in slug form.
+func Valid(s string) bool {
+	return s != "" && Make(s) == s
+}
+
+// Unique generates a slug from name and, while exists reports it as taken,
+// appends an increasing numeric suffix until a free one is found.
+func Unique(ctx context.Context, name string, exists func(ctx context.Context, slug string) (bool, error)) (string, error) {
+	base := Make(name)
+	if base == "" {
+		return "", ErrEmpty
+	}
+
+	candidate := base
+	for i := 2; i <= maxAttempts; i++ {
+		taken, err := exists(ctx, candidate)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return candidate, nil
+		}
+		candidate = WithSuffix(base, i)
+	}
+
+	return "", ErrExhausted
+}
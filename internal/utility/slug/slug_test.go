@@ -0,0 +1,62 @@
+package slug
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestMake(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"simple", "Blue Shirt", "blue-shirt"},
+		{"punctuation", "  Hello, World!! ", "hello-world"},
+		{"accents", "Áo thun nữ", "ao-thun-nu"},
+		{"vietnamese d", "Đồ điện tử", "do-dien-tu"},
+		{"digits", "iPhone 15 Pro Max", "iphone-15-pro-max"},
+		{"only symbols", "***", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Make(tt.in); got != tt.want {
+				t.Errorf("Make(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValid(t *testing.T) {
+	if !Valid("blue-shirt") {
+		t.Error("expected blue-shirt to be valid")
+	}
+	if Valid("Blue Shirt") {
+		t.Error("expected Blue Shirt to be invalid")
+	}
+	if Valid("") {
+		t.Error("expected empty string to be invalid")
+	}
+}
+
+func TestUnique(t *testing.T) {
+	taken := map[string]bool{"blue-shirt": true, "blue-shirt-2": true}
+	exists := func(_ context.Context, s string) (bool, error) {
+		return taken[s], nil
+	}
+
+	got, err := Unique(context.Background(), "Blue Shirt", exists)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "blue-shirt-3" {
+		t.Errorf("Unique() = %q, want %q", got, "blue-shirt-3")
+	}
+
+	_, err = Unique(context.Background(), "!!!", exists)
+	if !errors.Is(err, ErrEmpty) {
+		t.Errorf("Unique() error = %v, want %v", err, ErrEmpty)
+	}
+}
@@ -0,0 +1,76 @@
+package totp
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA1 key of the RFC 6238 test vectors, "12345678901234567890".
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode(t *testing.T) {
+	// RFC 6238 appendix B, truncated to 6 digits.
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+
+	for _, tt := range tests {
+		got, err := Code(rfcSecret, Step(time.Unix(tt.unix, 0)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("Code at %d = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111109, 0)
+
+	step, ok := Validate(rfcSecret, "081804", now, 1)
+	if !ok || step != Step(now) {
+		t.Errorf("current code: step %d, ok %v", step, ok)
+	}
+
+	if _, ok = Validate(rfcSecret, "081804", now.Add(Period), 1); !ok {
+		t.Error("code from the previous step should be accepted with skew 1")
+	}
+	if _, ok = Validate(rfcSecret, "081804", now.Add(2*Period), 1); ok {
+		t.Error("code from two steps ago should be rejected")
+	}
+	if _, ok = Validate(rfcSecret, "000000", now, 1); ok {
+		t.Error("wrong code accepted")
+	}
+	if _, ok = Validate(rfcSecret, "81804", now, 1); ok {
+		t.Error("short code accepted")
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = Code(secret, 1); err != nil {
+		t.Errorf("generated secret is not valid base32: %v", err)
+	}
+}
+
+func TestURI(t *testing.T) {
+	got := URI("Shop", "jane@example.com", "ABC")
+	for _, want := range []string{"otpauth://totp/Shop:jane@example.com?", "secret=ABC", "issuer=Shop", "digits=6", "period=30"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("URI %s is missing %s", got, want)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/thang1834/go-goss/config"
+)
+
+var ErrInvalidKey = errors.New("invalid blob key")
+
+// BlobStore stores opaque blobs under slash separated keys. The methods
+// follow S3's PutObject/DeleteObject semantics so an S3 compatible backend
+// can be dropped in: Put overwrites, and deleting a missing key succeeds.
+type BlobStore interface {
+	Put(ctx context.Context, key string, r io.Reader, contentType string) error
+	Delete(ctx context.Context, key string) error
+	// URL returns the address clients download the blob from.
+	URL(key string) string
+}
+
+// Server is implemented by stores that serve their own blobs, as opposed to
+// a bucket clients download from directly.
+type Server interface {
+	Handler() http.Handler
+}
+
+func New(cfg config.Storage) (BlobStore, error) {
+	switch cfg.Driver {
+	case "local":
+		return NewLocal(cfg.LocalDir, cfg.BaseURL)
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", cfg.Driver)
+	}
+}
+
+// cleanKey rejects keys that are empty or would escape the store's root.
+func cleanKey(key string) (string, error) {
+	cleaned := path.Clean("/" + key)[1:]
+	if cleaned == "" || cleaned != strings.TrimPrefix(key, "/") {
+		return "", ErrInvalidKey
+	}
+	return cleaned, nil
+}
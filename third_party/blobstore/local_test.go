@@ -0,0 +1,62 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLocal(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewLocal(dir, "/media/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	if err = s.Put(ctx, "products/1/a.jpg", strings.NewReader("jpeg"), "image/jpeg"); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.URL("products/1/a.jpg"); got != "/media/products/1/a.jpg" {
+		t.Errorf("URL = %q", got)
+	}
+
+	rr := httptest.NewRecorder()
+	rr.Header().Set("Content-Type", "application/json")
+	s.Handler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/media/products/1/a.jpg", nil))
+	if rr.Code != http.StatusOK || rr.Body.String() != "jpeg" {
+		t.Errorf("serve = %d %q", rr.Code, rr.Body.String())
+	}
+	if got := rr.Header().Get("Content-Type"); got != "image/jpeg" {
+		t.Errorf("Content-Type = %q, want image/jpeg", got)
+	}
+
+	if err = s.Delete(ctx, "products/1/a.jpg"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = os.Stat(filepath.Join(dir, "products", "1", "a.jpg")); !os.IsNotExist(err) {
+		t.Errorf("file still exists: %v", err)
+	}
+	if err = s.Delete(ctx, "products/1/a.jpg"); err != nil {
+		t.Errorf("deleting a missing blob: %v", err)
+	}
+}
+
+func TestLocalRejectsEscapingKeys(t *testing.T) {
+	s, err := NewLocal(t.TempDir(), "/media")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []string{"", "../etc/passwd", "a/../../b", "a//b"} {
+		err = s.Put(context.Background(), key, strings.NewReader("x"), "")
+		if !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("Put(%q) = %v, want ErrInvalidKey", key, err)
+		}
+	}
+}
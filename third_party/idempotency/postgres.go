@@ -0,0 +1,169 @@
+// Package idempotency implements middleware.IdempotencyStore in Postgres
+// and Redis.
+package idempotency
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+// Postgres keeps responses in the idempotency_keys table. It is used when
+// Redis is not enabled.
+type Postgres struct {
+	db          *sql.DB
+	stopCleanup chan bool
+}
+
+func NewPostgres(db *sql.DB) *Postgres {
+	return NewPostgresWithCleanupInterval(db, 5*time.Minute)
+}
+
+// NewPostgresWithCleanupInterval deletes expired keys every cleanupInterval
+// until StopCleanup is called. Zero disables the cleanup.
+func NewPostgresWithCleanupInterval(db *sql.DB, cleanupInterval time.Duration) *Postgres {
+	p := &Postgres{db: db}
+	if cleanupInterval > 0 {
+		p.stopCleanup = make(chan bool)
+		go p.startCleanup(cleanupInterval)
+	}
+	return p
+}
+
+// Claim inserts the key, taking over a row that has expired or whose claim
+// was abandoned. Both are recognised by expires_at, which is only lockTimeout
+// ahead while the request is in flight. Taking a row over hands it to owner,
+// so the request that abandoned it can no longer complete or release it.
+func (p *Postgres) Claim(ctx context.Context, key, owner, fingerprint string, lockTimeout time.Duration) (*middleware.IdempotentResponse, error) {
+	now := time.Now()
+
+	res, err := p.db.ExecContext(ctx, `
+		INSERT INTO idempotency_keys (key, owner, fingerprint, expires_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (key) DO UPDATE
+			SET owner       = excluded.owner,
+				fingerprint = excluded.fingerprint,
+				status_code = NULL,
+				header      = NULL,
+				body        = NULL,
+				expires_at  = excluded.expires_at
+			WHERE idempotency_keys.expires_at <= $5`, key, owner, fingerprint, now.Add(lockTimeout), now)
+	if err != nil {
+		return nil, err
+	}
+	if claimed, err := res.RowsAffected(); err != nil || claimed == 1 {
+		return nil, err
+	}
+
+	var (
+		storedFingerprint string
+		statusCode        sql.NullInt64
+		header            []byte
+		body              []byte
+	)
+	err = p.db.QueryRowContext(ctx, `
+		SELECT fingerprint, status_code, header, body
+		FROM idempotency_keys
+		WHERE key = $1`, key).
+		Scan(&storedFingerprint, &statusCode, &header, &body)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// Deleted by the cleanup in between.
+			return p.Claim(ctx, key, owner, fingerprint, lockTimeout)
+		}
+		return nil, err
+	}
+
+	switch {
+	case storedFingerprint != fingerprint:
+		return nil, middleware.ErrIdempotencyKeyReused
+	case !statusCode.Valid:
+		return nil, middleware.ErrIdempotencyKeyInUse
+	}
+
+	stored := &middleware.IdempotentResponse{
+		StatusCode: int(statusCode.Int64),
+		Body:       body,
+	}
+	if err = json.Unmarshal(header, &stored.Header); err != nil {
+		return nil, err
+	}
+	return stored, nil
+}
+
+func (p *Postgres) Complete(ctx context.Context, key, owner string, res middleware.IdempotentResponse, ttl time.Duration) error {
+	header, err := json.Marshal(res.Header)
+	if err != nil {
+		return err
+	}
+
+	result, err := p.db.ExecContext(ctx, `
+		UPDATE idempotency_keys
+		SET status_code = $3,
+			header      = $4,
+			body        = $5,
+			expires_at  = $6
+		WHERE key = $1
+		  AND owner = $2`, key, owner, res.StatusCode, header, res.Body, time.Now().Add(ttl))
+	if err != nil {
+		return err
+	}
+	return claimLost(result)
+}
+
+func (p *Postgres) Release(ctx context.Context, key, owner string) error {
+	result, err := p.db.ExecContext(ctx, `
+		DELETE FROM idempotency_keys
+		WHERE key = $1
+		  AND owner = $2
+		  AND status_code IS NULL`, key, owner)
+	if err != nil {
+		return err
+	}
+	return claimLost(result)
+}
+
+// claimLost reports a Complete or Release that matched no row because the
+// claim was taken over.
+func claimLost(result sql.Result) error {
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return middleware.ErrIdempotencyClaimLost
+	}
+	return nil
+}
+
+func (p *Postgres) startCleanup(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	for {
+		select {
+		case <-ticker.C:
+			if err := p.deleteExpired(); err != nil {
+				log.Println(err)
+			}
+		case <-p.stopCleanup:
+			ticker.Stop()
+			return
+		}
+	}
+}
+
+// StopCleanup terminates the background cleanup goroutine.
+func (p *Postgres) StopCleanup() {
+	if p.stopCleanup != nil {
+		p.stopCleanup <- true
+	}
+}
+
+func (p *Postgres) deleteExpired() error {
+	_, err := p.db.Exec(`DELETE FROM idempotency_keys WHERE expires_at < $1`, time.Now())
+	return err
+}
@@ -0,0 +1,115 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+// keyPrefix names hashes holding the owner and fingerprint of a claim, and
+// the response once the request has completed.
+const keyPrefix = "idempotency_key:"
+
+// claimScript takes a free key for ARGV[1] and returns nil, or returns the
+// fingerprint and response of the existing claim.
+var claimScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	redis.call("HSET", KEYS[1], "owner", ARGV[1], "fingerprint", ARGV[2])
+	redis.call("PEXPIRE", KEYS[1], ARGV[3])
+	return false
+end
+return redis.call("HMGET", KEYS[1], "fingerprint", "response")
+`)
+
+// completeScript stores the response if ARGV[1] still owns the claim.
+var completeScript = redis.NewScript(`
+if redis.call("HGET", KEYS[1], "owner") ~= ARGV[1] then
+	return 0
+end
+redis.call("HSET", KEYS[1], "response", ARGV[2])
+redis.call("PEXPIRE", KEYS[1], ARGV[3])
+return 1
+`)
+
+// releaseScript deletes the claim if ARGV[1] still owns it.
+var releaseScript = redis.NewScript(`
+if redis.call("HGET", KEYS[1], "owner") ~= ARGV[1] then
+	return 0
+end
+return redis.call("DEL", KEYS[1])
+`)
+
+// Redis keeps responses in Redis, which expires them. Each operation reads
+// and updates one key, so it works with a single node and a cluster.
+type Redis struct {
+	client redis.Scripter
+}
+
+func NewRedis(client redis.Scripter) *Redis {
+	return &Redis{client: client}
+}
+
+func (s *Redis) Claim(ctx context.Context, key, owner, fingerprint string, lockTimeout time.Duration) (*middleware.IdempotentResponse, error) {
+	values, err := claimScript.Run(ctx, s.client, []string{keyPrefix + key},
+		owner,
+		fingerprint,
+		lockTimeout.Milliseconds(),
+	).Slice()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	stored, _ := values[0].(string)
+	if stored != fingerprint {
+		return nil, middleware.ErrIdempotencyKeyReused
+	}
+	b, ok := values[1].(string)
+	if !ok {
+		return nil, middleware.ErrIdempotencyKeyInUse
+	}
+
+	var res middleware.IdempotentResponse
+	if err = json.Unmarshal([]byte(b), &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (s *Redis) Complete(ctx context.Context, key, owner string, res middleware.IdempotentResponse, ttl time.Duration) error {
+	b, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+
+	stored, err := completeScript.Run(ctx, s.client, []string{keyPrefix + key},
+		owner,
+		b,
+		ttl.Milliseconds(),
+	).Int()
+	if err != nil {
+		return err
+	}
+	if stored == 0 {
+		return middleware.ErrIdempotencyClaimLost
+	}
+	return nil
+}
+
+func (s *Redis) Release(ctx context.Context, key, owner string) error {
+	deleted, err := releaseScript.Run(ctx, s.client, []string{keyPrefix + key}, owner).Int()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return middleware.ErrIdempotencyClaimLost
+	}
+	return nil
+}
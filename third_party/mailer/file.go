@@ -0,0 +1,51 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// File writes every email as an .eml file, so local development can open
+// the mails without a mail server.
+type File struct {
+	dir  string
+	from string
+}
+
+func NewFile(dir, from string) (*File, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &File{
+		dir:  dir,
+		from: from,
+	}, nil
+}
+
+func (m *File) Send(_ context.Context, msg Message) error {
+	now := time.Now()
+	b, err := format(m.from, msg, now)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(m.dir, fmt.Sprintf("%s-*.eml", now.Format("20060102T150405")))
+	if err != nil {
+		return err
+	}
+
+	if _, err = f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Files lists the written emails, oldest first.
+func (m *File) Files() ([]string, error) {
+	return filepath.Glob(filepath.Join(m.dir, "*.eml"))
+}
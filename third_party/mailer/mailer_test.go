@@ -0,0 +1,66 @@
+package mailer
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFormat(t *testing.T) {
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	b, err := format("Shop <no-reply@example.com>", Message{
+		To:      "jane@example.com",
+		Subject: "Réinitialiser",
+		Body:    "hello",
+	}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := string(b)
+	for _, want := range []string{
+		"From: Shop <no-reply@example.com>\r\n",
+		"To: <jane@example.com>\r\n",
+		"Subject: =?utf-8?q?R=C3=A9initialiser?=\r\n",
+		"Date: Sun, 18 Oct 2026 09:00:00 +0000\r\n",
+		"\r\n\r\nhello",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("message is missing %q:\n%s", want, got)
+		}
+	}
+
+	if _, err = format("a@example.com", Message{To: "not an address"}, now); err == nil {
+		t.Error("expected an error for an invalid recipient")
+	}
+}
+
+func TestFile(t *testing.T) {
+	m, err := NewFile(t.TempDir(), "no-reply@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = m.Send(context.Background(), Message{To: "jane@example.com", Subject: "Hi", Body: "body"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := m.Files()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("got %d files, want 1", len(files))
+	}
+
+	b, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(string(b), "\r\n\r\nbody") {
+		t.Errorf("unexpected file content:\n%s", b)
+	}
+}
@@ -0,0 +1,52 @@
+package mailer
+
+import (
+	"context"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+)
+
+type SMTP struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+// NewSMTP sends through an SMTP relay. Authentication is skipped when user
+// is empty, as with local catch-all servers such as MailHog.
+func NewSMTP(host, port, user, pass, from string) *SMTP {
+	m := &SMTP{
+		addr: net.JoinHostPort(host, port),
+		from: from,
+	}
+	if user != "" {
+		m.auth = smtp.PlainAuth("", user, pass, host)
+	}
+	return m
+}
+
+func (m *SMTP) Send(ctx context.Context, msg Message) error {
+	b, err := format(m.from, msg, time.Now())
+	if err != nil {
+		return err
+	}
+
+	from, err := mail.ParseAddress(m.from)
+	if err != nil {
+		return err
+	}
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return err
+	}
+
+	// smtp.SendMail takes no context, so a cancelled request only stops us
+	// from starting the delivery.
+	if err = ctx.Err(); err != nil {
+		return err
+	}
+
+	return smtp.SendMail(m.addr, m.auth, from.Address, []string{to.Address}, b)
+}
@@ -0,0 +1,149 @@
+package oidc_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/thang1834/go-goss/third_party/oidc"
+	"github.com/thang1834/go-goss/third_party/oidc/oidctest"
+)
+
+const redirectURL = "http://app.test/callback"
+
+// authorize follows the issuer's authorization redirect and returns the code
+// and state handed back to the client.
+func authorize(t *testing.T, authURL string) (code, state string) {
+	t.Helper()
+
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	res, err := client.Get(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusFound {
+		t.Fatalf("authorize returned %s", res.Status)
+	}
+
+	loc, err := url.Parse(res.Header.Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loc.Query().Get("code"), loc.Query().Get("state")
+}
+
+func TestAuthorizationCodeFlow(t *testing.T) {
+	iss := oidctest.NewIssuer("client", "secret")
+	defer iss.Close()
+	iss.SetUser(oidctest.User{Subject: "42", Email: "jane@example.com", EmailVerified: true})
+
+	ctx := context.Background()
+	p, err := oidc.New(ctx, iss.Config("test", redirectURL), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verifier, _ := oidc.RandomString()
+	code, state := authorize(t, p.AuthCodeURL("state-1", "nonce-1", verifier))
+	if state != "state-1" {
+		t.Errorf("state = %q, want state-1", state)
+	}
+
+	claims, err := p.Exchange(ctx, code, verifier, "nonce-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claims.Subject != "42" || claims.Email != "jane@example.com" || !claims.EmailVerified {
+		t.Errorf("unexpected claims %+v", claims)
+	}
+
+	if _, err = p.Exchange(ctx, code, verifier, "nonce-1"); !errors.Is(err, oidc.ErrExchange) {
+		t.Errorf("reusing a code: got %v, want ErrExchange", err)
+	}
+}
+
+func TestExchangeRejects(t *testing.T) {
+	iss := oidctest.NewIssuer("client", "secret")
+	defer iss.Close()
+	iss.SetUser(oidctest.User{Subject: "42"})
+
+	ctx := context.Background()
+	p, err := oidc.New(ctx, iss.Config("test", redirectURL), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verifier, _ := oidc.RandomString()
+
+	code, _ := authorize(t, p.AuthCodeURL("s", "nonce", verifier))
+	if _, err = p.Exchange(ctx, code, "wrong-verifier", "nonce"); !errors.Is(err, oidc.ErrExchange) {
+		t.Errorf("wrong verifier: got %v, want ErrExchange", err)
+	}
+
+	code, _ = authorize(t, p.AuthCodeURL("s", "nonce", verifier))
+	if _, err = p.Exchange(ctx, code, verifier, "other-nonce"); !errors.Is(err, oidc.ErrInvalidToken) {
+		t.Errorf("wrong nonce: got %v, want ErrInvalidToken", err)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	iss := oidctest.NewIssuer("client", "secret")
+	defer iss.Close()
+
+	ctx := context.Background()
+	p, err := oidc.New(ctx, iss.Config("test", redirectURL), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	valid := func() map[string]any {
+		return map[string]any{
+			"iss":   iss.URL,
+			"sub":   "42",
+			"aud":   []string{"other", "client"},
+			"exp":   now.Add(time.Hour).Unix(),
+			"iat":   now.Unix(),
+			"nonce": "n",
+		}
+	}
+
+	tok, _ := iss.Sign(valid())
+	if _, err = p.Verify(ctx, tok, "n"); err != nil {
+		t.Fatalf("valid token: %v", err)
+	}
+
+	tests := map[string]func(c map[string]any){
+		"issuer":   func(c map[string]any) { c["iss"] = "https://evil.test" },
+		"audience": func(c map[string]any) { c["aud"] = "other" },
+		"expired":  func(c map[string]any) { c["exp"] = now.Add(-time.Hour).Unix() },
+		"future":   func(c map[string]any) { c["iat"] = now.Add(time.Hour).Unix() },
+		"nonce":    func(c map[string]any) { c["nonce"] = "m" },
+	}
+	for name, mutate := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := valid()
+			mutate(c)
+			tok, _ := iss.Sign(c)
+			if _, err := p.Verify(ctx, tok, "n"); !errors.Is(err, oidc.ErrInvalidToken) {
+				t.Errorf("got %v, want ErrInvalidToken", err)
+			}
+		})
+	}
+
+	t.Run("signature", func(t *testing.T) {
+		other := oidctest.NewIssuer("client", "secret")
+		defer other.Close()
+		c := valid()
+		tok, _ := other.Sign(c)
+		if _, err := p.Verify(ctx, tok, "n"); !errors.Is(err, oidc.ErrInvalidToken) {
+			t.Errorf("got %v, want ErrInvalidToken", err)
+		}
+	})
+}
@@ -8,12 +8,19 @@
 //
 //	CREATE TABLE IF NOT EXISTS sessions
 //	(
-//	    token   TEXT PRIMARY KEY,
-//	    user_id BIGINT      NOT NULL CONSTRAINT session_user_fk REFERENCES users ON DELETE CASCADE ,
-//	    data    BYTEA       NOT NULL,
-//	    expiry  TIMESTAMPTZ NOT NULL
+//	    token        TEXT PRIMARY KEY,
+//	    user_id      BIGINT      NOT NULL CONSTRAINT session_user_fk REFERENCES users ON DELETE CASCADE ,
+//	    data         BYTEA       NOT NULL,
+//	    expiry       TIMESTAMPTZ NOT NULL,
+//	    created_at   TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+//	    last_seen_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+//	    ip_address   TEXT,
+//	    user_agent   TEXT
 //	);
 //
+// The client's IP address and user agent are saved from middleware.Client in
+// the context, so users can tell their sessions apart.
+//
 // If number of records in `expiry` column is large, can consider indexing it using BRIN index
 //
 //	CREATE INDEX sessions_expiry_idx ON sessions USING brin (expiry);
@@ -83,19 +90,27 @@ func (p *PostgresStore) CommitCtx(ctx context.Context, token string, b []byte, e
 		userID = nil
 	}
 
+	var ip, userAgent any
+	if client, ok := middleware.ClientFrom(ctx); ok {
+		ip, userAgent = client.IPAddress, client.UserAgent
+	}
+
 	hash, err := sum(token)
 	if err != nil {
 		return err
 	}
 
 	_, err = p.db.ExecContext(ctx, `
-		INSERT INTO sessions (token, user_id, data, expiry) 
-		VALUES ($1, $2, $3, $4) 
+		INSERT INTO sessions (token, user_id, data, expiry, ip_address, user_agent) 
+		VALUES ($1, $2, $3, $4, $5, $6) 
 		ON CONFLICT (token) 
 			DO UPDATE 
 			SET data = EXCLUDED.data, 
-				expiry = EXCLUDED.expiry
-				`, hash, userID, b, expiry)
+				expiry = EXCLUDED.expiry,
+				last_seen_at = current_timestamp,
+				ip_address = COALESCE(EXCLUDED.ip_address, sessions.ip_address),
+				user_agent = COALESCE(EXCLUDED.user_agent, sessions.user_agent)
+				`, hash, userID, b, expiry, ip, userAgent)
 	if err != nil {
 		return err
 	}
@@ -147,6 +162,69 @@ func (p *PostgresStore) AllCtx(ctx context.Context) (map[string][]byte, error) {
 	return sessions, nil
 }
 
+// SessionID returns the ID of a session, which is its hashed token.
+func (p *PostgresStore) SessionID(token string) (string, error) {
+	return sum(token)
+}
+
+// ListUserSessions returns the active sessions of a user, most recently used
+// first.
+func (p *PostgresStore) ListUserSessions(ctx context.Context, userID uint64) ([]middleware.Session, error) {
+	rows, err := p.db.QueryContext(ctx, `
+		SELECT token, COALESCE(ip_address, ''), COALESCE(user_agent, ''), created_at, last_seen_at, expiry
+		FROM sessions
+		WHERE user_id = $1
+		  AND current_timestamp < expiry
+		ORDER BY last_seen_at DESC`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := make([]middleware.Session, 0)
+
+	for rows.Next() {
+		var s middleware.Session
+
+		err = rows.Scan(&s.ID, &s.IPAddress, &s.UserAgent, &s.CreatedAt, &s.LastSeenAt, &s.Expiry)
+		if err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, s)
+	}
+
+	return sessions, rows.Err()
+}
+
+// RevokeUserSession deletes one session of a user by its ID.
+func (p *PostgresStore) RevokeUserSession(ctx context.Context, userID uint64, id string) (bool, error) {
+	res, err := p.db.ExecContext(ctx, "DELETE FROM sessions WHERE token = $1 AND user_id = $2", id, userID)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// RevokeOtherUserSessions deletes every session of a user except keepID.
+func (p *PostgresStore) RevokeOtherUserSessions(ctx context.Context, userID uint64, keepID string) (int, error) {
+	res, err := p.db.ExecContext(ctx, "DELETE FROM sessions WHERE user_id = $1 AND token <> $2", userID, keepID)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(n), nil
+}
+
 // New returns a new PostgresStore instance, with a background cleanup goroutine
 // that runs every 5 minutes to remove expired session data.
 func New(db *sql.DB) *PostgresStore {
@@ -1,7 +1,6 @@
 package postgresstore
 
 import (
-	"bytes"
 	"context"
 	"database/sql"
 	"fmt"
@@ -16,6 +15,7 @@ import (
 
 	"github.com/thang1834/go-goss/config"
 	"github.com/thang1834/go-goss/internal/middleware"
+	"github.com/thang1834/go-goss/third_party/sessiontest"
 )
 
 func TestMain(m *testing.M) {
@@ -71,15 +71,30 @@ CREATE TABLE IF NOT EXISTS users
 	_, err = db.ExecContext(ctx, `
 CREATE TABLE IF NOT EXISTS sessions
 (
-    token   TEXT PRIMARY KEY,
-    user_id BIGINT      NOT NULL CONSTRAINT session_user_fk REFERENCES users ON DELETE CASCADE ,
-    data    BYTEA       NOT NULL,
-    expiry  TIMESTAMPTZ NOT NULL
+    token        TEXT PRIMARY KEY,
+    user_id      BIGINT      NOT NULL CONSTRAINT session_user_fk REFERENCES users ON DELETE CASCADE ,
+    data         BYTEA       NOT NULL,
+    expiry       TIMESTAMPTZ NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    last_seen_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    ip_address   TEXT,
+    user_agent   TEXT
 );`)
 	if err != nil {
 		log.Println(err)
 	}
 
+	// Test databases created before sessions gained client metadata.
+	_, err = db.ExecContext(ctx, `
+ALTER TABLE sessions
+    ADD COLUMN IF NOT EXISTS created_at   TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    ADD COLUMN IF NOT EXISTS last_seen_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    ADD COLUMN IF NOT EXISTS ip_address   TEXT,
+    ADD COLUMN IF NOT EXISTS user_agent   TEXT;`)
+	if err != nil {
+		log.Println(err)
+	}
+
 	_, err = db.ExecContext(ctx, `
 INSERT INTO users (email, password, verified_at) 
 VALUES ($1, $2, $3)
@@ -94,86 +109,34 @@ ON CONFLICT DO NOTHING
 	os.Exit(code)
 }
 
-func TestFind(t *testing.T) {
-	if testing.Short() {
-		t.Skip("skipping integration test")
-	}
+// newStore empties the sessions table and returns a store on it.
+func newStore(t *testing.T) sessiontest.Store {
+	t.Helper()
 
-	dsn := os.Getenv("SCS_POSTGRES_TEST_DSN")
-	db, err := sql.Open("postgres", dsn)
+	db, err := sql.Open("postgres", os.Getenv("SCS_POSTGRES_TEST_DSN"))
 	if err != nil {
 		t.Fatal(err)
 	}
-	defer db.Close()
+	t.Cleanup(func() { _ = db.Close() })
 	if err = db.Ping(); err != nil {
 		t.Fatal(err)
 	}
-	_, err = db.Exec("TRUNCATE TABLE sessions")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	hash, err := sum("session_token")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	_, err = db.Exec(`
-INSERT INTO sessions
-VALUES($1, $2, $3, current_timestamp + interval '1 minute')`, hash, 1, "encoded_data")
-	if err != nil {
+	if _, err = db.Exec("TRUNCATE TABLE sessions"); err != nil {
 		t.Fatal(err)
 	}
 
-	p := NewWithCleanupInterval(db, 0)
-
-	ctx := context.Background()
-
-	b, found, err := p.FindCtx(ctx, "session_token")
-	if err != nil {
-		t.Fatal(err)
-	}
-	if found != true {
-		t.Fatalf("got %v: expected %v", found, true)
-	}
-	if bytes.Equal(b, []byte("encoded_data")) == false {
-		t.Fatalf("got %v: expected %v", b, []byte("encoded_data"))
-	}
+	return NewWithCleanupInterval(db, 0)
 }
 
-func TestFindMissing(t *testing.T) {
+func TestStore(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
 
-	dsn := os.Getenv("SCS_POSTGRES_TEST_DSN")
-	db, err := sql.Open("postgres", dsn)
-	if err != nil {
-		t.Fatal(err)
-	}
-	defer db.Close()
-	if err = db.Ping(); err != nil {
-		t.Fatal(err)
-	}
-	_, err = db.Exec("TRUNCATE TABLE sessions")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	p := NewWithCleanupInterval(db, 0)
-
-	ctx := context.Background()
-
-	_, found, err := p.FindCtx(ctx, "missing_session_token")
-	if err != nil {
-		t.Fatalf("got %v: expected %v", err, nil)
-	}
-	if found != false {
-		t.Fatalf("got %v: expected %v", found, false)
-	}
+	sessiontest.Run(t, newStore)
 }
 
-func TestSaveNew(t *testing.T) {
+func TestSaveHashesToken(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
@@ -218,145 +181,6 @@ func TestSaveNew(t *testing.T) {
 	}
 }
 
-func TestSaveUpdated(t *testing.T) {
-	if testing.Short() {
-		t.Skip("skipping integration test")
-	}
-
-	dsn := os.Getenv("SCS_POSTGRES_TEST_DSN")
-	db, err := sql.Open("postgres", dsn)
-	if err != nil {
-		t.Fatal(err)
-	}
-	defer db.Close()
-	if err = db.Ping(); err != nil {
-		t.Fatal(err)
-	}
-	_, err = db.Exec("TRUNCATE TABLE sessions")
-	if err != nil {
-		t.Fatal(err)
-	}
-	_, err = db.Exec("INSERT INTO sessions VALUES('session_token', 1, 'encoded_data', current_timestamp + interval '1 minute')")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	p := NewWithCleanupInterval(db, 0)
-
-	ctx := context.Background()
-	ctx = context.WithValue(ctx, middleware.KeyID, uint64(1))
-
-	err = p.CommitCtx(ctx, "session_token", []byte("new_encoded_data"), time.Now().Add(time.Minute))
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	hash, err := sum("session_token")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	row := db.QueryRow("SELECT data FROM sessions WHERE token = $1", hash)
-	var data []byte
-	err = row.Scan(&data)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if reflect.DeepEqual(data, []byte("new_encoded_data")) == false {
-		t.Fatalf("got %v: expected %v", data, []byte("new_encoded_data"))
-	}
-}
-
-func TestExpiry(t *testing.T) {
-	if testing.Short() {
-		t.Skip("skipping integration test")
-	}
-
-	dsn := os.Getenv("SCS_POSTGRES_TEST_DSN")
-	db, err := sql.Open("postgres", dsn)
-	if err != nil {
-		t.Fatal(err)
-	}
-	defer db.Close()
-	if err = db.Ping(); err != nil {
-		t.Fatal(err)
-	}
-	_, err = db.Exec("TRUNCATE TABLE sessions")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	p := NewWithCleanupInterval(db, 0)
-
-	ctx := context.Background()
-	ctx = context.WithValue(ctx, middleware.KeyID, uint64(1))
-
-	err = p.CommitCtx(ctx, "session_token", []byte("encoded_data"), time.Now().Add(100*time.Millisecond))
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	_, found, _ := p.FindCtx(ctx, "session_token")
-	if found != true {
-		t.Fatalf("got %v: expected %v", found, true)
-	}
-
-	time.Sleep(100 * time.Millisecond)
-	_, found, _ = p.FindCtx(ctx, "session_token")
-	if found != false {
-		t.Fatalf("got %v: expected %v", found, false)
-	}
-}
-
-func TestDelete(t *testing.T) {
-	if testing.Short() {
-		t.Skip("skipping integration test")
-	}
-
-	dsn := os.Getenv("SCS_POSTGRES_TEST_DSN")
-	db, err := sql.Open("postgres", dsn)
-	if err != nil {
-		t.Fatal(err)
-	}
-	defer db.Close()
-	if err = db.Ping(); err != nil {
-		t.Fatal(err)
-	}
-	_, err = db.Exec("TRUNCATE TABLE sessions")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	hash, err := sum("session_token")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	_, err = db.Exec("INSERT INTO sessions VALUES($1, 1, 'encoded_data', current_timestamp + interval '1 minute')", hash)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	p := NewWithCleanupInterval(db, 0)
-
-	ctx := context.Background()
-
-	err = p.DeleteCtx(ctx, "session_token")
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	row := db.QueryRow("SELECT COUNT(*) FROM sessions WHERE token = 'session_token'")
-	var count int
-	err = row.Scan(&count)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if count != 0 {
-		t.Fatalf("got %d: expected %d", count, 0)
-	}
-}
-
 func TestCleanup(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
@@ -433,3 +257,4 @@ func TestStopNilCleanup(t *testing.T) {
 	// A send to a nil channel will block forever
 	p.StopCleanup()
 }
+
@@ -0,0 +1,48 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+// sweepInterval is how often Memory forgets keys whose bucket is full.
+const sweepInterval = time.Minute
+
+// Memory keeps rate limits in process. Each server instance counts on its
+// own, so it is meant for a single instance or when Redis is not available.
+type Memory struct {
+	mu        sync.Mutex
+	tats      map[string]time.Time
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		tats: make(map[string]time.Time),
+		now:  time.Now,
+	}
+}
+
+func (m *Memory) Allow(_ context.Context, key string, rate middleware.Rate) (middleware.RateResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	if now.Sub(m.lastSweep) > sweepInterval {
+		for k, tat := range m.tats {
+			if !tat.After(now) {
+				delete(m.tats, k)
+			}
+		}
+		m.lastSweep = now
+	}
+
+	tat, res := allow(now, m.tats[key], rate)
+	m.tats[key] = tat
+
+	return res, nil
+}
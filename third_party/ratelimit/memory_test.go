@@ -0,0 +1,59 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+func TestMemory(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	m := NewMemory()
+	m.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	rate := middleware.Rate{Limit: 3, Period: time.Minute}
+
+	for i := 2; i >= 0; i-- {
+		res, err := m.Allow(ctx, "a", rate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !res.Allowed || res.Remaining != i {
+			t.Fatalf("got %+v, want allowed with %d remaining", res, i)
+		}
+	}
+
+	res, _ := m.Allow(ctx, "a", rate)
+	if res.Allowed {
+		t.Fatal("fourth request in a burst of three was allowed")
+	}
+	if res.RetryAfter != 20*time.Second {
+		t.Errorf("RetryAfter = %s, want 20s", res.RetryAfter)
+	}
+	if res.Reset != time.Minute {
+		t.Errorf("Reset = %s, want 1m", res.Reset)
+	}
+
+	if res, _ = m.Allow(ctx, "b", rate); !res.Allowed {
+		t.Error("another key shares the limit")
+	}
+
+	// One request is let through every Period/Limit.
+	now = now.Add(20 * time.Second)
+	if res, _ = m.Allow(ctx, "a", rate); !res.Allowed || res.Remaining != 0 {
+		t.Errorf("got %+v after 20s, want allowed with 0 remaining", res)
+	}
+
+	// The full burst is available once the bucket has refilled, and idle keys
+	// are forgotten.
+	now = now.Add(2 * time.Minute)
+	if res, _ = m.Allow(ctx, "a", rate); !res.Allowed || res.Remaining != 2 {
+		t.Errorf("got %+v after refill, want allowed with 2 remaining", res)
+	}
+	if _, ok := m.tats["b"]; ok {
+		t.Error("idle key was not swept")
+	}
+}
@@ -0,0 +1,37 @@
+// Package ratelimit implements middleware.RateLimiter with the generic cell
+// rate algorithm (GCRA), a token bucket that keeps a single timestamp per
+// key: the theoretical arrival time (TAT) at which the bucket is full again.
+package ratelimit
+
+import (
+	"time"
+
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+// allow decides a request arriving at now for a key whose bucket is full
+// again at tat, returning the new TAT to store if it is allowed.
+func allow(now, tat time.Time, rate middleware.Rate) (time.Time, middleware.RateResult) {
+	emission := rate.Period / time.Duration(rate.Limit)
+
+	if tat.Before(now) {
+		tat = now
+	}
+	newTAT := tat.Add(emission)
+	allowAt := newTAT.Add(-rate.Period)
+
+	if now.Before(allowAt) {
+		return tat, middleware.RateResult{
+			Limit:      rate.Limit,
+			Reset:      tat.Sub(now),
+			RetryAfter: allowAt.Sub(now),
+		}
+	}
+
+	return newTAT, middleware.RateResult{
+		Allowed:   true,
+		Limit:     rate.Limit,
+		Remaining: int(now.Sub(allowAt) / emission),
+		Reset:     newTAT.Sub(now),
+	}
+}
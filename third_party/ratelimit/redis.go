@@ -0,0 +1,70 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+const keyPrefix = "ratelimit:"
+
+// script mirrors allow with times in microseconds. It reads and updates one
+// key, so it runs on a cluster as well as on a single node.
+var script = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local emission = tonumber(ARGV[2])
+local period = tonumber(ARGV[3])
+
+local tat = tonumber(redis.call("GET", KEYS[1]) or now)
+if tat < now then
+	tat = now
+end
+local new_tat = tat + emission
+local allow_at = new_tat - period
+
+if now < allow_at then
+	return {0, tat - now, allow_at - now}
+end
+
+redis.call("SET", KEYS[1], string.format("%.0f", new_tat), "PX", math.ceil((new_tat - now) / 1000))
+return {1, new_tat - now, now - allow_at}
+`)
+
+// Redis keeps rate limits in Redis so that every server instance shares
+// them. The clock of the calling instance is used.
+type Redis struct {
+	client redis.Scripter
+}
+
+func NewRedis(client redis.Scripter) *Redis {
+	return &Redis{client: client}
+}
+
+func (s *Redis) Allow(ctx context.Context, key string, rate middleware.Rate) (middleware.RateResult, error) {
+	emission := rate.Period / time.Duration(rate.Limit)
+
+	values, err := script.Run(ctx, s.client, []string{keyPrefix + key},
+		time.Now().UnixMicro(),
+		emission.Microseconds(),
+		rate.Period.Microseconds(),
+	).Int64Slice()
+	if err != nil {
+		return middleware.RateResult{}, err
+	}
+
+	res := middleware.RateResult{
+		Allowed: values[0] == 1,
+		Limit:   rate.Limit,
+		Reset:   time.Duration(values[1]) * time.Microsecond,
+	}
+	if res.Allowed {
+		res.Remaining = int(time.Duration(values[2]) * time.Microsecond / emission)
+	} else {
+		res.RetryAfter = time.Duration(values[2]) * time.Microsecond
+	}
+
+	return res, nil
+}
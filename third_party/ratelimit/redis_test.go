@@ -0,0 +1,81 @@
+package ratelimit
+
+import (
+	"context"
+	"log"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thang1834/go-goss/config"
+	"github.com/thang1834/go-goss/internal/middleware"
+	redisLib "github.com/thang1834/go-goss/third_party/redis"
+)
+
+var cfg config.Cache
+
+func TestMain(m *testing.M) {
+	getwd, err := os.Getwd()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	if strings.Contains(getwd, "/third_party/ratelimit") {
+		err := os.Chdir("../../")
+		if err != nil {
+			log.Println(err)
+		}
+	}
+
+	cfg = config.New().Cache
+
+	code := m.Run()
+	os.Exit(code)
+}
+
+func TestRedis(t *testing.T) {
+	if testing.Short() {
+		t.Skip("needs redis")
+	}
+
+	client := redisLib.New(cfg)
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx := context.Background()
+	key := "test:" + time.Now().Format(time.RFC3339Nano)
+	t.Cleanup(func() { client.Del(ctx, keyPrefix+key) })
+
+	s := NewRedis(client)
+	rate := middleware.Rate{Limit: 3, Period: time.Minute}
+
+	for i := 2; i >= 0; i-- {
+		res, err := s.Allow(ctx, key, rate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !res.Allowed || res.Remaining != i {
+			t.Fatalf("got %+v, want allowed with %d remaining", res, i)
+		}
+	}
+
+	res, err := s.Allow(ctx, key, rate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Allowed {
+		t.Fatal("fourth request in a burst of three was allowed")
+	}
+	if res.RetryAfter <= 19*time.Second || res.RetryAfter > 20*time.Second {
+		t.Errorf("RetryAfter = %s, want about 20s", res.RetryAfter)
+	}
+
+	ttl, err := client.PTTL(ctx, keyPrefix+key).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ttl <= 0 || ttl > time.Minute {
+		t.Errorf("key expires in %s, want within a minute", ttl)
+	}
+}
@@ -0,0 +1,333 @@
+// Package redisstore is a Redis implementation of the scs session store and
+// an alternative to postgresstore with the same behaviour:
+//
+//  1. Tokens are hashed before being saved into Redis.
+//  2. Sessions are indexed by user for the purpose of user session
+//     invalidation, listing and revocation.
+//
+// A session is a hash at "session:<hashed token>" with the fields data,
+// user_id, created_at, last_seen_at, ip_address and user_agent, expiring
+// together with the session. The sessions of a user are a sorted set at
+// "user_sessions:<user id>" of hashed tokens scored by their expiry in unix
+// milliseconds.
+package redisstore
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+const (
+	sessionPrefix = "session:"
+	userPrefix    = "user_sessions:"
+)
+
+// RedisStore represents the session store.
+type RedisStore struct {
+	client redis.UniversalClient
+}
+
+// New returns a new RedisStore. Expired sessions are removed by Redis, so
+// unlike postgresstore there is no cleanup goroutine.
+func New(client redis.UniversalClient) *RedisStore {
+	return &RedisStore{client: client}
+}
+
+func (p *RedisStore) Delete(token string) error {
+	return p.DeleteCtx(context.Background(), token)
+}
+
+func (p *RedisStore) Find(token string) ([]byte, bool, error) {
+	return p.FindCtx(context.Background(), token)
+}
+
+func (p *RedisStore) Commit(token string, b []byte, expiry time.Time) error {
+	return p.CommitCtx(context.Background(), token, b, expiry)
+}
+
+// FindCtx returns the data for a given session token. If the session token is
+// not found or is expired, the returned exists flag will be set to false.
+func (p *RedisStore) FindCtx(ctx context.Context, token string) ([]byte, bool, error) {
+	hash, err := sum(token)
+	if err != nil {
+		return nil, false, err
+	}
+
+	b, err := p.client.HGet(ctx, sessionKey(hash), "data").Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return b, true, nil
+}
+
+// CommitCtx adds a session token and data with the given expiry time, or
+// updates them if the token exists. As in postgresstore the user ID and client
+// are read from the request context.
+func (p *RedisStore) CommitCtx(ctx context.Context, token string, b []byte, expiry time.Time) error {
+	userID, loggedIn := ctx.Value(middleware.KeyID).(uint64)
+
+	hash, err := sum(token)
+	if err != nil {
+		return err
+	}
+
+	key := sessionKey(hash)
+	now := time.Now().UnixMilli()
+
+	fields := map[string]any{
+		"data":         b,
+		"last_seen_at": now,
+	}
+	if loggedIn {
+		fields["user_id"] = userID
+	}
+	if client, ok := middleware.ClientFrom(ctx); ok {
+		fields["ip_address"] = client.IPAddress
+		fields["user_agent"] = client.UserAgent
+	}
+
+	var latest *redis.ZSliceCmd
+	_, err = p.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSetNX(ctx, key, "created_at", now)
+		pipe.HSet(ctx, key, fields)
+		pipe.PExpireAt(ctx, key, expiry)
+
+		if loggedIn {
+			index := userKey(userID)
+			pipe.ZAdd(ctx, index, redis.Z{Score: float64(expiry.UnixMilli()), Member: hash})
+			pipe.ZRemRangeByScore(ctx, index, "-inf", strconv.FormatInt(now, 10))
+			latest = pipe.ZRangeWithScores(ctx, index, -1, -1)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// The index lives as long as the last of its sessions.
+	if latest != nil && len(latest.Val()) > 0 {
+		last := time.UnixMilli(int64(latest.Val()[0].Score))
+		return p.client.PExpireAt(ctx, userKey(userID), last).Err()
+	}
+	return nil
+}
+
+// DeleteCtx removes a session token and corresponding data.
+func (p *RedisStore) DeleteCtx(ctx context.Context, token string) error {
+	hash, err := sum(token)
+	if err != nil {
+		return err
+	}
+
+	key := sessionKey(hash)
+
+	userID, err := p.client.HGet(ctx, key, "user_id").Uint64()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return err
+	}
+
+	_, err = p.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		if userID != 0 {
+			pipe.ZRem(ctx, userKey(userID), hash)
+		}
+		return nil
+	})
+	return err
+}
+
+// AllCtx returns a map containing the hashed token and data for all active
+// sessions.
+func (p *RedisStore) AllCtx(ctx context.Context) (map[string][]byte, error) {
+	var mu sync.Mutex
+	sessions := make(map[string][]byte)
+
+	scan := func(ctx context.Context, client redis.UniversalClient) error {
+		iter := client.Scan(ctx, 0, sessionPrefix+"*", 100).Iterator()
+		for iter.Next(ctx) {
+			b, err := client.HGet(ctx, iter.Val(), "data").Bytes()
+			if errors.Is(err, redis.Nil) {
+				continue
+			} else if err != nil {
+				return err
+			}
+
+			mu.Lock()
+			sessions[strings.TrimPrefix(iter.Val(), sessionPrefix)] = b
+			mu.Unlock()
+		}
+		return iter.Err()
+	}
+
+	// Keys are spread over the masters of a cluster.
+	if cluster, ok := p.client.(*redis.ClusterClient); ok {
+		err := cluster.ForEachMaster(ctx, func(ctx context.Context, node *redis.Client) error {
+			return scan(ctx, node)
+		})
+		return sessions, err
+	}
+
+	return sessions, scan(ctx, p.client)
+}
+
+// SessionID returns the ID of a session, which is its hashed token.
+func (p *RedisStore) SessionID(token string) (string, error) {
+	return sum(token)
+}
+
+// ListUserSessions returns the active sessions of a user, most recently used
+// first.
+func (p *RedisStore) ListUserSessions(ctx context.Context, userID uint64) ([]middleware.Session, error) {
+	members, err := p.userSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	cmds := make([]*redis.MapStringStringCmd, len(members))
+	_, err = p.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, m := range members {
+			cmds[i] = pipe.HGetAll(ctx, sessionKey(m.Member.(string)))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]middleware.Session, 0, len(members))
+	for i, cmd := range cmds {
+		fields := cmd.Val()
+		if len(fields) == 0 {
+			// Deleted without going through DeleteCtx, e.g. evicted.
+			continue
+		}
+
+		sessions = append(sessions, middleware.Session{
+			ID:         members[i].Member.(string),
+			IPAddress:  fields["ip_address"],
+			UserAgent:  fields["user_agent"],
+			CreatedAt:  unixMilli(fields["created_at"]),
+			LastSeenAt: unixMilli(fields["last_seen_at"]),
+			Expiry:     time.UnixMilli(int64(members[i].Score)),
+		})
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeenAt.After(sessions[j].LastSeenAt)
+	})
+
+	return sessions, nil
+}
+
+// RevokeUserSession deletes one session of a user by its ID.
+func (p *RedisStore) RevokeUserSession(ctx context.Context, userID uint64, id string) (bool, error) {
+	index := userKey(userID)
+
+	err := p.client.ZScore(ctx, index, id).Err()
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	var deleted *redis.IntCmd
+	_, err = p.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		deleted = pipe.Del(ctx, sessionKey(id))
+		pipe.ZRem(ctx, index, id)
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return deleted.Val() > 0, nil
+}
+
+// RevokeOtherUserSessions deletes every session of a user except keepID.
+func (p *RedisStore) RevokeOtherUserSessions(ctx context.Context, userID uint64, keepID string) (int, error) {
+	members, err := p.userSessions(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	index := userKey(userID)
+
+	var deleted []*redis.IntCmd
+	_, err = p.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, m := range members {
+			id := m.Member.(string)
+			if id == keepID {
+				continue
+			}
+			// One key per command, as sessions live in different cluster
+			// slots.
+			deleted = append(deleted, pipe.Del(ctx, sessionKey(id)))
+			pipe.ZRem(ctx, index, id)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var n int
+	for _, cmd := range deleted {
+		n += int(cmd.Val())
+	}
+	return n, nil
+}
+
+// userSessions returns the index entries of a user's unexpired sessions.
+func (p *RedisStore) userSessions(ctx context.Context, userID uint64) ([]redis.Z, error) {
+	index := userKey(userID)
+	now := strconv.FormatInt(time.Now().UnixMilli(), 10)
+
+	var members *redis.ZSliceCmd
+	_, err := p.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRemRangeByScore(ctx, index, "-inf", now)
+		members = pipe.ZRangeWithScores(ctx, index, 0, -1)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return members.Val(), nil
+}
+
+func sessionKey(hash string) string {
+	return sessionPrefix + hash
+}
+
+func userKey(userID uint64) string {
+	return userPrefix + strconv.FormatUint(userID, 10)
+}
+
+func unixMilli(s string) time.Time {
+	ms, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
+
+func sum(token string) (string, error) {
+	h := xxhash.New()
+	_, err := h.Write([]byte(token))
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
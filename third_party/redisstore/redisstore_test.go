@@ -0,0 +1,118 @@
+package redisstore
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/thang1834/go-goss/config"
+	"github.com/thang1834/go-goss/internal/middleware"
+	redisLib "github.com/thang1834/go-goss/third_party/redis"
+	"github.com/thang1834/go-goss/third_party/sessiontest"
+)
+
+var cfg config.Cache
+
+func TestMain(m *testing.M) {
+	getwd, err := os.Getwd()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	if strings.Contains(getwd, "/third_party/redisstore") {
+		err := os.Chdir("../../")
+		if err != nil {
+			log.Println(err)
+		}
+	}
+
+	cfg = config.New().Cache
+
+	code := m.Run()
+	os.Exit(code)
+}
+
+// newClient connects to the configured Redis and removes the keys left by
+// previous tests.
+func newClient(t *testing.T) *redis.Client {
+	t.Helper()
+
+	client := redisLib.New(cfg)
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx := context.Background()
+	if err := client.Ping(ctx).Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, pattern := range []string{sessionPrefix + "*", userPrefix + "*"} {
+		iter := client.Scan(ctx, 0, pattern, 100).Iterator()
+		for iter.Next(ctx) {
+			if err := client.Del(ctx, iter.Val()).Err(); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := iter.Err(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return client
+}
+
+func TestStore(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	sessiontest.Run(t, func(t *testing.T) sessiontest.Store {
+		return New(newClient(t))
+	})
+}
+
+func TestSaveHashesToken(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	client := newClient(t)
+	p := New(client)
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, middleware.KeyID, uint64(1))
+
+	err := p.CommitCtx(ctx, "session_token", []byte("encoded_data"), time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hash, err := sum("session_token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := client.HGet(ctx, sessionKey(hash), "data").Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(data, []byte("encoded_data")) == false {
+		t.Fatalf("got %v: expected %v", data, []byte("encoded_data"))
+	}
+
+	// Tokens are only stored hashed.
+	exists, err := client.Exists(ctx, sessionKey("session_token")).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exists != 0 {
+		t.Fatal("plain token was used as a key")
+	}
+}
+
@@ -0,0 +1,117 @@
+package responsecache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+// LRU keeps up to size responses in process, dropping the least recently
+// used one to make room. Each server instance has its own, so responses
+// invalidated on another instance are served until they expire.
+type LRU struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+	tags    map[string]map[string]struct{}
+	// invalidated is when each tag was last invalidated.
+	invalidated map[string]time.Time
+	now         func() time.Time
+}
+
+type lruEntry struct {
+	key string
+	res middleware.CachedResponse
+}
+
+func NewLRU(size int) *LRU {
+	return &LRU{
+		size:        max(size, 1),
+		order:       list.New(),
+		entries:     make(map[string]*list.Element),
+		tags:        make(map[string]map[string]struct{}),
+		invalidated: make(map[string]time.Time),
+		now:         time.Now,
+	}
+}
+
+func (c *LRU) Get(_ context.Context, key string) (*middleware.CachedResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, nil
+	}
+	e := el.Value.(*lruEntry)
+	if !e.res.Expires.After(c.now()) {
+		c.remove(el)
+		return nil, nil
+	}
+	c.order.MoveToFront(el)
+
+	res := e.res
+	return &res, nil
+}
+
+func (c *LRU) Set(_ context.Context, key string, res middleware.CachedResponse) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, tag := range res.Tags {
+		if at, ok := c.invalidated[tag]; ok && !at.Before(res.RequestedAt) {
+			return nil
+		}
+	}
+
+	if el, ok := c.entries[key]; ok {
+		c.remove(el)
+	}
+
+	c.entries[key] = c.order.PushFront(&lruEntry{key: key, res: res})
+	for _, tag := range res.Tags {
+		if c.tags[tag] == nil {
+			c.tags[tag] = make(map[string]struct{})
+		}
+		c.tags[tag][key] = struct{}{}
+	}
+
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+
+	return nil
+}
+
+func (c *LRU) Invalidate(_ context.Context, tags ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	for _, tag := range tags {
+		c.invalidated[tag] = now
+		for key := range c.tags[tag] {
+			if el, ok := c.entries[key]; ok {
+				c.remove(el)
+			}
+		}
+		delete(c.tags, tag)
+	}
+
+	return nil
+}
+
+func (c *LRU) remove(el *list.Element) {
+	e := c.order.Remove(el).(*lruEntry)
+	delete(c.entries, e.key)
+	for _, tag := range e.res.Tags {
+		delete(c.tags[tag], e.key)
+		if len(c.tags[tag]) == 0 {
+			delete(c.tags, tag)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package responsecache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+func TestLRU(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewLRU(2)
+	c.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	set := func(key string, ttl time.Duration, tags ...string) {
+		t.Helper()
+		err := c.Set(ctx, key, middleware.CachedResponse{Body: []byte(key), Tags: tags, Expires: now.Add(ttl)})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	cached := func(key string) bool {
+		t.Helper()
+		res, err := c.Get(ctx, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res != nil
+	}
+
+	set("a", time.Minute, "products")
+	set("b", time.Minute, "categories")
+	if !cached("a") {
+		t.Fatal("a was not cached")
+	}
+
+	// b is now the least recently used.
+	set("c", time.Minute, "products", "categories")
+	if cached("b") {
+		t.Error("b was not evicted")
+	}
+	if !cached("a") || !cached("c") {
+		t.Error("a or c was evicted")
+	}
+
+	if err := c.Invalidate(ctx, "categories"); err != nil {
+		t.Fatal(err)
+	}
+	if cached("c") {
+		t.Error("c was not invalidated with its tag")
+	}
+	if !cached("a") {
+		t.Error("a was invalidated without its tag")
+	}
+
+	set("d", time.Second)
+	now = now.Add(time.Second)
+	if cached("d") {
+		t.Error("d was served after it expired")
+	}
+
+	// e was requested before its tag was invalidated and stored after.
+	requested := now
+	now = now.Add(time.Second)
+	if err := c.Invalidate(ctx, "products"); err != nil {
+		t.Fatal(err)
+	}
+	err := c.Set(ctx, "e", middleware.CachedResponse{Tags: []string{"products"}, RequestedAt: requested, Expires: now.Add(time.Minute)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cached("e") {
+		t.Error("e was stored although its tag was invalidated while it was requested")
+	}
+}
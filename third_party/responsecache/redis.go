@@ -0,0 +1,149 @@
+package responsecache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+const (
+	keyPrefix         = "response:"
+	tagPrefix         = "response_tag:"
+	invalidatedPrefix = "response_tag_invalidated:"
+)
+
+// Redis keeps responses in Redis, shared by every server instance. Each tag
+// is a set of the keys stored with it, next to the time it was last
+// invalidated in Unix nanoseconds. It works with a single node and a
+// cluster.
+//
+// The times are compared with RequestedAt, which comes from another
+// instance's clock, so clocks must agree to well within a request.
+type Redis struct {
+	client redis.Cmdable
+}
+
+func NewRedis(client redis.Cmdable) *Redis {
+	return &Redis{client: client}
+}
+
+func (c *Redis) Get(ctx context.Context, key string) (*middleware.CachedResponse, error) {
+	b, err := c.client.Get(ctx, keyPrefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var res middleware.CachedResponse
+	if err = json.Unmarshal(b, &res); err != nil {
+		return nil, err
+	}
+
+	// Set cannot check for an invalidation and store the response in one
+	// step on a cluster, so a response stored just after its tag was
+	// invalidated is caught here.
+	stale, err := c.invalidatedSince(ctx, res.Tags, res.RequestedAt)
+	if err != nil || stale {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (c *Redis) Set(ctx context.Context, key string, res middleware.CachedResponse) error {
+	ttl := time.Until(res.Expires)
+	if ttl <= 0 {
+		return nil
+	}
+
+	stale, err := c.invalidatedSince(ctx, res.Tags, res.RequestedAt)
+	if err != nil || stale {
+		return err
+	}
+
+	b, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+
+	// Keys and tags may live on different cluster nodes, so they are
+	// pipelined rather than sent in one transaction.
+	_, err = c.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, keyPrefix+key, b, ttl)
+		for _, tag := range res.Tags {
+			pipe.SAdd(ctx, tagPrefix+tag, keyPrefix+key)
+			pipe.Expire(ctx, tagPrefix+tag, ttl)
+		}
+		return nil
+	})
+
+	return err
+}
+
+// Invalidate records the time before dropping the keys, so that a response
+// stored meanwhile is not served.
+func (c *Redis) Invalidate(ctx context.Context, tags ...string) error {
+	for _, tag := range tags {
+		err := c.client.Set(ctx, invalidatedPrefix+tag, time.Now().UnixNano(), 0).Err()
+		if err != nil {
+			return err
+		}
+
+		keys, err := c.client.SMembers(ctx, tagPrefix+tag).Result()
+		if err != nil {
+			return err
+		}
+
+		_, err = c.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			for _, key := range keys {
+				pipe.Del(ctx, key)
+			}
+			pipe.Del(ctx, tagPrefix+tag)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// invalidatedSince tells whether one of tags was invalidated at or after t.
+func (c *Redis) invalidatedSince(ctx context.Context, tags []string, t time.Time) (bool, error) {
+	if len(tags) == 0 {
+		return false, nil
+	}
+
+	cmds := make([]*redis.StringCmd, len(tags))
+	_, err := c.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, tag := range tags {
+			cmds[i] = pipe.Get(ctx, invalidatedPrefix+tag)
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return false, err
+	}
+
+	for _, cmd := range cmds {
+		at, err := cmd.Int64()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+		if at >= t.UnixNano() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,94 @@
+package responsecache
+
+import (
+	"context"
+	"log"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thang1834/go-goss/config"
+	"github.com/thang1834/go-goss/internal/middleware"
+	redisLib "github.com/thang1834/go-goss/third_party/redis"
+)
+
+var cfg config.Cache
+
+func TestMain(m *testing.M) {
+	getwd, err := os.Getwd()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	if strings.Contains(getwd, "/third_party/responsecache") {
+		err := os.Chdir("../../")
+		if err != nil {
+			log.Println(err)
+		}
+	}
+
+	cfg = config.New().Cache
+
+	code := m.Run()
+	os.Exit(code)
+}
+
+func TestRedis(t *testing.T) {
+	if testing.Short() {
+		t.Skip("needs redis")
+	}
+
+	client := redisLib.New(cfg)
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx := context.Background()
+	key := "test:" + time.Now().Format(time.RFC3339Nano)
+	tag := "test_tag:" + key
+
+	c := NewRedis(client)
+	t.Cleanup(func() {
+		_ = c.Invalidate(ctx, tag)
+		_ = client.Del(ctx, invalidatedPrefix+tag).Err()
+	})
+
+	requested := time.Now()
+	err := c.Set(ctx, key, middleware.CachedResponse{
+		Body:        []byte("ok"),
+		Tags:        []string{tag},
+		RequestedAt: requested,
+		Expires:     time.Now().Add(time.Minute),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := c.Get(ctx, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res == nil || string(res.Body) != "ok" {
+		t.Fatalf("got %+v, want the stored response", res)
+	}
+
+	if err = c.Invalidate(ctx, tag); err != nil {
+		t.Fatal(err)
+	}
+	if res, err = c.Get(ctx, key); err != nil || res != nil {
+		t.Errorf("got %+v, %v after invalidating its tag", res, err)
+	}
+
+	err = c.Set(ctx, key, middleware.CachedResponse{
+		Body:        []byte("stale"),
+		Tags:        []string{tag},
+		RequestedAt: requested,
+		Expires:     time.Now().Add(time.Minute),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res, err = c.Get(ctx, key); err != nil || res != nil {
+		t.Errorf("got %+v, %v for a response requested before its tag was invalidated", res, err)
+	}
+}
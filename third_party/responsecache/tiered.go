@@ -0,0 +1,57 @@
+package responsecache
+
+import (
+	"context"
+	"errors"
+
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+// Tiered looks a response up in each of its caches in turn, fastest first,
+// and copies it into the faster ones it was missing from.
+type Tiered struct {
+	tiers []middleware.ResponseCache
+}
+
+func NewTiered(tiers ...middleware.ResponseCache) *Tiered {
+	return &Tiered{tiers: tiers}
+}
+
+func (c *Tiered) Get(ctx context.Context, key string) (*middleware.CachedResponse, error) {
+	for i, tier := range c.tiers {
+		res, err := tier.Get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			continue
+		}
+
+		for _, faster := range c.tiers[:i] {
+			if err = faster.Set(ctx, key, *res); err != nil {
+				return nil, err
+			}
+		}
+		return res, nil
+	}
+
+	return nil, nil
+}
+
+func (c *Tiered) Set(ctx context.Context, key string, res middleware.CachedResponse) error {
+	var errs []error
+	for _, tier := range c.tiers {
+		errs = append(errs, tier.Set(ctx, key, res))
+	}
+	return errors.Join(errs...)
+}
+
+// Invalidate drops the slower tiers first so that a concurrent Get cannot
+// copy a dropped response back into a faster one.
+func (c *Tiered) Invalidate(ctx context.Context, tags ...string) error {
+	var errs []error
+	for i := len(c.tiers) - 1; i >= 0; i-- {
+		errs = append(errs, c.tiers[i].Invalidate(ctx, tags...))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,205 @@
+// Package sessiontest checks that a session store keeps the contract the
+// session middleware relies on. Each store runs it from its own tests.
+package sessiontest
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gmhafiz/scs/v2"
+
+	"github.com/thang1834/go-goss/internal/middleware"
+)
+
+// Store is a session store that can list sessions by user.
+type Store interface {
+	scs.CtxStore
+	middleware.SessionIndex
+}
+
+// Run runs the contract tests against the stores returned by newStore, which
+// must hold no sessions. User 1 must be able to own sessions.
+func Run(t *testing.T, newStore func(t *testing.T) Store) {
+	t.Run("Find", func(t *testing.T) { testFind(t, newStore(t)) })
+	t.Run("FindMissing", func(t *testing.T) { testFindMissing(t, newStore(t)) })
+	t.Run("SaveUpdated", func(t *testing.T) { testSaveUpdated(t, newStore(t)) })
+	t.Run("Expiry", func(t *testing.T) { testExpiry(t, newStore(t)) })
+	t.Run("Delete", func(t *testing.T) { testDelete(t, newStore(t)) })
+	t.Run("UserSessions", func(t *testing.T) { testUserSessions(t, newStore(t)) })
+}
+
+func userContext() context.Context {
+	return context.WithValue(context.Background(), middleware.KeyID, uint64(1))
+}
+
+func testFind(t *testing.T, p Store) {
+	ctx := userContext()
+
+	err := p.CommitCtx(ctx, "session_token", []byte("encoded_data"), time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, found, err := p.FindCtx(ctx, "session_token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found != true {
+		t.Fatalf("got %v: expected %v", found, true)
+	}
+	if bytes.Equal(b, []byte("encoded_data")) == false {
+		t.Fatalf("got %v: expected %v", b, []byte("encoded_data"))
+	}
+}
+
+func testFindMissing(t *testing.T, p Store) {
+	_, found, err := p.FindCtx(context.Background(), "missing_session_token")
+	if err != nil {
+		t.Fatalf("got %v: expected %v", err, nil)
+	}
+	if found != false {
+		t.Fatalf("got %v: expected %v", found, false)
+	}
+}
+
+func testSaveUpdated(t *testing.T, p Store) {
+	ctx := userContext()
+
+	err := p.CommitCtx(ctx, "session_token", []byte("encoded_data"), time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = p.CommitCtx(ctx, "session_token", []byte("new_encoded_data"), time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, found, err := p.FindCtx(ctx, "session_token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !found || bytes.Equal(b, []byte("new_encoded_data")) == false {
+		t.Fatalf("got %v: expected %v", b, []byte("new_encoded_data"))
+	}
+}
+
+func testExpiry(t *testing.T, p Store) {
+	ctx := userContext()
+
+	err := p.CommitCtx(ctx, "session_token", []byte("encoded_data"), time.Now().Add(100*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, found, _ := p.FindCtx(ctx, "session_token")
+	if found != true {
+		t.Fatalf("got %v: expected %v", found, true)
+	}
+
+	time.Sleep(150 * time.Millisecond)
+	_, found, _ = p.FindCtx(ctx, "session_token")
+	if found != false {
+		t.Fatalf("got %v: expected %v", found, false)
+	}
+}
+
+func testDelete(t *testing.T, p Store) {
+	ctx := userContext()
+
+	err := p.CommitCtx(ctx, "session_token", []byte("encoded_data"), time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = p.DeleteCtx(ctx, "session_token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, found, _ := p.FindCtx(ctx, "session_token")
+	if found {
+		t.Fatal("deleted session can still be found")
+	}
+
+	sessions, err := p.ListUserSessions(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 0 {
+		t.Fatalf("got %d sessions: expected %d", len(sessions), 0)
+	}
+}
+
+func testUserSessions(t *testing.T, p Store) {
+	ctx := userContext()
+	ctx = context.WithValue(ctx, middleware.KeyClient, middleware.Client{IPAddress: "192.0.2.1", UserAgent: "test"})
+
+	for _, token := range []string{"one", "two", "three"} {
+		err := p.CommitCtx(ctx, token, []byte("encoded_data"), time.Now().Add(time.Minute))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	sessions, err := p.ListUserSessions(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 3 {
+		t.Fatalf("got %d sessions: expected %d", len(sessions), 3)
+	}
+	if sessions[0].IPAddress != "192.0.2.1" || sessions[0].UserAgent != "test" {
+		t.Fatalf("got %+v: expected client metadata", sessions[0])
+	}
+
+	one, err := p.SessionID("one")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := p.RevokeUserSession(ctx, 2, one)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found {
+		t.Fatal("revoked a session of another user")
+	}
+
+	n, err := p.RevokeOtherUserSessions(ctx, 1, one)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Fatalf("got %d: expected %d", n, 2)
+	}
+
+	found, err = p.RevokeUserSession(ctx, 1, one)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !found {
+		t.Fatal("expected the remaining session to be revoked")
+	}
+
+	// An empty keepID logs the user out everywhere, as Repo.Logout does.
+	err = p.CommitCtx(ctx, "four", []byte("encoded_data"), time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, err = p.RevokeOtherUserSessions(ctx, 1, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Fatalf("got %d: expected %d", n, 1)
+	}
+
+	for _, token := range []string{"one", "four"} {
+		_, exists, _ := p.FindCtx(ctx, token)
+		if exists {
+			t.Fatalf("revoked session %q can still be found", token)
+		}
+	}
+}